@@ -47,6 +47,7 @@ func NewOraclePreBlockHandler(
 	strategy currencypair.CurrencyPairStrategy,
 	veCodec codec.VoteExtensionCodec,
 	ecCodec codec.ExtendedCommitCodec,
+	opts ...abciaggregator.PriceApplierOption,
 ) *PreBlockHandler {
 	va := abciaggregator.NewDefaultVoteAggregator(
 		logger,
@@ -59,6 +60,7 @@ func NewOraclePreBlockHandler(
 		veCodec,
 		ecCodec,
 		logger,
+		opts...,
 	)
 
 	return &PreBlockHandler{
@@ -635,8 +635,8 @@ func (s *PreBlockTestSuite) TestValidatorReports() {
 
 		// mock oracle keeper calls
 		mockOracleKeeper.On("GetAllCurrencyPairs", s.ctx).Return([]slinkytypes.CurrencyPair{btcUsd, mogUsd}, nil)
-		mockOracleKeeper.On("SetPriceForCurrencyPair", s.ctx, btcUsd, mock.Anything).Return(nil)
-		mockOracleKeeper.On("SetPriceForCurrencyPair", s.ctx, mogUsd, mock.Anything).Return(nil)
+		mockOracleKeeper.On("ApplyPriceUpdate", s.ctx, btcUsd, mock.Anything, uint64(2)).Return(nil)
+		mockOracleKeeper.On("ApplyPriceUpdate", s.ctx, mogUsd, mock.Anything, uint64(1)).Return(nil)
 
 		// create extended commit info
 		val1Vote, err := testutils.CreateExtendedVoteInfo(val1, map[uint64][]byte{
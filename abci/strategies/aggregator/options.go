@@ -0,0 +1,17 @@
+package aggregator
+
+import (
+	"github.com/skip-mev/slinky/pkg/math/voteweighted"
+)
+
+// PriceApplierOption is a function that enables optional configuration of the oraclePriceApplier.
+type PriceApplierOption func(*oraclePriceApplier)
+
+// WithPriceStatistics returns a PriceApplierOption that configures the price applier to compute the statistics of the
+// prices reported by validators, i.e. their dispersion and the fraction of voting power that reported them, and to
+// write them to state alongside each price.
+func WithPriceStatistics(fn voteweighted.StatisticsFnFromContext) PriceApplierOption {
+	return func(opa *oraclePriceApplier) {
+		opa.statsFn = fn
+	}
+}
@@ -9,6 +9,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/skip-mev/slinky/abci/strategies/codec"
 	slinkyabcitypes "github.com/skip-mev/slinky/abci/types"
+	"github.com/skip-mev/slinky/aggregator"
+	"github.com/skip-mev/slinky/pkg/math/voteweighted"
 	oracletypes "github.com/skip-mev/slinky/x/oracle/types"
 
 	slinkytypes "github.com/skip-mev/slinky/pkg/types"
@@ -44,6 +46,10 @@ type oraclePriceApplier struct {
 	// codecs
 	voteExtensionCodec  codec.VoteExtensionCodec
 	extendedCommitCodec codec.ExtendedCommitCodec
+
+	// statsFn is used to compute the statistics of the prices reported by validators. If nil, no statistics are
+	// written to state.
+	statsFn voteweighted.StatisticsFnFromContext
 }
 
 // NewOraclePriceApplier returns a new oraclePriceApplier.
@@ -53,14 +59,21 @@ func NewOraclePriceApplier(
 	voteExtensionCodec codec.VoteExtensionCodec,
 	extendedCommitCodec codec.ExtendedCommitCodec,
 	logger log.Logger,
+	opts ...PriceApplierOption,
 ) PriceApplier {
-	return &oraclePriceApplier{
+	opa := &oraclePriceApplier{
 		va:                  va,
 		ok:                  ok,
 		logger:              logger,
 		voteExtensionCodec:  voteExtensionCodec,
 		extendedCommitCodec: extendedCommitCodec,
 	}
+
+	for _, opt := range opts {
+		opt(opa)
+	}
+
+	return opa
 }
 
 func (opa *oraclePriceApplier) ApplyPricesFromVoteExtensions(ctx sdk.Context, req *cometabci.RequestFinalizeBlock) (map[slinkytypes.CurrencyPair]*big.Int, error) {
@@ -93,6 +106,26 @@ func (opa *oraclePriceApplier) ApplyPricesFromVoteExtensions(ctx sdk.Context, re
 		return nil, err
 	}
 
+	// Count the number of validators that contributed a price for each currency pair, and collect the prices
+	// reported by each validator to compute the price statistics.
+	numValidators := make(map[slinkytypes.CurrencyPair]uint64)
+	providers := make(aggregator.AggregatedProviderData[string, map[slinkytypes.CurrencyPair]*big.Int], len(votes))
+	for _, vote := range votes {
+		validatorPrices := opa.va.GetPriceForValidator(vote.ConsAddress)
+		providers[vote.ConsAddress.String()] = validatorPrices
+
+		for cp, price := range validatorPrices {
+			if price != nil {
+				numValidators[cp]++
+			}
+		}
+	}
+
+	var stats map[slinkytypes.CurrencyPair]voteweighted.PriceStatistics
+	if opa.statsFn != nil {
+		stats = opa.statsFn(ctx)(providers)
+	}
+
 	currencyPairs := opa.ok.GetAllCurrencyPairs(ctx)
 	for _, cp := range currencyPairs {
 		price, ok := prices[cp]
@@ -102,6 +135,7 @@ func (opa *oraclePriceApplier) ApplyPricesFromVoteExtensions(ctx sdk.Context, re
 				"currency_pair", cp.String(),
 			)
 
+			opa.ok.ApplyMissedPrice(ctx, cp)
 			continue
 		}
 
@@ -112,6 +146,7 @@ func (opa *oraclePriceApplier) ApplyPricesFromVoteExtensions(ctx sdk.Context, re
 				"price", price.String(),
 			)
 
+			opa.ok.ApplyMissedPrice(ctx, cp)
 			continue
 		}
 
@@ -122,7 +157,14 @@ func (opa *oraclePriceApplier) ApplyPricesFromVoteExtensions(ctx sdk.Context, re
 			BlockHeight:    uint64(ctx.BlockHeight()),
 		}
 
-		if err := opa.ok.SetPriceForCurrencyPair(ctx, cp, quotePrice); err != nil {
+		if stat, ok := stats[cp]; ok && stat.InterquartileRange != nil {
+			dispersion := math.NewIntFromBigInt(stat.InterquartileRange)
+			votingPowerFraction := stat.VotingPowerFraction
+			quotePrice.Dispersion = &dispersion
+			quotePrice.VotingPowerFraction = &votingPowerFraction
+		}
+
+		if err := opa.ok.ApplyPriceUpdate(ctx, cp, quotePrice, numValidators[cp]); err != nil {
 			opa.logger.Error(
 				"failed to set price for currency pair",
 				"currency_pair", cp.String(),
@@ -15,8 +15,11 @@ import (
 	abcimocks "github.com/skip-mev/slinky/abci/types/mocks"
 
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	vetypes "github.com/skip-mev/slinky/abci/ve/types"
+	slinkyaggregator "github.com/skip-mev/slinky/aggregator"
+	"github.com/skip-mev/slinky/pkg/math/voteweighted"
 	slinkytypes "github.com/skip-mev/slinky/pkg/types"
 	oracletypes "github.com/skip-mev/slinky/x/oracle/types"
 	"github.com/stretchr/testify/mock"
@@ -127,10 +130,17 @@ func TestPriceApplier(t *testing.T) {
 			cp: big.NewInt(-100),
 		}, nil)
 
+		va.On("GetPriceForValidator", ca).Return(map[slinkytypes.CurrencyPair]*big.Int{
+			cp: big.NewInt(-100),
+		}).Once()
+
 		ok.On("GetAllCurrencyPairs", ctx).Return(
 			[]slinkytypes.CurrencyPair{cp},
 		)
 
+		// negative prices are reported as missed
+		ok.On("ApplyMissedPrice", ctx, cp).Return().Once()
+
 		_, err = pa.ApplyPricesFromVoteExtensions(ctx, &abcitypes.RequestFinalizeBlock{
 			Txs: [][]byte{extCommitInfoBz},
 		})
@@ -196,17 +206,30 @@ func TestPriceApplier(t *testing.T) {
 			cp: big.NewInt(150),
 		}, nil)
 
+		// both validators contributed a price
+		va.On("GetPriceForValidator", ca1).Return(map[slinkytypes.CurrencyPair]*big.Int{
+			cp: big.NewInt(100),
+		}).Once()
+		va.On("GetPriceForValidator", ca2).Return(map[slinkytypes.CurrencyPair]*big.Int{
+			cp: big.NewInt(200),
+		}).Once()
+
 		// return multiple prices
 		ok.On("GetAllCurrencyPairs", ctx).Return(
-			[]slinkytypes.CurrencyPair{cp, slinkytypes.NewCurrencyPair("ETH", "USD")}, // ignore last cp
+			[]slinkytypes.CurrencyPair{cp, slinkytypes.NewCurrencyPair("ETH", "USD")}, // last cp is missed
 		)
 
-		ok.On("SetPriceForCurrencyPair", ctx, cp, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		ok.On("ApplyMissedPrice", ctx, slinkytypes.NewCurrencyPair("ETH", "USD")).Return().Once()
+		ok.On("ApplyPriceUpdate", ctx, cp, mock.Anything, uint64(2)).Return(nil).Run(func(args mock.Arguments) {
 			qp := args.Get(2).(oracletypes.QuotePrice)
 
 			require.Equal(t, qp.Price.BigInt(), big.NewInt(150))
 			require.Equal(t, qp.BlockTimestamp, ctx.BlockHeader().Time)
 			require.Equal(t, qp.BlockHeight, uint64(ctx.BlockHeight()))
+
+			// no statistics are written unless configured
+			require.Nil(t, qp.Dispersion)
+			require.Nil(t, qp.VotingPowerFraction)
 		})
 
 		prices, err := pa.ApplyPricesFromVoteExtensions(ctx, &abcitypes.RequestFinalizeBlock{
@@ -228,3 +251,88 @@ func TestPriceApplier(t *testing.T) {
 		require.Equal(t, expPrices, valPrices)
 	})
 }
+
+func TestPriceApplierWithStatistics(t *testing.T) {
+	veCodec := codec.NewDefaultVoteExtensionCodec()
+	extCommitcodec := codec.NewDefaultExtendedCommitCodec()
+
+	va := mocks.NewVoteAggregator(t)
+	ok := abcimocks.NewOracleKeeper(t)
+
+	cp := slinkytypes.NewCurrencyPair("BTC", "USD")
+	ca1 := sdk.ConsAddress("val1")
+	ca2 := sdk.ConsAddress("val2")
+
+	statsFn := func(_ sdk.Context) voteweighted.StatisticsFn {
+		return func(providers slinkyaggregator.AggregatedProviderData[string, map[slinkytypes.CurrencyPair]*big.Int]) map[slinkytypes.CurrencyPair]voteweighted.PriceStatistics {
+			// the statistics are computed from the prices reported by each validator
+			require.Equal(t, slinkyaggregator.AggregatedProviderData[string, map[slinkytypes.CurrencyPair]*big.Int]{
+				ca1.String(): {cp: big.NewInt(100)},
+				ca2.String(): {cp: big.NewInt(200)},
+			}, providers)
+
+			return map[slinkytypes.CurrencyPair]voteweighted.PriceStatistics{
+				cp: {
+					InterquartileRange:  big.NewInt(100),
+					VotingPowerFraction: math.LegacyNewDecWithPrec(8, 1),
+				},
+			}
+		}
+	}
+
+	pa := aggregator.NewOraclePriceApplier(
+		va,
+		ok,
+		veCodec,
+		extCommitcodec,
+		log.NewNopLogger(),
+		aggregator.WithPriceStatistics(statsFn),
+	)
+
+	prices1 := map[uint64][]byte{
+		1: big.NewInt(100).Bytes(),
+	}
+	prices2 := map[uint64][]byte{
+		1: big.NewInt(200).Bytes(),
+	}
+
+	vote1, err := testutils.CreateExtendedVoteInfo(ca1, prices1, veCodec)
+	require.NoError(t, err)
+
+	vote2, err := testutils.CreateExtendedVoteInfo(ca2, prices2, veCodec)
+	require.NoError(t, err)
+
+	_, extCommitInfoBz, err := testutils.CreateExtendedCommitInfo(
+		[]abcitypes.ExtendedVoteInfo{vote1, vote2},
+		extCommitcodec,
+	)
+	require.NoError(t, err)
+
+	ctx := sdk.Context{}.WithBlockHeader(cmtproto.Header{
+		Time: time.Now(),
+	}).WithBlockHeight(1)
+
+	va.On("AggregateOracleVotes", ctx, mock.Anything).Return(map[slinkytypes.CurrencyPair]*big.Int{
+		cp: big.NewInt(150),
+	}, nil).Once()
+	va.On("GetPriceForValidator", ca1).Return(map[slinkytypes.CurrencyPair]*big.Int{
+		cp: big.NewInt(100),
+	}).Once()
+	va.On("GetPriceForValidator", ca2).Return(map[slinkytypes.CurrencyPair]*big.Int{
+		cp: big.NewInt(200),
+	}).Once()
+
+	ok.On("GetAllCurrencyPairs", ctx).Return([]slinkytypes.CurrencyPair{cp}).Once()
+	ok.On("ApplyPriceUpdate", ctx, cp, mock.Anything, uint64(2)).Return(nil).Run(func(args mock.Arguments) {
+		qp := args.Get(2).(oracletypes.QuotePrice)
+
+		require.Equal(t, math.NewInt(150), qp.Price)
+		require.Equal(t, math.NewInt(100), *qp.Dispersion)
+		require.Equal(t, math.LegacyNewDecWithPrec(8, 1), *qp.VotingPowerFraction)
+	}).Once()
+
+	_, err = pa.ApplyPricesFromVoteExtensions(ctx, &abcitypes.RequestFinalizeBlock{
+		Txs: [][]byte{extCommitInfoBz},
+	})
+	require.NoError(t, err)
+}
@@ -13,5 +13,6 @@ import (
 //go:generate mockery --name OracleKeeper --filename mock_oracle_keeper.go
 type OracleKeeper interface { //golint:ignore
 	GetAllCurrencyPairs(ctx sdk.Context) []slinkytypes.CurrencyPair
-	SetPriceForCurrencyPair(ctx sdk.Context, cp slinkytypes.CurrencyPair, qp oracletypes.QuotePrice) error
+	ApplyPriceUpdate(ctx sdk.Context, cp slinkytypes.CurrencyPair, qp oracletypes.QuotePrice, numValidators uint64) error
+	ApplyMissedPrice(ctx sdk.Context, cp slinkytypes.CurrencyPair)
 }
@@ -16,39 +16,44 @@ type OracleKeeper struct {
 	mock.Mock
 }
 
-// GetAllCurrencyPairs provides a mock function with given fields: ctx
-func (_m *OracleKeeper) GetAllCurrencyPairs(ctx types.Context) []pkgtypes.CurrencyPair {
-	ret := _m.Called(ctx)
+// ApplyMissedPrice provides a mock function with given fields: ctx, cp
+func (_m *OracleKeeper) ApplyMissedPrice(ctx types.Context, cp pkgtypes.CurrencyPair) {
+	_m.Called(ctx, cp)
+}
+
+// ApplyPriceUpdate provides a mock function with given fields: ctx, cp, qp, numValidators
+func (_m *OracleKeeper) ApplyPriceUpdate(ctx types.Context, cp pkgtypes.CurrencyPair, qp oracletypes.QuotePrice, numValidators uint64) error {
+	ret := _m.Called(ctx, cp, qp, numValidators)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetAllCurrencyPairs")
+		panic("no return value specified for ApplyPriceUpdate")
 	}
 
-	var r0 []pkgtypes.CurrencyPair
-	if rf, ok := ret.Get(0).(func(types.Context) []pkgtypes.CurrencyPair); ok {
-		r0 = rf(ctx)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.Context, pkgtypes.CurrencyPair, oracletypes.QuotePrice, uint64) error); ok {
+		r0 = rf(ctx, cp, qp, numValidators)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]pkgtypes.CurrencyPair)
-		}
+		r0 = ret.Error(0)
 	}
 
 	return r0
 }
 
-// SetPriceForCurrencyPair provides a mock function with given fields: ctx, cp, qp
-func (_m *OracleKeeper) SetPriceForCurrencyPair(ctx types.Context, cp pkgtypes.CurrencyPair, qp oracletypes.QuotePrice) error {
-	ret := _m.Called(ctx, cp, qp)
+// GetAllCurrencyPairs provides a mock function with given fields: ctx
+func (_m *OracleKeeper) GetAllCurrencyPairs(ctx types.Context) []pkgtypes.CurrencyPair {
+	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SetPriceForCurrencyPair")
+		panic("no return value specified for GetAllCurrencyPairs")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(types.Context, pkgtypes.CurrencyPair, oracletypes.QuotePrice) error); ok {
-		r0 = rf(ctx, cp, qp)
+	var r0 []pkgtypes.CurrencyPair
+	if rf, ok := ret.Get(0).(func(types.Context) []pkgtypes.CurrencyPair); ok {
+		r0 = rf(ctx)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pkgtypes.CurrencyPair)
+		}
 	}
 
 	return r0
@@ -593,6 +593,7 @@ var (
 	fd_Ticker_currency_pair      protoreflect.FieldDescriptor
 	fd_Ticker_decimals           protoreflect.FieldDescriptor
 	fd_Ticker_min_provider_count protoreflect.FieldDescriptor
+	fd_Ticker_status             protoreflect.FieldDescriptor
 	fd_Ticker_enabled            protoreflect.FieldDescriptor
 	fd_Ticker_metadata_JSON      protoreflect.FieldDescriptor
 )
@@ -603,6 +604,7 @@ func init() {
 	fd_Ticker_currency_pair = md_Ticker.Fields().ByName("currency_pair")
 	fd_Ticker_decimals = md_Ticker.Fields().ByName("decimals")
 	fd_Ticker_min_provider_count = md_Ticker.Fields().ByName("min_provider_count")
+	fd_Ticker_status = md_Ticker.Fields().ByName("status")
 	fd_Ticker_enabled = md_Ticker.Fields().ByName("enabled")
 	fd_Ticker_metadata_JSON = md_Ticker.Fields().ByName("metadata_JSON")
 }
@@ -690,6 +692,12 @@ func (x *fastReflection_Ticker) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_Ticker_status, value) {
+			return
+		}
+	}
 	if x.Enabled != false {
 		value := protoreflect.ValueOfBool(x.Enabled)
 		if !f(fd_Ticker_enabled, value) {
@@ -723,6 +731,8 @@ func (x *fastReflection_Ticker) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.Decimals != uint64(0)
 	case "slinky.marketmap.v1.Ticker.min_provider_count":
 		return x.MinProviderCount != uint64(0)
+	case "slinky.marketmap.v1.Ticker.status":
+		return x.Status != 0
 	case "slinky.marketmap.v1.Ticker.enabled":
 		return x.Enabled != false
 	case "slinky.marketmap.v1.Ticker.metadata_JSON":
@@ -749,6 +759,8 @@ func (x *fastReflection_Ticker) Clear(fd protoreflect.FieldDescriptor) {
 		x.Decimals = uint64(0)
 	case "slinky.marketmap.v1.Ticker.min_provider_count":
 		x.MinProviderCount = uint64(0)
+	case "slinky.marketmap.v1.Ticker.status":
+		x.Status = 0
 	case "slinky.marketmap.v1.Ticker.enabled":
 		x.Enabled = false
 	case "slinky.marketmap.v1.Ticker.metadata_JSON":
@@ -778,6 +790,9 @@ func (x *fastReflection_Ticker) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "slinky.marketmap.v1.Ticker.min_provider_count":
 		value := x.MinProviderCount
 		return protoreflect.ValueOfUint64(value)
+	case "slinky.marketmap.v1.Ticker.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
 	case "slinky.marketmap.v1.Ticker.enabled":
 		value := x.Enabled
 		return protoreflect.ValueOfBool(value)
@@ -810,6 +825,8 @@ func (x *fastReflection_Ticker) Set(fd protoreflect.FieldDescriptor, value proto
 		x.Decimals = value.Uint()
 	case "slinky.marketmap.v1.Ticker.min_provider_count":
 		x.MinProviderCount = value.Uint()
+	case "slinky.marketmap.v1.Ticker.status":
+		x.Status = (MarketStatus)(value.Enum())
 	case "slinky.marketmap.v1.Ticker.enabled":
 		x.Enabled = value.Bool()
 	case "slinky.marketmap.v1.Ticker.metadata_JSON":
@@ -843,6 +860,8 @@ func (x *fastReflection_Ticker) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field decimals of message slinky.marketmap.v1.Ticker is not mutable"))
 	case "slinky.marketmap.v1.Ticker.min_provider_count":
 		panic(fmt.Errorf("field min_provider_count of message slinky.marketmap.v1.Ticker is not mutable"))
+	case "slinky.marketmap.v1.Ticker.status":
+		panic(fmt.Errorf("field status of message slinky.marketmap.v1.Ticker is not mutable"))
 	case "slinky.marketmap.v1.Ticker.enabled":
 		panic(fmt.Errorf("field enabled of message slinky.marketmap.v1.Ticker is not mutable"))
 	case "slinky.marketmap.v1.Ticker.metadata_JSON":
@@ -867,6 +886,8 @@ func (x *fastReflection_Ticker) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfUint64(uint64(0))
 	case "slinky.marketmap.v1.Ticker.min_provider_count":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "slinky.marketmap.v1.Ticker.status":
+		return protoreflect.ValueOfEnum(0)
 	case "slinky.marketmap.v1.Ticker.enabled":
 		return protoreflect.ValueOfBool(false)
 	case "slinky.marketmap.v1.Ticker.metadata_JSON":
@@ -950,6 +971,9 @@ func (x *fastReflection_Ticker) ProtoMethods() *protoiface.Methods {
 		if x.MinProviderCount != 0 {
 			n += 1 + runtime.Sov(uint64(x.MinProviderCount))
 		}
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
+		}
 		if x.Enabled {
 			n += 2
 		}
@@ -1003,6 +1027,11 @@ func (x *fastReflection_Ticker) ProtoMethods() *protoiface.Methods {
 			i--
 			dAtA[i] = 0x70
 		}
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x20
+		}
 		if x.MinProviderCount != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinProviderCount))
 			i--
@@ -1150,6 +1179,25 @@ func (x *fastReflection_Ticker) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= MarketStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			case 14:
 				if wireType != 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
@@ -1237,13 +1285,65 @@ func (x *fastReflection_Ticker) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_ProviderConfig_5_list)(nil)
+
+type _ProviderConfig_5_list struct {
+	list *[]*v1.CurrencyPair
+}
+
+func (x *_ProviderConfig_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ProviderConfig_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_ProviderConfig_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1.CurrencyPair)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ProviderConfig_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1.CurrencyPair)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ProviderConfig_5_list) AppendMutable() protoreflect.Value {
+	v := new(v1.CurrencyPair)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ProviderConfig_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ProviderConfig_5_list) NewElement() protoreflect.Value {
+	v := new(v1.CurrencyPair)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ProviderConfig_5_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_ProviderConfig                   protoreflect.MessageDescriptor
-	fd_ProviderConfig_name              protoreflect.FieldDescriptor
-	fd_ProviderConfig_off_chain_ticker  protoreflect.FieldDescriptor
-	fd_ProviderConfig_normalize_by_pair protoreflect.FieldDescriptor
-	fd_ProviderConfig_invert            protoreflect.FieldDescriptor
-	fd_ProviderConfig_metadata_JSON     protoreflect.FieldDescriptor
+	md_ProviderConfig                    protoreflect.MessageDescriptor
+	fd_ProviderConfig_name               protoreflect.FieldDescriptor
+	fd_ProviderConfig_off_chain_ticker   protoreflect.FieldDescriptor
+	fd_ProviderConfig_normalize_by_pair  protoreflect.FieldDescriptor
+	fd_ProviderConfig_invert             protoreflect.FieldDescriptor
+	fd_ProviderConfig_normalization_path protoreflect.FieldDescriptor
+	fd_ProviderConfig_metadata_JSON      protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1253,6 +1353,7 @@ func init() {
 	fd_ProviderConfig_off_chain_ticker = md_ProviderConfig.Fields().ByName("off_chain_ticker")
 	fd_ProviderConfig_normalize_by_pair = md_ProviderConfig.Fields().ByName("normalize_by_pair")
 	fd_ProviderConfig_invert = md_ProviderConfig.Fields().ByName("invert")
+	fd_ProviderConfig_normalization_path = md_ProviderConfig.Fields().ByName("normalization_path")
 	fd_ProviderConfig_metadata_JSON = md_ProviderConfig.Fields().ByName("metadata_JSON")
 }
 
@@ -1345,6 +1446,12 @@ func (x *fastReflection_ProviderConfig) Range(f func(protoreflect.FieldDescripto
 			return
 		}
 	}
+	if len(x.NormalizationPath) != 0 {
+		value := protoreflect.ValueOfList(&_ProviderConfig_5_list{list: &x.NormalizationPath})
+		if !f(fd_ProviderConfig_normalization_path, value) {
+			return
+		}
+	}
 	if x.Metadata_JSON != "" {
 		value := protoreflect.ValueOfString(x.Metadata_JSON)
 		if !f(fd_ProviderConfig_metadata_JSON, value) {
@@ -1374,6 +1481,8 @@ func (x *fastReflection_ProviderConfig) Has(fd protoreflect.FieldDescriptor) boo
 		return x.NormalizeByPair != nil
 	case "slinky.marketmap.v1.ProviderConfig.invert":
 		return x.Invert != false
+	case "slinky.marketmap.v1.ProviderConfig.normalization_path":
+		return len(x.NormalizationPath) != 0
 	case "slinky.marketmap.v1.ProviderConfig.metadata_JSON":
 		return x.Metadata_JSON != ""
 	default:
@@ -1400,6 +1509,8 @@ func (x *fastReflection_ProviderConfig) Clear(fd protoreflect.FieldDescriptor) {
 		x.NormalizeByPair = nil
 	case "slinky.marketmap.v1.ProviderConfig.invert":
 		x.Invert = false
+	case "slinky.marketmap.v1.ProviderConfig.normalization_path":
+		x.NormalizationPath = nil
 	case "slinky.marketmap.v1.ProviderConfig.metadata_JSON":
 		x.Metadata_JSON = ""
 	default:
@@ -1430,6 +1541,12 @@ func (x *fastReflection_ProviderConfig) Get(descriptor protoreflect.FieldDescrip
 	case "slinky.marketmap.v1.ProviderConfig.invert":
 		value := x.Invert
 		return protoreflect.ValueOfBool(value)
+	case "slinky.marketmap.v1.ProviderConfig.normalization_path":
+		if len(x.NormalizationPath) == 0 {
+			return protoreflect.ValueOfList(&_ProviderConfig_5_list{})
+		}
+		listValue := &_ProviderConfig_5_list{list: &x.NormalizationPath}
+		return protoreflect.ValueOfList(listValue)
 	case "slinky.marketmap.v1.ProviderConfig.metadata_JSON":
 		value := x.Metadata_JSON
 		return protoreflect.ValueOfString(value)
@@ -1461,6 +1578,10 @@ func (x *fastReflection_ProviderConfig) Set(fd protoreflect.FieldDescriptor, val
 		x.NormalizeByPair = value.Message().Interface().(*v1.CurrencyPair)
 	case "slinky.marketmap.v1.ProviderConfig.invert":
 		x.Invert = value.Bool()
+	case "slinky.marketmap.v1.ProviderConfig.normalization_path":
+		lv := value.List()
+		clv := lv.(*_ProviderConfig_5_list)
+		x.NormalizationPath = *clv.list
 	case "slinky.marketmap.v1.ProviderConfig.metadata_JSON":
 		x.Metadata_JSON = value.Interface().(string)
 	default:
@@ -1488,6 +1609,12 @@ func (x *fastReflection_ProviderConfig) Mutable(fd protoreflect.FieldDescriptor)
 			x.NormalizeByPair = new(v1.CurrencyPair)
 		}
 		return protoreflect.ValueOfMessage(x.NormalizeByPair.ProtoReflect())
+	case "slinky.marketmap.v1.ProviderConfig.normalization_path":
+		if x.NormalizationPath == nil {
+			x.NormalizationPath = []*v1.CurrencyPair{}
+		}
+		value := &_ProviderConfig_5_list{list: &x.NormalizationPath}
+		return protoreflect.ValueOfList(value)
 	case "slinky.marketmap.v1.ProviderConfig.name":
 		panic(fmt.Errorf("field name of message slinky.marketmap.v1.ProviderConfig is not mutable"))
 	case "slinky.marketmap.v1.ProviderConfig.off_chain_ticker":
@@ -1518,6 +1645,9 @@ func (x *fastReflection_ProviderConfig) NewField(fd protoreflect.FieldDescriptor
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	case "slinky.marketmap.v1.ProviderConfig.invert":
 		return protoreflect.ValueOfBool(false)
+	case "slinky.marketmap.v1.ProviderConfig.normalization_path":
+		list := []*v1.CurrencyPair{}
+		return protoreflect.ValueOfList(&_ProviderConfig_5_list{list: &list})
 	case "slinky.marketmap.v1.ProviderConfig.metadata_JSON":
 		return protoreflect.ValueOfString("")
 	default:
@@ -1604,6 +1734,12 @@ func (x *fastReflection_ProviderConfig) ProtoMethods() *protoiface.Methods {
 		if x.Invert {
 			n += 2
 		}
+		if len(x.NormalizationPath) > 0 {
+			for _, e := range x.NormalizationPath {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		l = len(x.Metadata_JSON)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
@@ -1644,6 +1780,22 @@ func (x *fastReflection_ProviderConfig) ProtoMethods() *protoiface.Methods {
 			i--
 			dAtA[i] = 0x7a
 		}
+		if len(x.NormalizationPath) > 0 {
+			for iNdEx := len(x.NormalizationPath) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.NormalizationPath[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
 		if x.Invert {
 			i--
 			if x.Invert {
@@ -1851,6 +2003,40 @@ func (x *fastReflection_ProviderConfig) ProtoMethods() *protoiface.Methods {
 					}
 				}
 				x.Invert = bool(v != 0)
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NormalizationPath", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NormalizationPath = append(x.NormalizationPath, &v1.CurrencyPair{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.NormalizationPath[len(x.NormalizationPath)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			case 15:
 				if wireType != 2 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Metadata_JSON", wireType)
@@ -2600,141 +2786,1015 @@ func (x *fastReflection_MarketMap) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: slinky/marketmap/v1/market.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
-
-// Market encapsulates a Ticker and its provider-specific configuration.
-type Market struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+var _ protoreflect.List = (*_ScheduledUpdate_4_list)(nil)
 
-	// Ticker represents a price feed for a given asset pair i.e. BTC/USD. The
-	// price feed is scaled to a number of decimal places and has a minimum number
-	// of providers required to consider the ticker valid.
-	Ticker *Ticker `protobuf:"bytes,1,opt,name=ticker,proto3" json:"ticker,omitempty"`
-	// ProviderConfigs is the list of provider-specific configs for this Market.
-	ProviderConfigs []*ProviderConfig `protobuf:"bytes,2,rep,name=provider_configs,json=providerConfigs,proto3" json:"provider_configs,omitempty"`
+type _ScheduledUpdate_4_list struct {
+	list *[]*Market
 }
 
-func (x *Market) Reset() {
-	*x = Market{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_slinky_marketmap_v1_market_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *_ScheduledUpdate_4_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
+	return len(*x.list)
 }
 
-func (x *Market) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *_ScheduledUpdate_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (*Market) ProtoMessage() {}
+func (x *_ScheduledUpdate_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Market)
+	(*x.list)[i] = concreteValue
+}
 
-// Deprecated: Use Market.ProtoReflect.Descriptor instead.
-func (*Market) Descriptor() ([]byte, []int) {
-	return file_slinky_marketmap_v1_market_proto_rawDescGZIP(), []int{0}
+func (x *_ScheduledUpdate_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Market)
+	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *Market) GetTicker() *Ticker {
-	if x != nil {
-		return x.Ticker
-	}
-	return nil
+func (x *_ScheduledUpdate_4_list) AppendMutable() protoreflect.Value {
+	v := new(Market)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *Market) GetProviderConfigs() []*ProviderConfig {
-	if x != nil {
-		return x.ProviderConfigs
+func (x *_ScheduledUpdate_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
-	return nil
+	*x.list = (*x.list)[:n]
 }
 
-// Ticker represents a price feed for a given asset pair i.e. BTC/USD. The price
-// feed is scaled to a number of decimal places and has a minimum number of
-// providers required to consider the ticker valid.
-type Ticker struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *_ScheduledUpdate_4_list) NewElement() protoreflect.Value {
+	v := new(Market)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
 
-	// CurrencyPair is the currency pair for this ticker.
-	CurrencyPair *v1.CurrencyPair `protobuf:"bytes,1,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair,omitempty"`
-	// Decimals is the number of decimal places for the ticker. The number of
-	// decimal places is used to convert the price to a human-readable format.
-	Decimals uint64 `protobuf:"varint,2,opt,name=decimals,proto3" json:"decimals,omitempty"`
-	// MinProviderCount is the minimum number of providers required to consider
-	// the ticker valid.
-	MinProviderCount uint64 `protobuf:"varint,3,opt,name=min_provider_count,json=minProviderCount,proto3" json:"min_provider_count,omitempty"`
-	// Enabled is the flag that denotes if the Ticker is enabled for price
-	// fetching by an oracle.
-	Enabled bool `protobuf:"varint,14,opt,name=enabled,proto3" json:"enabled,omitempty"`
-	// MetadataJSON is a string of JSON that encodes any extra configuration
-	// for the given ticker.
-	Metadata_JSON string `protobuf:"bytes,15,opt,name=metadata_JSON,json=metadataJSON,proto3" json:"metadata_JSON,omitempty"`
+func (x *_ScheduledUpdate_4_list) IsValid() bool {
+	return x.list != nil
 }
 
-func (x *Ticker) Reset() {
-	*x = Ticker{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_slinky_marketmap_v1_market_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+var _ protoreflect.List = (*_ScheduledUpdate_5_list)(nil)
+
+type _ScheduledUpdate_5_list struct {
+	list *[]*Market
+}
+
+func (x *_ScheduledUpdate_5_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
+	return len(*x.list)
 }
 
-func (x *Ticker) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *_ScheduledUpdate_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (*Ticker) ProtoMessage() {}
+func (x *_ScheduledUpdate_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Market)
+	(*x.list)[i] = concreteValue
+}
 
-// Deprecated: Use Ticker.ProtoReflect.Descriptor instead.
-func (*Ticker) Descriptor() ([]byte, []int) {
-	return file_slinky_marketmap_v1_market_proto_rawDescGZIP(), []int{1}
+func (x *_ScheduledUpdate_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Market)
+	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *Ticker) GetCurrencyPair() *v1.CurrencyPair {
-	if x != nil {
-		return x.CurrencyPair
-	}
-	return nil
+func (x *_ScheduledUpdate_5_list) AppendMutable() protoreflect.Value {
+	v := new(Market)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *Ticker) GetDecimals() uint64 {
-	if x != nil {
-		return x.Decimals
+func (x *_ScheduledUpdate_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
-	return 0
+	*x.list = (*x.list)[:n]
 }
 
-func (x *Ticker) GetMinProviderCount() uint64 {
-	if x != nil {
-		return x.MinProviderCount
-	}
-	return 0
+func (x *_ScheduledUpdate_5_list) NewElement() protoreflect.Value {
+	v := new(Market)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *Ticker) GetEnabled() bool {
-	if x != nil {
-		return x.Enabled
-	}
-	return false
+func (x *_ScheduledUpdate_5_list) IsValid() bool {
+	return x.list != nil
 }
 
-func (x *Ticker) GetMetadata_JSON() string {
-	if x != nil {
+var (
+	md_ScheduledUpdate                   protoreflect.MessageDescriptor
+	fd_ScheduledUpdate_id                protoreflect.FieldDescriptor
+	fd_ScheduledUpdate_authority         protoreflect.FieldDescriptor
+	fd_ScheduledUpdate_activation_height protoreflect.FieldDescriptor
+	fd_ScheduledUpdate_create_markets    protoreflect.FieldDescriptor
+	fd_ScheduledUpdate_update_markets    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_slinky_marketmap_v1_market_proto_init()
+	md_ScheduledUpdate = File_slinky_marketmap_v1_market_proto.Messages().ByName("ScheduledUpdate")
+	fd_ScheduledUpdate_id = md_ScheduledUpdate.Fields().ByName("id")
+	fd_ScheduledUpdate_authority = md_ScheduledUpdate.Fields().ByName("authority")
+	fd_ScheduledUpdate_activation_height = md_ScheduledUpdate.Fields().ByName("activation_height")
+	fd_ScheduledUpdate_create_markets = md_ScheduledUpdate.Fields().ByName("create_markets")
+	fd_ScheduledUpdate_update_markets = md_ScheduledUpdate.Fields().ByName("update_markets")
+}
+
+var _ protoreflect.Message = (*fastReflection_ScheduledUpdate)(nil)
+
+type fastReflection_ScheduledUpdate ScheduledUpdate
+
+func (x *ScheduledUpdate) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ScheduledUpdate)(x)
+}
+
+func (x *ScheduledUpdate) slowProtoReflect() protoreflect.Message {
+	mi := &file_slinky_marketmap_v1_market_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ScheduledUpdate_messageType fastReflection_ScheduledUpdate_messageType
+var _ protoreflect.MessageType = fastReflection_ScheduledUpdate_messageType{}
+
+type fastReflection_ScheduledUpdate_messageType struct{}
+
+func (x fastReflection_ScheduledUpdate_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ScheduledUpdate)(nil)
+}
+func (x fastReflection_ScheduledUpdate_messageType) New() protoreflect.Message {
+	return new(fastReflection_ScheduledUpdate)
+}
+func (x fastReflection_ScheduledUpdate_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ScheduledUpdate
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ScheduledUpdate) Descriptor() protoreflect.MessageDescriptor {
+	return md_ScheduledUpdate
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ScheduledUpdate) Type() protoreflect.MessageType {
+	return _fastReflection_ScheduledUpdate_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ScheduledUpdate) New() protoreflect.Message {
+	return new(fastReflection_ScheduledUpdate)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ScheduledUpdate) Interface() protoreflect.ProtoMessage {
+	return (*ScheduledUpdate)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ScheduledUpdate) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_ScheduledUpdate_id, value) {
+			return
+		}
+	}
+	if x.Authority != "" {
+		value := protoreflect.ValueOfString(x.Authority)
+		if !f(fd_ScheduledUpdate_authority, value) {
+			return
+		}
+	}
+	if x.ActivationHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ActivationHeight)
+		if !f(fd_ScheduledUpdate_activation_height, value) {
+			return
+		}
+	}
+	if len(x.CreateMarkets) != 0 {
+		value := protoreflect.ValueOfList(&_ScheduledUpdate_4_list{list: &x.CreateMarkets})
+		if !f(fd_ScheduledUpdate_create_markets, value) {
+			return
+		}
+	}
+	if len(x.UpdateMarkets) != 0 {
+		value := protoreflect.ValueOfList(&_ScheduledUpdate_5_list{list: &x.UpdateMarkets})
+		if !f(fd_ScheduledUpdate_update_markets, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ScheduledUpdate) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "slinky.marketmap.v1.ScheduledUpdate.id":
+		return x.Id != uint64(0)
+	case "slinky.marketmap.v1.ScheduledUpdate.authority":
+		return x.Authority != ""
+	case "slinky.marketmap.v1.ScheduledUpdate.activation_height":
+		return x.ActivationHeight != uint64(0)
+	case "slinky.marketmap.v1.ScheduledUpdate.create_markets":
+		return len(x.CreateMarkets) != 0
+	case "slinky.marketmap.v1.ScheduledUpdate.update_markets":
+		return len(x.UpdateMarkets) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.ScheduledUpdate"))
+		}
+		panic(fmt.Errorf("message slinky.marketmap.v1.ScheduledUpdate does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScheduledUpdate) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "slinky.marketmap.v1.ScheduledUpdate.id":
+		x.Id = uint64(0)
+	case "slinky.marketmap.v1.ScheduledUpdate.authority":
+		x.Authority = ""
+	case "slinky.marketmap.v1.ScheduledUpdate.activation_height":
+		x.ActivationHeight = uint64(0)
+	case "slinky.marketmap.v1.ScheduledUpdate.create_markets":
+		x.CreateMarkets = nil
+	case "slinky.marketmap.v1.ScheduledUpdate.update_markets":
+		x.UpdateMarkets = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.ScheduledUpdate"))
+		}
+		panic(fmt.Errorf("message slinky.marketmap.v1.ScheduledUpdate does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ScheduledUpdate) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "slinky.marketmap.v1.ScheduledUpdate.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "slinky.marketmap.v1.ScheduledUpdate.authority":
+		value := x.Authority
+		return protoreflect.ValueOfString(value)
+	case "slinky.marketmap.v1.ScheduledUpdate.activation_height":
+		value := x.ActivationHeight
+		return protoreflect.ValueOfUint64(value)
+	case "slinky.marketmap.v1.ScheduledUpdate.create_markets":
+		if len(x.CreateMarkets) == 0 {
+			return protoreflect.ValueOfList(&_ScheduledUpdate_4_list{})
+		}
+		listValue := &_ScheduledUpdate_4_list{list: &x.CreateMarkets}
+		return protoreflect.ValueOfList(listValue)
+	case "slinky.marketmap.v1.ScheduledUpdate.update_markets":
+		if len(x.UpdateMarkets) == 0 {
+			return protoreflect.ValueOfList(&_ScheduledUpdate_5_list{})
+		}
+		listValue := &_ScheduledUpdate_5_list{list: &x.UpdateMarkets}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.ScheduledUpdate"))
+		}
+		panic(fmt.Errorf("message slinky.marketmap.v1.ScheduledUpdate does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScheduledUpdate) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "slinky.marketmap.v1.ScheduledUpdate.id":
+		x.Id = value.Uint()
+	case "slinky.marketmap.v1.ScheduledUpdate.authority":
+		x.Authority = value.Interface().(string)
+	case "slinky.marketmap.v1.ScheduledUpdate.activation_height":
+		x.ActivationHeight = value.Uint()
+	case "slinky.marketmap.v1.ScheduledUpdate.create_markets":
+		lv := value.List()
+		clv := lv.(*_ScheduledUpdate_4_list)
+		x.CreateMarkets = *clv.list
+	case "slinky.marketmap.v1.ScheduledUpdate.update_markets":
+		lv := value.List()
+		clv := lv.(*_ScheduledUpdate_5_list)
+		x.UpdateMarkets = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.ScheduledUpdate"))
+		}
+		panic(fmt.Errorf("message slinky.marketmap.v1.ScheduledUpdate does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScheduledUpdate) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "slinky.marketmap.v1.ScheduledUpdate.create_markets":
+		if x.CreateMarkets == nil {
+			x.CreateMarkets = []*Market{}
+		}
+		value := &_ScheduledUpdate_4_list{list: &x.CreateMarkets}
+		return protoreflect.ValueOfList(value)
+	case "slinky.marketmap.v1.ScheduledUpdate.update_markets":
+		if x.UpdateMarkets == nil {
+			x.UpdateMarkets = []*Market{}
+		}
+		value := &_ScheduledUpdate_5_list{list: &x.UpdateMarkets}
+		return protoreflect.ValueOfList(value)
+	case "slinky.marketmap.v1.ScheduledUpdate.id":
+		panic(fmt.Errorf("field id of message slinky.marketmap.v1.ScheduledUpdate is not mutable"))
+	case "slinky.marketmap.v1.ScheduledUpdate.authority":
+		panic(fmt.Errorf("field authority of message slinky.marketmap.v1.ScheduledUpdate is not mutable"))
+	case "slinky.marketmap.v1.ScheduledUpdate.activation_height":
+		panic(fmt.Errorf("field activation_height of message slinky.marketmap.v1.ScheduledUpdate is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.ScheduledUpdate"))
+		}
+		panic(fmt.Errorf("message slinky.marketmap.v1.ScheduledUpdate does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ScheduledUpdate) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "slinky.marketmap.v1.ScheduledUpdate.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "slinky.marketmap.v1.ScheduledUpdate.authority":
+		return protoreflect.ValueOfString("")
+	case "slinky.marketmap.v1.ScheduledUpdate.activation_height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "slinky.marketmap.v1.ScheduledUpdate.create_markets":
+		list := []*Market{}
+		return protoreflect.ValueOfList(&_ScheduledUpdate_4_list{list: &list})
+	case "slinky.marketmap.v1.ScheduledUpdate.update_markets":
+		list := []*Market{}
+		return protoreflect.ValueOfList(&_ScheduledUpdate_5_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.ScheduledUpdate"))
+		}
+		panic(fmt.Errorf("message slinky.marketmap.v1.ScheduledUpdate does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ScheduledUpdate) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in slinky.marketmap.v1.ScheduledUpdate", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ScheduledUpdate) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScheduledUpdate) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ScheduledUpdate) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ScheduledUpdate) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ScheduledUpdate)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.Authority)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ActivationHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.ActivationHeight))
+		}
+		if len(x.CreateMarkets) > 0 {
+			for _, e := range x.CreateMarkets {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.UpdateMarkets) > 0 {
+			for _, e := range x.UpdateMarkets {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ScheduledUpdate)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.UpdateMarkets) > 0 {
+			for iNdEx := len(x.UpdateMarkets) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.UpdateMarkets[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.CreateMarkets) > 0 {
+			for iNdEx := len(x.CreateMarkets) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.CreateMarkets[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.ActivationHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ActivationHeight))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Authority) > 0 {
+			i -= len(x.Authority)
+			copy(dAtA[i:], x.Authority)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Authority)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ScheduledUpdate)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ScheduledUpdate: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ScheduledUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Authority = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+				}
+				x.ActivationHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ActivationHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreateMarkets", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CreateMarkets = append(x.CreateMarkets, &Market{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CreateMarkets[len(x.CreateMarkets)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UpdateMarkets", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.UpdateMarkets = append(x.UpdateMarkets, &Market{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.UpdateMarkets[len(x.UpdateMarkets)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: slinky/marketmap/v1/market.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// MarketStatus is the lifecycle state of a market in the market map.
+type MarketStatus int32
+
+const (
+	// MARKET_STATUS_UNSPECIFIED denotes a market whose status is derived from
+	// its Enabled flag.
+	MarketStatus_MARKET_STATUS_UNSPECIFIED MarketStatus = 0
+	// MARKET_STATUS_PROPOSED denotes a market that has been added to the market
+	// map but is not yet fetched by oracles.
+	MarketStatus_MARKET_STATUS_PROPOSED MarketStatus = 1
+	// MARKET_STATUS_ENABLED denotes a market that is fetched by oracles.
+	MarketStatus_MARKET_STATUS_ENABLED MarketStatus = 2
+	// MARKET_STATUS_DISABLED denotes a market that is temporarily not fetched by
+	// oracles.
+	MarketStatus_MARKET_STATUS_DISABLED MarketStatus = 3
+	// MARKET_STATUS_DEPRECATED denotes a disabled market that is scheduled for
+	// removal and can no longer be enabled.
+	MarketStatus_MARKET_STATUS_DEPRECATED MarketStatus = 4
+	// MARKET_STATUS_REMOVED denotes a market that has been removed from the
+	// market map. Markets are deleted from state when removed, so this status is
+	// never stored.
+	MarketStatus_MARKET_STATUS_REMOVED MarketStatus = 5
+)
+
+// Enum value maps for MarketStatus.
+var (
+	MarketStatus_name = map[int32]string{
+		0: "MARKET_STATUS_UNSPECIFIED",
+		1: "MARKET_STATUS_PROPOSED",
+		2: "MARKET_STATUS_ENABLED",
+		3: "MARKET_STATUS_DISABLED",
+		4: "MARKET_STATUS_DEPRECATED",
+		5: "MARKET_STATUS_REMOVED",
+	}
+	MarketStatus_value = map[string]int32{
+		"MARKET_STATUS_UNSPECIFIED": 0,
+		"MARKET_STATUS_PROPOSED":    1,
+		"MARKET_STATUS_ENABLED":     2,
+		"MARKET_STATUS_DISABLED":    3,
+		"MARKET_STATUS_DEPRECATED":  4,
+		"MARKET_STATUS_REMOVED":     5,
+	}
+)
+
+func (x MarketStatus) Enum() *MarketStatus {
+	p := new(MarketStatus)
+	*p = x
+	return p
+}
+
+func (x MarketStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MarketStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_slinky_marketmap_v1_market_proto_enumTypes[0].Descriptor()
+}
+
+func (MarketStatus) Type() protoreflect.EnumType {
+	return &file_slinky_marketmap_v1_market_proto_enumTypes[0]
+}
+
+func (x MarketStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MarketStatus.Descriptor instead.
+func (MarketStatus) EnumDescriptor() ([]byte, []int) {
+	return file_slinky_marketmap_v1_market_proto_rawDescGZIP(), []int{0}
+}
+
+// Market encapsulates a Ticker and its provider-specific configuration.
+type Market struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Ticker represents a price feed for a given asset pair i.e. BTC/USD. The
+	// price feed is scaled to a number of decimal places and has a minimum number
+	// of providers required to consider the ticker valid.
+	Ticker *Ticker `protobuf:"bytes,1,opt,name=ticker,proto3" json:"ticker,omitempty"`
+	// ProviderConfigs is the list of provider-specific configs for this Market.
+	ProviderConfigs []*ProviderConfig `protobuf:"bytes,2,rep,name=provider_configs,json=providerConfigs,proto3" json:"provider_configs,omitempty"`
+}
+
+func (x *Market) Reset() {
+	*x = Market{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_slinky_marketmap_v1_market_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Market) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Market) ProtoMessage() {}
+
+// Deprecated: Use Market.ProtoReflect.Descriptor instead.
+func (*Market) Descriptor() ([]byte, []int) {
+	return file_slinky_marketmap_v1_market_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Market) GetTicker() *Ticker {
+	if x != nil {
+		return x.Ticker
+	}
+	return nil
+}
+
+func (x *Market) GetProviderConfigs() []*ProviderConfig {
+	if x != nil {
+		return x.ProviderConfigs
+	}
+	return nil
+}
+
+// Ticker represents a price feed for a given asset pair i.e. BTC/USD. The price
+// feed is scaled to a number of decimal places and has a minimum number of
+// providers required to consider the ticker valid.
+type Ticker struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// CurrencyPair is the currency pair for this ticker.
+	CurrencyPair *v1.CurrencyPair `protobuf:"bytes,1,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair,omitempty"`
+	// Decimals is the number of decimal places for the ticker. The number of
+	// decimal places is used to convert the price to a human-readable format.
+	Decimals uint64 `protobuf:"varint,2,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	// MinProviderCount is the minimum number of providers required to consider
+	// the ticker valid.
+	MinProviderCount uint64 `protobuf:"varint,3,opt,name=min_provider_count,json=minProviderCount,proto3" json:"min_provider_count,omitempty"`
+	// Status is the lifecycle state of the market. If unspecified, the status
+	// is derived from Enabled. If specified, Enabled must be true if and only if
+	// the status is MARKET_STATUS_ENABLED.
+	Status MarketStatus `protobuf:"varint,4,opt,name=status,proto3,enum=slinky.marketmap.v1.MarketStatus" json:"status,omitempty"`
+	// Enabled is the flag that denotes if the Ticker is enabled for price
+	// fetching by an oracle.
+	Enabled bool `protobuf:"varint,14,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// MetadataJSON is a string of JSON that encodes any extra configuration
+	// for the given ticker.
+	Metadata_JSON string `protobuf:"bytes,15,opt,name=metadata_JSON,json=metadataJSON,proto3" json:"metadata_JSON,omitempty"`
+}
+
+func (x *Ticker) Reset() {
+	*x = Ticker{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_slinky_marketmap_v1_market_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Ticker) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ticker) ProtoMessage() {}
+
+// Deprecated: Use Ticker.ProtoReflect.Descriptor instead.
+func (*Ticker) Descriptor() ([]byte, []int) {
+	return file_slinky_marketmap_v1_market_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Ticker) GetCurrencyPair() *v1.CurrencyPair {
+	if x != nil {
+		return x.CurrencyPair
+	}
+	return nil
+}
+
+func (x *Ticker) GetDecimals() uint64 {
+	if x != nil {
+		return x.Decimals
+	}
+	return 0
+}
+
+func (x *Ticker) GetMinProviderCount() uint64 {
+	if x != nil {
+		return x.MinProviderCount
+	}
+	return 0
+}
+
+func (x *Ticker) GetStatus() MarketStatus {
+	if x != nil {
+		return x.Status
+	}
+	return MarketStatus_MARKET_STATUS_UNSPECIFIED
+}
+
+func (x *Ticker) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Ticker) GetMetadata_JSON() string {
+	if x != nil {
 		return x.Metadata_JSON
 	}
 	return ""
@@ -2760,6 +3820,13 @@ type ProviderConfig struct {
 	// Invert is a boolean indicating if the BASE and QUOTE of the market should
 	// be inverted. i.e. BASE -> QUOTE, QUOTE -> BASE
 	Invert bool `protobuf:"varint,4,opt,name=invert,proto3" json:"invert,omitempty"`
+	// NormalizationPath is an ordered list of currency pairs for this ticker to
+	// be normalized by. The price is multiplied by the index price of each pair
+	// in order. For example, if the desired Ticker is TOKEN/USD, this market
+	// could be reached using: OffChainTicker = TOKEN/ETH NormalizationPath =
+	// [ETH/USDT, USDT/USD]. This field is optional and cannot be set alongside
+	// NormalizeByPair.
+	NormalizationPath []*v1.CurrencyPair `protobuf:"bytes,5,rep,name=normalization_path,json=normalizationPath,proto3" json:"normalization_path,omitempty"`
 	// MetadataJSON is a string of JSON that encodes any extra configuration
 	// for the given provider config.
 	Metadata_JSON string `protobuf:"bytes,15,opt,name=metadata_JSON,json=metadataJSON,proto3" json:"metadata_JSON,omitempty"`
@@ -2813,6 +3880,13 @@ func (x *ProviderConfig) GetInvert() bool {
 	return false
 }
 
+func (x *ProviderConfig) GetNormalizationPath() []*v1.CurrencyPair {
+	if x != nil {
+		return x.NormalizationPath
+	}
+	return nil
+}
+
 func (x *ProviderConfig) GetMetadata_JSON() string {
 	if x != nil {
 		return x.Metadata_JSON
@@ -2858,6 +3932,83 @@ func (x *MarketMap) GetMarkets() map[string]*Market {
 	return nil
 }
 
+// ScheduledUpdate is a set of market map changes submitted by a market
+// authority that is applied at the beginning of the block at the given
+// activation height.
+type ScheduledUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Id is the unique identifier of the scheduled update.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Authority is the market authority that scheduled the update.
+	Authority string `protobuf:"bytes,2,opt,name=authority,proto3" json:"authority,omitempty"`
+	// ActivationHeight is the block height at which the update is applied.
+	ActivationHeight uint64 `protobuf:"varint,3,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	// CreateMarkets is the list of markets to be created when the update is
+	// applied.
+	CreateMarkets []*Market `protobuf:"bytes,4,rep,name=create_markets,json=createMarkets,proto3" json:"create_markets,omitempty"`
+	// UpdateMarkets is the list of markets to be updated when the update is
+	// applied.
+	UpdateMarkets []*Market `protobuf:"bytes,5,rep,name=update_markets,json=updateMarkets,proto3" json:"update_markets,omitempty"`
+}
+
+func (x *ScheduledUpdate) Reset() {
+	*x = ScheduledUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_slinky_marketmap_v1_market_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduledUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledUpdate) ProtoMessage() {}
+
+// Deprecated: Use ScheduledUpdate.ProtoReflect.Descriptor instead.
+func (*ScheduledUpdate) Descriptor() ([]byte, []int) {
+	return file_slinky_marketmap_v1_market_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ScheduledUpdate) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ScheduledUpdate) GetAuthority() string {
+	if x != nil {
+		return x.Authority
+	}
+	return ""
+}
+
+func (x *ScheduledUpdate) GetActivationHeight() uint64 {
+	if x != nil {
+		return x.ActivationHeight
+	}
+	return 0
+}
+
+func (x *ScheduledUpdate) GetCreateMarkets() []*Market {
+	if x != nil {
+		return x.CreateMarkets
+	}
+	return nil
+}
+
+func (x *ScheduledUpdate) GetUpdateMarkets() []*Market {
+	if x != nil {
+		return x.UpdateMarkets
+	}
+	return nil
+}
+
 var File_slinky_marketmap_v1_market_proto protoreflect.FileDescriptor
 
 var file_slinky_marketmap_v1_market_proto_rawDesc = []byte{
@@ -2878,7 +4029,7 @@ var file_slinky_marketmap_v1_market_proto_rawDesc = []byte{
 	0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
 	0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0f, 0x70,
 	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x3a, 0x08,
-	0x98, 0xa0, 0x1f, 0x00, 0x80, 0xdc, 0x20, 0x00, 0x22, 0xe5, 0x01, 0x0a, 0x06, 0x54, 0x69, 0x63,
+	0x98, 0xa0, 0x1f, 0x00, 0x80, 0xdc, 0x20, 0x00, 0x22, 0xa0, 0x02, 0x0a, 0x06, 0x54, 0x69, 0x63,
 	0x6b, 0x65, 0x72, 0x12, 0x48, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f,
 	0x70, 0x61, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x6c, 0x69,
 	0x6e, 0x6b, 0x79, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x72,
@@ -2888,50 +4039,87 @@ var file_slinky_marketmap_v1_market_proto_rawDesc = []byte{
 	0x08, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x69, 0x6e,
 	0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
 	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6d, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
-	0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c,
-	0x65, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
-	0x64, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x4a, 0x53,
-	0x4f, 0x4e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x4a, 0x53, 0x4f, 0x4e, 0x3a, 0x08, 0x98, 0xa0, 0x1f, 0x00, 0x80, 0xdc, 0x20, 0x00,
-	0x22, 0xd6, 0x01, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x6f, 0x66, 0x66, 0x5f, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0e, 0x6f, 0x66, 0x66, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x54, 0x69, 0x63, 0x6b, 0x65,
-	0x72, 0x12, 0x49, 0x0a, 0x11, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x5f, 0x62,
-	0x79, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73,
-	0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61, 0x69, 0x72, 0x52, 0x0f, 0x6e, 0x6f, 0x72,
-	0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x50, 0x61, 0x69, 0x72, 0x12, 0x16, 0x0a, 0x06,
-	0x69, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x6e,
-	0x76, 0x65, 0x72, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x5f, 0x4a, 0x53, 0x4f, 0x4e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x4a, 0x53, 0x4f, 0x4e, 0x22, 0xbb, 0x01, 0x0a, 0x09, 0x4d, 0x61,
-	0x72, 0x6b, 0x65, 0x74, 0x4d, 0x61, 0x70, 0x12, 0x4b, 0x0a, 0x07, 0x6d, 0x61, 0x72, 0x6b, 0x65,
-	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b,
-	0x79, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
-	0x61, 0x72, 0x6b, 0x65, 0x74, 0x4d, 0x61, 0x70, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x6d, 0x61, 0x72,
-	0x6b, 0x65, 0x74, 0x73, 0x1a, 0x57, 0x0a, 0x0c, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x31, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x6d,
-	0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b,
-	0x65, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x3a, 0x08, 0x98,
-	0xa0, 0x1f, 0x00, 0x80, 0xdc, 0x20, 0x00, 0x42, 0xc6, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e,
+	0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79,
+	0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x4a, 0x53, 0x4f, 0x4e, 0x18, 0x0f, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x4a, 0x53, 0x4f,
+	0x4e, 0x3a, 0x08, 0x98, 0xa0, 0x1f, 0x00, 0x80, 0xdc, 0x20, 0x00, 0x22, 0xaa, 0x02, 0x0a, 0x0e,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x6f, 0x66, 0x66, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f,
+	0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x66,
+	0x66, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x12, 0x49, 0x0a, 0x11,
+	0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x5f, 0x70, 0x61, 0x69,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x50, 0x61, 0x69, 0x72, 0x52, 0x0f, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a,
+	0x65, 0x42, 0x79, 0x50, 0x61, 0x69, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x6e, 0x76, 0x65, 0x72,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x12,
+	0x52, 0x0a, 0x12, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x6c,
+	0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61, 0x69, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x11, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x61, 0x74, 0x68, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x5f,
+	0x4a, 0x53, 0x4f, 0x4e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x4a, 0x53, 0x4f, 0x4e, 0x22, 0xbb, 0x01, 0x0a, 0x09, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x4d, 0x61, 0x70, 0x12, 0x4b, 0x0a, 0x07, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79,
+	0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x4d, 0x61, 0x70, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x6d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x73, 0x1a, 0x57, 0x0a, 0x0c, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x31, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x3a, 0x08, 0x98, 0xa0,
+	0x1f, 0x00, 0x80, 0xdc, 0x20, 0x00, 0x22, 0x80, 0x02, 0x0a, 0x0f, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x10, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x48, 0x0a, 0x0e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
 	0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70,
-	0x2e, 0x76, 0x31, 0x42, 0x0b, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x50, 0x01, 0x5a, 0x30, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2f, 0x6d, 0x61, 0x72, 0x6b,
-	0x65, 0x74, 0x6d, 0x61, 0x70, 0x2f, 0x76, 0x31, 0x3b, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d,
-	0x61, 0x70, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x53, 0x4d, 0x58, 0xaa, 0x02, 0x13, 0x53, 0x6c, 0x69,
-	0x6e, 0x6b, 0x79, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x56, 0x31,
-	0xca, 0x02, 0x13, 0x53, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x5c, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
-	0x6d, 0x61, 0x70, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1f, 0x53, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x5c,
-	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x53, 0x6c, 0x69, 0x6e, 0x6b,
-	0x79, 0x3a, 0x3a, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x3a, 0x3a, 0x56, 0x31,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x12,
+	0x48, 0x0a, 0x0e, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79,
+	0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0d, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x2a, 0xb9, 0x01, 0x0a, 0x0c, 0x4d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x19, 0x4d, 0x41,
+	0x52, 0x4b, 0x45, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x4d, 0x41, 0x52,
+	0x4b, 0x45, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x52, 0x4f, 0x50, 0x4f,
+	0x53, 0x45, 0x44, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x4d, 0x41, 0x52, 0x4b, 0x45, 0x54, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x45, 0x4e, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x02,
+	0x12, 0x1a, 0x0a, 0x16, 0x4d, 0x41, 0x52, 0x4b, 0x45, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x44, 0x49, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1c, 0x0a, 0x18,
+	0x4d, 0x41, 0x52, 0x4b, 0x45, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x44, 0x45,
+	0x50, 0x52, 0x45, 0x43, 0x41, 0x54, 0x45, 0x44, 0x10, 0x04, 0x12, 0x19, 0x0a, 0x15, 0x4d, 0x41,
+	0x52, 0x4b, 0x45, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x4d, 0x4f,
+	0x56, 0x45, 0x44, 0x10, 0x05, 0x42, 0xc6, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x73, 0x6c,
+	0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76,
+	0x31, 0x42, 0x0b, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
+	0x5a, 0x30, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x6d, 0x61, 0x70, 0x2f, 0x76, 0x31, 0x3b, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70,
+	0x76, 0x31, 0xa2, 0x02, 0x03, 0x53, 0x4d, 0x58, 0xaa, 0x02, 0x13, 0x53, 0x6c, 0x69, 0x6e, 0x6b,
+	0x79, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x56, 0x31, 0xca, 0x02,
+	0x13, 0x53, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x5c, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61,
+	0x70, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1f, 0x53, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x5c, 0x4d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x53, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x3a,
+	0x3a, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2946,27 +4134,34 @@ func file_slinky_marketmap_v1_market_proto_rawDescGZIP() []byte {
 	return file_slinky_marketmap_v1_market_proto_rawDescData
 }
 
-var file_slinky_marketmap_v1_market_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_slinky_marketmap_v1_market_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_slinky_marketmap_v1_market_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_slinky_marketmap_v1_market_proto_goTypes = []interface{}{
-	(*Market)(nil),          // 0: slinky.marketmap.v1.Market
-	(*Ticker)(nil),          // 1: slinky.marketmap.v1.Ticker
-	(*ProviderConfig)(nil),  // 2: slinky.marketmap.v1.ProviderConfig
-	(*MarketMap)(nil),       // 3: slinky.marketmap.v1.MarketMap
-	nil,                     // 4: slinky.marketmap.v1.MarketMap.MarketsEntry
-	(*v1.CurrencyPair)(nil), // 5: slinky.types.v1.CurrencyPair
+	(MarketStatus)(0),       // 0: slinky.marketmap.v1.MarketStatus
+	(*Market)(nil),          // 1: slinky.marketmap.v1.Market
+	(*Ticker)(nil),          // 2: slinky.marketmap.v1.Ticker
+	(*ProviderConfig)(nil),  // 3: slinky.marketmap.v1.ProviderConfig
+	(*MarketMap)(nil),       // 4: slinky.marketmap.v1.MarketMap
+	(*ScheduledUpdate)(nil), // 5: slinky.marketmap.v1.ScheduledUpdate
+	nil,                     // 6: slinky.marketmap.v1.MarketMap.MarketsEntry
+	(*v1.CurrencyPair)(nil), // 7: slinky.types.v1.CurrencyPair
 }
 var file_slinky_marketmap_v1_market_proto_depIdxs = []int32{
-	1, // 0: slinky.marketmap.v1.Market.ticker:type_name -> slinky.marketmap.v1.Ticker
-	2, // 1: slinky.marketmap.v1.Market.provider_configs:type_name -> slinky.marketmap.v1.ProviderConfig
-	5, // 2: slinky.marketmap.v1.Ticker.currency_pair:type_name -> slinky.types.v1.CurrencyPair
-	5, // 3: slinky.marketmap.v1.ProviderConfig.normalize_by_pair:type_name -> slinky.types.v1.CurrencyPair
-	4, // 4: slinky.marketmap.v1.MarketMap.markets:type_name -> slinky.marketmap.v1.MarketMap.MarketsEntry
-	0, // 5: slinky.marketmap.v1.MarketMap.MarketsEntry.value:type_name -> slinky.marketmap.v1.Market
-	6, // [6:6] is the sub-list for method output_type
-	6, // [6:6] is the sub-list for method input_type
-	6, // [6:6] is the sub-list for extension type_name
-	6, // [6:6] is the sub-list for extension extendee
-	0, // [0:6] is the sub-list for field type_name
+	2,  // 0: slinky.marketmap.v1.Market.ticker:type_name -> slinky.marketmap.v1.Ticker
+	3,  // 1: slinky.marketmap.v1.Market.provider_configs:type_name -> slinky.marketmap.v1.ProviderConfig
+	7,  // 2: slinky.marketmap.v1.Ticker.currency_pair:type_name -> slinky.types.v1.CurrencyPair
+	0,  // 3: slinky.marketmap.v1.Ticker.status:type_name -> slinky.marketmap.v1.MarketStatus
+	7,  // 4: slinky.marketmap.v1.ProviderConfig.normalize_by_pair:type_name -> slinky.types.v1.CurrencyPair
+	7,  // 5: slinky.marketmap.v1.ProviderConfig.normalization_path:type_name -> slinky.types.v1.CurrencyPair
+	6,  // 6: slinky.marketmap.v1.MarketMap.markets:type_name -> slinky.marketmap.v1.MarketMap.MarketsEntry
+	1,  // 7: slinky.marketmap.v1.ScheduledUpdate.create_markets:type_name -> slinky.marketmap.v1.Market
+	1,  // 8: slinky.marketmap.v1.ScheduledUpdate.update_markets:type_name -> slinky.marketmap.v1.Market
+	1,  // 9: slinky.marketmap.v1.MarketMap.MarketsEntry.value:type_name -> slinky.marketmap.v1.Market
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_slinky_marketmap_v1_market_proto_init() }
@@ -3023,19 +4218,32 @@ func file_slinky_marketmap_v1_market_proto_init() {
 				return nil
 			}
 		}
+		file_slinky_marketmap_v1_market_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduledUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_slinky_marketmap_v1_market_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   5,
+			NumEnums:      1,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_slinky_marketmap_v1_market_proto_goTypes,
 		DependencyIndexes: file_slinky_marketmap_v1_market_proto_depIdxs,
+		EnumInfos:         file_slinky_marketmap_v1_market_proto_enumTypes,
 		MessageInfos:      file_slinky_marketmap_v1_market_proto_msgTypes,
 	}.Build()
 	File_slinky_marketmap_v1_market_proto = out.File
@@ -59,9 +59,10 @@ func (x *_Params_1_list) IsValid() bool {
 }
 
 var (
-	md_Params                    protoreflect.MessageDescriptor
-	fd_Params_market_authorities protoreflect.FieldDescriptor
-	fd_Params_admin              protoreflect.FieldDescriptor
+	md_Params                     protoreflect.MessageDescriptor
+	fd_Params_market_authorities  protoreflect.FieldDescriptor
+	fd_Params_admin               protoreflect.FieldDescriptor
+	fd_Params_max_pending_updates protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -69,6 +70,7 @@ func init() {
 	md_Params = File_slinky_marketmap_v1_params_proto.Messages().ByName("Params")
 	fd_Params_market_authorities = md_Params.Fields().ByName("market_authorities")
 	fd_Params_admin = md_Params.Fields().ByName("admin")
+	fd_Params_max_pending_updates = md_Params.Fields().ByName("max_pending_updates")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -148,6 +150,12 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.MaxPendingUpdates != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxPendingUpdates)
+		if !f(fd_Params_max_pending_updates, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -167,6 +175,8 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return len(x.MarketAuthorities) != 0
 	case "slinky.marketmap.v1.Params.admin":
 		return x.Admin != ""
+	case "slinky.marketmap.v1.Params.max_pending_updates":
+		return x.MaxPendingUpdates != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.Params"))
@@ -187,6 +197,8 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.MarketAuthorities = nil
 	case "slinky.marketmap.v1.Params.admin":
 		x.Admin = ""
+	case "slinky.marketmap.v1.Params.max_pending_updates":
+		x.MaxPendingUpdates = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.Params"))
@@ -212,6 +224,9 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "slinky.marketmap.v1.Params.admin":
 		value := x.Admin
 		return protoreflect.ValueOfString(value)
+	case "slinky.marketmap.v1.Params.max_pending_updates":
+		value := x.MaxPendingUpdates
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.Params"))
@@ -238,6 +253,8 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.MarketAuthorities = *clv.list
 	case "slinky.marketmap.v1.Params.admin":
 		x.Admin = value.Interface().(string)
+	case "slinky.marketmap.v1.Params.max_pending_updates":
+		x.MaxPendingUpdates = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.Params"))
@@ -266,6 +283,8 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		return protoreflect.ValueOfList(value)
 	case "slinky.marketmap.v1.Params.admin":
 		panic(fmt.Errorf("field admin of message slinky.marketmap.v1.Params is not mutable"))
+	case "slinky.marketmap.v1.Params.max_pending_updates":
+		panic(fmt.Errorf("field max_pending_updates of message slinky.marketmap.v1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.Params"))
@@ -284,6 +303,8 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfList(&_Params_1_list{list: &list})
 	case "slinky.marketmap.v1.Params.admin":
 		return protoreflect.ValueOfString("")
+	case "slinky.marketmap.v1.Params.max_pending_updates":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.Params"))
@@ -363,6 +384,9 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.MaxPendingUpdates != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxPendingUpdates))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -392,6 +416,11 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.MaxPendingUpdates != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxPendingUpdates))
+			i--
+			dAtA[i] = 0x18
+		}
 		if len(x.Admin) > 0 {
 			i -= len(x.Admin)
 			copy(dAtA[i:], x.Admin)
@@ -521,6 +550,25 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				}
 				x.Admin = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxPendingUpdates", wireType)
+				}
+				x.MaxPendingUpdates = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxPendingUpdates |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -581,6 +629,9 @@ type Params struct {
 	// Admin is an address that can remove addresses from the MarketAuthorities
 	// list. Only governance can add to the MarketAuthorities or change the Admin.
 	Admin string `protobuf:"bytes,2,opt,name=admin,proto3" json:"admin,omitempty"`
+	// MaxPendingUpdates is the maximum number of scheduled updates that can be
+	// pending at any given time. A value of zero disables the limit.
+	MaxPendingUpdates uint64 `protobuf:"varint,3,opt,name=max_pending_updates,json=maxPendingUpdates,proto3" json:"max_pending_updates,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -617,18 +668,28 @@ func (x *Params) GetAdmin() string {
 	return ""
 }
 
+func (x *Params) GetMaxPendingUpdates() uint64 {
+	if x != nil {
+		return x.MaxPendingUpdates
+	}
+	return 0
+}
+
 var File_slinky_marketmap_v1_params_proto protoreflect.FileDescriptor
 
 var file_slinky_marketmap_v1_params_proto_rawDesc = []byte{
 	0x0a, 0x20, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d,
 	0x61, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x12, 0x13, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65,
-	0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x22, 0x4d, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x22, 0x7d, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d,
 	0x73, 0x12, 0x2d, 0x0a, 0x12, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x61, 0x75, 0x74, 0x68,
 	0x6f, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x6d,
 	0x61, 0x72, 0x6b, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73,
 	0x12, 0x14, 0x0a, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x42, 0xc6, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x73,
+	0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x42, 0xc6, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x73,
 	0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e,
 	0x76, 0x31, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
 	0x01, 0x5a, 0x30, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
@@ -19,10 +19,11 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_MarketMap_FullMethodName   = "/slinky.marketmap.v1.Query/MarketMap"
-	Query_Market_FullMethodName      = "/slinky.marketmap.v1.Query/Market"
-	Query_LastUpdated_FullMethodName = "/slinky.marketmap.v1.Query/LastUpdated"
-	Query_Params_FullMethodName      = "/slinky.marketmap.v1.Query/Params"
+	Query_MarketMap_FullMethodName      = "/slinky.marketmap.v1.Query/MarketMap"
+	Query_Market_FullMethodName         = "/slinky.marketmap.v1.Query/Market"
+	Query_LastUpdated_FullMethodName    = "/slinky.marketmap.v1.Query/LastUpdated"
+	Query_Params_FullMethodName         = "/slinky.marketmap.v1.Query/Params"
+	Query_PendingUpdates_FullMethodName = "/slinky.marketmap.v1.Query/PendingUpdates"
 )
 
 // QueryClient is the client API for Query service.
@@ -39,6 +40,9 @@ type QueryClient interface {
 	LastUpdated(ctx context.Context, in *LastUpdatedRequest, opts ...grpc.CallOption) (*LastUpdatedResponse, error)
 	// Params returns the current x/marketmap module parameters.
 	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
+	// PendingUpdates returns the scheduled market map updates that have not yet
+	// been applied, ordered by activation height.
+	PendingUpdates(ctx context.Context, in *PendingUpdatesRequest, opts ...grpc.CallOption) (*PendingUpdatesResponse, error)
 }
 
 type queryClient struct {
@@ -85,6 +89,15 @@ func (c *queryClient) Params(ctx context.Context, in *ParamsRequest, opts ...grp
 	return out, nil
 }
 
+func (c *queryClient) PendingUpdates(ctx context.Context, in *PendingUpdatesRequest, opts ...grpc.CallOption) (*PendingUpdatesResponse, error) {
+	out := new(PendingUpdatesResponse)
+	err := c.cc.Invoke(ctx, Query_PendingUpdates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -99,6 +112,9 @@ type QueryServer interface {
 	LastUpdated(context.Context, *LastUpdatedRequest) (*LastUpdatedResponse, error)
 	// Params returns the current x/marketmap module parameters.
 	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
+	// PendingUpdates returns the scheduled market map updates that have not yet
+	// been applied, ordered by activation height.
+	PendingUpdates(context.Context, *PendingUpdatesRequest) (*PendingUpdatesResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -118,6 +134,9 @@ func (UnimplementedQueryServer) LastUpdated(context.Context, *LastUpdatedRequest
 func (UnimplementedQueryServer) Params(context.Context, *ParamsRequest) (*ParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (UnimplementedQueryServer) PendingUpdates(context.Context, *PendingUpdatesRequest) (*PendingUpdatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingUpdates not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -203,6 +222,24 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PendingUpdates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PendingUpdatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingUpdates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PendingUpdates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingUpdates(ctx, req.(*PendingUpdatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -226,6 +263,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "PendingUpdates",
+			Handler:    _Query_PendingUpdates_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "slinky/marketmap/v1/query.proto",
@@ -23,6 +23,9 @@ const (
 	Msg_UpdateMarkets_FullMethodName           = "/slinky.marketmap.v1.Msg/UpdateMarkets"
 	Msg_UpdateParams_FullMethodName            = "/slinky.marketmap.v1.Msg/UpdateParams"
 	Msg_RemoveMarketAuthorities_FullMethodName = "/slinky.marketmap.v1.Msg/RemoveMarketAuthorities"
+	Msg_RemoveMarkets_FullMethodName           = "/slinky.marketmap.v1.Msg/RemoveMarkets"
+	Msg_ScheduleMarketUpdates_FullMethodName   = "/slinky.marketmap.v1.Msg/ScheduleMarketUpdates"
+	Msg_CancelScheduledUpdate_FullMethodName   = "/slinky.marketmap.v1.Msg/CancelScheduledUpdate"
 )
 
 // MsgClient is the client API for Msg service.
@@ -39,6 +42,15 @@ type MsgClient interface {
 	// RemoveMarketAuthorities defines a method for removing market authorities
 	// from the x/marketmap module. the signer must be the admin.
 	RemoveMarketAuthorities(ctx context.Context, in *MsgRemoveMarketAuthorities, opts ...grpc.CallOption) (*MsgRemoveMarketAuthoritiesResponse, error)
+	// RemoveMarkets removes markets from the market map. Markets must be
+	// proposed, disabled or deprecated to be removed.
+	RemoveMarkets(ctx context.Context, in *MsgRemoveMarkets, opts ...grpc.CallOption) (*MsgRemoveMarketsResponse, error)
+	// ScheduleMarketUpdates schedules markets to be created and updated at a
+	// future block height.
+	ScheduleMarketUpdates(ctx context.Context, in *MsgScheduleMarketUpdates, opts ...grpc.CallOption) (*MsgScheduleMarketUpdatesResponse, error)
+	// CancelScheduledUpdate cancels a pending scheduled update. The signer must
+	// be the authority that scheduled the update or the admin.
+	CancelScheduledUpdate(ctx context.Context, in *MsgCancelScheduledUpdate, opts ...grpc.CallOption) (*MsgCancelScheduledUpdateResponse, error)
 }
 
 type msgClient struct {
@@ -85,6 +97,33 @@ func (c *msgClient) RemoveMarketAuthorities(ctx context.Context, in *MsgRemoveMa
 	return out, nil
 }
 
+func (c *msgClient) RemoveMarkets(ctx context.Context, in *MsgRemoveMarkets, opts ...grpc.CallOption) (*MsgRemoveMarketsResponse, error) {
+	out := new(MsgRemoveMarketsResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveMarkets_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ScheduleMarketUpdates(ctx context.Context, in *MsgScheduleMarketUpdates, opts ...grpc.CallOption) (*MsgScheduleMarketUpdatesResponse, error) {
+	out := new(MsgScheduleMarketUpdatesResponse)
+	err := c.cc.Invoke(ctx, Msg_ScheduleMarketUpdates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelScheduledUpdate(ctx context.Context, in *MsgCancelScheduledUpdate, opts ...grpc.CallOption) (*MsgCancelScheduledUpdateResponse, error) {
+	out := new(MsgCancelScheduledUpdateResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelScheduledUpdate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -99,6 +138,15 @@ type MsgServer interface {
 	// RemoveMarketAuthorities defines a method for removing market authorities
 	// from the x/marketmap module. the signer must be the admin.
 	RemoveMarketAuthorities(context.Context, *MsgRemoveMarketAuthorities) (*MsgRemoveMarketAuthoritiesResponse, error)
+	// RemoveMarkets removes markets from the market map. Markets must be
+	// proposed, disabled or deprecated to be removed.
+	RemoveMarkets(context.Context, *MsgRemoveMarkets) (*MsgRemoveMarketsResponse, error)
+	// ScheduleMarketUpdates schedules markets to be created and updated at a
+	// future block height.
+	ScheduleMarketUpdates(context.Context, *MsgScheduleMarketUpdates) (*MsgScheduleMarketUpdatesResponse, error)
+	// CancelScheduledUpdate cancels a pending scheduled update. The signer must
+	// be the authority that scheduled the update or the admin.
+	CancelScheduledUpdate(context.Context, *MsgCancelScheduledUpdate) (*MsgCancelScheduledUpdateResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -118,6 +166,15 @@ func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgParams) (*MsgPar
 func (UnimplementedMsgServer) RemoveMarketAuthorities(context.Context, *MsgRemoveMarketAuthorities) (*MsgRemoveMarketAuthoritiesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveMarketAuthorities not implemented")
 }
+func (UnimplementedMsgServer) RemoveMarkets(context.Context, *MsgRemoveMarkets) (*MsgRemoveMarketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMarkets not implemented")
+}
+func (UnimplementedMsgServer) ScheduleMarketUpdates(context.Context, *MsgScheduleMarketUpdates) (*MsgScheduleMarketUpdatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleMarketUpdates not implemented")
+}
+func (UnimplementedMsgServer) CancelScheduledUpdate(context.Context, *MsgCancelScheduledUpdate) (*MsgCancelScheduledUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledUpdate not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -203,6 +260,60 @@ func _Msg_RemoveMarketAuthorities_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RemoveMarkets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveMarkets)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveMarkets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveMarkets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveMarkets(ctx, req.(*MsgRemoveMarkets))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ScheduleMarketUpdates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleMarketUpdates)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleMarketUpdates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ScheduleMarketUpdates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleMarketUpdates(ctx, req.(*MsgScheduleMarketUpdates))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelScheduledUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelScheduledUpdate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelScheduledUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelScheduledUpdate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelScheduledUpdate(ctx, req.(*MsgCancelScheduledUpdate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -226,6 +337,18 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveMarketAuthorities",
 			Handler:    _Msg_RemoveMarketAuthorities_Handler,
 		},
+		{
+			MethodName: "RemoveMarkets",
+			Handler:    _Msg_RemoveMarkets_Handler,
+		},
+		{
+			MethodName: "ScheduleMarketUpdates",
+			Handler:    _Msg_ScheduleMarketUpdates_Handler,
+		},
+		{
+			MethodName: "CancelScheduledUpdate",
+			Handler:    _Msg_CancelScheduledUpdate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "slinky/marketmap/v1/tx.proto",
@@ -13,15 +13,65 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_Module_3_list)(nil)
+
+type _Module_3_list struct {
+	list *[]string
+}
+
+func (x *_Module_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Module_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Module_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Module_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Module_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Module at list field HooksOrder as it is not of Message kind"))
+}
+
+func (x *_Module_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Module_3_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Module_3_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Module           protoreflect.MessageDescriptor
-	fd_Module_authority protoreflect.FieldDescriptor
+	md_Module                      protoreflect.MessageDescriptor
+	fd_Module_authority            protoreflect.FieldDescriptor
+	fd_Module_price_history_blocks protoreflect.FieldDescriptor
+	fd_Module_hooks_order          protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_slinky_oracle_module_v1_module_proto_init()
 	md_Module = File_slinky_oracle_module_v1_module_proto.Messages().ByName("Module")
 	fd_Module_authority = md_Module.Fields().ByName("authority")
+	fd_Module_price_history_blocks = md_Module.Fields().ByName("price_history_blocks")
+	fd_Module_hooks_order = md_Module.Fields().ByName("hooks_order")
 }
 
 var _ protoreflect.Message = (*fastReflection_Module)(nil)
@@ -95,6 +145,18 @@ func (x *fastReflection_Module) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.PriceHistoryBlocks != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PriceHistoryBlocks)
+		if !f(fd_Module_price_history_blocks, value) {
+			return
+		}
+	}
+	if len(x.HooksOrder) != 0 {
+		value := protoreflect.ValueOfList(&_Module_3_list{list: &x.HooksOrder})
+		if !f(fd_Module_hooks_order, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -112,6 +174,10 @@ func (x *fastReflection_Module) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	case "slinky.oracle.module.v1.Module.authority":
 		return x.Authority != ""
+	case "slinky.oracle.module.v1.Module.price_history_blocks":
+		return x.PriceHistoryBlocks != uint64(0)
+	case "slinky.oracle.module.v1.Module.hooks_order":
+		return len(x.HooksOrder) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.module.v1.Module"))
@@ -130,6 +196,10 @@ func (x *fastReflection_Module) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	case "slinky.oracle.module.v1.Module.authority":
 		x.Authority = ""
+	case "slinky.oracle.module.v1.Module.price_history_blocks":
+		x.PriceHistoryBlocks = uint64(0)
+	case "slinky.oracle.module.v1.Module.hooks_order":
+		x.HooksOrder = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.module.v1.Module"))
@@ -149,6 +219,15 @@ func (x *fastReflection_Module) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "slinky.oracle.module.v1.Module.authority":
 		value := x.Authority
 		return protoreflect.ValueOfString(value)
+	case "slinky.oracle.module.v1.Module.price_history_blocks":
+		value := x.PriceHistoryBlocks
+		return protoreflect.ValueOfUint64(value)
+	case "slinky.oracle.module.v1.Module.hooks_order":
+		if len(x.HooksOrder) == 0 {
+			return protoreflect.ValueOfList(&_Module_3_list{})
+		}
+		listValue := &_Module_3_list{list: &x.HooksOrder}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.module.v1.Module"))
@@ -171,6 +250,12 @@ func (x *fastReflection_Module) Set(fd protoreflect.FieldDescriptor, value proto
 	switch fd.FullName() {
 	case "slinky.oracle.module.v1.Module.authority":
 		x.Authority = value.Interface().(string)
+	case "slinky.oracle.module.v1.Module.price_history_blocks":
+		x.PriceHistoryBlocks = value.Uint()
+	case "slinky.oracle.module.v1.Module.hooks_order":
+		lv := value.List()
+		clv := lv.(*_Module_3_list)
+		x.HooksOrder = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.module.v1.Module"))
@@ -191,8 +276,16 @@ func (x *fastReflection_Module) Set(fd protoreflect.FieldDescriptor, value proto
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Module) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "slinky.oracle.module.v1.Module.hooks_order":
+		if x.HooksOrder == nil {
+			x.HooksOrder = []string{}
+		}
+		value := &_Module_3_list{list: &x.HooksOrder}
+		return protoreflect.ValueOfList(value)
 	case "slinky.oracle.module.v1.Module.authority":
 		panic(fmt.Errorf("field authority of message slinky.oracle.module.v1.Module is not mutable"))
+	case "slinky.oracle.module.v1.Module.price_history_blocks":
+		panic(fmt.Errorf("field price_history_blocks of message slinky.oracle.module.v1.Module is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.module.v1.Module"))
@@ -208,6 +301,11 @@ func (x *fastReflection_Module) NewField(fd protoreflect.FieldDescriptor) protor
 	switch fd.FullName() {
 	case "slinky.oracle.module.v1.Module.authority":
 		return protoreflect.ValueOfString("")
+	case "slinky.oracle.module.v1.Module.price_history_blocks":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "slinky.oracle.module.v1.Module.hooks_order":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Module_3_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.module.v1.Module"))
@@ -281,6 +379,15 @@ func (x *fastReflection_Module) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.PriceHistoryBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.PriceHistoryBlocks))
+		}
+		if len(x.HooksOrder) > 0 {
+			for _, s := range x.HooksOrder {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -310,6 +417,20 @@ func (x *fastReflection_Module) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.HooksOrder) > 0 {
+			for iNdEx := len(x.HooksOrder) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.HooksOrder[iNdEx])
+				copy(dAtA[i:], x.HooksOrder[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.HooksOrder[iNdEx])))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if x.PriceHistoryBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PriceHistoryBlocks))
+			i--
+			dAtA[i] = 0x10
+		}
 		if len(x.Authority) > 0 {
 			i -= len(x.Authority)
 			copy(dAtA[i:], x.Authority)
@@ -398,6 +519,57 @@ func (x *fastReflection_Module) ProtoMethods() *protoiface.Methods {
 				}
 				x.Authority = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PriceHistoryBlocks", wireType)
+				}
+				x.PriceHistoryBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PriceHistoryBlocks |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HooksOrder", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.HooksOrder = append(x.HooksOrder, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -455,6 +627,14 @@ type Module struct {
 	// Authority defines the custom module authority. If not set, defaults to the
 	// governance module.
 	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// PriceHistoryBlocks is the number of blocks of price history retained for
+	// each currency pair. If zero, no price history is retained. The price
+	// history is not included in the genesis export and resets on export.
+	PriceHistoryBlocks uint64 `protobuf:"varint,2,opt,name=price_history_blocks,json=priceHistoryBlocks,proto3" json:"price_history_blocks,omitempty"`
+	// HooksOrder specifies the order of oracle hooks and should be a list
+	// of module names which provide an oracle hooks instance. If no order is
+	// provided, then hooks will be applied in alphabetical order of module names.
+	HooksOrder []string `protobuf:"bytes,3,rep,name=hooks_order,json=hooksOrder,proto3" json:"hooks_order,omitempty"`
 }
 
 func (x *Module) Reset() {
@@ -484,6 +664,20 @@ func (x *Module) GetAuthority() string {
 	return ""
 }
 
+func (x *Module) GetPriceHistoryBlocks() uint64 {
+	if x != nil {
+		return x.PriceHistoryBlocks
+	}
+	return 0
+}
+
+func (x *Module) GetHooksOrder() []string {
+	if x != nil {
+		return x.HooksOrder
+	}
+	return nil
+}
+
 var File_slinky_oracle_module_v1_module_proto protoreflect.FileDescriptor
 
 var file_slinky_oracle_module_v1_module_proto_rawDesc = []byte{
@@ -493,26 +687,32 @@ var file_slinky_oracle_module_v1_module_proto_rawDesc = []byte{
 	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x1a,
 	0x20, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x2f, 0x76, 0x31, 0x61, 0x6c,
 	0x70, 0x68, 0x61, 0x31, 0x2f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0x53, 0x0a, 0x06, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61,
-	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x3a, 0x2b, 0xba, 0xc0, 0x96, 0xda, 0x01,
-	0x25, 0x0a, 0x23, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6b,
-	0x69, 0x70, 0x2d, 0x6d, 0x65, 0x76, 0x2f, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2f, 0x78, 0x2f,
-	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x42, 0xdc, 0x01, 0x0a, 0x1b, 0x63, 0x6f, 0x6d, 0x2e, 0x73,
-	0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x6d, 0x6f, 0x64,
-	0x75, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x31, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
-	0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2f, 0x6f,
-	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x3b,
-	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x53, 0x4f, 0x4d, 0xaa, 0x02,
-	0x17, 0x53, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x4d,
-	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x17, 0x53, 0x6c, 0x69, 0x6e, 0x6b,
-	0x79, 0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5c,
-	0x56, 0x31, 0xe2, 0x02, 0x23, 0x53, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x5c, 0x4f, 0x72, 0x61, 0x63,
-	0x6c, 0x65, 0x5c, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1a, 0x53, 0x6c, 0x69, 0x6e, 0x6b,
-	0x79, 0x3a, 0x3a, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x3a, 0x3a, 0x4d, 0x6f, 0x64, 0x75, 0x6c,
-	0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x22, 0xa6, 0x01, 0x0a, 0x06, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x30, 0x0a, 0x14, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x70, 0x72, 0x69, 0x63, 0x65, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
+	0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0a, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x3a, 0x2b, 0xba,
+	0xc0, 0x96, 0xda, 0x01, 0x25, 0x0a, 0x23, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x73, 0x6b, 0x69, 0x70, 0x2d, 0x6d, 0x65, 0x76, 0x2f, 0x73, 0x6c, 0x69, 0x6e, 0x6b,
+	0x79, 0x2f, 0x78, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x42, 0xdc, 0x01, 0x0a, 0x1b, 0x63,
+	0x6f, 0x6d, 0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
+	0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x4d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x31, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x6c, 0x69, 0x6e,
+	0x6b, 0x79, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x2f, 0x76, 0x31, 0x3b, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x53,
+	0x4f, 0x4d, 0xaa, 0x02, 0x17, 0x53, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x4f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x17, 0x53,
+	0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x4d, 0x6f, 0x64,
+	0x75, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x23, 0x53, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x5c,
+	0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5c, 0x56, 0x31,
+	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1a, 0x53,
+	0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x3a, 0x3a, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x3a, 0x3a, 0x4d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -0,0 +1,1477 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package oraclev1
+
+import (
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	v1 "github.com/skip-mev/slinky/api/slinky/types/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var (
+	md_CircuitBreaker                       protoreflect.MessageDescriptor
+	fd_CircuitBreaker_max_block_change_bps  protoreflect.FieldDescriptor
+	fd_CircuitBreaker_max_window_change_bps protoreflect.FieldDescriptor
+	fd_CircuitBreaker_window_blocks         protoreflect.FieldDescriptor
+	fd_CircuitBreaker_action                protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_slinky_oracle_v1_circuit_breaker_proto_init()
+	md_CircuitBreaker = File_slinky_oracle_v1_circuit_breaker_proto.Messages().ByName("CircuitBreaker")
+	fd_CircuitBreaker_max_block_change_bps = md_CircuitBreaker.Fields().ByName("max_block_change_bps")
+	fd_CircuitBreaker_max_window_change_bps = md_CircuitBreaker.Fields().ByName("max_window_change_bps")
+	fd_CircuitBreaker_window_blocks = md_CircuitBreaker.Fields().ByName("window_blocks")
+	fd_CircuitBreaker_action = md_CircuitBreaker.Fields().ByName("action")
+}
+
+var _ protoreflect.Message = (*fastReflection_CircuitBreaker)(nil)
+
+type fastReflection_CircuitBreaker CircuitBreaker
+
+func (x *CircuitBreaker) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_CircuitBreaker)(x)
+}
+
+func (x *CircuitBreaker) slowProtoReflect() protoreflect.Message {
+	mi := &file_slinky_oracle_v1_circuit_breaker_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_CircuitBreaker_messageType fastReflection_CircuitBreaker_messageType
+var _ protoreflect.MessageType = fastReflection_CircuitBreaker_messageType{}
+
+type fastReflection_CircuitBreaker_messageType struct{}
+
+func (x fastReflection_CircuitBreaker_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_CircuitBreaker)(nil)
+}
+func (x fastReflection_CircuitBreaker_messageType) New() protoreflect.Message {
+	return new(fastReflection_CircuitBreaker)
+}
+func (x fastReflection_CircuitBreaker_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_CircuitBreaker
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_CircuitBreaker) Descriptor() protoreflect.MessageDescriptor {
+	return md_CircuitBreaker
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_CircuitBreaker) Type() protoreflect.MessageType {
+	return _fastReflection_CircuitBreaker_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_CircuitBreaker) New() protoreflect.Message {
+	return new(fastReflection_CircuitBreaker)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_CircuitBreaker) Interface() protoreflect.ProtoMessage {
+	return (*CircuitBreaker)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_CircuitBreaker) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MaxBlockChangeBps != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxBlockChangeBps)
+		if !f(fd_CircuitBreaker_max_block_change_bps, value) {
+			return
+		}
+	}
+	if x.MaxWindowChangeBps != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxWindowChangeBps)
+		if !f(fd_CircuitBreaker_max_window_change_bps, value) {
+			return
+		}
+	}
+	if x.WindowBlocks != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.WindowBlocks)
+		if !f(fd_CircuitBreaker_window_blocks, value) {
+			return
+		}
+	}
+	if x.Action != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Action))
+		if !f(fd_CircuitBreaker_action, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_CircuitBreaker) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "slinky.oracle.v1.CircuitBreaker.max_block_change_bps":
+		return x.MaxBlockChangeBps != uint64(0)
+	case "slinky.oracle.v1.CircuitBreaker.max_window_change_bps":
+		return x.MaxWindowChangeBps != uint64(0)
+	case "slinky.oracle.v1.CircuitBreaker.window_blocks":
+		return x.WindowBlocks != uint64(0)
+	case "slinky.oracle.v1.CircuitBreaker.action":
+		return x.Action != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CircuitBreaker"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.CircuitBreaker does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CircuitBreaker) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "slinky.oracle.v1.CircuitBreaker.max_block_change_bps":
+		x.MaxBlockChangeBps = uint64(0)
+	case "slinky.oracle.v1.CircuitBreaker.max_window_change_bps":
+		x.MaxWindowChangeBps = uint64(0)
+	case "slinky.oracle.v1.CircuitBreaker.window_blocks":
+		x.WindowBlocks = uint64(0)
+	case "slinky.oracle.v1.CircuitBreaker.action":
+		x.Action = 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CircuitBreaker"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.CircuitBreaker does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_CircuitBreaker) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "slinky.oracle.v1.CircuitBreaker.max_block_change_bps":
+		value := x.MaxBlockChangeBps
+		return protoreflect.ValueOfUint64(value)
+	case "slinky.oracle.v1.CircuitBreaker.max_window_change_bps":
+		value := x.MaxWindowChangeBps
+		return protoreflect.ValueOfUint64(value)
+	case "slinky.oracle.v1.CircuitBreaker.window_blocks":
+		value := x.WindowBlocks
+		return protoreflect.ValueOfUint64(value)
+	case "slinky.oracle.v1.CircuitBreaker.action":
+		value := x.Action
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CircuitBreaker"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.CircuitBreaker does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CircuitBreaker) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "slinky.oracle.v1.CircuitBreaker.max_block_change_bps":
+		x.MaxBlockChangeBps = value.Uint()
+	case "slinky.oracle.v1.CircuitBreaker.max_window_change_bps":
+		x.MaxWindowChangeBps = value.Uint()
+	case "slinky.oracle.v1.CircuitBreaker.window_blocks":
+		x.WindowBlocks = value.Uint()
+	case "slinky.oracle.v1.CircuitBreaker.action":
+		x.Action = (CircuitBreakerAction)(value.Enum())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CircuitBreaker"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.CircuitBreaker does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CircuitBreaker) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "slinky.oracle.v1.CircuitBreaker.max_block_change_bps":
+		panic(fmt.Errorf("field max_block_change_bps of message slinky.oracle.v1.CircuitBreaker is not mutable"))
+	case "slinky.oracle.v1.CircuitBreaker.max_window_change_bps":
+		panic(fmt.Errorf("field max_window_change_bps of message slinky.oracle.v1.CircuitBreaker is not mutable"))
+	case "slinky.oracle.v1.CircuitBreaker.window_blocks":
+		panic(fmt.Errorf("field window_blocks of message slinky.oracle.v1.CircuitBreaker is not mutable"))
+	case "slinky.oracle.v1.CircuitBreaker.action":
+		panic(fmt.Errorf("field action of message slinky.oracle.v1.CircuitBreaker is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CircuitBreaker"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.CircuitBreaker does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_CircuitBreaker) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "slinky.oracle.v1.CircuitBreaker.max_block_change_bps":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "slinky.oracle.v1.CircuitBreaker.max_window_change_bps":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "slinky.oracle.v1.CircuitBreaker.window_blocks":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "slinky.oracle.v1.CircuitBreaker.action":
+		return protoreflect.ValueOfEnum(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CircuitBreaker"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.CircuitBreaker does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_CircuitBreaker) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in slinky.oracle.v1.CircuitBreaker", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_CircuitBreaker) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CircuitBreaker) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_CircuitBreaker) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_CircuitBreaker) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*CircuitBreaker)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.MaxBlockChangeBps != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxBlockChangeBps))
+		}
+		if x.MaxWindowChangeBps != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxWindowChangeBps))
+		}
+		if x.WindowBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.WindowBlocks))
+		}
+		if x.Action != 0 {
+			n += 1 + runtime.Sov(uint64(x.Action))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*CircuitBreaker)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Action != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Action))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.WindowBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.WindowBlocks))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.MaxWindowChangeBps != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxWindowChangeBps))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.MaxBlockChangeBps != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxBlockChangeBps))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*CircuitBreaker)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CircuitBreaker: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CircuitBreaker: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxBlockChangeBps", wireType)
+				}
+				x.MaxBlockChangeBps = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxBlockChangeBps |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxWindowChangeBps", wireType)
+				}
+				x.MaxWindowChangeBps = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxWindowChangeBps |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WindowBlocks", wireType)
+				}
+				x.WindowBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.WindowBlocks |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+				}
+				x.Action = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Action |= CircuitBreakerAction(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_CurrencyPairCircuitBreaker                 protoreflect.MessageDescriptor
+	fd_CurrencyPairCircuitBreaker_currency_pair   protoreflect.FieldDescriptor
+	fd_CurrencyPairCircuitBreaker_circuit_breaker protoreflect.FieldDescriptor
+	fd_CurrencyPairCircuitBreaker_halted          protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_slinky_oracle_v1_circuit_breaker_proto_init()
+	md_CurrencyPairCircuitBreaker = File_slinky_oracle_v1_circuit_breaker_proto.Messages().ByName("CurrencyPairCircuitBreaker")
+	fd_CurrencyPairCircuitBreaker_currency_pair = md_CurrencyPairCircuitBreaker.Fields().ByName("currency_pair")
+	fd_CurrencyPairCircuitBreaker_circuit_breaker = md_CurrencyPairCircuitBreaker.Fields().ByName("circuit_breaker")
+	fd_CurrencyPairCircuitBreaker_halted = md_CurrencyPairCircuitBreaker.Fields().ByName("halted")
+}
+
+var _ protoreflect.Message = (*fastReflection_CurrencyPairCircuitBreaker)(nil)
+
+type fastReflection_CurrencyPairCircuitBreaker CurrencyPairCircuitBreaker
+
+func (x *CurrencyPairCircuitBreaker) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_CurrencyPairCircuitBreaker)(x)
+}
+
+func (x *CurrencyPairCircuitBreaker) slowProtoReflect() protoreflect.Message {
+	mi := &file_slinky_oracle_v1_circuit_breaker_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_CurrencyPairCircuitBreaker_messageType fastReflection_CurrencyPairCircuitBreaker_messageType
+var _ protoreflect.MessageType = fastReflection_CurrencyPairCircuitBreaker_messageType{}
+
+type fastReflection_CurrencyPairCircuitBreaker_messageType struct{}
+
+func (x fastReflection_CurrencyPairCircuitBreaker_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_CurrencyPairCircuitBreaker)(nil)
+}
+func (x fastReflection_CurrencyPairCircuitBreaker_messageType) New() protoreflect.Message {
+	return new(fastReflection_CurrencyPairCircuitBreaker)
+}
+func (x fastReflection_CurrencyPairCircuitBreaker_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_CurrencyPairCircuitBreaker
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_CurrencyPairCircuitBreaker) Descriptor() protoreflect.MessageDescriptor {
+	return md_CurrencyPairCircuitBreaker
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_CurrencyPairCircuitBreaker) Type() protoreflect.MessageType {
+	return _fastReflection_CurrencyPairCircuitBreaker_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_CurrencyPairCircuitBreaker) New() protoreflect.Message {
+	return new(fastReflection_CurrencyPairCircuitBreaker)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_CurrencyPairCircuitBreaker) Interface() protoreflect.ProtoMessage {
+	return (*CurrencyPairCircuitBreaker)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_CurrencyPairCircuitBreaker) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.CurrencyPair != nil {
+		value := protoreflect.ValueOfMessage(x.CurrencyPair.ProtoReflect())
+		if !f(fd_CurrencyPairCircuitBreaker_currency_pair, value) {
+			return
+		}
+	}
+	if x.CircuitBreaker != nil {
+		value := protoreflect.ValueOfMessage(x.CircuitBreaker.ProtoReflect())
+		if !f(fd_CurrencyPairCircuitBreaker_circuit_breaker, value) {
+			return
+		}
+	}
+	if x.Halted != false {
+		value := protoreflect.ValueOfBool(x.Halted)
+		if !f(fd_CurrencyPairCircuitBreaker_halted, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_CurrencyPairCircuitBreaker) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "slinky.oracle.v1.CurrencyPairCircuitBreaker.currency_pair":
+		return x.CurrencyPair != nil
+	case "slinky.oracle.v1.CurrencyPairCircuitBreaker.circuit_breaker":
+		return x.CircuitBreaker != nil
+	case "slinky.oracle.v1.CurrencyPairCircuitBreaker.halted":
+		return x.Halted != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairCircuitBreaker"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.CurrencyPairCircuitBreaker does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CurrencyPairCircuitBreaker) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "slinky.oracle.v1.CurrencyPairCircuitBreaker.currency_pair":
+		x.CurrencyPair = nil
+	case "slinky.oracle.v1.CurrencyPairCircuitBreaker.circuit_breaker":
+		x.CircuitBreaker = nil
+	case "slinky.oracle.v1.CurrencyPairCircuitBreaker.halted":
+		x.Halted = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairCircuitBreaker"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.CurrencyPairCircuitBreaker does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_CurrencyPairCircuitBreaker) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "slinky.oracle.v1.CurrencyPairCircuitBreaker.currency_pair":
+		value := x.CurrencyPair
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "slinky.oracle.v1.CurrencyPairCircuitBreaker.circuit_breaker":
+		value := x.CircuitBreaker
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "slinky.oracle.v1.CurrencyPairCircuitBreaker.halted":
+		value := x.Halted
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairCircuitBreaker"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.CurrencyPairCircuitBreaker does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CurrencyPairCircuitBreaker) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "slinky.oracle.v1.CurrencyPairCircuitBreaker.currency_pair":
+		x.CurrencyPair = value.Message().Interface().(*v1.CurrencyPair)
+	case "slinky.oracle.v1.CurrencyPairCircuitBreaker.circuit_breaker":
+		x.CircuitBreaker = value.Message().Interface().(*CircuitBreaker)
+	case "slinky.oracle.v1.CurrencyPairCircuitBreaker.halted":
+		x.Halted = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairCircuitBreaker"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.CurrencyPairCircuitBreaker does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CurrencyPairCircuitBreaker) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "slinky.oracle.v1.CurrencyPairCircuitBreaker.currency_pair":
+		if x.CurrencyPair == nil {
+			x.CurrencyPair = new(v1.CurrencyPair)
+		}
+		return protoreflect.ValueOfMessage(x.CurrencyPair.ProtoReflect())
+	case "slinky.oracle.v1.CurrencyPairCircuitBreaker.circuit_breaker":
+		if x.CircuitBreaker == nil {
+			x.CircuitBreaker = new(CircuitBreaker)
+		}
+		return protoreflect.ValueOfMessage(x.CircuitBreaker.ProtoReflect())
+	case "slinky.oracle.v1.CurrencyPairCircuitBreaker.halted":
+		panic(fmt.Errorf("field halted of message slinky.oracle.v1.CurrencyPairCircuitBreaker is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairCircuitBreaker"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.CurrencyPairCircuitBreaker does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_CurrencyPairCircuitBreaker) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "slinky.oracle.v1.CurrencyPairCircuitBreaker.currency_pair":
+		m := new(v1.CurrencyPair)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "slinky.oracle.v1.CurrencyPairCircuitBreaker.circuit_breaker":
+		m := new(CircuitBreaker)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "slinky.oracle.v1.CurrencyPairCircuitBreaker.halted":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairCircuitBreaker"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.CurrencyPairCircuitBreaker does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_CurrencyPairCircuitBreaker) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in slinky.oracle.v1.CurrencyPairCircuitBreaker", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_CurrencyPairCircuitBreaker) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CurrencyPairCircuitBreaker) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_CurrencyPairCircuitBreaker) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_CurrencyPairCircuitBreaker) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*CurrencyPairCircuitBreaker)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.CurrencyPair != nil {
+			l = options.Size(x.CurrencyPair)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.CircuitBreaker != nil {
+			l = options.Size(x.CircuitBreaker)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Halted {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*CurrencyPairCircuitBreaker)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Halted {
+			i--
+			if x.Halted {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.CircuitBreaker != nil {
+			encoded, err := options.Marshal(x.CircuitBreaker)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.CurrencyPair != nil {
+			encoded, err := options.Marshal(x.CurrencyPair)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*CurrencyPairCircuitBreaker)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CurrencyPairCircuitBreaker: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CurrencyPairCircuitBreaker: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.CurrencyPair == nil {
+					x.CurrencyPair = &v1.CurrencyPair{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CurrencyPair); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CircuitBreaker", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.CircuitBreaker == nil {
+					x.CircuitBreaker = &CircuitBreaker{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CircuitBreaker); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Halted", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Halted = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: slinky/oracle/v1/circuit_breaker.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// CircuitBreakerAction is the action taken when a price update exceeds the
+// limits of a CurrencyPair's CircuitBreaker.
+type CircuitBreakerAction int32
+
+const (
+	// CIRCUIT_BREAKER_ACTION_UNSPECIFIED is an invalid action.
+	CircuitBreakerAction_CIRCUIT_BREAKER_ACTION_UNSPECIFIED CircuitBreakerAction = 0
+	// CIRCUIT_BREAKER_ACTION_CLAMP writes the price clamped to the limits.
+	CircuitBreakerAction_CIRCUIT_BREAKER_ACTION_CLAMP CircuitBreakerAction = 1
+	// CIRCUIT_BREAKER_ACTION_SKIP drops the price update.
+	CircuitBreakerAction_CIRCUIT_BREAKER_ACTION_SKIP CircuitBreakerAction = 2
+	// CIRCUIT_BREAKER_ACTION_HALT drops the price update and halts price updates
+	// for the CurrencyPair until the circuit breaker is reset.
+	CircuitBreakerAction_CIRCUIT_BREAKER_ACTION_HALT CircuitBreakerAction = 3
+)
+
+// Enum value maps for CircuitBreakerAction.
+var (
+	CircuitBreakerAction_name = map[int32]string{
+		0: "CIRCUIT_BREAKER_ACTION_UNSPECIFIED",
+		1: "CIRCUIT_BREAKER_ACTION_CLAMP",
+		2: "CIRCUIT_BREAKER_ACTION_SKIP",
+		3: "CIRCUIT_BREAKER_ACTION_HALT",
+	}
+	CircuitBreakerAction_value = map[string]int32{
+		"CIRCUIT_BREAKER_ACTION_UNSPECIFIED": 0,
+		"CIRCUIT_BREAKER_ACTION_CLAMP":       1,
+		"CIRCUIT_BREAKER_ACTION_SKIP":        2,
+		"CIRCUIT_BREAKER_ACTION_HALT":        3,
+	}
+)
+
+func (x CircuitBreakerAction) Enum() *CircuitBreakerAction {
+	p := new(CircuitBreakerAction)
+	*p = x
+	return p
+}
+
+func (x CircuitBreakerAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CircuitBreakerAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_slinky_oracle_v1_circuit_breaker_proto_enumTypes[0].Descriptor()
+}
+
+func (CircuitBreakerAction) Type() protoreflect.EnumType {
+	return &file_slinky_oracle_v1_circuit_breaker_proto_enumTypes[0]
+}
+
+func (x CircuitBreakerAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CircuitBreakerAction.Descriptor instead.
+func (CircuitBreakerAction) EnumDescriptor() ([]byte, []int) {
+	return file_slinky_oracle_v1_circuit_breaker_proto_rawDescGZIP(), []int{0}
+}
+
+// CircuitBreaker defines the maximum change of a CurrencyPair's price
+// between blocks and over a window of blocks, and the action taken when a
+// price update exceeds either limit.
+type CircuitBreaker struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// MaxBlockChangeBps is the maximum change, in basis points, of the price
+	// relative to the previous price. If zero, the change is not limited.
+	MaxBlockChangeBps uint64 `protobuf:"varint,1,opt,name=max_block_change_bps,json=maxBlockChangeBps,proto3" json:"max_block_change_bps,omitempty"`
+	// MaxWindowChangeBps is the maximum change, in basis points, of the price
+	// relative to the oldest price within the last WindowBlocks blocks. If
+	// zero, the change is not limited.
+	MaxWindowChangeBps uint64 `protobuf:"varint,2,opt,name=max_window_change_bps,json=maxWindowChangeBps,proto3" json:"max_window_change_bps,omitempty"`
+	// WindowBlocks is the number of blocks over which MaxWindowChangeBps is
+	// enforced. The window is limited by the price history retained by the
+	// module.
+	WindowBlocks uint64 `protobuf:"varint,3,opt,name=window_blocks,json=windowBlocks,proto3" json:"window_blocks,omitempty"`
+	// Action is the action taken when a price update exceeds either limit.
+	Action CircuitBreakerAction `protobuf:"varint,4,opt,name=action,proto3,enum=slinky.oracle.v1.CircuitBreakerAction" json:"action,omitempty"`
+}
+
+func (x *CircuitBreaker) Reset() {
+	*x = CircuitBreaker{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_slinky_oracle_v1_circuit_breaker_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CircuitBreaker) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CircuitBreaker) ProtoMessage() {}
+
+// Deprecated: Use CircuitBreaker.ProtoReflect.Descriptor instead.
+func (*CircuitBreaker) Descriptor() ([]byte, []int) {
+	return file_slinky_oracle_v1_circuit_breaker_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CircuitBreaker) GetMaxBlockChangeBps() uint64 {
+	if x != nil {
+		return x.MaxBlockChangeBps
+	}
+	return 0
+}
+
+func (x *CircuitBreaker) GetMaxWindowChangeBps() uint64 {
+	if x != nil {
+		return x.MaxWindowChangeBps
+	}
+	return 0
+}
+
+func (x *CircuitBreaker) GetWindowBlocks() uint64 {
+	if x != nil {
+		return x.WindowBlocks
+	}
+	return 0
+}
+
+func (x *CircuitBreaker) GetAction() CircuitBreakerAction {
+	if x != nil {
+		return x.Action
+	}
+	return CircuitBreakerAction_CIRCUIT_BREAKER_ACTION_UNSPECIFIED
+}
+
+// CurrencyPairCircuitBreaker is the CircuitBreaker configured for a
+// CurrencyPair, alongside whether price updates for the CurrencyPair are
+// halted.
+type CurrencyPairCircuitBreaker struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// CurrencyPair is the CurrencyPair the CircuitBreaker is configured for.
+	CurrencyPair *v1.CurrencyPair `protobuf:"bytes,1,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair,omitempty"`
+	// CircuitBreaker is the configured CircuitBreaker.
+	CircuitBreaker *CircuitBreaker `protobuf:"bytes,2,opt,name=circuit_breaker,json=circuitBreaker,proto3" json:"circuit_breaker,omitempty"`
+	// Halted is true if the CircuitBreaker was tripped with the HALT action and
+	// has not been reset.
+	Halted bool `protobuf:"varint,3,opt,name=halted,proto3" json:"halted,omitempty"`
+}
+
+func (x *CurrencyPairCircuitBreaker) Reset() {
+	*x = CurrencyPairCircuitBreaker{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_slinky_oracle_v1_circuit_breaker_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CurrencyPairCircuitBreaker) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrencyPairCircuitBreaker) ProtoMessage() {}
+
+// Deprecated: Use CurrencyPairCircuitBreaker.ProtoReflect.Descriptor instead.
+func (*CurrencyPairCircuitBreaker) Descriptor() ([]byte, []int) {
+	return file_slinky_oracle_v1_circuit_breaker_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CurrencyPairCircuitBreaker) GetCurrencyPair() *v1.CurrencyPair {
+	if x != nil {
+		return x.CurrencyPair
+	}
+	return nil
+}
+
+func (x *CurrencyPairCircuitBreaker) GetCircuitBreaker() *CircuitBreaker {
+	if x != nil {
+		return x.CircuitBreaker
+	}
+	return nil
+}
+
+func (x *CurrencyPairCircuitBreaker) GetHalted() bool {
+	if x != nil {
+		return x.Halted
+	}
+	return false
+}
+
+var File_slinky_oracle_v1_circuit_breaker_proto protoreflect.FileDescriptor
+
+var file_slinky_oracle_v1_circuit_breaker_proto_rawDesc = []byte{
+	0x0a, 0x26, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f,
+	0x76, 0x31, 0x2f, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x5f, 0x62, 0x72, 0x65, 0x61, 0x6b,
+	0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x10, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79,
+	0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x23, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x76,
+	0x31, 0x2f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd9, 0x01, 0x0a, 0x0e, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69,
+	0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x12, 0x2f, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x62, 0x70, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x70, 0x73, 0x12, 0x31, 0x0a, 0x15, 0x6d, 0x61, 0x78,
+	0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x62,
+	0x70, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x57, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x70, 0x73, 0x12, 0x23, 0x0a, 0x0d,
+	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0c, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x73, 0x12, 0x3e, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x26, 0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61,
+	0x6b, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0xcf, 0x01, 0x0a, 0x1a, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61,
+	0x69, 0x72, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72,
+	0x12, 0x48, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x70, 0x61, 0x69,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x50, 0x61, 0x69, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61, 0x69, 0x72, 0x12, 0x4f, 0x0a, 0x0f, 0x63, 0x69,
+	0x72, 0x63, 0x75, 0x69, 0x74, 0x5f, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x6f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72,
+	0x65, 0x61, 0x6b, 0x65, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0e, 0x63, 0x69, 0x72,
+	0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x68,
+	0x61, 0x6c, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x61, 0x6c,
+	0x74, 0x65, 0x64, 0x2a, 0xa2, 0x01, 0x0a, 0x14, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42,
+	0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x22,
+	0x43, 0x49, 0x52, 0x43, 0x55, 0x49, 0x54, 0x5f, 0x42, 0x52, 0x45, 0x41, 0x4b, 0x45, 0x52, 0x5f,
+	0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x20, 0x0a, 0x1c, 0x43, 0x49, 0x52, 0x43, 0x55, 0x49, 0x54, 0x5f,
+	0x42, 0x52, 0x45, 0x41, 0x4b, 0x45, 0x52, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43,
+	0x4c, 0x41, 0x4d, 0x50, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x43, 0x49, 0x52, 0x43, 0x55, 0x49,
+	0x54, 0x5f, 0x42, 0x52, 0x45, 0x41, 0x4b, 0x45, 0x52, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x53, 0x4b, 0x49, 0x50, 0x10, 0x02, 0x12, 0x1f, 0x0a, 0x1b, 0x43, 0x49, 0x52, 0x43, 0x55,
+	0x49, 0x54, 0x5f, 0x42, 0x52, 0x45, 0x41, 0x4b, 0x45, 0x52, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x48, 0x41, 0x4c, 0x54, 0x10, 0x03, 0x42, 0xb9, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d,
+	0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76,
+	0x31, 0x42, 0x13, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65,
+	0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x6c, 0x69, 0x6e, 0x6b,
+	0x79, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x6f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x53, 0x4f, 0x58, 0xaa, 0x02, 0x10, 0x53, 0x6c, 0x69,
+	0x6e, 0x6b, 0x79, 0x2e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x10,
+	0x53, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31,
+	0xe2, 0x02, 0x1c, 0x53, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65,
+	0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
+	0x02, 0x12, 0x53, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x3a, 0x3a, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65,
+	0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_slinky_oracle_v1_circuit_breaker_proto_rawDescOnce sync.Once
+	file_slinky_oracle_v1_circuit_breaker_proto_rawDescData = file_slinky_oracle_v1_circuit_breaker_proto_rawDesc
+)
+
+func file_slinky_oracle_v1_circuit_breaker_proto_rawDescGZIP() []byte {
+	file_slinky_oracle_v1_circuit_breaker_proto_rawDescOnce.Do(func() {
+		file_slinky_oracle_v1_circuit_breaker_proto_rawDescData = protoimpl.X.CompressGZIP(file_slinky_oracle_v1_circuit_breaker_proto_rawDescData)
+	})
+	return file_slinky_oracle_v1_circuit_breaker_proto_rawDescData
+}
+
+var file_slinky_oracle_v1_circuit_breaker_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_slinky_oracle_v1_circuit_breaker_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_slinky_oracle_v1_circuit_breaker_proto_goTypes = []interface{}{
+	(CircuitBreakerAction)(0),          // 0: slinky.oracle.v1.CircuitBreakerAction
+	(*CircuitBreaker)(nil),             // 1: slinky.oracle.v1.CircuitBreaker
+	(*CurrencyPairCircuitBreaker)(nil), // 2: slinky.oracle.v1.CurrencyPairCircuitBreaker
+	(*v1.CurrencyPair)(nil),            // 3: slinky.types.v1.CurrencyPair
+}
+var file_slinky_oracle_v1_circuit_breaker_proto_depIdxs = []int32{
+	0, // 0: slinky.oracle.v1.CircuitBreaker.action:type_name -> slinky.oracle.v1.CircuitBreakerAction
+	3, // 1: slinky.oracle.v1.CurrencyPairCircuitBreaker.currency_pair:type_name -> slinky.types.v1.CurrencyPair
+	1, // 2: slinky.oracle.v1.CurrencyPairCircuitBreaker.circuit_breaker:type_name -> slinky.oracle.v1.CircuitBreaker
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_slinky_oracle_v1_circuit_breaker_proto_init() }
+func file_slinky_oracle_v1_circuit_breaker_proto_init() {
+	if File_slinky_oracle_v1_circuit_breaker_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_slinky_oracle_v1_circuit_breaker_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CircuitBreaker); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_slinky_oracle_v1_circuit_breaker_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CurrencyPairCircuitBreaker); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_slinky_oracle_v1_circuit_breaker_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_slinky_oracle_v1_circuit_breaker_proto_goTypes,
+		DependencyIndexes: file_slinky_oracle_v1_circuit_breaker_proto_depIdxs,
+		EnumInfos:         file_slinky_oracle_v1_circuit_breaker_proto_enumTypes,
+		MessageInfos:      file_slinky_oracle_v1_circuit_breaker_proto_msgTypes,
+	}.Build()
+	File_slinky_oracle_v1_circuit_breaker_proto = out.File
+	file_slinky_oracle_v1_circuit_breaker_proto_rawDesc = nil
+	file_slinky_oracle_v1_circuit_breaker_proto_goTypes = nil
+	file_slinky_oracle_v1_circuit_breaker_proto_depIdxs = nil
+}
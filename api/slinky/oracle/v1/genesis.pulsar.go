@@ -17,10 +17,12 @@ import (
 )
 
 var (
-	md_QuotePrice                 protoreflect.MessageDescriptor
-	fd_QuotePrice_price           protoreflect.FieldDescriptor
-	fd_QuotePrice_block_timestamp protoreflect.FieldDescriptor
-	fd_QuotePrice_block_height    protoreflect.FieldDescriptor
+	md_QuotePrice                       protoreflect.MessageDescriptor
+	fd_QuotePrice_price                 protoreflect.FieldDescriptor
+	fd_QuotePrice_block_timestamp       protoreflect.FieldDescriptor
+	fd_QuotePrice_block_height          protoreflect.FieldDescriptor
+	fd_QuotePrice_dispersion            protoreflect.FieldDescriptor
+	fd_QuotePrice_voting_power_fraction protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -29,6 +31,8 @@ func init() {
 	fd_QuotePrice_price = md_QuotePrice.Fields().ByName("price")
 	fd_QuotePrice_block_timestamp = md_QuotePrice.Fields().ByName("block_timestamp")
 	fd_QuotePrice_block_height = md_QuotePrice.Fields().ByName("block_height")
+	fd_QuotePrice_dispersion = md_QuotePrice.Fields().ByName("dispersion")
+	fd_QuotePrice_voting_power_fraction = md_QuotePrice.Fields().ByName("voting_power_fraction")
 }
 
 var _ protoreflect.Message = (*fastReflection_QuotePrice)(nil)
@@ -114,6 +118,18 @@ func (x *fastReflection_QuotePrice) Range(f func(protoreflect.FieldDescriptor, p
 			return
 		}
 	}
+	if x.Dispersion != "" {
+		value := protoreflect.ValueOfString(x.Dispersion)
+		if !f(fd_QuotePrice_dispersion, value) {
+			return
+		}
+	}
+	if x.VotingPowerFraction != "" {
+		value := protoreflect.ValueOfString(x.VotingPowerFraction)
+		if !f(fd_QuotePrice_voting_power_fraction, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -135,6 +151,10 @@ func (x *fastReflection_QuotePrice) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.BlockTimestamp != nil
 	case "slinky.oracle.v1.QuotePrice.block_height":
 		return x.BlockHeight != uint64(0)
+	case "slinky.oracle.v1.QuotePrice.dispersion":
+		return x.Dispersion != ""
+	case "slinky.oracle.v1.QuotePrice.voting_power_fraction":
+		return x.VotingPowerFraction != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.QuotePrice"))
@@ -157,6 +177,10 @@ func (x *fastReflection_QuotePrice) Clear(fd protoreflect.FieldDescriptor) {
 		x.BlockTimestamp = nil
 	case "slinky.oracle.v1.QuotePrice.block_height":
 		x.BlockHeight = uint64(0)
+	case "slinky.oracle.v1.QuotePrice.dispersion":
+		x.Dispersion = ""
+	case "slinky.oracle.v1.QuotePrice.voting_power_fraction":
+		x.VotingPowerFraction = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.QuotePrice"))
@@ -182,6 +206,12 @@ func (x *fastReflection_QuotePrice) Get(descriptor protoreflect.FieldDescriptor)
 	case "slinky.oracle.v1.QuotePrice.block_height":
 		value := x.BlockHeight
 		return protoreflect.ValueOfUint64(value)
+	case "slinky.oracle.v1.QuotePrice.dispersion":
+		value := x.Dispersion
+		return protoreflect.ValueOfString(value)
+	case "slinky.oracle.v1.QuotePrice.voting_power_fraction":
+		value := x.VotingPowerFraction
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.QuotePrice"))
@@ -208,6 +238,10 @@ func (x *fastReflection_QuotePrice) Set(fd protoreflect.FieldDescriptor, value p
 		x.BlockTimestamp = value.Message().Interface().(*timestamppb.Timestamp)
 	case "slinky.oracle.v1.QuotePrice.block_height":
 		x.BlockHeight = value.Uint()
+	case "slinky.oracle.v1.QuotePrice.dispersion":
+		x.Dispersion = value.Interface().(string)
+	case "slinky.oracle.v1.QuotePrice.voting_power_fraction":
+		x.VotingPowerFraction = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.QuotePrice"))
@@ -237,6 +271,10 @@ func (x *fastReflection_QuotePrice) Mutable(fd protoreflect.FieldDescriptor) pro
 		panic(fmt.Errorf("field price of message slinky.oracle.v1.QuotePrice is not mutable"))
 	case "slinky.oracle.v1.QuotePrice.block_height":
 		panic(fmt.Errorf("field block_height of message slinky.oracle.v1.QuotePrice is not mutable"))
+	case "slinky.oracle.v1.QuotePrice.dispersion":
+		panic(fmt.Errorf("field dispersion of message slinky.oracle.v1.QuotePrice is not mutable"))
+	case "slinky.oracle.v1.QuotePrice.voting_power_fraction":
+		panic(fmt.Errorf("field voting_power_fraction of message slinky.oracle.v1.QuotePrice is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.QuotePrice"))
@@ -257,6 +295,10 @@ func (x *fastReflection_QuotePrice) NewField(fd protoreflect.FieldDescriptor) pr
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	case "slinky.oracle.v1.QuotePrice.block_height":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "slinky.oracle.v1.QuotePrice.dispersion":
+		return protoreflect.ValueOfString("")
+	case "slinky.oracle.v1.QuotePrice.voting_power_fraction":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.QuotePrice"))
@@ -337,6 +379,14 @@ func (x *fastReflection_QuotePrice) ProtoMethods() *protoiface.Methods {
 		if x.BlockHeight != 0 {
 			n += 1 + runtime.Sov(uint64(x.BlockHeight))
 		}
+		l = len(x.Dispersion)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.VotingPowerFraction)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -366,6 +416,20 @@ func (x *fastReflection_QuotePrice) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.VotingPowerFraction) > 0 {
+			i -= len(x.VotingPowerFraction)
+			copy(dAtA[i:], x.VotingPowerFraction)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VotingPowerFraction)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Dispersion) > 0 {
+			i -= len(x.Dispersion)
+			copy(dAtA[i:], x.Dispersion)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Dispersion)))
+			i--
+			dAtA[i] = 0x22
+		}
 		if x.BlockHeight != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
 			i--
@@ -528,6 +592,70 @@ func (x *fastReflection_QuotePrice) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Dispersion", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Dispersion = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VotingPowerFraction", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VotingPowerFraction = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1755,10 +1883,114 @@ func (x *_GenesisState_1_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_GenesisState_3_list)(nil)
+
+type _GenesisState_3_list struct {
+	list *[]*CurrencyPairCircuitBreaker
+}
+
+func (x *_GenesisState_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CurrencyPairCircuitBreaker)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CurrencyPairCircuitBreaker)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_3_list) AppendMutable() protoreflect.Value {
+	v := new(CurrencyPairCircuitBreaker)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_3_list) NewElement() protoreflect.Value {
+	v := new(CurrencyPairCircuitBreaker)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_4_list)(nil)
+
+type _GenesisState_4_list struct {
+	list *[]*CurrencyPairMaxPriceAge
+}
+
+func (x *_GenesisState_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CurrencyPairMaxPriceAge)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CurrencyPairMaxPriceAge)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_4_list) AppendMutable() protoreflect.Value {
+	v := new(CurrencyPairMaxPriceAge)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_4_list) NewElement() protoreflect.Value {
+	v := new(CurrencyPairMaxPriceAge)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_GenesisState                       protoreflect.MessageDescriptor
 	fd_GenesisState_currency_pair_genesis protoreflect.FieldDescriptor
 	fd_GenesisState_next_id               protoreflect.FieldDescriptor
+	fd_GenesisState_circuit_breakers      protoreflect.FieldDescriptor
+	fd_GenesisState_max_price_ages        protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1766,6 +1998,8 @@ func init() {
 	md_GenesisState = File_slinky_oracle_v1_genesis_proto.Messages().ByName("GenesisState")
 	fd_GenesisState_currency_pair_genesis = md_GenesisState.Fields().ByName("currency_pair_genesis")
 	fd_GenesisState_next_id = md_GenesisState.Fields().ByName("next_id")
+	fd_GenesisState_circuit_breakers = md_GenesisState.Fields().ByName("circuit_breakers")
+	fd_GenesisState_max_price_ages = md_GenesisState.Fields().ByName("max_price_ages")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -1845,6 +2079,18 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if len(x.CircuitBreakers) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_3_list{list: &x.CircuitBreakers})
+		if !f(fd_GenesisState_circuit_breakers, value) {
+			return
+		}
+	}
+	if len(x.MaxPriceAges) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_4_list{list: &x.MaxPriceAges})
+		if !f(fd_GenesisState_max_price_ages, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1864,6 +2110,10 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 		return len(x.CurrencyPairGenesis) != 0
 	case "slinky.oracle.v1.GenesisState.next_id":
 		return x.NextId != uint64(0)
+	case "slinky.oracle.v1.GenesisState.circuit_breakers":
+		return len(x.CircuitBreakers) != 0
+	case "slinky.oracle.v1.GenesisState.max_price_ages":
+		return len(x.MaxPriceAges) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.GenesisState"))
@@ -1884,6 +2134,10 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 		x.CurrencyPairGenesis = nil
 	case "slinky.oracle.v1.GenesisState.next_id":
 		x.NextId = uint64(0)
+	case "slinky.oracle.v1.GenesisState.circuit_breakers":
+		x.CircuitBreakers = nil
+	case "slinky.oracle.v1.GenesisState.max_price_ages":
+		x.MaxPriceAges = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.GenesisState"))
@@ -1909,6 +2163,18 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 	case "slinky.oracle.v1.GenesisState.next_id":
 		value := x.NextId
 		return protoreflect.ValueOfUint64(value)
+	case "slinky.oracle.v1.GenesisState.circuit_breakers":
+		if len(x.CircuitBreakers) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_3_list{})
+		}
+		listValue := &_GenesisState_3_list{list: &x.CircuitBreakers}
+		return protoreflect.ValueOfList(listValue)
+	case "slinky.oracle.v1.GenesisState.max_price_ages":
+		if len(x.MaxPriceAges) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_4_list{})
+		}
+		listValue := &_GenesisState_4_list{list: &x.MaxPriceAges}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.GenesisState"))
@@ -1935,6 +2201,14 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 		x.CurrencyPairGenesis = *clv.list
 	case "slinky.oracle.v1.GenesisState.next_id":
 		x.NextId = value.Uint()
+	case "slinky.oracle.v1.GenesisState.circuit_breakers":
+		lv := value.List()
+		clv := lv.(*_GenesisState_3_list)
+		x.CircuitBreakers = *clv.list
+	case "slinky.oracle.v1.GenesisState.max_price_ages":
+		lv := value.List()
+		clv := lv.(*_GenesisState_4_list)
+		x.MaxPriceAges = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.GenesisState"))
@@ -1961,6 +2235,18 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 		}
 		value := &_GenesisState_1_list{list: &x.CurrencyPairGenesis}
 		return protoreflect.ValueOfList(value)
+	case "slinky.oracle.v1.GenesisState.circuit_breakers":
+		if x.CircuitBreakers == nil {
+			x.CircuitBreakers = []*CurrencyPairCircuitBreaker{}
+		}
+		value := &_GenesisState_3_list{list: &x.CircuitBreakers}
+		return protoreflect.ValueOfList(value)
+	case "slinky.oracle.v1.GenesisState.max_price_ages":
+		if x.MaxPriceAges == nil {
+			x.MaxPriceAges = []*CurrencyPairMaxPriceAge{}
+		}
+		value := &_GenesisState_4_list{list: &x.MaxPriceAges}
+		return protoreflect.ValueOfList(value)
 	case "slinky.oracle.v1.GenesisState.next_id":
 		panic(fmt.Errorf("field next_id of message slinky.oracle.v1.GenesisState is not mutable"))
 	default:
@@ -1981,6 +2267,12 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 		return protoreflect.ValueOfList(&_GenesisState_1_list{list: &list})
 	case "slinky.oracle.v1.GenesisState.next_id":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "slinky.oracle.v1.GenesisState.circuit_breakers":
+		list := []*CurrencyPairCircuitBreaker{}
+		return protoreflect.ValueOfList(&_GenesisState_3_list{list: &list})
+	case "slinky.oracle.v1.GenesisState.max_price_ages":
+		list := []*CurrencyPairMaxPriceAge{}
+		return protoreflect.ValueOfList(&_GenesisState_4_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.GenesisState"))
@@ -2059,6 +2351,18 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 		if x.NextId != 0 {
 			n += 1 + runtime.Sov(uint64(x.NextId))
 		}
+		if len(x.CircuitBreakers) > 0 {
+			for _, e := range x.CircuitBreakers {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.MaxPriceAges) > 0 {
+			for _, e := range x.MaxPriceAges {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2088,6 +2392,38 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.MaxPriceAges) > 0 {
+			for iNdEx := len(x.MaxPriceAges) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MaxPriceAges[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.CircuitBreakers) > 0 {
+			for iNdEx := len(x.CircuitBreakers) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.CircuitBreakers[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
 		if x.NextId != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.NextId))
 			i--
@@ -2211,6 +2547,74 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakers", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CircuitBreakers = append(x.CircuitBreakers, &CurrencyPairCircuitBreaker{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CircuitBreakers[len(x.CircuitBreakers)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxPriceAges", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxPriceAges = append(x.MaxPriceAges, &CurrencyPairMaxPriceAge{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MaxPriceAges[len(x.MaxPriceAges)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2273,6 +2677,16 @@ type QuotePrice struct {
 	BlockTimestamp *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=block_timestamp,json=blockTimestamp,proto3" json:"block_timestamp,omitempty"`
 	// BlockHeight is height of block mentioned above
 	BlockHeight uint64 `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// Dispersion is the stake-weighted interquartile range of the prices
+	// reported by validators for this update, i.e. the difference between the
+	// stake-weighted 75th and 25th percentile prices. This is nil if the price
+	// was not derived from validator votes or the application does not compute
+	// price statistics.
+	Dispersion string `protobuf:"bytes,4,opt,name=dispersion,proto3" json:"dispersion,omitempty"`
+	// VotingPowerFraction is the fraction of the total voting power that
+	// reported a price for this update. This is nil in the same cases as
+	// Dispersion.
+	VotingPowerFraction string `protobuf:"bytes,5,opt,name=voting_power_fraction,json=votingPowerFraction,proto3" json:"voting_power_fraction,omitempty"`
 }
 
 func (x *QuotePrice) Reset() {
@@ -2316,6 +2730,20 @@ func (x *QuotePrice) GetBlockHeight() uint64 {
 	return 0
 }
 
+func (x *QuotePrice) GetDispersion() string {
+	if x != nil {
+		return x.Dispersion
+	}
+	return ""
+}
+
+func (x *QuotePrice) GetVotingPowerFraction() string {
+	if x != nil {
+		return x.VotingPowerFraction
+	}
+	return ""
+}
+
 // CurrencyPairState represents the stateful information tracked by the x/oracle
 // module per-currency-pair.
 type CurrencyPairState struct {
@@ -2453,6 +2881,12 @@ type GenesisState struct {
 	CurrencyPairGenesis []*CurrencyPairGenesis `protobuf:"bytes,1,rep,name=currency_pair_genesis,json=currencyPairGenesis,proto3" json:"currency_pair_genesis,omitempty"`
 	// NextID is the next ID to be used for a CurrencyPair
 	NextId uint64 `protobuf:"varint,2,opt,name=next_id,json=nextId,proto3" json:"next_id,omitempty"`
+	// CircuitBreakers is the set of CircuitBreakers configured for
+	// CurrencyPairs in the module.
+	CircuitBreakers []*CurrencyPairCircuitBreaker `protobuf:"bytes,3,rep,name=circuit_breakers,json=circuitBreakers,proto3" json:"circuit_breakers,omitempty"`
+	// MaxPriceAges is the set of MaxPriceAges configured for CurrencyPairs in
+	// the module.
+	MaxPriceAges []*CurrencyPairMaxPriceAge `protobuf:"bytes,4,rep,name=max_price_ages,json=maxPriceAges,proto3" json:"max_price_ages,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -2489,6 +2923,20 @@ func (x *GenesisState) GetNextId() uint64 {
 	return 0
 }
 
+func (x *GenesisState) GetCircuitBreakers() []*CurrencyPairCircuitBreaker {
+	if x != nil {
+		return x.CircuitBreakers
+	}
+	return nil
+}
+
+func (x *GenesisState) GetMaxPriceAges() []*CurrencyPairMaxPriceAge {
+	if x != nil {
+		return x.MaxPriceAges
+	}
+	return nil
+}
+
 var File_slinky_oracle_v1_genesis_proto protoreflect.FileDescriptor
 
 var file_slinky_oracle_v1_genesis_proto_rawDesc = []byte{
@@ -2502,61 +2950,88 @@ var file_slinky_oracle_v1_genesis_proto_rawDesc = []byte{
 	0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x23, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2f, 0x74, 0x79, 0x70,
 	0x65, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x70,
-	0x61, 0x69, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc1, 0x01, 0x0a, 0x0a, 0x51, 0x75,
-	0x6f, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x41, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2b, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
-	0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
-	0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x49, 0x6e, 0x74, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x4d, 0x0a, 0x0f, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0e, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x73, 0x0a,
-	0x11, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61, 0x69, 0x72, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x12, 0x38, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x42,
-	0x04, 0xc8, 0xde, 0x1f, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05,
-	0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6e, 0x6f, 0x6e,
-	0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
-	0x69, 0x64, 0x22, 0xd9, 0x01, 0x0a, 0x13, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50,
-	0x61, 0x69, 0x72, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x12, 0x48, 0x0a, 0x0d, 0x63, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61, 0x69, 0x72,
-	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
-	0x50, 0x61, 0x69, 0x72, 0x12, 0x52, 0x0a, 0x13, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
-	0x5f, 0x70, 0x61, 0x69, 0x72, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x42,
-	0x04, 0xc8, 0xde, 0x1f, 0x01, 0x52, 0x11, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50,
-	0x61, 0x69, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x88,
-	0x01, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
-	0x5f, 0x0a, 0x15, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x70, 0x61, 0x69, 0x72,
-	0x5f, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25,
+	0x61, 0x69, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x26, 0x73, 0x6c, 0x69, 0x6e, 0x6b,
+	0x79, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x69, 0x72, 0x63,
+	0x75, 0x69, 0x74, 0x5f, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x20, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
+	0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0xf5, 0x02, 0x0a, 0x0a, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x12, 0x41, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x2b, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74,
+	0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x05,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x4d, 0x0a, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00,
+	0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x4b, 0x0a, 0x0a, 0x64, 0x69, 0x73, 0x70, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2b, 0xc8, 0xde, 0x1f,
+	0x01, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
+	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x70, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x65, 0x0a, 0x15, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70,
+	0x6f, 0x77, 0x65, 0x72, 0x5f, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x01, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c,
+	0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x13, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6f,
+	0x77, 0x65, 0x72, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x73, 0x0a, 0x11, 0x43,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61, 0x69, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x38, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f,
+	0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0xd9, 0x01, 0x0a, 0x13, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61, 0x69,
+	0x72, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x12, 0x48, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61, 0x69, 0x72, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61,
+	0x69, 0x72, 0x12, 0x52, 0x0a, 0x13, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x70,
+	0x61, 0x69, 0x72, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x01, 0x52, 0x11, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61, 0x69,
+	0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0xbe, 0x02, 0x0a,
+	0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x5f, 0x0a,
+	0x15, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x5f, 0x67,
+	0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73,
+	0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61, 0x69, 0x72, 0x47, 0x65, 0x6e, 0x65,
+	0x73, 0x69, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x13, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x50, 0x61, 0x69, 0x72, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x12, 0x17,
+	0x0a, 0x07, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x6e, 0x65, 0x78, 0x74, 0x49, 0x64, 0x12, 0x5d, 0x0a, 0x10, 0x63, 0x69, 0x72, 0x63, 0x75,
+	0x69, 0x74, 0x5f, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2c, 0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61, 0x69,
+	0x72, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0f, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72,
+	0x65, 0x61, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x55, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x5f, 0x61, 0x67, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29,
 	0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61, 0x69, 0x72, 0x47, 0x65,
-	0x6e, 0x65, 0x73, 0x69, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x13, 0x63, 0x75, 0x72,
-	0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61, 0x69, 0x72, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73,
-	0x12, 0x17, 0x0a, 0x07, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x06, 0x6e, 0x65, 0x78, 0x74, 0x49, 0x64, 0x42, 0xb2, 0x01, 0x0a, 0x14, 0x63, 0x6f,
-	0x6d, 0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e,
-	0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x50, 0x01, 0x5a, 0x2a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2f, 0x6f, 0x72, 0x61, 0x63,
-	0x6c, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x76, 0x31, 0xa2, 0x02,
-	0x03, 0x53, 0x4f, 0x58, 0xaa, 0x02, 0x10, 0x53, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x4f, 0x72,
-	0x61, 0x63, 0x6c, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x10, 0x53, 0x6c, 0x69, 0x6e, 0x6b, 0x79,
-	0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1c, 0x53, 0x6c, 0x69,
-	0x6e, 0x6b, 0x79, 0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50,
-	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x12, 0x53, 0x6c, 0x69, 0x6e,
-	0x6b, 0x79, 0x3a, 0x3a, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x31, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61, 0x69, 0x72, 0x4d, 0x61,
+	0x78, 0x50, 0x72, 0x69, 0x63, 0x65, 0x41, 0x67, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
+	0x0c, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x69, 0x63, 0x65, 0x41, 0x67, 0x65, 0x73, 0x42, 0xb2, 0x01,
+	0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x6f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2f,
+	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
+	0x76, 0x31, 0xa2, 0x02, 0x03, 0x53, 0x4f, 0x58, 0xaa, 0x02, 0x10, 0x53, 0x6c, 0x69, 0x6e, 0x6b,
+	0x79, 0x2e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x10, 0x53, 0x6c,
+	0x69, 0x6e, 0x6b, 0x79, 0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02,
+	0x1c, 0x53, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56,
+	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x12,
+	0x53, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x3a, 0x3a, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x3a, 0x3a,
+	0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2573,12 +3048,14 @@ func file_slinky_oracle_v1_genesis_proto_rawDescGZIP() []byte {
 
 var file_slinky_oracle_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_slinky_oracle_v1_genesis_proto_goTypes = []interface{}{
-	(*QuotePrice)(nil),            // 0: slinky.oracle.v1.QuotePrice
-	(*CurrencyPairState)(nil),     // 1: slinky.oracle.v1.CurrencyPairState
-	(*CurrencyPairGenesis)(nil),   // 2: slinky.oracle.v1.CurrencyPairGenesis
-	(*GenesisState)(nil),          // 3: slinky.oracle.v1.GenesisState
-	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
-	(*v1.CurrencyPair)(nil),       // 5: slinky.types.v1.CurrencyPair
+	(*QuotePrice)(nil),                 // 0: slinky.oracle.v1.QuotePrice
+	(*CurrencyPairState)(nil),          // 1: slinky.oracle.v1.CurrencyPairState
+	(*CurrencyPairGenesis)(nil),        // 2: slinky.oracle.v1.CurrencyPairGenesis
+	(*GenesisState)(nil),               // 3: slinky.oracle.v1.GenesisState
+	(*timestamppb.Timestamp)(nil),      // 4: google.protobuf.Timestamp
+	(*v1.CurrencyPair)(nil),            // 5: slinky.types.v1.CurrencyPair
+	(*CurrencyPairCircuitBreaker)(nil), // 6: slinky.oracle.v1.CurrencyPairCircuitBreaker
+	(*CurrencyPairMaxPriceAge)(nil),    // 7: slinky.oracle.v1.CurrencyPairMaxPriceAge
 }
 var file_slinky_oracle_v1_genesis_proto_depIdxs = []int32{
 	4, // 0: slinky.oracle.v1.QuotePrice.block_timestamp:type_name -> google.protobuf.Timestamp
@@ -2586,11 +3063,13 @@ var file_slinky_oracle_v1_genesis_proto_depIdxs = []int32{
 	5, // 2: slinky.oracle.v1.CurrencyPairGenesis.currency_pair:type_name -> slinky.types.v1.CurrencyPair
 	0, // 3: slinky.oracle.v1.CurrencyPairGenesis.currency_pair_price:type_name -> slinky.oracle.v1.QuotePrice
 	2, // 4: slinky.oracle.v1.GenesisState.currency_pair_genesis:type_name -> slinky.oracle.v1.CurrencyPairGenesis
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	6, // 5: slinky.oracle.v1.GenesisState.circuit_breakers:type_name -> slinky.oracle.v1.CurrencyPairCircuitBreaker
+	7, // 6: slinky.oracle.v1.GenesisState.max_price_ages:type_name -> slinky.oracle.v1.CurrencyPairMaxPriceAge
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_slinky_oracle_v1_genesis_proto_init() }
@@ -2598,6 +3077,8 @@ func file_slinky_oracle_v1_genesis_proto_init() {
 	if File_slinky_oracle_v1_genesis_proto != nil {
 		return
 	}
+	file_slinky_oracle_v1_circuit_breaker_proto_init()
+	file_slinky_oracle_v1_staleness_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_slinky_oracle_v1_genesis_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*QuotePrice); i {
@@ -22,6 +22,9 @@ const (
 	Query_GetAllCurrencyPairs_FullMethodName = "/slinky.oracle.v1.Query/GetAllCurrencyPairs"
 	Query_GetPrice_FullMethodName            = "/slinky.oracle.v1.Query/GetPrice"
 	Query_GetPrices_FullMethodName           = "/slinky.oracle.v1.Query/GetPrices"
+	Query_GetPriceHistory_FullMethodName     = "/slinky.oracle.v1.Query/GetPriceHistory"
+	Query_GetTWAP_FullMethodName             = "/slinky.oracle.v1.Query/GetTWAP"
+	Query_GetCircuitBreaker_FullMethodName   = "/slinky.oracle.v1.Query/GetCircuitBreaker"
 )
 
 // QueryClient is the client API for Query service.
@@ -34,6 +37,15 @@ type QueryClient interface {
 	// that CurrencyPair.
 	GetPrice(ctx context.Context, in *GetPriceRequest, opts ...grpc.CallOption) (*GetPriceResponse, error)
 	GetPrices(ctx context.Context, in *GetPricesRequest, opts ...grpc.CallOption) (*GetPricesResponse, error)
+	// Given a CurrencyPair, return the retained historical QuotePrices for that
+	// CurrencyPair ordered by block height.
+	GetPriceHistory(ctx context.Context, in *GetPriceHistoryRequest, opts ...grpc.CallOption) (*GetPriceHistoryResponse, error)
+	// Given a CurrencyPair and a window, return the time-weighted average price
+	// of the CurrencyPair over the window ending at the current block time.
+	GetTWAP(ctx context.Context, in *GetTWAPRequest, opts ...grpc.CallOption) (*GetTWAPResponse, error)
+	// Given a CurrencyPair, return the CircuitBreaker configured for that
+	// CurrencyPair and whether its price updates are halted.
+	GetCircuitBreaker(ctx context.Context, in *GetCircuitBreakerRequest, opts ...grpc.CallOption) (*GetCircuitBreakerResponse, error)
 }
 
 type queryClient struct {
@@ -71,6 +83,33 @@ func (c *queryClient) GetPrices(ctx context.Context, in *GetPricesRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) GetPriceHistory(ctx context.Context, in *GetPriceHistoryRequest, opts ...grpc.CallOption) (*GetPriceHistoryResponse, error) {
+	out := new(GetPriceHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_GetPriceHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetTWAP(ctx context.Context, in *GetTWAPRequest, opts ...grpc.CallOption) (*GetTWAPResponse, error) {
+	out := new(GetTWAPResponse)
+	err := c.cc.Invoke(ctx, Query_GetTWAP_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetCircuitBreaker(ctx context.Context, in *GetCircuitBreakerRequest, opts ...grpc.CallOption) (*GetCircuitBreakerResponse, error) {
+	out := new(GetCircuitBreakerResponse)
+	err := c.cc.Invoke(ctx, Query_GetCircuitBreaker_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -81,6 +120,15 @@ type QueryServer interface {
 	// that CurrencyPair.
 	GetPrice(context.Context, *GetPriceRequest) (*GetPriceResponse, error)
 	GetPrices(context.Context, *GetPricesRequest) (*GetPricesResponse, error)
+	// Given a CurrencyPair, return the retained historical QuotePrices for that
+	// CurrencyPair ordered by block height.
+	GetPriceHistory(context.Context, *GetPriceHistoryRequest) (*GetPriceHistoryResponse, error)
+	// Given a CurrencyPair and a window, return the time-weighted average price
+	// of the CurrencyPair over the window ending at the current block time.
+	GetTWAP(context.Context, *GetTWAPRequest) (*GetTWAPResponse, error)
+	// Given a CurrencyPair, return the CircuitBreaker configured for that
+	// CurrencyPair and whether its price updates are halted.
+	GetCircuitBreaker(context.Context, *GetCircuitBreakerRequest) (*GetCircuitBreakerResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -97,6 +145,15 @@ func (UnimplementedQueryServer) GetPrice(context.Context, *GetPriceRequest) (*Ge
 func (UnimplementedQueryServer) GetPrices(context.Context, *GetPricesRequest) (*GetPricesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPrices not implemented")
 }
+func (UnimplementedQueryServer) GetPriceHistory(context.Context, *GetPriceHistoryRequest) (*GetPriceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPriceHistory not implemented")
+}
+func (UnimplementedQueryServer) GetTWAP(context.Context, *GetTWAPRequest) (*GetTWAPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTWAP not implemented")
+}
+func (UnimplementedQueryServer) GetCircuitBreaker(context.Context, *GetCircuitBreakerRequest) (*GetCircuitBreakerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCircuitBreaker not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -164,6 +221,60 @@ func _Query_GetPrices_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetPriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPriceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetPriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetPriceHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetPriceHistory(ctx, req.(*GetPriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetTWAP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTWAPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetTWAP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetTWAP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTWAP(ctx, req.(*GetTWAPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetCircuitBreaker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCircuitBreakerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetCircuitBreaker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetCircuitBreaker_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetCircuitBreaker(ctx, req.(*GetCircuitBreakerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -183,6 +294,18 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPrices",
 			Handler:    _Query_GetPrices_Handler,
 		},
+		{
+			MethodName: "GetPriceHistory",
+			Handler:    _Query_GetPriceHistory_Handler,
+		},
+		{
+			MethodName: "GetTWAP",
+			Handler:    _Query_GetTWAP_Handler,
+		},
+		{
+			MethodName: "GetCircuitBreaker",
+			Handler:    _Query_GetCircuitBreaker_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "slinky/oracle/v1/query.proto",
@@ -21,6 +21,9 @@ const _ = grpc.SupportPackageIsVersion7
 const (
 	Msg_AddCurrencyPairs_FullMethodName    = "/slinky.oracle.v1.Msg/AddCurrencyPairs"
 	Msg_RemoveCurrencyPairs_FullMethodName = "/slinky.oracle.v1.Msg/RemoveCurrencyPairs"
+	Msg_SetCircuitBreaker_FullMethodName   = "/slinky.oracle.v1.Msg/SetCircuitBreaker"
+	Msg_ResetCircuitBreaker_FullMethodName = "/slinky.oracle.v1.Msg/ResetCircuitBreaker"
+	Msg_SetMaxPriceAge_FullMethodName      = "/slinky.oracle.v1.Msg/SetMaxPriceAge"
 )
 
 // MsgClient is the client API for Msg service.
@@ -35,6 +38,17 @@ type MsgClient interface {
 	// given set of currency-pairs from the module's state. Thus these
 	// CurrencyPairs will no longer have price-data available from this module.
 	RemoveCurrencyPairs(ctx context.Context, in *MsgRemoveCurrencyPairs, opts ...grpc.CallOption) (*MsgRemoveCurrencyPairsResponse, error)
+	// SetCircuitBreaker will be used only by governance to set the
+	// CircuitBreaker of a CurrencyPair. A CircuitBreaker without any limits
+	// removes the CurrencyPair's CircuitBreaker.
+	SetCircuitBreaker(ctx context.Context, in *MsgSetCircuitBreaker, opts ...grpc.CallOption) (*MsgSetCircuitBreakerResponse, error)
+	// ResetCircuitBreaker will be used only by governance to resume price
+	// updates for a CurrencyPair halted by its CircuitBreaker.
+	ResetCircuitBreaker(ctx context.Context, in *MsgResetCircuitBreaker, opts ...grpc.CallOption) (*MsgResetCircuitBreakerResponse, error)
+	// SetMaxPriceAge will be used only by governance to set the MaxPriceAge of
+	// a CurrencyPair. A MaxPriceAge without any limits removes the
+	// CurrencyPair's MaxPriceAge.
+	SetMaxPriceAge(ctx context.Context, in *MsgSetMaxPriceAge, opts ...grpc.CallOption) (*MsgSetMaxPriceAgeResponse, error)
 }
 
 type msgClient struct {
@@ -63,6 +77,33 @@ func (c *msgClient) RemoveCurrencyPairs(ctx context.Context, in *MsgRemoveCurren
 	return out, nil
 }
 
+func (c *msgClient) SetCircuitBreaker(ctx context.Context, in *MsgSetCircuitBreaker, opts ...grpc.CallOption) (*MsgSetCircuitBreakerResponse, error) {
+	out := new(MsgSetCircuitBreakerResponse)
+	err := c.cc.Invoke(ctx, Msg_SetCircuitBreaker_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ResetCircuitBreaker(ctx context.Context, in *MsgResetCircuitBreaker, opts ...grpc.CallOption) (*MsgResetCircuitBreakerResponse, error) {
+	out := new(MsgResetCircuitBreakerResponse)
+	err := c.cc.Invoke(ctx, Msg_ResetCircuitBreaker_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetMaxPriceAge(ctx context.Context, in *MsgSetMaxPriceAge, opts ...grpc.CallOption) (*MsgSetMaxPriceAgeResponse, error) {
+	out := new(MsgSetMaxPriceAgeResponse)
+	err := c.cc.Invoke(ctx, Msg_SetMaxPriceAge_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -75,6 +116,17 @@ type MsgServer interface {
 	// given set of currency-pairs from the module's state. Thus these
 	// CurrencyPairs will no longer have price-data available from this module.
 	RemoveCurrencyPairs(context.Context, *MsgRemoveCurrencyPairs) (*MsgRemoveCurrencyPairsResponse, error)
+	// SetCircuitBreaker will be used only by governance to set the
+	// CircuitBreaker of a CurrencyPair. A CircuitBreaker without any limits
+	// removes the CurrencyPair's CircuitBreaker.
+	SetCircuitBreaker(context.Context, *MsgSetCircuitBreaker) (*MsgSetCircuitBreakerResponse, error)
+	// ResetCircuitBreaker will be used only by governance to resume price
+	// updates for a CurrencyPair halted by its CircuitBreaker.
+	ResetCircuitBreaker(context.Context, *MsgResetCircuitBreaker) (*MsgResetCircuitBreakerResponse, error)
+	// SetMaxPriceAge will be used only by governance to set the MaxPriceAge of
+	// a CurrencyPair. A MaxPriceAge without any limits removes the
+	// CurrencyPair's MaxPriceAge.
+	SetMaxPriceAge(context.Context, *MsgSetMaxPriceAge) (*MsgSetMaxPriceAgeResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -88,6 +140,15 @@ func (UnimplementedMsgServer) AddCurrencyPairs(context.Context, *MsgAddCurrencyP
 func (UnimplementedMsgServer) RemoveCurrencyPairs(context.Context, *MsgRemoveCurrencyPairs) (*MsgRemoveCurrencyPairsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveCurrencyPairs not implemented")
 }
+func (UnimplementedMsgServer) SetCircuitBreaker(context.Context, *MsgSetCircuitBreaker) (*MsgSetCircuitBreakerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCircuitBreaker not implemented")
+}
+func (UnimplementedMsgServer) ResetCircuitBreaker(context.Context, *MsgResetCircuitBreaker) (*MsgResetCircuitBreakerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetCircuitBreaker not implemented")
+}
+func (UnimplementedMsgServer) SetMaxPriceAge(context.Context, *MsgSetMaxPriceAge) (*MsgSetMaxPriceAgeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMaxPriceAge not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -137,6 +198,60 @@ func _Msg_RemoveCurrencyPairs_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetCircuitBreaker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetCircuitBreaker)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetCircuitBreaker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetCircuitBreaker_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetCircuitBreaker(ctx, req.(*MsgSetCircuitBreaker))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResetCircuitBreaker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResetCircuitBreaker)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResetCircuitBreaker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ResetCircuitBreaker_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResetCircuitBreaker(ctx, req.(*MsgResetCircuitBreaker))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetMaxPriceAge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetMaxPriceAge)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetMaxPriceAge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetMaxPriceAge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetMaxPriceAge(ctx, req.(*MsgSetMaxPriceAge))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -152,6 +267,18 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveCurrencyPairs",
 			Handler:    _Msg_RemoveCurrencyPairs_Handler,
 		},
+		{
+			MethodName: "SetCircuitBreaker",
+			Handler:    _Msg_SetCircuitBreaker_Handler,
+		},
+		{
+			MethodName: "ResetCircuitBreaker",
+			Handler:    _Msg_ResetCircuitBreaker_Handler,
+		},
+		{
+			MethodName: "SetMaxPriceAge",
+			Handler:    _Msg_SetMaxPriceAge_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "slinky/oracle/v1/tx.proto",
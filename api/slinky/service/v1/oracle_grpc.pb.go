@@ -19,7 +19,9 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Oracle_Prices_FullMethodName = "/slinky.service.v1.Oracle/Prices"
+	Oracle_Prices_FullMethodName         = "/slinky.service.v1.Oracle/Prices"
+	Oracle_StreamPrices_FullMethodName   = "/slinky.service.v1.Oracle/StreamPrices"
+	Oracle_ProviderPrices_FullMethodName = "/slinky.service.v1.Oracle/ProviderPrices"
 )
 
 // OracleClient is the client API for Oracle service.
@@ -28,6 +30,15 @@ const (
 type OracleClient interface {
 	// Prices defines a method for fetching the latest prices.
 	Prices(ctx context.Context, in *QueryPricesRequest, opts ...grpc.CallOption) (*QueryPricesResponse, error)
+	// StreamPrices defines a method for subscribing to the prices aggregated by
+	// the oracle. A response is pushed after every oracle update. Over HTTP, the
+	// stream is served as server-sent events at /slinky/oracle/v1/prices/stream.
+	StreamPrices(ctx context.Context, in *StreamPricesRequest, opts ...grpc.CallOption) (Oracle_StreamPricesClient, error)
+	// ProviderPrices defines a method for fetching the per-provider breakdown of
+	// the latest prices. This includes the raw price reported by each provider,
+	// the inputs used to convert the price to the market's ticker, and whether
+	// the price was dropped.
+	ProviderPrices(ctx context.Context, in *QueryProviderPricesRequest, opts ...grpc.CallOption) (*QueryProviderPricesResponse, error)
 }
 
 type oracleClient struct {
@@ -47,12 +58,62 @@ func (c *oracleClient) Prices(ctx context.Context, in *QueryPricesRequest, opts
 	return out, nil
 }
 
+func (c *oracleClient) StreamPrices(ctx context.Context, in *StreamPricesRequest, opts ...grpc.CallOption) (Oracle_StreamPricesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Oracle_ServiceDesc.Streams[0], Oracle_StreamPrices_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &oracleStreamPricesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Oracle_StreamPricesClient interface {
+	Recv() (*QueryPricesResponse, error)
+	grpc.ClientStream
+}
+
+type oracleStreamPricesClient struct {
+	grpc.ClientStream
+}
+
+func (x *oracleStreamPricesClient) Recv() (*QueryPricesResponse, error) {
+	m := new(QueryPricesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *oracleClient) ProviderPrices(ctx context.Context, in *QueryProviderPricesRequest, opts ...grpc.CallOption) (*QueryProviderPricesResponse, error) {
+	out := new(QueryProviderPricesResponse)
+	err := c.cc.Invoke(ctx, Oracle_ProviderPrices_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OracleServer is the server API for Oracle service.
 // All implementations must embed UnimplementedOracleServer
 // for forward compatibility
 type OracleServer interface {
 	// Prices defines a method for fetching the latest prices.
 	Prices(context.Context, *QueryPricesRequest) (*QueryPricesResponse, error)
+	// StreamPrices defines a method for subscribing to the prices aggregated by
+	// the oracle. A response is pushed after every oracle update. Over HTTP, the
+	// stream is served as server-sent events at /slinky/oracle/v1/prices/stream.
+	StreamPrices(*StreamPricesRequest, Oracle_StreamPricesServer) error
+	// ProviderPrices defines a method for fetching the per-provider breakdown of
+	// the latest prices. This includes the raw price reported by each provider,
+	// the inputs used to convert the price to the market's ticker, and whether
+	// the price was dropped.
+	ProviderPrices(context.Context, *QueryProviderPricesRequest) (*QueryProviderPricesResponse, error)
 	mustEmbedUnimplementedOracleServer()
 }
 
@@ -63,6 +124,12 @@ type UnimplementedOracleServer struct {
 func (UnimplementedOracleServer) Prices(context.Context, *QueryPricesRequest) (*QueryPricesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Prices not implemented")
 }
+func (UnimplementedOracleServer) StreamPrices(*StreamPricesRequest, Oracle_StreamPricesServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamPrices not implemented")
+}
+func (UnimplementedOracleServer) ProviderPrices(context.Context, *QueryProviderPricesRequest) (*QueryProviderPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderPrices not implemented")
+}
 func (UnimplementedOracleServer) mustEmbedUnimplementedOracleServer() {}
 
 // UnsafeOracleServer may be embedded to opt out of forward compatibility for this service.
@@ -94,6 +161,45 @@ func _Oracle_Prices_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Oracle_StreamPrices_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamPricesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OracleServer).StreamPrices(m, &oracleStreamPricesServer{stream})
+}
+
+type Oracle_StreamPricesServer interface {
+	Send(*QueryPricesResponse) error
+	grpc.ServerStream
+}
+
+type oracleStreamPricesServer struct {
+	grpc.ServerStream
+}
+
+func (x *oracleStreamPricesServer) Send(m *QueryPricesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Oracle_ProviderPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProviderPricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OracleServer).ProviderPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Oracle_ProviderPrices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OracleServer).ProviderPrices(ctx, req.(*QueryProviderPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Oracle_ServiceDesc is the grpc.ServiceDesc for Oracle service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -105,7 +211,17 @@ var Oracle_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Prices",
 			Handler:    _Oracle_Prices_Handler,
 		},
+		{
+			MethodName: "ProviderPrices",
+			Handler:    _Oracle_ProviderPrices_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamPrices",
+			Handler:       _Oracle_StreamPrices_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "slinky/service/v1/oracle.proto",
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/skip-mev/slinky/oracle/types"
 	binanceapi "github.com/skip-mev/slinky/providers/apis/binance"
 	coinbaseapi "github.com/skip-mev/slinky/providers/apis/coinbase"
+	"github.com/skip-mev/slinky/providers/apis/defi/evmpools"
 	"github.com/skip-mev/slinky/providers/apis/defi/raydium"
 	"github.com/skip-mev/slinky/providers/apis/defi/uniswapv3"
 	"github.com/skip-mev/slinky/providers/apis/dydx"
@@ -46,6 +47,16 @@ var (
 			API:  uniswapv3.DefaultBaseAPIConfig,
 			Type: types.ConfigType,
 		},
+		{
+			Name: evmpools.CurveProviderNames[constants.ETHEREUM],
+			API:  evmpools.DefaultCurveETHAPIConfig,
+			Type: types.ConfigType,
+		},
+		{
+			Name: evmpools.BalancerProviderNames[constants.ETHEREUM],
+			API:  evmpools.DefaultBalancerETHAPIConfig,
+			Type: types.ConfigType,
+		},
 
 		// Exchange API providers
 		{
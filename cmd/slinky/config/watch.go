@@ -0,0 +1,82 @@
+package config
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// DefaultWatchInterval is the default interval at which a watched file is checked for changes.
+const DefaultWatchInterval = time.Second
+
+// fileState is the state of a watched file that is compared to detect changes.
+type fileState struct {
+	realPath string
+	modTime  time.Time
+	size     int64
+}
+
+// statFile returns the current state of the file at the given path.
+func statFile(path string) (fileState, error) {
+	realPath, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		return fileState{}, err
+	}
+
+	info, err := os.Stat(realPath)
+	if err != nil {
+		return fileState{}, err
+	}
+
+	return fileState{
+		realPath: realPath,
+		modTime:  info.ModTime(),
+		size:     info.Size(),
+	}, nil
+}
+
+// WatchFile polls the file at the given path every interval and calls onChange whenever the file
+// is modified or replaced. Symlinks are resolved on every check so that swaps of the link target,
+// i.e. updates to Kubernetes ConfigMap volumes, are observed. This is a blocking call that returns
+// once the context is cancelled. An error is returned if the file cannot be read initially.
+func WatchFile(
+	ctx context.Context,
+	path string,
+	interval time.Duration,
+	logger *zap.Logger,
+	onChange func(),
+) error {
+	last, err := statFile(path)
+	if err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	logger.Info("watching file for changes", zap.String("path", path), zap.Duration("interval", interval))
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			current, err := statFile(path)
+			if err != nil {
+				// The file may be briefly missing while it is being replaced.
+				logger.Debug("failed to stat watched file", zap.String("path", path), zap.Error(err))
+				continue
+			}
+
+			if current == last {
+				continue
+			}
+
+			last = current
+			logger.Info("watched file changed", zap.String("path", path))
+			onChange()
+		}
+	}
+}
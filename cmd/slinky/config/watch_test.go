@@ -0,0 +1,96 @@
+package config_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	cmdconfig "github.com/skip-mev/slinky/cmd/slinky/config"
+)
+
+func TestWatchFile(t *testing.T) {
+	const interval = 10 * time.Millisecond
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "oracle.json")
+	require.NoError(t, os.WriteFile(path, []byte("{}"), 0o600))
+
+	// The linked file is a symlink to the data file, mirroring a Kubernetes ConfigMap volume.
+	link := filepath.Join(dir, "linked.json")
+	require.NoError(t, os.Symlink(path, link))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	watch := func(path string, counter *atomic.Int32) <-chan error {
+		errCh := make(chan error, 1)
+		go func() {
+			errCh <- cmdconfig.WatchFile(ctx, path, interval, zap.NewNop(), func() {
+				counter.Add(1)
+			})
+		}()
+		return errCh
+	}
+
+	var changes, linkChanges atomic.Int32
+	errCh := watch(path, &changes)
+	linkErrCh := watch(link, &linkChanges)
+
+	// Unchanged files do not trigger a change.
+	time.Sleep(10 * interval)
+	require.Zero(t, changes.Load())
+	require.Zero(t, linkChanges.Load())
+
+	// Writes to the file are observed.
+	require.NoError(t, os.WriteFile(path, []byte(`{"updateInterval": "1s"}`), 0o600))
+	require.Eventually(t, func() bool {
+		return changes.Load() == 1
+	}, 2*time.Second, interval)
+
+	// Atomic replacements of the file are observed.
+	tmp := filepath.Join(dir, "oracle.json.tmp")
+	require.NoError(t, os.WriteFile(tmp, []byte(`{"updateInterval": "2s"}`), 0o600))
+	require.NoError(t, os.Rename(tmp, path))
+	require.Eventually(t, func() bool {
+		return changes.Load() == 2
+	}, 2*time.Second, interval)
+
+	// Swapping the target of a symlinked file is observed.
+	observed := linkChanges.Load()
+	next := filepath.Join(dir, "next.json")
+	require.NoError(t, os.WriteFile(next, []byte("{}"), 0o600))
+	tmpLink := filepath.Join(dir, "linked.json.tmp")
+	require.NoError(t, os.Symlink(next, tmpLink))
+	require.NoError(t, os.Rename(tmpLink, link))
+	require.Eventually(t, func() bool {
+		return linkChanges.Load() > observed
+	}, 2*time.Second, interval)
+
+	// The watchers exit once the context is cancelled.
+	cancel()
+	for _, ch := range []<-chan error{errCh, linkErrCh} {
+		select {
+		case err := <-ch:
+			require.NoError(t, err)
+		case <-time.After(2 * time.Second):
+			t.Fatal("watcher did not exit")
+		}
+	}
+}
+
+func TestWatchFileMissingFile(t *testing.T) {
+	err := cmdconfig.WatchFile(
+		context.Background(),
+		filepath.Join(t.TempDir(), "oracle.json"),
+		time.Second,
+		zap.NewNop(),
+		func() {},
+	)
+	require.Error(t, err)
+}
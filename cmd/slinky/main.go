@@ -214,20 +214,9 @@ func runOracle() error {
 	logger := log.NewLogger(logCfg)
 	defer logger.Sync()
 
-	var cfg config.OracleConfig
-	var err error
-
-	cfg, err = cmdconfig.ReadOracleConfigWithOverrides(oracleCfgPath, marketMapProvider)
+	cfg, err := readOracleConfig()
 	if err != nil {
-		return fmt.Errorf("failed to get oracle config: %w", err)
-	}
-
-	// overwrite endpoint
-	if marketMapEndPoint != "" {
-		cfg, err = overwriteMarketMapEndpoint(cfg, marketMapEndPoint)
-		if err != nil {
-			return fmt.Errorf("failed to overwrite market endpoint %s: %w", marketMapEndPoint, err)
-		}
+		return err
 	}
 
 	var marketCfg mmtypes.MarketMap
@@ -272,6 +261,7 @@ func runOracle() error {
 		oracle.WithMetrics(metrics),
 		oracle.WithMaxCacheAge(cfg.MaxPriceAge),
 		oracle.WithPriceAggregator(aggregator),
+		oracle.WithTWAPConfigs(cfg.TWAP),
 	}
 
 	// Create the orchestrator and start the orchestrator.
@@ -294,7 +284,12 @@ func runOracle() error {
 	if err != nil {
 		return fmt.Errorf("failed to create oracle: %w", err)
 	}
-	srv := oracleserver.NewOracleServer(orc, logger)
+	srv := oracleserver.NewOracleServer(
+		orc,
+		logger,
+		oracleserver.WithProviderOrchestrator(orch),
+		oracleserver.WithMaxSyncAge(cfg.MaxPriceAge),
+	)
 
 	// cancel oracle on interrupt or terminate
 	go func() {
@@ -304,6 +299,9 @@ func runOracle() error {
 		cancel()
 	}()
 
+	// reload the oracle config on SIGHUP or whenever the config file changes
+	go watchOracleConfig(ctx, logger, orch, orc)
+
 	// start prometheus metrics
 	if cfg.Metrics.Enabled {
 		logger.Info("starting prometheus metrics", zap.String("address", cfg.Metrics.PrometheusServerAddress))
@@ -340,6 +338,79 @@ func runOracle() error {
 	return nil
 }
 
+// readOracleConfig reads the oracle config from the configured path and applies the command
+// line overrides.
+func readOracleConfig() (config.OracleConfig, error) {
+	cfg, err := cmdconfig.ReadOracleConfigWithOverrides(oracleCfgPath, marketMapProvider)
+	if err != nil {
+		return config.OracleConfig{}, fmt.Errorf("failed to get oracle config: %w", err)
+	}
+
+	// overwrite endpoint
+	if marketMapEndPoint != "" {
+		cfg, err = overwriteMarketMapEndpoint(cfg, marketMapEndPoint)
+		if err != nil {
+			return config.OracleConfig{}, fmt.Errorf("failed to overwrite market endpoint %s: %w", marketMapEndPoint, err)
+		}
+	}
+
+	return cfg, nil
+}
+
+// watchOracleConfig reloads the oracle config whenever the process receives a SIGHUP or the
+// config file is changed. Only the providers whose config changed are restarted. This blocks
+// until the context is cancelled.
+func watchOracleConfig(
+	ctx context.Context,
+	logger *zap.Logger,
+	orch *orchestrator.ProviderOrchestrator,
+	orc *oracle.OracleImpl,
+) {
+	hups := make(chan os.Signal, 1)
+	signal.Notify(hups, syscall.SIGHUP)
+	defer signal.Stop(hups)
+
+	// reloads is buffered so that changes observed while a reload is in progress are coalesced.
+	reloads := make(chan struct{}, 1)
+	if oracleCfgPath != "" {
+		go func() {
+			err := cmdconfig.WatchFile(ctx, oracleCfgPath, cmdconfig.DefaultWatchInterval, logger, func() {
+				select {
+				case reloads <- struct{}{}:
+				default:
+				}
+			})
+			if err != nil {
+				logger.Error("failed to watch oracle config", zap.Error(err))
+			}
+		}()
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-hups:
+			logger.Info("received SIGHUP; reloading oracle config")
+		case <-reloads:
+			logger.Info("oracle config changed; reloading oracle config")
+		}
+
+		cfg, err := readOracleConfig()
+		if err != nil {
+			logger.Error("failed to reload oracle config; keeping the current config", zap.Error(err))
+			continue
+		}
+
+		if err := orch.UpdateWithConfig(cfg); err != nil {
+			logger.Error("failed to apply some of the reloaded oracle config", zap.Error(err))
+		}
+		orc.UpdateProviders(orch.GetPriceProviders())
+
+		logger.Info("reloaded oracle config")
+	}
+}
+
 func overwriteMarketMapEndpoint(cfg config.OracleConfig, overwrite string) (config.OracleConfig, error) {
 	for providerName, provider := range cfg.Providers {
 		if provider.Type == mmservicetypes.ConfigType {
@@ -40,6 +40,25 @@ type APIConfig struct {
 
 	// Name is the name of the provider that corresponds to this config.
 	Name string `json:"name"`
+
+	// RateLimit configures the rate at which requests are sent to the API. Rate limiting
+	// is disabled by default.
+	RateLimit RateLimitConfig `json:"rateLimit"`
+
+	// Retry configures how failed and rate limited requests are retried. Retries are
+	// disabled by default.
+	Retry RetryConfig `json:"retry"`
+
+	// CircuitBreaker configures when requests stop being sent to an API that is failing.
+	// The circuit breaker is disabled by default.
+	CircuitBreaker CircuitBreakerConfig `json:"circuitBreaker"`
+
+	// MaxBlockLag is the maximum number of blocks that an endpoint of an EVM based provider
+	// may lag behind the endpoint with the highest block height. Responses from endpoints
+	// that lag further behind are dropped. This is only used by providers that query
+	// multiple EVM endpoints. By default, only responses from the endpoints with the
+	// highest block height are used.
+	MaxBlockLag uint64 `json:"maxBlockLag"`
 }
 
 // Endpoint holds all data necessary for an API provider to connect to a given endpoint
@@ -62,6 +81,16 @@ func (e Endpoint) ValidateBasic() error {
 	return e.Authentication.ValidateBasic()
 }
 
+// ValidateSignedBasic performs basic validation of an endpoint whose authentication may be
+// used to sign authentication requests, e.g. a websocket endpoint.
+func (e Endpoint) ValidateSignedBasic() error {
+	if len(e.URL) == 0 {
+		return fmt.Errorf("endpoint url cannot be empty")
+	}
+
+	return e.Authentication.ValidateSignedBasic()
+}
+
 // Authentication holds all data necessary for an API provider to authenticate with an
 // endpoint.
 type Authentication struct {
@@ -70,6 +99,14 @@ type Authentication struct {
 
 	// APIKeyHeader is the header that will be used to set the API key.
 	APIKeyHeader string `json:"apiKeyHeader"`
+
+	// APISecret is the secret used to sign authentication requests, e.g. the login
+	// message sent after a websocket connection is established.
+	APISecret string `json:"apiSecret"`
+
+	// Passphrase is an optional passphrase that some providers require alongside the
+	// API key and secret when signing authentication requests.
+	Passphrase string `json:"passphrase"`
 }
 
 // Enabled returns true if the authentication is enabled.
@@ -77,13 +114,42 @@ func (a Authentication) Enabled() bool {
 	return a.APIKey != "" && a.APIKeyHeader != ""
 }
 
+// Signed returns true if the authentication includes a secret that can be used to sign
+// authentication requests.
+func (a Authentication) Signed() bool {
+	return a.APIKey != "" && a.APISecret != ""
+}
+
 // ValidateBasic performs basic validation of the API authentication. Specifically, the APIKey + APIKeyHeader
-// must be set atomically.
+// must be set atomically, as the API key is sent under the header.
 func (a Authentication) ValidateBasic() error {
+	if err := a.ValidateSignedBasic(); err != nil {
+		return err
+	}
+
 	if a.APIKey != "" && a.APIKeyHeader == "" {
 		return fmt.Errorf("api key header cannot be empty when api key is set")
 	}
 
+	return nil
+}
+
+// ValidateSignedBasic performs basic validation of authentication that may be used to sign authentication
+// requests. This is identical to ValidateBasic, except that the APIKeyHeader may be omitted if an APISecret
+// is set, i.e. the API key is only used to sign authentication requests.
+func (a Authentication) ValidateSignedBasic() error {
+	if a.APISecret != "" && a.APIKey == "" {
+		return fmt.Errorf("api key cannot be empty when api secret is set")
+	}
+
+	if a.Passphrase != "" && a.APISecret == "" {
+		return fmt.Errorf("api secret cannot be empty when passphrase is set")
+	}
+
+	if a.APIKey != "" && a.APIKeyHeader == "" && a.APISecret == "" {
+		return fmt.Errorf("api key header cannot be empty when api key is set")
+	}
+
 	if a.APIKey == "" && a.APIKeyHeader != "" {
 		return fmt.Errorf("api key cannot be empty when api key header is set")
 	}
@@ -123,5 +189,13 @@ func (c *APIConfig) ValidateBasic() error {
 		}
 	}
 
-	return nil
+	if err := c.RateLimit.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if err := c.Retry.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return c.CircuitBreaker.ValidateBasic()
 }
@@ -216,6 +216,199 @@ func TestAPIConfig(t *testing.T) {
 				BatchSize: 1,
 			},
 		},
+		{
+			name: "bad config with invalid endpoint authentication (API-key missing for API secret)",
+			config: config.APIConfig{
+				Enabled:          true,
+				Timeout:          time.Second,
+				Interval:         time.Second,
+				ReconnectTimeout: time.Second,
+				MaxQueries:       1,
+				Name:             "test",
+				Endpoints: []config.Endpoint{
+					{
+						URL: "http://test.com",
+						Authentication: config.Authentication{
+							APISecret: "secret",
+						},
+					},
+				},
+				BatchSize: 1,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad config with invalid endpoint authentication (API secret missing for passphrase)",
+			config: config.APIConfig{
+				Enabled:          true,
+				Timeout:          time.Second,
+				Interval:         time.Second,
+				ReconnectTimeout: time.Second,
+				MaxQueries:       1,
+				Name:             "test",
+				Endpoints: []config.Endpoint{
+					{
+						URL: "http://test.com",
+						Authentication: config.Authentication{
+							APIKey:       "test",
+							APIKeyHeader: "X-API-KEY",
+							Passphrase:   "passphrase",
+						},
+					},
+				},
+				BatchSize: 1,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad config with signed endpoint authentication (HTTP header field missing)",
+			config: config.APIConfig{
+				Enabled:          true,
+				Timeout:          time.Second,
+				Interval:         time.Second,
+				ReconnectTimeout: time.Second,
+				MaxQueries:       1,
+				Name:             "test",
+				Endpoints: []config.Endpoint{
+					{
+						URL: "http://test.com",
+						Authentication: config.Authentication{
+							APIKey:     "test",
+							APISecret:  "secret",
+							Passphrase: "passphrase",
+						},
+					},
+				},
+				BatchSize: 1,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "good config with rate limit, retries and circuit breaker",
+			config: config.APIConfig{
+				Enabled:          true,
+				Timeout:          time.Second,
+				Interval:         time.Second,
+				ReconnectTimeout: time.Second,
+				MaxQueries:       1,
+				Name:             "test",
+				Endpoints:        []config.Endpoint{{URL: "http://test.com"}},
+				RateLimit: config.RateLimitConfig{
+					RequestsPerSecond: 0.5,
+					Burst:             2,
+				},
+				Retry: config.RetryConfig{
+					MaxRetries:     2,
+					InitialBackoff: 100 * time.Millisecond,
+					MaxBackoff:     time.Second,
+				},
+				CircuitBreaker: config.CircuitBreakerConfig{
+					FailureThreshold: 5,
+					Cooldown:         time.Minute,
+				},
+			},
+			expectedErr: false,
+		},
+		{
+			name: "bad config with negative rate limit",
+			config: config.APIConfig{
+				Enabled:          true,
+				Timeout:          time.Second,
+				Interval:         time.Second,
+				ReconnectTimeout: time.Second,
+				MaxQueries:       1,
+				Name:             "test",
+				Endpoints:        []config.Endpoint{{URL: "http://test.com"}},
+				RateLimit: config.RateLimitConfig{
+					RequestsPerSecond: -1,
+				},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad config with negative rate limit burst",
+			config: config.APIConfig{
+				Enabled:          true,
+				Timeout:          time.Second,
+				Interval:         time.Second,
+				ReconnectTimeout: time.Second,
+				MaxQueries:       1,
+				Name:             "test",
+				Endpoints:        []config.Endpoint{{URL: "http://test.com"}},
+				RateLimit: config.RateLimitConfig{
+					RequestsPerSecond: 1,
+					Burst:             -1,
+				},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad config with retries and no initial backoff",
+			config: config.APIConfig{
+				Enabled:          true,
+				Timeout:          time.Second,
+				Interval:         time.Second,
+				ReconnectTimeout: time.Second,
+				MaxQueries:       1,
+				Name:             "test",
+				Endpoints:        []config.Endpoint{{URL: "http://test.com"}},
+				Retry: config.RetryConfig{
+					MaxRetries: 1,
+					MaxBackoff: time.Second,
+				},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad config with retries and max backoff less than initial backoff",
+			config: config.APIConfig{
+				Enabled:          true,
+				Timeout:          time.Second,
+				Interval:         time.Second,
+				ReconnectTimeout: time.Second,
+				MaxQueries:       1,
+				Name:             "test",
+				Endpoints:        []config.Endpoint{{URL: "http://test.com"}},
+				Retry: config.RetryConfig{
+					MaxRetries:     1,
+					InitialBackoff: time.Second,
+					MaxBackoff:     time.Millisecond,
+				},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad config with negative max retries",
+			config: config.APIConfig{
+				Enabled:          true,
+				Timeout:          time.Second,
+				Interval:         time.Second,
+				ReconnectTimeout: time.Second,
+				MaxQueries:       1,
+				Name:             "test",
+				Endpoints:        []config.Endpoint{{URL: "http://test.com"}},
+				Retry: config.RetryConfig{
+					MaxRetries: -1,
+				},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad config with circuit breaker and no cooldown",
+			config: config.APIConfig{
+				Enabled:          true,
+				Timeout:          time.Second,
+				Interval:         time.Second,
+				ReconnectTimeout: time.Second,
+				MaxQueries:       1,
+				Name:             "test",
+				Endpoints:        []config.Endpoint{{URL: "http://test.com"}},
+				CircuitBreaker: config.CircuitBreakerConfig{
+					FailureThreshold: 1,
+				},
+			},
+			expectedErr: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,110 @@
+package config
+
+import (
+	"fmt"
+	"time"
+)
+
+// RateLimitConfig configures the token bucket that limits the rate at which requests are sent
+// to a provider's API.
+type RateLimitConfig struct {
+	// RequestsPerSecond is the rate at which tokens are added to the bucket. A value of 0
+	// disables rate limiting.
+	RequestsPerSecond float64 `json:"requestsPerSecond"`
+
+	// Burst is the size of the bucket i.e. the maximum number of requests that can be sent
+	// at once. The effective value is max(1, Burst).
+	Burst int `json:"burst"`
+}
+
+// Enabled returns true if rate limiting is enabled.
+func (c RateLimitConfig) Enabled() bool {
+	return c.RequestsPerSecond > 0
+}
+
+// ValidateBasic performs basic validation of the rate limit config.
+func (c RateLimitConfig) ValidateBasic() error {
+	if c.RequestsPerSecond < 0 {
+		return fmt.Errorf("rate limit requests per second cannot be negative")
+	}
+
+	if c.Burst < 0 {
+		return fmt.Errorf("rate limit burst cannot be negative")
+	}
+
+	return nil
+}
+
+// RetryConfig configures how requests that fail, are rate limited, or return a 5xx status code
+// are retried. Retries are made within the provider's timeout, so the timeout must leave room for
+// the configured backoff.
+type RetryConfig struct {
+	// MaxRetries is the maximum number of times a request is retried. A value of 0 disables
+	// retries.
+	MaxRetries int `json:"maxRetries"`
+
+	// InitialBackoff is the backoff before the first retry. The backoff doubles after every
+	// retry and is jittered to avoid retrying in lockstep with other clients.
+	InitialBackoff time.Duration `json:"initialBackoff"`
+
+	// MaxBackoff is the maximum backoff between retries. Backoffs requested by the API via
+	// the Retry-After header are not capped.
+	MaxBackoff time.Duration `json:"maxBackoff"`
+}
+
+// Enabled returns true if retries are enabled.
+func (c RetryConfig) Enabled() bool {
+	return c.MaxRetries > 0
+}
+
+// ValidateBasic performs basic validation of the retry config.
+func (c RetryConfig) ValidateBasic() error {
+	if c.MaxRetries < 0 {
+		return fmt.Errorf("retry max retries cannot be negative")
+	}
+
+	if !c.Enabled() {
+		return nil
+	}
+
+	if c.InitialBackoff <= 0 {
+		return fmt.Errorf("retry initial backoff must be strictly positive")
+	}
+
+	if c.MaxBackoff < c.InitialBackoff {
+		return fmt.Errorf("retry max backoff must be greater than or equal to the initial backoff")
+	}
+
+	return nil
+}
+
+// CircuitBreakerConfig configures the circuit breaker that stops requests from being sent to a
+// provider's API after it has failed repeatedly.
+type CircuitBreakerConfig struct {
+	// FailureThreshold is the number of consecutive failed requests after which the circuit
+	// opens and requests are rejected without being sent. A value of 0 disables the circuit
+	// breaker.
+	FailureThreshold int `json:"failureThreshold"`
+
+	// Cooldown is the amount of time the circuit stays open before a single trial request
+	// is sent. The circuit closes if the trial request succeeds and re-opens otherwise.
+	Cooldown time.Duration `json:"cooldown"`
+}
+
+// Enabled returns true if the circuit breaker is enabled.
+func (c CircuitBreakerConfig) Enabled() bool {
+	return c.FailureThreshold > 0
+}
+
+// ValidateBasic performs basic validation of the circuit breaker config.
+func (c CircuitBreakerConfig) ValidateBasic() error {
+	if c.FailureThreshold < 0 {
+		return fmt.Errorf("circuit breaker failure threshold cannot be negative")
+	}
+
+	if c.Enabled() && c.Cooldown <= 0 {
+		return fmt.Errorf("circuit breaker cooldown must be strictly positive")
+	}
+
+	return nil
+}
@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/spf13/viper"
@@ -26,6 +27,11 @@ type OracleConfig struct {
 	// Metrics is the metrics configurations for the oracle.
 	Metrics MetricsConfig `json:"metrics"`
 
+	// TWAP is the list of markets for which the oracle will serve a time-weighted average
+	// price over a sliding window instead of the most recently aggregated price. This field
+	// is optional.
+	TWAP []TWAPConfig `json:"twap"`
+
 	// Host is the host that the oracle will listen on.
 	Host string `json:"host"`
 
@@ -49,6 +55,23 @@ func (c *OracleConfig) ValidateBasic() error {
 		}
 	}
 
+	seenTWAPs := make(map[string]struct{})
+	for _, twap := range c.TWAP {
+		if err := twap.ValidateBasic(); err != nil {
+			return fmt.Errorf("twap is not formatted correctly: %w", err)
+		}
+
+		if twap.Window < c.UpdateInterval {
+			return fmt.Errorf("twap window for %s must be at least the update interval", twap.Ticker)
+		}
+
+		ticker := strings.ToUpper(twap.Ticker)
+		if _, ok := seenTWAPs[ticker]; ok {
+			return fmt.Errorf("duplicate twap config for %s", twap.Ticker)
+		}
+		seenTWAPs[ticker] = struct{}{}
+	}
+
 	if len(c.Host) == 0 {
 		return fmt.Errorf("oracle host cannot be empty")
 	}
@@ -185,6 +185,59 @@ func TestOracleConfig(t *testing.T) {
 			},
 			expectedErr: true,
 		},
+		{
+			name: "good config with twap",
+			config: config.OracleConfig{
+				UpdateInterval: time.Second,
+				MaxPriceAge:    time.Minute,
+				TWAP: []config.TWAPConfig{
+					{Ticker: "BTC/USD", Window: time.Minute},
+				},
+				Host: "localhost",
+				Port: "8080",
+			},
+			expectedErr: false,
+		},
+		{
+			name: "bad config with invalid twap ticker",
+			config: config.OracleConfig{
+				UpdateInterval: time.Second,
+				MaxPriceAge:    time.Minute,
+				TWAP: []config.TWAPConfig{
+					{Ticker: "BTCUSD", Window: time.Minute},
+				},
+				Host: "localhost",
+				Port: "8080",
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad config with twap window shorter than the update interval",
+			config: config.OracleConfig{
+				UpdateInterval: time.Second,
+				MaxPriceAge:    time.Minute,
+				TWAP: []config.TWAPConfig{
+					{Ticker: "BTC/USD", Window: time.Millisecond},
+				},
+				Host: "localhost",
+				Port: "8080",
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad config with duplicate twap",
+			config: config.OracleConfig{
+				UpdateInterval: time.Second,
+				MaxPriceAge:    time.Minute,
+				TWAP: []config.TWAPConfig{
+					{Ticker: "BTC/USD", Window: time.Minute},
+					{Ticker: "btc/usd", Window: time.Hour},
+				},
+				Host: "localhost",
+				Port: "8080",
+			},
+			expectedErr: true,
+		},
 	}
 
 	for _, tc := range testCases {
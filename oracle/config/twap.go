@@ -0,0 +1,31 @@
+package config
+
+import (
+	"fmt"
+	"time"
+
+	slinkytypes "github.com/skip-mev/slinky/pkg/types"
+)
+
+// TWAPConfig configures the oracle to serve a time-weighted average price (TWAP) for a
+// given market instead of the most recently aggregated price.
+type TWAPConfig struct {
+	// Ticker is the ticker of the market i.e. BTC/USD.
+	Ticker string `json:"ticker"`
+
+	// Window is the sliding window over which the time-weighted average price is computed.
+	Window time.Duration `json:"window"`
+}
+
+// ValidateBasic performs basic validation of the TWAP config.
+func (c *TWAPConfig) ValidateBasic() error {
+	if _, err := slinkytypes.CurrencyPairFromString(c.Ticker); err != nil {
+		return fmt.Errorf("invalid twap ticker %s: %w", c.Ticker, err)
+	}
+
+	if c.Window <= 0 {
+		return fmt.Errorf("twap window for %s must be greater than 0", c.Ticker)
+	}
+
+	return nil
+}
@@ -64,6 +64,20 @@ const (
 	DefaultMaxSubscriptionsPerConnection = 0
 )
 
+const (
+	// EndpointSelectionPriority dials the configured endpoints in the order in which they
+	// are specified, falling back to the next endpoint if a connection cannot be established.
+	EndpointSelectionPriority = "priority"
+
+	// EndpointSelectionRoundRobin rotates through the configured endpoints on every
+	// (re)connection, starting with the endpoint after the one that was last dialed.
+	EndpointSelectionRoundRobin = "round_robin"
+
+	// DefaultEndpointSelection is the default strategy used to select the endpoint to
+	// dial when (re)connecting to the provider.
+	DefaultEndpointSelection = EndpointSelectionPriority
+)
+
 // WebSocketConfig defines a config for a websocket based data provider.
 type WebSocketConfig struct {
 	// Enabled is a flag that indicates whether the provider is websocket based.
@@ -86,6 +100,11 @@ type WebSocketConfig struct {
 	// must be specified.
 	Endpoints []Endpoint `json:"endpoints"`
 
+	// EndpointSelection is the strategy used to select which endpoint to dial when
+	// (re)connecting to the provider. This must be one of "priority" or "round_robin".
+	// If empty, the endpoints are dialed in priority order.
+	EndpointSelection string `json:"endpointSelection"`
+
 	// Name is the name of the provider that corresponds to this config.
 	Name string `json:"name"`
 
@@ -133,6 +152,11 @@ type WebSocketConfig struct {
 	// can be assigned to a single connection for this provider.  The null value (0),
 	// indicates that there is no limit per connection.
 	MaxSubscriptionsPerConnection int `json:"maxSubscriptionsPerConnection"`
+
+	// SubscribeToVolume specifies whether the provider should subscribe to an additional
+	// channel to retrieve the 24h traded volume of each market. This is only used by
+	// providers that do not report volume on the channel used for prices, e.g. OKX.
+	SubscribeToVolume bool `json:"subscribeToVolume"`
 }
 
 // ValidateBasic performs basic validation of the websocket config.
@@ -158,11 +182,17 @@ func (c *WebSocketConfig) ValidateBasic() error {
 	}
 
 	for i, e := range c.Endpoints {
-		if err := e.ValidateBasic(); err != nil {
+		if err := e.ValidateSignedBasic(); err != nil {
 			return fmt.Errorf("endpoint %d: %w", i, err)
 		}
 	}
 
+	switch c.EndpointSelection {
+	case "", EndpointSelectionPriority, EndpointSelectionRoundRobin:
+	default:
+		return fmt.Errorf("invalid websocket endpoint selection %q", c.EndpointSelection)
+	}
+
 	if len(c.Name) == 0 {
 		return fmt.Errorf("websocket name cannot be empty")
 	}
@@ -402,6 +402,67 @@ func TestWebSocketConfig(t *testing.T) {
 			},
 			expectedErr: true,
 		},
+		{
+			name: "bad config with invalid endpoint selection",
+			config: config.WebSocketConfig{
+				Enabled:               true,
+				MaxBufferSize:         1,
+				ReconnectionTimeout:   config.DefaultReconnectionTimeout,
+				PostConnectionTimeout: config.DefaultPostConnectionTimeout,
+				Name:                  "test",
+				Endpoints: []config.Endpoint{
+					{
+						URL: "wss://test.com",
+					},
+				},
+				EndpointSelection:             "random",
+				ReadBufferSize:                config.DefaultReadBufferSize,
+				WriteBufferSize:               config.DefaultWriteBufferSize,
+				HandshakeTimeout:              config.DefaultHandshakeTimeout,
+				EnableCompression:             config.DefaultEnableCompression,
+				ReadTimeout:                   config.DefaultReadTimeout,
+				WriteTimeout:                  config.DefaultWriteTimeout,
+				PingInterval:                  config.DefaultPingInterval,
+				WriteInterval:                 config.DefaultWriteInterval,
+				MaxReadErrorCount:             config.DefaultMaxReadErrorCount,
+				MaxSubscriptionsPerConnection: config.DefaultMaxSubscriptionsPerConnection,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "good config with round-robin endpoint selection",
+			config: config.WebSocketConfig{
+				Enabled:               true,
+				MaxBufferSize:         1,
+				ReconnectionTimeout:   config.DefaultReconnectionTimeout,
+				PostConnectionTimeout: config.DefaultPostConnectionTimeout,
+				Name:                  "test",
+				Endpoints: []config.Endpoint{
+					{
+						URL: "wss://test.com",
+					},
+					{
+						URL: "wss://relay.test.com",
+						Authentication: config.Authentication{
+							APIKey:    "test",
+							APISecret: "secret",
+						},
+					},
+				},
+				EndpointSelection:             config.EndpointSelectionRoundRobin,
+				ReadBufferSize:                config.DefaultReadBufferSize,
+				WriteBufferSize:               config.DefaultWriteBufferSize,
+				HandshakeTimeout:              config.DefaultHandshakeTimeout,
+				EnableCompression:             config.DefaultEnableCompression,
+				ReadTimeout:                   config.DefaultReadTimeout,
+				WriteTimeout:                  config.DefaultWriteTimeout,
+				PingInterval:                  config.DefaultPingInterval,
+				WriteInterval:                 config.DefaultWriteInterval,
+				MaxReadErrorCount:             config.DefaultMaxReadErrorCount,
+				MaxSubscriptionsPerConnection: config.DefaultMaxSubscriptionsPerConnection,
+			},
+			expectedErr: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -7,7 +7,14 @@ import "github.com/skip-mev/slinky/oracle/types"
 //go:generate mockery --name PriceAggregator
 type PriceAggregator interface {
 	SetProviderPrices(provider string, prices types.Prices)
+	SetProviderPricesAndVolumes(provider string, prices, volumes types.Prices)
 	AggregatePrices()
 	GetPrices() types.Prices
 	Reset()
 }
+
+// ProviderPriceReporter is an optional interface that a PriceAggregator can implement to
+// report how the price of each market was resolved from the provider prices.
+type ProviderPriceReporter interface {
+	GetProviderPriceReports() types.ProviderPriceReports
+}
@@ -52,6 +52,14 @@ type Metrics interface {
 	// to calculate the final price for a given market.
 	AddProviderCountForMarket(market string, count int)
 
+	// AddProviderOutlier increments the number of times a provider's price was rejected
+	// as an outlier for a given market before aggregation.
+	AddProviderOutlier(providerName, pairID string)
+
+	// UpdateProviderLiquidity updates the liquidity backing the price reported by the provider
+	// for the given off-chain ticker, e.g. the depth of a DEX pool.
+	UpdateProviderLiquidity(providerName, ticker string, liquidity float64)
+
 	// SetSlinkyBuildInfo sets the build information for the Slinky binary.
 	SetSlinkyBuildInfo()
 }
@@ -64,6 +72,8 @@ type OracleMetricsImpl struct {
 	aggregatePrices *prometheus.GaugeVec
 	providerTick    *prometheus.CounterVec
 	providerCount   *prometheus.GaugeVec
+	outliers        *prometheus.CounterVec
+	liquidity       *prometheus.GaugeVec
 	slinkyBuildInfo *prometheus.GaugeVec
 }
 
@@ -109,6 +119,16 @@ func NewMetrics() Metrics {
 			Name:      "health_check_market_providers",
 			Help:      "Number of providers that were utilized to calculate the final price for a given market.",
 		}, []string{PairIDLabel}),
+		outliers: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: OracleSubsystem,
+			Name:      "provider_outliers_total",
+			Help:      "Number of provider prices that were rejected as outliers for a given currency pair.",
+		}, []string{ProviderLabel, PairIDLabel}),
+		liquidity: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: OracleSubsystem,
+			Name:      "provider_liquidity",
+			Help:      "Liquidity backing the price of a given off-chain ticker on a provider.",
+		}, []string{ProviderLabel, PairIDLabel}),
 		slinkyBuildInfo: prometheus.NewGaugeVec(prometheus.GaugeOpts{
 			Namespace: OracleSubsystem,
 			Name:      "slinky_build_info",
@@ -123,6 +143,8 @@ func NewMetrics() Metrics {
 	prometheus.MustRegister(m.aggregatePrices)
 	prometheus.MustRegister(m.providerTick)
 	prometheus.MustRegister(m.providerCount)
+	prometheus.MustRegister(m.outliers)
+	prometheus.MustRegister(m.liquidity)
 	prometheus.MustRegister(m.slinkyBuildInfo)
 
 	return m
@@ -163,6 +185,16 @@ func (m *noOpOracleMetrics) AddProviderTick(_, _ string, _ bool) {
 func (m *noOpOracleMetrics) AddProviderCountForMarket(string, int) {
 }
 
+// AddProviderOutlier increments the number of times a provider's price was rejected
+// as an outlier for a given market before aggregation.
+func (m *noOpOracleMetrics) AddProviderOutlier(string, string) {
+}
+
+// UpdateProviderLiquidity updates the liquidity backing the price reported by the provider
+// for the given off-chain ticker.
+func (m *noOpOracleMetrics) UpdateProviderLiquidity(string, string, float64) {
+}
+
 // SetSlinkyBuildInfo sets the build information for the Slinky binary.
 func (m *noOpOracleMetrics) SetSlinkyBuildInfo() {}
 
@@ -228,6 +260,26 @@ func (m *OracleMetricsImpl) AddProviderCountForMarket(market string, count int)
 	).Set(float64(count))
 }
 
+// AddProviderOutlier increments the number of times a provider's price was rejected
+// as an outlier for a given market before aggregation.
+func (m *OracleMetricsImpl) AddProviderOutlier(providerName, pairID string) {
+	m.outliers.With(prometheus.Labels{
+		ProviderLabel: strings.ToLower(providerName),
+		PairIDLabel:   strings.ToLower(pairID),
+	},
+	).Add(1)
+}
+
+// UpdateProviderLiquidity updates the liquidity backing the price reported by the provider
+// for the given off-chain ticker.
+func (m *OracleMetricsImpl) UpdateProviderLiquidity(providerName, ticker string, liquidity float64) {
+	m.liquidity.With(prometheus.Labels{
+		ProviderLabel: strings.ToLower(providerName),
+		PairIDLabel:   strings.ToLower(ticker),
+	},
+	).Set(liquidity)
+}
+
 // SetSlinkyBuildInfo sets the build information for the Slinky binary. The version exported
 // is determined by the build time version in accordance with the build pkg.
 func (m *OracleMetricsImpl) SetSlinkyBuildInfo() {
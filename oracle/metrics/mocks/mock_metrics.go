@@ -14,6 +14,11 @@ func (_m *Metrics) AddProviderCountForMarket(market string, count int) {
 	_m.Called(market, count)
 }
 
+// AddProviderOutlier provides a mock function with given fields: providerName, pairID
+func (_m *Metrics) AddProviderOutlier(providerName string, pairID string) {
+	_m.Called(providerName, pairID)
+}
+
 // AddProviderTick provides a mock function with given fields: providerName, pairID, success
 func (_m *Metrics) AddProviderTick(providerName string, pairID string, success bool) {
 	_m.Called(providerName, pairID, success)
@@ -44,6 +49,11 @@ func (_m *Metrics) UpdatePrice(name string, pairID string, decimals uint64, pric
 	_m.Called(name, pairID, decimals, price)
 }
 
+// UpdateProviderLiquidity provides a mock function with given fields: providerName, ticker, liquidity
+func (_m *Metrics) UpdateProviderLiquidity(providerName string, ticker string, liquidity float64) {
+	_m.Called(providerName, ticker, liquidity)
+}
+
 // NewMetrics creates a new instance of Metrics. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMetrics(t interface {
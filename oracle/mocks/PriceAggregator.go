@@ -48,6 +48,11 @@ func (_m *PriceAggregator) SetProviderPrices(provider string, prices map[string]
 	_m.Called(provider, prices)
 }
 
+// SetProviderPricesAndVolumes provides a mock function with given fields: provider, prices, volumes
+func (_m *PriceAggregator) SetProviderPricesAndVolumes(provider string, prices map[string]*big.Float, volumes map[string]*big.Float) {
+	_m.Called(provider, prices, volumes)
+}
+
 // NewPriceAggregator creates a new instance of PriceAggregator. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewPriceAggregator(t interface {
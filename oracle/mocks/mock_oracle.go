@@ -8,6 +8,10 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	oracle "github.com/skip-mev/slinky/oracle"
+
+	types "github.com/skip-mev/slinky/oracle/types"
+
 	time "time"
 )
 
@@ -54,6 +58,26 @@ func (_m *Oracle) GetPrices() map[string]*big.Float {
 	return r0
 }
 
+// GetProviderPrices provides a mock function with given fields:
+func (_m *Oracle) GetProviderPrices() map[string][]types.ProviderPriceReport {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProviderPrices")
+	}
+
+	var r0 map[string][]types.ProviderPriceReport
+	if rf, ok := ret.Get(0).(func() map[string][]types.ProviderPriceReport); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]types.ProviderPriceReport)
+		}
+	}
+
+	return r0
+}
+
 // IsRunning provides a mock function with given fields:
 func (_m *Oracle) IsRunning() bool {
 	ret := _m.Called()
@@ -95,6 +119,38 @@ func (_m *Oracle) Stop() {
 	_m.Called()
 }
 
+// Subscribe provides a mock function with given fields:
+func (_m *Oracle) Subscribe() (<-chan oracle.PriceUpdate, func()) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Subscribe")
+	}
+
+	var r0 <-chan oracle.PriceUpdate
+	var r1 func()
+	if rf, ok := ret.Get(0).(func() (<-chan oracle.PriceUpdate, func())); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() <-chan oracle.PriceUpdate); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan oracle.PriceUpdate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() func()); ok {
+		r1 = rf()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(func())
+		}
+	}
+
+	return r0, r1
+}
+
 // NewOracle creates a new instance of Oracle. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewOracle(t interface {
@@ -8,6 +8,7 @@ import (
 	"github.com/skip-mev/slinky/oracle/config"
 	oraclemetrics "github.com/skip-mev/slinky/oracle/metrics"
 	"github.com/skip-mev/slinky/oracle/types"
+	slinkytypes "github.com/skip-mev/slinky/pkg/types"
 )
 
 // Option is a function that can be used to configure an Oracle.
@@ -81,3 +82,18 @@ func WithProviders(providers []*types.PriceProvider) Option {
 		o.providers = providers
 	}
 }
+
+// WithTWAPConfigs sets the markets for which the Oracle serves a time-weighted average price
+// over a sliding window instead of the most recently aggregated price.
+func WithTWAPConfigs(twaps []config.TWAPConfig) Option {
+	return func(o *OracleImpl) {
+		for _, twap := range twaps {
+			if err := twap.ValidateBasic(); err != nil {
+				panic(err)
+			}
+
+			cp, _ := slinkytypes.CurrencyPairFromString(twap.Ticker)
+			o.twapWindows[cp.String()] = twap.Window
+		}
+	}
+}
@@ -23,6 +23,8 @@ type Oracle interface {
 	IsRunning() bool
 	GetLastSyncTime() time.Time
 	GetPrices() types.Prices
+	GetProviderPrices() types.ProviderPriceReports
+	Subscribe() (<-chan PriceUpdate, func())
 	Start(ctx context.Context) error
 	Stop()
 }
@@ -62,6 +64,22 @@ type OracleImpl struct { //nolint
 
 	// maxCacheAge is the longest amount of time a price will stay in our cache
 	maxCacheAge time.Duration
+
+	// twapWindows is the set of markets for which the oracle serves a time-weighted average
+	// price, indexed by ticker -> window.
+	twapWindows map[string]time.Duration
+
+	// priceHistories maintain the recently aggregated prices of each market in TWAP mode,
+	// indexed by ticker.
+	priceHistories map[string]*PriceHistory
+
+	// subscriptions is the set of subscribers that receive the aggregated prices after
+	// every tick.
+	subscriptions subscriptions
+
+	// providerResults are the raw results returned by each provider in the most recent tick,
+	// indexed by provider -> offChainTicker -> result.
+	providerResults map[string]map[string]providerResult
 }
 
 // New returns a new instance of an Oracle. The oracle inputs providers that are
@@ -74,11 +92,14 @@ type OracleImpl struct { //nolint
 // price across all providers.
 func New(opts ...Option) (*OracleImpl, error) {
 	o := &OracleImpl{
-		closer:         ssync.NewCloser(),
-		logger:         zap.NewNop(),
-		metrics:        oraclemetrics.NewNopMetrics(),
-		updateInterval: 1 * time.Second,
-		maxCacheAge:    time.Minute, // default max cache age is 1 minute
+		closer:          ssync.NewCloser(),
+		logger:          zap.NewNop(),
+		metrics:         oraclemetrics.NewNopMetrics(),
+		updateInterval:  1 * time.Second,
+		maxCacheAge:     time.Minute, // default max cache age is 1 minute
+		twapWindows:     make(map[string]time.Duration),
+		priceHistories:  make(map[string]*PriceHistory),
+		providerResults: make(map[string]map[string]providerResult),
 	}
 
 	for _, opt := range opts {
@@ -148,9 +169,10 @@ func (o *OracleImpl) tick() {
 
 	// Reset the provider prices before fetching new prices.
 	o.priceAggregator.Reset()
+	o.resetProviderResults()
 
 	// Retrieve the latest prices from each provider.
-	for _, priceProvider := range o.providers {
+	for _, priceProvider := range o.getProviders() {
 		o.fetchPrices(priceProvider)
 	}
 
@@ -158,7 +180,13 @@ func (o *OracleImpl) tick() {
 
 	// Compute aggregated prices and update the oracle.
 	o.priceAggregator.AggregatePrices()
-	o.setLastSyncTime(time.Now().UTC())
+	now := time.Now().UTC()
+	o.updatePriceHistories(now)
+	o.setLastSyncTime(now)
+	o.publish(PriceUpdate{
+		Prices:    o.GetPrices(),
+		Timestamp: now,
+	})
 
 	// update the last sync time
 	o.metrics.AddTick()
@@ -203,10 +231,20 @@ func (o *OracleImpl) fetchPrices(provider *types.PriceProvider) {
 		return
 	}
 
-	timeFilteredPrices := make(types.Prices)
+	var (
+		timeFilteredPrices  = make(types.Prices)
+		timeFilteredVolumes = make(types.Prices)
+		results             = make(map[string]providerResult, len(prices))
+	)
 	for pair, result := range prices {
 		// If the price is older than the maxCacheAge, skip it.
 		diff := time.Now().UTC().Sub(result.Timestamp)
+		results[pair.GetOffChainTicker()] = providerResult{
+			value:     result.Value,
+			liquidity: result.Liquidity,
+			timestamp: result.Timestamp,
+			stale:     diff > o.maxCacheAge,
+		}
 		if diff > o.maxCacheAge {
 			o.logger.Debug(
 				"skipping price",
@@ -228,6 +266,13 @@ func (o *OracleImpl) fetchPrices(provider *types.PriceProvider) {
 			zap.Duration("diff", diff),
 		)
 		timeFilteredPrices[pair.GetOffChainTicker()] = result.Value
+		if result.Volume != nil {
+			timeFilteredVolumes[pair.GetOffChainTicker()] = result.Volume
+		}
+		if result.Liquidity != nil {
+			liquidity, _ := result.Liquidity.Float64()
+			o.metrics.UpdateProviderLiquidity(provider.Name(), pair.GetOffChainTicker(), liquidity)
+		}
 	}
 
 	o.logger.Debug("provider returned prices",
@@ -235,7 +280,26 @@ func (o *OracleImpl) fetchPrices(provider *types.PriceProvider) {
 		zap.String("data handler type", string(provider.Type())),
 		zap.Int("prices", len(prices)),
 	)
-	o.priceAggregator.SetProviderPrices(provider.Name(), timeFilteredPrices)
+	o.priceAggregator.SetProviderPricesAndVolumes(provider.Name(), timeFilteredPrices, timeFilteredVolumes)
+	o.setProviderResults(provider.Name(), results)
+}
+
+// UpdateProviders replaces the set of providers that the oracle fetches prices from. This is
+// used to swap in providers that were restarted with an updated configuration.
+func (o *OracleImpl) UpdateProviders(providers []*types.PriceProvider) {
+	o.mtx.Lock()
+	defer o.mtx.Unlock()
+
+	o.providers = providers
+	o.logger.Info("updated oracle providers", zap.Int("num_providers", len(providers)))
+}
+
+// getProviders returns the set of providers that the oracle fetches prices from.
+func (o *OracleImpl) getProviders() []*types.PriceProvider {
+	o.mtx.RLock()
+	defer o.mtx.RUnlock()
+
+	return o.providers
 }
 
 // GetLastSyncTime returns the last time the oracle successfully updated prices.
@@ -254,8 +318,60 @@ func (o *OracleImpl) setLastSyncTime(t time.Time) {
 	o.lastPriceSync = t
 }
 
-// GetPrices returns the aggregate prices from the oracle.
+// GetPrices returns the aggregate prices from the oracle. Markets configured in TWAP mode
+// return the time-weighted average price over their window.
 func (o *OracleImpl) GetPrices() types.Prices {
 	prices := o.priceAggregator.GetPrices()
+	if len(o.twapWindows) == 0 {
+		return prices
+	}
+
+	o.mtx.RLock()
+	defer o.mtx.RUnlock()
+
+	for ticker, window := range o.twapWindows {
+		// Only markets that were priced in the most recent tick are served.
+		if _, ok := prices[ticker]; !ok {
+			continue
+		}
+
+		history, ok := o.priceHistories[ticker]
+		if !ok {
+			continue
+		}
+
+		if twap := history.TWAP(window, o.updateInterval); twap != nil {
+			prices[ticker] = twap
+		}
+	}
+
 	return prices
 }
+
+// updatePriceHistories records the most recently aggregated prices of each market in TWAP
+// mode. The history of each market retains enough samples to cover its window.
+func (o *OracleImpl) updatePriceHistories(timestamp time.Time) {
+	if len(o.twapWindows) == 0 {
+		return
+	}
+
+	prices := o.priceAggregator.GetPrices()
+
+	o.mtx.Lock()
+	defer o.mtx.Unlock()
+
+	for ticker, window := range o.twapWindows {
+		price, ok := prices[ticker]
+		if !ok || price == nil {
+			continue
+		}
+
+		history, ok := o.priceHistories[ticker]
+		if !ok {
+			history = NewPriceHistory(int(window/o.updateInterval) + 2)
+			o.priceHistories[ticker] = history
+		}
+
+		history.Add(timestamp, price)
+	}
+}
@@ -0,0 +1,120 @@
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"reflect"
+
+	"go.uber.org/zap"
+
+	"github.com/skip-mev/slinky/oracle/config"
+	"github.com/skip-mev/slinky/oracle/types"
+	mmclienttypes "github.com/skip-mev/slinky/service/clients/marketmap/types"
+)
+
+// UpdateWithConfig updates the orchestrator's price providers with the given oracle config.
+// Specifically, it determines which provider configs have a diff, and only restarts the
+// providers whose config changed. Providers that were removed from the config are stopped
+// and providers that were added are created and started. Only price provider configs can be
+// updated; the remaining fields of the oracle config, as well as the market map provider's
+// config, are applied on restart.
+func (o *ProviderOrchestrator) UpdateWithConfig(cfg config.OracleConfig) error {
+	if err := cfg.ValidateBasic(); err != nil {
+		o.logger.Error("failed to validate oracle config", zap.Error(err))
+		return err
+	}
+
+	o.mut.Lock()
+	defer o.mut.Unlock()
+
+	// Stop all price providers that are no longer configured.
+	for name, state := range o.providers {
+		if providerCfg, ok := cfg.Providers[name]; ok && providerCfg.Type == types.ConfigType {
+			continue
+		}
+
+		o.logger.Info("removing provider", zap.String("provider", name))
+		state.Provider.Stop()
+		delete(o.providers, name)
+	}
+
+	updated := maps.Clone(cfg.Providers)
+
+	var errs []error
+	for name, providerCfg := range cfg.Providers {
+		switch providerCfg.Type {
+		case types.ConfigType:
+		case mmclienttypes.ConfigType:
+			current, ok := o.cfg.Providers[name]
+			if !ok || !reflect.DeepEqual(current, providerCfg) {
+				o.logger.Warn(
+					"market map provider config changed; a restart is required to apply it",
+					zap.String("provider", name),
+				)
+			}
+
+			// Retain the config that the market map provider is running with.
+			if ok {
+				updated[name] = current
+			} else {
+				delete(updated, name)
+			}
+
+			continue
+		default:
+			errs = append(errs, fmt.Errorf("unknown provider type: %s", providerCfg.Type))
+			delete(updated, name)
+			continue
+		}
+
+		state, ok := o.providers[name]
+		if ok && reflect.DeepEqual(state.Cfg, providerCfg) {
+			continue
+		}
+
+		if err := o.restartPriceProvider(providerCfg); err != nil {
+			o.logger.Error("failed to restart provider", zap.String("provider", name), zap.Error(err))
+			errs = append(errs, err)
+
+			// Retain the config that the provider is running with.
+			if ok {
+				updated[name] = state.Cfg
+			} else {
+				delete(updated, name)
+			}
+		}
+	}
+
+	o.cfg.Providers = updated
+	return errors.Join(errs...)
+}
+
+// restartPriceProvider creates a new price provider for the given provider configuration and
+// replaces the existing provider, if any. The existing provider is only stopped once the new
+// provider has been created.
+func (o *ProviderOrchestrator) restartPriceProvider(cfg config.ProviderConfig) error {
+	ctx := o.mainCtx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	previous, exists := o.providers[cfg.Name]
+	if err := o.createPriceProvider(ctx, cfg); err != nil {
+		return err
+	}
+
+	if exists {
+		o.logger.Info("stopping provider with outdated config", zap.String("provider", cfg.Name))
+		previous.Provider.Stop()
+	}
+
+	providerTickers, err := types.ProviderTickersFromMarketMap(cfg.Name, o.marketMap)
+	if err != nil {
+		return fmt.Errorf("failed to create %s's provider market map: %w", cfg.Name, err)
+	}
+
+	_, err = o.UpdateProviderState(providerTickers, o.providers[cfg.Name])
+	return err
+}
@@ -0,0 +1,129 @@
+package orchestrator_test
+
+import (
+	"context"
+	"maps"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/oracle/config"
+	"github.com/skip-mev/slinky/oracle/orchestrator"
+	"github.com/skip-mev/slinky/providers/apis/binance"
+	"github.com/skip-mev/slinky/providers/apis/coinbase"
+	oraclefactory "github.com/skip-mev/slinky/providers/factories/oracle"
+	"github.com/skip-mev/slinky/providers/websockets/okx"
+)
+
+func TestUpdateWithConfig(t *testing.T) {
+	newOrchestrator := func(t *testing.T, opts ...orchestrator.Option) *orchestrator.ProviderOrchestrator {
+		t.Helper()
+
+		opts = append(
+			opts,
+			orchestrator.WithLogger(logger),
+			orchestrator.WithPriceAPIQueryHandlerFactory(oraclefactory.APIQueryHandlerFactory),
+			orchestrator.WithPriceWebSocketQueryHandlerFactory(oraclefactory.WebSocketQueryHandlerFactory),
+		)
+		o, err := orchestrator.NewProviderOrchestrator(oracleCfg, opts...)
+		require.NoError(t, err)
+
+		return o
+	}
+
+	// withProviders returns a copy of the oracle config with the given providers.
+	withProviders := func(providers map[string]config.ProviderConfig) config.OracleConfig {
+		cfg := oracleCfg
+		cfg.Providers = providers
+		return cfg
+	}
+
+	t.Run("bad config is rejected", func(t *testing.T) {
+		o := newOrchestrator(t)
+		require.NoError(t, o.Init(context.TODO()))
+
+		cfg := oracleCfg
+		cfg.UpdateInterval = 0
+		require.Error(t, o.UpdateWithConfig(cfg))
+		require.Len(t, o.GetProviderState(), 3)
+	})
+
+	t.Run("providers are not restarted if their config is unchanged", func(t *testing.T) {
+		o := newOrchestrator(t)
+		require.NoError(t, o.Init(context.TODO()))
+		before := o.GetProviderState()
+
+		require.NoError(t, o.UpdateWithConfig(withProviders(maps.Clone(oracleCfg.Providers))))
+
+		after := o.GetProviderState()
+		require.Len(t, after, 3)
+		for name, state := range before {
+			require.Same(t, state.Provider, after[name].Provider)
+		}
+	})
+
+	t.Run("only providers whose config changed are restarted", func(t *testing.T) {
+		o := newOrchestrator(t)
+		require.NoError(t, o.Init(context.TODO()))
+		before := o.GetProviderState()
+
+		providers := maps.Clone(oracleCfg.Providers)
+		coinbaseCfg := providers[coinbase.Name]
+		coinbaseCfg.API.Interval = 2 * coinbaseCfg.API.Interval
+		providers[coinbase.Name] = coinbaseCfg
+
+		require.NoError(t, o.UpdateWithConfig(withProviders(providers)))
+
+		after := o.GetProviderState()
+		require.Len(t, after, 3)
+		require.NotSame(t, before[coinbase.Name].Provider, after[coinbase.Name].Provider)
+		require.Equal(t, coinbaseCfg, after[coinbase.Name].Cfg)
+		require.Equal(t, coinbaseCfg.API, after[coinbase.Name].Provider.GetAPIConfig())
+		require.Same(t, before[binance.Name].Provider, after[binance.Name].Provider)
+		require.Same(t, before[okx.Name].Provider, after[okx.Name].Provider)
+	})
+
+	t.Run("providers can be removed and added", func(t *testing.T) {
+		o := newOrchestrator(t)
+		require.NoError(t, o.Init(context.TODO()))
+
+		providers := maps.Clone(oracleCfg.Providers)
+		delete(providers, okx.Name)
+		require.NoError(t, o.UpdateWithConfig(withProviders(providers)))
+
+		state := o.GetProviderState()
+		require.Len(t, state, 2)
+		require.NotContains(t, state, okx.Name)
+
+		require.NoError(t, o.UpdateWithConfig(withProviders(maps.Clone(oracleCfg.Providers))))
+
+		state = o.GetProviderState()
+		require.Len(t, state, 3)
+		require.Equal(t, oracleCfg.Providers[okx.Name], state[okx.Name].Cfg)
+	})
+
+	t.Run("restarted providers with tickers are started", func(t *testing.T) {
+		o := newOrchestrator(t, orchestrator.WithMarketMap(marketMap))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		require.NoError(t, o.Start(ctx))
+		defer o.Stop()
+
+		before := o.GetProviderState()
+		require.Eventually(t, before[coinbase.Name].Provider.IsRunning, 5*time.Second, 50*time.Millisecond)
+
+		providers := maps.Clone(oracleCfg.Providers)
+		coinbaseCfg := providers[coinbase.Name]
+		coinbaseCfg.API.Interval = 2 * coinbaseCfg.API.Interval
+		providers[coinbase.Name] = coinbaseCfg
+		require.NoError(t, o.UpdateWithConfig(withProviders(providers)))
+
+		after := o.GetProviderState()
+		require.Eventually(t, func() bool {
+			return !before[coinbase.Name].Provider.IsRunning() && after[coinbase.Name].Provider.IsRunning()
+		}, 5*time.Second, 50*time.Millisecond)
+		require.Len(t, after[coinbase.Name].Provider.GetIDs(), len(before[coinbase.Name].Provider.GetIDs()))
+	})
+}
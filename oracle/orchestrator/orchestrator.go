@@ -2,6 +2,7 @@ package orchestrator
 
 import (
 	"context"
+	"maps"
 	"sync"
 
 	"go.uber.org/zap"
@@ -112,7 +113,7 @@ func (o *ProviderOrchestrator) GetProviderState() map[string]ProviderState {
 	o.mut.Lock()
 	defer o.mut.Unlock()
 
-	return o.providers
+	return maps.Clone(o.providers)
 }
 
 // GetPriceProviders returns all price providers.
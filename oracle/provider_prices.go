@@ -0,0 +1,71 @@
+package oracle
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/skip-mev/slinky/oracle/types"
+)
+
+// providerResult is the raw result returned by a provider for a single ticker.
+type providerResult struct {
+	value     *big.Float
+	liquidity *big.Float
+	timestamp time.Time
+	stale     bool
+}
+
+// GetProviderPrices returns the breakdown of each provider price used to calculate the price
+// of each market in the most recent tick. This includes prices that were dropped because they
+// are stale or were rejected as outliers. An empty set of reports is returned if the price
+// aggregator does not report the provider prices it used.
+func (o *OracleImpl) GetProviderPrices() types.ProviderPriceReports {
+	reporter, ok := o.priceAggregator.(ProviderPriceReporter)
+	if !ok {
+		return make(types.ProviderPriceReports)
+	}
+
+	reports := reporter.GetProviderPriceReports()
+
+	o.mtx.RLock()
+	defer o.mtx.RUnlock()
+
+	for ticker, providerReports := range reports {
+		updated := make([]types.ProviderPriceReport, len(providerReports))
+		for i, report := range providerReports {
+			if result, ok := o.providerResults[report.Provider][report.OffChainTicker]; ok {
+				report.Timestamp = result.timestamp
+				report.Stale = result.stale
+				report.Liquidity = result.liquidity
+
+				// Stale prices are never passed to the aggregator so the raw price is
+				// sourced from the provider's result.
+				if report.Price == nil {
+					report.Price = result.value
+				}
+			}
+
+			updated[i] = report
+		}
+
+		reports[ticker] = updated
+	}
+
+	return reports
+}
+
+// resetProviderResults clears the provider results recorded in the previous tick.
+func (o *OracleImpl) resetProviderResults() {
+	o.mtx.Lock()
+	defer o.mtx.Unlock()
+
+	o.providerResults = make(map[string]map[string]providerResult)
+}
+
+// setProviderResults records the raw results returned by the given provider.
+func (o *OracleImpl) setProviderResults(provider string, results map[string]providerResult) {
+	o.mtx.Lock()
+	defer o.mtx.Unlock()
+
+	o.providerResults[provider] = results
+}
@@ -0,0 +1,121 @@
+package oracle_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/skip-mev/slinky/oracle"
+	"github.com/skip-mev/slinky/oracle/mocks"
+	"github.com/skip-mev/slinky/oracle/types"
+	"github.com/skip-mev/slinky/providers/base/testutils"
+	providertypes "github.com/skip-mev/slinky/providers/types"
+)
+
+// reportingAggregator is a price aggregator that reports a fixed set of provider prices.
+type reportingAggregator struct {
+	*mocks.PriceAggregator
+
+	reports types.ProviderPriceReports
+}
+
+func (a *reportingAggregator) GetProviderPriceReports() types.ProviderPriceReports {
+	reports := make(types.ProviderPriceReports, len(a.reports))
+	for ticker, providerReports := range a.reports {
+		reports[ticker] = append([]types.ProviderPriceReport(nil), providerReports...)
+	}
+
+	return reports
+}
+
+func TestGetProviderPrices(t *testing.T) {
+	t.Run("aggregator that does not report provider prices", func(t *testing.T) {
+		o, err := oracle.New(oracle.WithPriceAggregator(mocks.NewPriceAggregator(t)))
+		require.NoError(t, err)
+		require.Empty(t, o.GetProviderPrices())
+	})
+
+	t.Run("stale prices are reported with their raw price", func(t *testing.T) {
+		logger := zap.NewNop()
+		btc := types.NewProviderTicker("BTC/USD", "{}")
+		eth := types.NewProviderTicker("ETH/USD", "{}")
+
+		fresh := time.Date(9999, 1, 1, 0, 0, 0, 0, time.UTC)
+		stale := time.Date(1738, 1, 1, 0, 0, 0, 0, time.UTC)
+		resolved := types.ResolvedPrices{
+			btc: {Value: big.NewFloat(100), Timestamp: fresh, Liquidity: big.NewFloat(1e6)},
+			eth: {Value: big.NewFloat(200), Timestamp: stale},
+		}
+		response := providertypes.NewGetResponse[types.ProviderTicker, *big.Float](resolved, nil)
+		provider := testutils.CreateAPIProviderWithGetResponses[types.ProviderTicker, *big.Float](
+			t,
+			logger,
+			providerCfg1,
+			[]types.ProviderTicker{btc, eth},
+			[]providertypes.GetResponse[types.ProviderTicker, *big.Float]{response},
+			50*time.Millisecond,
+		)
+
+		aggregator := &reportingAggregator{
+			PriceAggregator: mocks.NewPriceAggregator(t),
+			reports: types.ProviderPriceReports{
+				btc.String(): {
+					{
+						Provider:       providerCfg1.Name,
+						OffChainTicker: btc.GetOffChainTicker(),
+						Price:          big.NewFloat(100),
+						ConvertedPrice: big.NewFloat(100),
+					},
+				},
+				eth.String(): {
+					{
+						Provider:       providerCfg1.Name,
+						OffChainTicker: eth.GetOffChainTicker(),
+					},
+				},
+			},
+		}
+		aggregator.On("Reset").Return().Maybe()
+		aggregator.On("SetProviderPricesAndVolumes", providerCfg1.Name, mock.Anything, mock.Anything).Return().Maybe()
+		aggregator.On("AggregatePrices").Return().Maybe()
+		aggregator.On("GetPrices").Return(types.Prices{}).Maybe()
+
+		o, err := oracle.New(
+			oracle.WithLogger(logger),
+			oracle.WithUpdateInterval(50*time.Millisecond),
+			oracle.WithProviders([]*types.PriceProvider{provider}),
+			oracle.WithPriceAggregator(aggregator),
+		)
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go provider.Start(ctx) //nolint:errcheck
+		go o.Start(ctx)        //nolint:errcheck
+		defer o.Stop()
+
+		require.Eventually(t, func() bool {
+			reports := o.GetProviderPrices()
+			return !reports[eth.String()][0].Timestamp.IsZero()
+		}, 5*time.Second, 50*time.Millisecond)
+
+		reports := o.GetProviderPrices()
+		require.Len(t, reports, 2)
+
+		require.Equal(t, fresh, reports[btc.String()][0].Timestamp)
+		require.False(t, reports[btc.String()][0].Stale)
+		require.Equal(t, big.NewFloat(100), reports[btc.String()][0].Price)
+		require.Equal(t, big.NewFloat(1e6), reports[btc.String()][0].Liquidity)
+
+		require.Equal(t, stale, reports[eth.String()][0].Timestamp)
+		require.True(t, reports[eth.String()][0].Stale)
+		require.Equal(t, big.NewFloat(200), reports[eth.String()][0].Price)
+		require.Nil(t, reports[eth.String()][0].ConvertedPrice)
+		require.Nil(t, reports[eth.String()][0].Liquidity)
+	})
+}
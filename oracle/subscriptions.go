@@ -0,0 +1,72 @@
+package oracle
+
+import (
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/skip-mev/slinky/oracle/types"
+)
+
+// DefaultSubscriptionBufferSize is the number of price updates that can be buffered for
+// a subscriber before updates are dropped.
+const DefaultSubscriptionBufferSize = 16
+
+// PriceUpdate is the set of prices published to subscribers after every oracle tick.
+type PriceUpdate struct {
+	// Prices are the prices returned by the oracle after the tick.
+	Prices types.Prices
+	// Timestamp is the time at which the prices were aggregated.
+	Timestamp time.Time
+}
+
+// subscriptions maintains the set of subscribers to the oracle's price updates.
+type subscriptions struct {
+	mtx    sync.Mutex
+	nextID uint64
+	subs   map[uint64]chan PriceUpdate
+}
+
+// Subscribe returns a channel that receives the prices aggregated by the oracle after every
+// tick, along with a function that cancels the subscription and closes the channel. Updates
+// are dropped for subscribers that do not keep up with the oracle's update interval.
+func (o *OracleImpl) Subscribe() (<-chan PriceUpdate, func()) {
+	o.subscriptions.mtx.Lock()
+	defer o.subscriptions.mtx.Unlock()
+
+	if o.subscriptions.subs == nil {
+		o.subscriptions.subs = make(map[uint64]chan PriceUpdate)
+	}
+
+	id := o.subscriptions.nextID
+	o.subscriptions.nextID++
+
+	ch := make(chan PriceUpdate, DefaultSubscriptionBufferSize)
+	o.subscriptions.subs[id] = ch
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			o.subscriptions.mtx.Lock()
+			defer o.subscriptions.mtx.Unlock()
+
+			delete(o.subscriptions.subs, id)
+			close(ch)
+		})
+	}
+}
+
+// publish sends the given price update to every subscriber without blocking.
+func (o *OracleImpl) publish(update PriceUpdate) {
+	o.subscriptions.mtx.Lock()
+	defer o.subscriptions.mtx.Unlock()
+
+	for id, ch := range o.subscriptions.subs {
+		select {
+		case ch <- update:
+		default:
+			o.logger.Debug("dropping price update for slow subscriber", zap.Uint64("subscriber", id))
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package oracle_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/skip-mev/slinky/oracle"
+	"github.com/skip-mev/slinky/oracle/mocks"
+	"github.com/skip-mev/slinky/oracle/types"
+)
+
+func TestSubscribe(t *testing.T) {
+	prices := types.Prices{
+		"BTC/USD": big.NewFloat(100),
+	}
+
+	aggregator := mocks.NewPriceAggregator(t)
+	aggregator.On("Reset").Return()
+	aggregator.On("AggregatePrices").Return()
+	aggregator.On("GetPrices").Return(prices)
+
+	o, err := oracle.New(
+		oracle.WithLogger(zap.NewNop()),
+		oracle.WithUpdateInterval(50*time.Millisecond),
+		oracle.WithPriceAggregator(aggregator),
+	)
+	require.NoError(t, err)
+
+	updates, unsubscribe := o.Subscribe()
+	slow, unsubscribeSlow := o.Subscribe()
+	defer unsubscribeSlow()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go o.Start(ctx) //nolint:errcheck
+
+	// Every tick is published to the subscriber.
+	for i := 0; i < 2; i++ {
+		select {
+		case update := <-updates:
+			require.Equal(t, prices, update.Prices)
+			require.False(t, update.Timestamp.IsZero())
+		case <-time.After(2 * time.Second):
+			t.Fatal("failed to receive price update")
+		}
+	}
+
+	// Unsubscribing closes the channel and is idempotent.
+	unsubscribe()
+	unsubscribe()
+	require.Eventually(t, func() bool {
+		_, ok := <-updates
+		return !ok
+	}, 2*time.Second, 10*time.Millisecond)
+
+	// Updates are dropped for subscribers that do not keep up rather than blocking the oracle.
+	require.Eventually(t, func() bool {
+		return len(slow) == oracle.DefaultSubscriptionBufferSize
+	}, 5*time.Second, 50*time.Millisecond)
+	lastSync := o.GetLastSyncTime()
+	require.Eventually(t, func() bool {
+		return o.GetLastSyncTime().After(lastSync)
+	}, 2*time.Second, 10*time.Millisecond)
+
+	o.Stop()
+}
@@ -0,0 +1,101 @@
+package oracle
+
+import (
+	"math/big"
+	"time"
+)
+
+// priceSample is a single aggregated price observed by the oracle.
+type priceSample struct {
+	timestamp time.Time
+	price     *big.Float
+}
+
+// PriceHistory is a bounded ring buffer of the aggregated prices of a single market. It is
+// used to compute the time-weighted average price (TWAP) of the market over a sliding window.
+// PriceHistory is not thread-safe.
+type PriceHistory struct {
+	samples []priceSample
+	// next is the index that the next sample will be written to.
+	next int
+	// size is the number of samples currently in the buffer.
+	size int
+}
+
+// NewPriceHistory returns a new PriceHistory that retains at most capacity samples.
+func NewPriceHistory(capacity int) *PriceHistory {
+	if capacity <= 0 {
+		panic("price history capacity must be positive")
+	}
+
+	return &PriceHistory{
+		samples: make([]priceSample, capacity),
+	}
+}
+
+// Add adds a price observed at the given timestamp to the history. If the history is full,
+// the oldest sample is overwritten. Samples are expected to be added in chronological order.
+func (h *PriceHistory) Add(timestamp time.Time, price *big.Float) {
+	h.samples[h.next] = priceSample{
+		timestamp: timestamp,
+		price:     new(big.Float).Copy(price),
+	}
+
+	h.next = (h.next + 1) % len(h.samples)
+	if h.size < len(h.samples) {
+		h.size++
+	}
+}
+
+// Len returns the number of samples in the history.
+func (h *PriceHistory) Len() int {
+	return h.size
+}
+
+// TWAP returns the time-weighted average price over the window ending at the most recent
+// sample. Each sample is weighted by the time elapsed since the previous sample, clamped to
+// the window. The oldest sample in the history is assumed to have been observed interval
+// after its (evicted or missing) predecessor. Nil is returned if the history is empty.
+func (h *PriceHistory) TWAP(window, interval time.Duration) *big.Float {
+	if h.size == 0 {
+		return nil
+	}
+
+	latest := h.at(h.size - 1)
+	start := latest.timestamp.Add(-window)
+
+	var (
+		weightedSum = new(big.Float)
+		totalWeight = new(big.Float)
+	)
+	for i := h.size - 1; i >= 0; i-- {
+		sample := h.at(i)
+		if !sample.timestamp.After(start) {
+			break
+		}
+
+		from := sample.timestamp.Add(-interval)
+		if i > 0 {
+			from = h.at(i - 1).timestamp
+		}
+		if from.Before(start) {
+			from = start
+		}
+
+		weight := new(big.Float).SetInt64(int64(sample.timestamp.Sub(from)))
+		weightedSum.Add(weightedSum, new(big.Float).Mul(sample.price, weight))
+		totalWeight.Add(totalWeight, weight)
+	}
+
+	if totalWeight.Sign() == 0 {
+		return new(big.Float).Copy(latest.price)
+	}
+
+	return weightedSum.Quo(weightedSum, totalWeight)
+}
+
+// at returns the i-th oldest sample in the history.
+func (h *PriceHistory) at(i int) priceSample {
+	oldest := (h.next - h.size + len(h.samples)) % len(h.samples)
+	return h.samples[(oldest+i)%len(h.samples)]
+}
@@ -0,0 +1,98 @@
+package oracle_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/oracle"
+)
+
+func TestPriceHistory(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name     string
+		capacity int
+		samples  []float64
+		window   time.Duration
+		expected *big.Float
+	}{
+		{
+			name:     "empty history",
+			capacity: 3,
+			window:   time.Minute,
+			expected: nil,
+		},
+		{
+			name:     "single sample",
+			capacity: 3,
+			samples:  []float64{100},
+			window:   time.Minute,
+			expected: big.NewFloat(100),
+		},
+		{
+			name:     "equally weighted samples within the window",
+			capacity: 5,
+			samples:  []float64{100, 200, 300},
+			window:   time.Minute,
+			expected: big.NewFloat(200),
+		},
+		{
+			name:     "samples outside of the window are ignored",
+			capacity: 5,
+			samples:  []float64{1_000, 100, 200},
+			window:   2 * time.Second,
+			expected: big.NewFloat(150),
+		},
+		{
+			name:     "partially covered samples are clamped to the window",
+			capacity: 5,
+			samples:  []float64{1_000, 100, 200},
+			window:   1500 * time.Millisecond,
+			expected: big.NewFloat(200*2.0/3.0 + 100*1.0/3.0),
+		},
+		{
+			name:     "oldest samples are evicted when the history is full",
+			capacity: 2,
+			samples:  []float64{1_000, 100, 200},
+			window:   time.Minute,
+			expected: big.NewFloat(150),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			history := oracle.NewPriceHistory(tc.capacity)
+			for i, price := range tc.samples {
+				history.Add(start.Add(time.Duration(i)*time.Second), big.NewFloat(price))
+			}
+			require.Equal(t, min(len(tc.samples), tc.capacity), history.Len())
+
+			twap := history.TWAP(tc.window, time.Second)
+			if tc.expected == nil {
+				require.Nil(t, twap)
+				return
+			}
+
+			expected, _ := tc.expected.Float64()
+			actual, _ := twap.Float64()
+			require.InDelta(t, expected, actual, 1e-9)
+		})
+	}
+}
+
+func TestPriceHistoryTimeWeighting(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	history := oracle.NewPriceHistory(10)
+
+	// The price of 100 is observed for one second whereas the price of 200 is observed
+	// for three seconds.
+	history.Add(start, big.NewFloat(100))
+	history.Add(start.Add(3*time.Second), big.NewFloat(200))
+
+	twap, _ := history.TWAP(time.Minute, time.Second).Float64()
+	require.InDelta(t, 175, twap, 1e-9)
+}
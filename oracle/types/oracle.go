@@ -89,6 +89,12 @@ var (
 	// NewPriceResultWithCode is a function alias for the new price result with code.
 	NewPriceResultWithCode = providertypes.NewResultWithCode[*big.Float]
 
+	// NewPriceResultWithVolume is a function alias for the new price result with volume.
+	NewPriceResultWithVolume = providertypes.NewResultWithVolume[*big.Float]
+
+	// NewPriceResultWithLiquidity is a function alias for the new price result with liquidity.
+	NewPriceResultWithLiquidity = providertypes.NewResultWithLiquidity[*big.Float]
+
 	// NewPriceResponse is a function alias for the new price response.
 	NewPriceResponse = providertypes.NewGetResponse[ProviderTicker, *big.Float]
 
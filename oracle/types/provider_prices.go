@@ -0,0 +1,47 @@
+package types
+
+import (
+	"math/big"
+	"time"
+)
+
+// ProviderPriceReport is the breakdown of a single provider price that is used when
+// calculating the price of a market.
+type ProviderPriceReport struct {
+	// Provider is the name of the provider.
+	Provider string
+	// OffChainTicker is the ticker of the market on the provider.
+	OffChainTicker string
+	// Price is the raw price reported by the provider. This is nil if the provider has
+	// not reported a price.
+	Price *big.Float
+	// Timestamp is the time at which the provider last updated the price.
+	Timestamp time.Time
+	// Stale is true if the price was dropped because it is older than the oracle's
+	// max price age.
+	Stale bool
+	// Invert is true if the raw price is inverted before it is normalized.
+	Invert bool
+	// Normalizations is the ordered list of index prices the price is normalized by.
+	Normalizations []NormalizationPrice
+	// ConvertedPrice is the price converted to the market's ticker. This is nil if the
+	// price could not be converted.
+	ConvertedPrice *big.Float
+	// Outlier is true if the converted price was rejected as an outlier.
+	Outlier bool
+	// Liquidity is the liquidity backing the raw price, e.g. the depth of a DEX pool. This is
+	// nil if the provider does not report liquidity.
+	Liquidity *big.Float
+}
+
+// NormalizationPrice is an index price used to normalize a provider price.
+type NormalizationPrice struct {
+	// Ticker is the ticker of the index price i.e. USDT/USD.
+	Ticker string
+	// Price is the index price. This is nil if the index price is missing.
+	Price *big.Float
+}
+
+// ProviderPriceReports is a map of ticker to the breakdown of each provider price that
+// is configured for the market.
+type ProviderPriceReports = map[string][]ProviderPriceReport
@@ -16,16 +16,28 @@ import (
 
 var _ oracle.PriceAggregator = &IndexPriceAggregator{}
 
-// IndexPriceAggregator is an aggregator that calculates the median price for each ticker,
+// IndexPriceAggregator is an aggregator that calculates the index price for each ticker,
 // resolved from a predefined set of conversion markets. A conversion market is a set of
 // markets that can be used to convert the prices of a set of tickers to a common ticker.
-// These are defined in the market map configuration.
+// These are defined in the market map configuration. The converted prices are aggregated
+// using the strategy configured in the ticker's metadata, defaulting to the median.
 type IndexPriceAggregator struct {
 	mtx     sync.Mutex
 	logger  *zap.Logger
 	cfg     mmtypes.MarketMap
 	metrics oraclemetrics.Metrics
 
+	// defaultStrategy is the aggregation strategy used for markets that do not configure
+	// their own strategy.
+	defaultStrategy AggregationStrategy
+	// strategies cache the aggregation strategies configured for each ticker.
+	strategies map[string]AggregationStrategy
+	// defaultOutlierFilter is the outlier filter used for markets that do not configure
+	// their own filter. This is nil if outliers are not rejected by default.
+	defaultOutlierFilter OutlierFilter
+	// outlierFilters cache the outlier filters configured for each ticker.
+	outlierFilters map[string]OutlierFilter
+
 	// indexPrices cache the median prices for each ticker. These are unscaled prices.
 	indexPrices types.Prices
 	// scaledPrices cache the scaled prices for each ticker. These are the prices that can be
@@ -34,6 +46,12 @@ type IndexPriceAggregator struct {
 	// providerPrices cache the unscaled prices for each provider. These are indexed by
 	// provider -> offChainTicker -> price.
 	providerPrices map[string]types.Prices
+	// providerVolumes cache the 24h base volumes reported by each provider. These are indexed
+	// by provider -> offChainTicker -> volume.
+	providerVolumes map[string]types.Prices
+	// reports cache the breakdown of the provider prices used to calculate the index price
+	// of each ticker in the most recent aggregation.
+	reports types.ProviderPriceReports
 }
 
 // NewIndexPriceAggregator returns a new Index Price Aggregator.
@@ -41,6 +59,7 @@ func NewIndexPriceAggregator(
 	logger *zap.Logger,
 	cfg mmtypes.MarketMap,
 	metrics oraclemetrics.Metrics,
+	opts ...Option,
 ) (*IndexPriceAggregator, error) {
 	if logger == nil {
 		return nil, fmt.Errorf("logger cannot be nil")
@@ -51,31 +70,42 @@ func NewIndexPriceAggregator(
 		metrics = oraclemetrics.NewNopMetrics()
 	}
 
-	return &IndexPriceAggregator{
-		logger:         logger.With(zap.String("process", "index_price_aggregator")),
-		cfg:            cfg,
-		metrics:        metrics,
-		indexPrices:    make(types.Prices),
-		scaledPrices:   make(types.Prices),
-		providerPrices: make(map[string]types.Prices),
-	}, nil
+	m := &IndexPriceAggregator{
+		logger:          logger.With(zap.String("process", "index_price_aggregator")),
+		metrics:         metrics,
+		defaultStrategy: MedianStrategy{},
+		indexPrices:     make(types.Prices),
+		scaledPrices:    make(types.Prices),
+		providerPrices:  make(map[string]types.Prices),
+		providerVolumes: make(map[string]types.Prices),
+		reports:         make(types.ProviderPriceReports),
+	}
+
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	m.setMarketMap(cfg)
+	return m, nil
 }
 
-// AggregatePrices implements the aggregate function for the median price calculation. Specifically, this
+// AggregatePrices implements the aggregate function for the index price calculation. Specifically, this
 // aggregation function aggregates the prices seen by each provider by first converting each price to a
-// common ticker and then calculating the median of the converted prices. Prices are converted either
+// common ticker and then applying the market's aggregation strategy to the converted prices. Prices
+// are converted either
 //
 //  1. Directly from the base ticker to the target ticker. i.e. I have BTC/USD and I want BTC/USD.
 //  2. Using the index price of an asset. i.e. I have BTC/USDT and I want BTC/USD. I can convert
 //     BTC/USDT to BTC/USD using the index price of USDT/USD.
 //
-// The index price cache contains the previously calculated median prices.
+// The index price cache contains the previously calculated index prices.
 func (m *IndexPriceAggregator) AggregatePrices() {
 	m.mtx.Lock()
 	defer m.mtx.Unlock()
 
 	indexPrices := make(types.Prices)
 	scaledPrices := make(types.Prices)
+	reports := make(types.ProviderPriceReports)
 
 	for ticker, market := range m.cfg.Markets {
 		if !market.Ticker.Enabled {
@@ -87,7 +117,9 @@ func (m *IndexPriceAggregator) AggregatePrices() {
 		// ex. BTC/USDT * Index USDT/USD = BTC/USD
 		//     BTC/USDC * Index USDC/USD = BTC/USD
 		target := market.Ticker
-		convertedPrices := m.CalculateConvertedPrices(market)
+		unfilteredPrices := m.CalculateConvertedPrices(market)
+		convertedPrices := m.FilterOutliers(market, unfilteredPrices)
+		reports[ticker] = m.providerPriceReports(market, unfilteredPrices, convertedPrices)
 		m.metrics.AddProviderCountForMarket(target.String(), len(convertedPrices))
 
 		// We need to have at least the minimum number of providers to calculate the median.
@@ -103,17 +135,30 @@ func (m *IndexPriceAggregator) AggregatePrices() {
 			continue
 		}
 
-		// Take the median of the converted prices. This takes the average of the middle two
-		// prices if the number of prices is even.
-		price := math.CalculateMedian(convertedPrices)
+		// Aggregate the converted prices using the market's aggregation strategy.
+		strategy := m.GetAggregationStrategy(ticker)
+		price, err := strategy.Aggregate(convertedPrices)
+		if err != nil {
+			m.logger.Error(
+				"failed to aggregate converted prices",
+				zap.String("target_ticker", ticker),
+				zap.String("strategy", strategy.Name()),
+				zap.Any("converted_prices", convertedPrices),
+				zap.Error(err),
+			)
+
+			continue
+		}
+
 		indexPrices[target.String()] = new(big.Float).Copy(price)
 
 		// Scale the price to the target ticker's decimals.
 		scaledPrices[target.String()] = math.ScaleBigFloat(new(big.Float).Copy(price), target.Decimals)
 
 		m.logger.Debug(
-			"calculated median price",
+			"calculated index price",
 			zap.String("target_ticker", ticker),
+			zap.String("strategy", strategy.Name()),
 
 			zap.String("unscaled_price", indexPrices[target.String()].String()),
 			zap.String("scaled_price", scaledPrices[target.String()].String()),
@@ -126,9 +171,10 @@ func (m *IndexPriceAggregator) AggregatePrices() {
 
 	// Update the aggregated data. These prices are going to be used as the index prices the
 	// next time we calculate prices.
-	m.logger.Debug("calculated median prices for price feeds", zap.Int("num_prices", len(indexPrices)))
+	m.logger.Debug("calculated index prices for price feeds", zap.Int("num_prices", len(indexPrices)))
 	m.indexPrices = indexPrices
 	m.scaledPrices = scaledPrices
+	m.reports = reports
 }
 
 // CalculateConvertedPrices calculates the converted prices for a given set of paths and target ticker.
@@ -136,7 +182,7 @@ func (m *IndexPriceAggregator) AggregatePrices() {
 // MaxPriceAge window so is safe to use.
 func (m *IndexPriceAggregator) CalculateConvertedPrices(
 	market mmtypes.Market,
-) []*big.Float {
+) []ConvertedPrice {
 	m.logger.Debug("calculating converted prices", zap.String("ticker", market.Ticker.String()))
 	if len(market.ProviderConfigs) == 0 {
 		m.logger.Error(
@@ -147,7 +193,7 @@ func (m *IndexPriceAggregator) CalculateConvertedPrices(
 		return nil
 	}
 
-	convertedPrices := make([]*big.Float, 0, len(market.ProviderConfigs))
+	convertedPrices := make([]ConvertedPrice, 0, len(market.ProviderConfigs))
 	for _, cfg := range market.ProviderConfigs {
 		// Calculate the converted price.
 		adjustedPrice, err := m.CalculateAdjustedPrice(cfg)
@@ -163,7 +209,12 @@ func (m *IndexPriceAggregator) CalculateConvertedPrices(
 			continue
 		}
 
-		convertedPrices = append(convertedPrices, adjustedPrice)
+		convertedPrices = append(convertedPrices, ConvertedPrice{
+			Provider:       cfg.Name,
+			OffChainTicker: cfg.OffChainTicker,
+			Price:          adjustedPrice,
+			Volume:         m.GetProviderVolume(cfg),
+		})
 		m.logger.Debug(
 			"calculated converted price",
 			zap.String("target_ticker", market.Ticker.String()),
@@ -179,16 +230,49 @@ func (m *IndexPriceAggregator) CalculateConvertedPrices(
 	return convertedPrices
 }
 
+// FilterOutliers removes the converted prices that are rejected by the market's outlier
+// filter. The filter is applied against the previous index price of the market. Each
+// rejected price is logged and recorded in the metrics on a per-provider basis.
+func (m *IndexPriceAggregator) FilterOutliers(
+	market mmtypes.Market,
+	convertedPrices []ConvertedPrice,
+) []ConvertedPrice {
+	target := market.Ticker.String()
+	filter := m.GetOutlierFilter(target)
+	if filter == nil || len(convertedPrices) == 0 {
+		return convertedPrices
+	}
+
+	// The previous index price may be missing if the market has not been priced yet.
+	previous := m.indexPrices[target]
+	kept, rejected := filter.Filter(convertedPrices, previous)
+	for _, price := range rejected {
+		m.logger.Info(
+			"rejected outlier price",
+			zap.String("target_ticker", target),
+			zap.String("provider", price.Provider),
+			zap.String("price", price.Price.String()),
+			zap.String("filter", filter.Name()),
+		)
+
+		m.metrics.AddProviderOutlier(price.Provider, target)
+	}
+
+	return kept
+}
+
 // CalculateAdjustedPrice calculates an adjusted price for a given set of operations (if applicable).
 // In particular, this assumes that every operation is either:
 //
 //  1. A direct conversion from the base ticker to the target ticker i.e. we want BTC/USD and
 //     we have BTC/USD from a provider (e.g. Coinbase).
 //  2. We need to convert the price of a given asset against the index price of an asset.
+//  3. We need to convert the price of a given asset against the index prices of an ordered
+//     path of assets i.e. TOKEN/ETH * INDEX ETH/USDT * INDEX USDT/USD = TOKEN/USD.
 //
-// In the first case, we can simply return the price of the provider. In the second case, we need
-// to adjust the price by the index price of the asset. If the index price is not available, we
-// return an error.
+// In the first case, we can simply return the price of the provider. In the remaining cases, we
+// need to adjust the price by the index price of each asset. If any index price is not available,
+// we return an error.
 func (m *IndexPriceAggregator) CalculateAdjustedPrice(
 	cfg mmtypes.ProviderConfig,
 ) (*big.Float, error) {
@@ -197,15 +281,21 @@ func (m *IndexPriceAggregator) CalculateAdjustedPrice(
 		return nil, err
 	}
 
-	if cfg.NormalizeByPair == nil {
+	normalizations := cfg.Normalizations()
+	if len(normalizations) == 0 {
 		return price, nil
 	}
 
-	normalizeByIndexPrice, err := m.GetIndexPrice(*cfg.NormalizeByPair)
-	if err != nil {
-		return nil, err
+	adjustedPrice := price
+	for _, pair := range normalizations {
+		normalizeByIndexPrice, err := m.GetIndexPrice(pair)
+		if err != nil {
+			return nil, err
+		}
+
+		// Make sure that the price is adjusted by the market price.
+		adjustedPrice = new(big.Float).Mul(adjustedPrice, normalizeByIndexPrice)
 	}
 
-	// Make sure that the price is adjusted by the market price.
-	return new(big.Float).Mul(price, normalizeByIndexPrice), nil
+	return adjustedPrice, nil
 }
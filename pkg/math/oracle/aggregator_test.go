@@ -362,7 +362,7 @@ func TestCalculateConvertedPrices(t *testing.T) {
 
 			// Ensure that the prices are as expected.
 			for i, price := range prices {
-				require.Equal(t, tc.expectedPrices[i].SetPrec(36), price.SetPrec(36))
+				require.Equal(t, tc.expectedPrices[i].SetPrec(36), price.Price.SetPrec(36))
 			}
 		})
 	}
@@ -525,6 +525,57 @@ func TestCalculateAdjustedPrice(t *testing.T) {
 			expectedPrice: big.NewFloat(4_400),
 			expectedErr:   false,
 		},
+		{
+			name:   "price is adjusted using a normalization path (PEPE/ETH * ETH/USDT * USDT/USD = PEPE/USD)",
+			target: PEPE_USD,
+			cfg: mmtypes.ProviderConfig{
+				Name:           coinbase.Name,
+				OffChainTicker: "PEPE-ETH",
+				NormalizationPath: []pkgtypes.CurrencyPair{
+					pkgtypes.NewCurrencyPair("ETH", "USDT"),
+					usdtusdCP,
+				},
+			},
+			malleate: func(aggregator *oracle.IndexPriceAggregator) {
+				prices := types.Prices{
+					"PEPE-ETH": big.NewFloat(0.000000002),
+				}
+				aggregator.SetProviderPrices(coinbase.Name, prices)
+
+				indexPrices := types.Prices{
+					pkgtypes.NewCurrencyPair("ETH", "USDT").String(): big.NewFloat(4_000),
+					usdtusdCP.String(): big.NewFloat(1.1),
+				}
+				aggregator.SetIndexPrices(indexPrices)
+			},
+			expectedPrice: big.NewFloat(0.0000088),
+			expectedErr:   false,
+		},
+		{
+			name:   "price cannot be adjusted if an intermediate index price in the normalization path does not exist",
+			target: PEPE_USD,
+			cfg: mmtypes.ProviderConfig{
+				Name:           coinbase.Name,
+				OffChainTicker: "PEPE-ETH",
+				NormalizationPath: []pkgtypes.CurrencyPair{
+					pkgtypes.NewCurrencyPair("ETH", "USDT"),
+					usdtusdCP,
+				},
+			},
+			malleate: func(aggregator *oracle.IndexPriceAggregator) {
+				prices := types.Prices{
+					"PEPE-ETH": big.NewFloat(0.000000002),
+				}
+				aggregator.SetProviderPrices(coinbase.Name, prices)
+
+				indexPrices := types.Prices{
+					usdtusdCP.String(): big.NewFloat(1.1),
+				}
+				aggregator.SetIndexPrices(indexPrices)
+			},
+			expectedPrice: nil,
+			expectedErr:   true,
+		},
 		{
 			name:   "price for USDT/USD needs to be adjust by eth prices (USDT/ETH * ETH/USD = USDT/USD)",
 			target: USDT_USD,
@@ -639,3 +690,81 @@ func TestCalculateAdjustedPrice(t *testing.T) {
 		})
 	}
 }
+
+func TestGetProviderPriceReports(t *testing.T) {
+	ticker := BTC_USD
+	ticker.MinProviderCount = 1
+	ticker.Metadata_JSON = `{"outlier_filter": {"method": "mad", "threshold": 3}}`
+
+	marketmap := mmtypes.MarketMap{
+		Markets: map[string]mmtypes.Market{
+			ticker.String(): {
+				Ticker: ticker,
+				ProviderConfigs: []mmtypes.ProviderConfig{
+					{
+						Name:           coinbase.Name,
+						OffChainTicker: "BTC-USD",
+					},
+					{
+						Name:            binance.Name,
+						OffChainTicker:  "BTCUSDT",
+						NormalizeByPair: &usdtusdCP,
+					},
+					{
+						Name:           kucoin.Name,
+						OffChainTicker: "BTC-USD",
+					},
+				},
+			},
+			USDT_USD.String(): {
+				Ticker: USDT_USD,
+				ProviderConfigs: []mmtypes.ProviderConfig{
+					{
+						Name:           coinbase.Name,
+						OffChainTicker: "USDT-USD",
+					},
+				},
+			},
+		},
+	}
+
+	m, err := oracle.NewIndexPriceAggregator(logger, marketmap, metrics.NewNopMetrics())
+	require.NoError(t, err)
+	require.Empty(t, m.GetProviderPriceReports())
+
+	m.SetIndexPrices(types.Prices{USDT_USD.String(): big.NewFloat(1)})
+	m.SetProviderPrices(coinbase.Name, types.Prices{"BTC-USD": big.NewFloat(70_000)})
+	m.SetProviderPrices(binance.Name, types.Prices{"BTCUSDT": big.NewFloat(70_100)})
+	m.SetProviderPrices(kucoin.Name, types.Prices{"BTC-USD": big.NewFloat(100_000)})
+	m.AggregatePrices()
+
+	reports := m.GetProviderPriceReports()
+	require.Len(t, reports, 2)
+
+	btc := reports[ticker.String()]
+	require.Len(t, btc, 3)
+
+	require.Equal(t, coinbase.Name, btc[0].Provider)
+	require.Equal(t, big.NewFloat(70_000), btc[0].Price)
+	require.Empty(t, btc[0].Normalizations)
+	require.NotNil(t, btc[0].ConvertedPrice)
+	require.False(t, btc[0].Outlier)
+
+	require.Equal(t, binance.Name, btc[1].Provider)
+	require.Equal(t, "BTCUSDT", btc[1].OffChainTicker)
+	require.Equal(t, []types.NormalizationPrice{
+		{Ticker: USDT_USD.String(), Price: big.NewFloat(1)},
+	}, btc[1].Normalizations)
+	require.NotNil(t, btc[1].ConvertedPrice)
+	require.False(t, btc[1].Outlier)
+
+	require.Equal(t, kucoin.Name, btc[2].Provider)
+	require.True(t, btc[2].Outlier)
+
+	// USDT/USD has no provider prices so the report has no price and cannot be converted.
+	usdt := reports[USDT_USD.String()]
+	require.Len(t, usdt, 1)
+	require.Nil(t, usdt[0].Price)
+	require.Nil(t, usdt[0].ConvertedPrice)
+	require.False(t, usdt[0].Outlier)
+}
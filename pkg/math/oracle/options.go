@@ -0,0 +1,28 @@
+package oracle
+
+// Option is a function that can be used to configure an IndexPriceAggregator.
+type Option func(*IndexPriceAggregator)
+
+// WithDefaultAggregationStrategy sets the aggregation strategy used for markets that do not
+// configure a strategy in their ticker metadata. By default, the median is used.
+func WithDefaultAggregationStrategy(strategy AggregationStrategy) Option {
+	return func(m *IndexPriceAggregator) {
+		if strategy == nil {
+			panic("aggregation strategy cannot be nil")
+		}
+
+		m.defaultStrategy = strategy
+	}
+}
+
+// WithDefaultOutlierFilter sets the outlier filter used for markets that do not configure
+// a filter in their ticker metadata. By default, no outliers are rejected.
+func WithDefaultOutlierFilter(filter OutlierFilter) Option {
+	return func(m *IndexPriceAggregator) {
+		if filter == nil {
+			panic("outlier filter cannot be nil")
+		}
+
+		m.defaultOutlierFilter = filter
+	}
+}
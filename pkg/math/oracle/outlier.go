@@ -0,0 +1,186 @@
+package oracle
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/skip-mev/slinky/pkg/math"
+)
+
+const (
+	// MADOutlierFilterName is the name of the median absolute deviation outlier filter.
+	MADOutlierFilterName = "mad"
+	// PercentBandOutlierFilterName is the name of the outlier filter that rejects prices
+	// outside a percentage band around the previous index price.
+	PercentBandOutlierFilterName = "percent_band"
+
+	// MinRelativeMAD is the minimum median absolute deviation used by the MAD filter, as a
+	// fraction of the median i.e. 0.0001 is 1 basis point. This prevents the filter from
+	// rejecting every price that differs from the median when most prices are identical.
+	MinRelativeMAD = 0.0001
+)
+
+// OutlierFilter defines how converted prices that deviate significantly from the rest of
+// the set are rejected before the prices for a market are aggregated.
+type OutlierFilter interface {
+	// Name returns the name of the filter.
+	Name() string
+	// Filter splits the converted prices into the prices that are kept and the prices that
+	// were rejected as outliers. The previous index price of the market is nil if the market
+	// has not been priced yet.
+	Filter(prices []ConvertedPrice, previous *big.Float) (kept, rejected []ConvertedPrice)
+}
+
+// OutlierFilterConfig is the configuration used to select and configure the outlier filter
+// for a given market.
+type OutlierFilterConfig struct {
+	// Method is the name of the outlier filter to use.
+	Method string `json:"method"`
+	// Threshold is the maximum deviation a price may have before it is rejected. For the
+	// MAD filter this is the number of median absolute deviations from the median. For the
+	// percent band filter this is the maximum fractional deviation from the previous index
+	// price i.e. 0.05 is 5%.
+	Threshold float64 `json:"threshold"`
+}
+
+// ValidateBasic performs basic validation on the outlier filter config.
+func (c OutlierFilterConfig) ValidateBasic() error {
+	switch c.Method {
+	case MADOutlierFilterName, PercentBandOutlierFilterName:
+	default:
+		return fmt.Errorf("unknown outlier filter method: %s", c.Method)
+	}
+
+	if c.Threshold <= 0 {
+		return fmt.Errorf("outlier filter threshold must be positive; got %f", c.Threshold)
+	}
+
+	return nil
+}
+
+// NewOutlierFilter returns the outlier filter described by the given config.
+func NewOutlierFilter(cfg OutlierFilterConfig) (OutlierFilter, error) {
+	if err := cfg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if cfg.Method == MADOutlierFilterName {
+		return MADOutlierFilter{Threshold: cfg.Threshold}, nil
+	}
+
+	return PercentBandOutlierFilter{MaxDeviation: cfg.Threshold}, nil
+}
+
+// OutlierFilterFromMetadata returns the outlier filter configured in a ticker's metadata
+// JSON. If the metadata does not configure a filter, nil is returned.
+func OutlierFilterFromMetadata(metadataJSON string) (OutlierFilter, error) {
+	if len(metadataJSON) == 0 {
+		return nil, nil
+	}
+
+	var metadata TickerMetadata
+	if err := json.Unmarshal([]byte(metadataJSON), &metadata); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal ticker metadata: %w", err)
+	}
+
+	if metadata.OutlierFilter == nil {
+		return nil, nil
+	}
+
+	return NewOutlierFilter(*metadata.OutlierFilter)
+}
+
+// MADOutlierFilter rejects prices whose absolute deviation from the median of the set is
+// greater than Threshold times the median absolute deviation (MAD) of the set. The MAD is
+// floored at MinRelativeMAD times the median, so that tight markets - where more than half
+// of the prices may be identical and the MAD is zero - do not reject prices that differ
+// from the median by a negligible amount.
+type MADOutlierFilter struct {
+	Threshold float64
+}
+
+// Name returns the name of the filter.
+func (MADOutlierFilter) Name() string {
+	return MADOutlierFilterName
+}
+
+// Filter rejects the prices that deviate more than Threshold MADs from the median. Sets of
+// fewer than three prices cannot identify an outlier and are returned as is.
+func (f MADOutlierFilter) Filter(prices []ConvertedPrice, _ *big.Float) ([]ConvertedPrice, []ConvertedPrice) {
+	if len(prices) < 3 {
+		return prices, nil
+	}
+
+	median := math.CalculateMedian(rawPrices(prices))
+	deviations := make([]*big.Float, len(prices))
+	for i, price := range prices {
+		deviations[i] = new(big.Float).Abs(new(big.Float).Sub(price.Price, median))
+	}
+
+	// CalculateMedian sorts the values in place so a copy is used to retain the ordering
+	// of the deviations.
+	cpy := make([]*big.Float, len(deviations))
+	copy(cpy, deviations)
+	mad := math.CalculateMedian(cpy)
+
+	floor := new(big.Float).Mul(new(big.Float).Abs(median), big.NewFloat(MinRelativeMAD))
+	if mad.Cmp(floor) < 0 {
+		mad = floor
+	}
+
+	// A zero MAD with a zero median gives no scale to measure the deviations against.
+	if mad.Sign() == 0 {
+		return prices, nil
+	}
+
+	limit := new(big.Float).Mul(mad, big.NewFloat(f.Threshold))
+
+	kept := make([]ConvertedPrice, 0, len(prices))
+	rejected := make([]ConvertedPrice, 0)
+	for i, price := range prices {
+		if deviations[i].Cmp(limit) > 0 {
+			rejected = append(rejected, price)
+			continue
+		}
+
+		kept = append(kept, price)
+	}
+
+	return kept, rejected
+}
+
+// PercentBandOutlierFilter rejects prices that deviate from the previous index price of
+// the market by more than MaxDeviation (expressed as a fraction of the previous price).
+type PercentBandOutlierFilter struct {
+	MaxDeviation float64
+}
+
+// Name returns the name of the filter.
+func (PercentBandOutlierFilter) Name() string {
+	return PercentBandOutlierFilterName
+}
+
+// Filter rejects the prices outside of the band around the previous index price. If there
+// is no previous index price, all prices are kept.
+func (f PercentBandOutlierFilter) Filter(prices []ConvertedPrice, previous *big.Float) ([]ConvertedPrice, []ConvertedPrice) {
+	if previous == nil || previous.Sign() == 0 {
+		return prices, nil
+	}
+
+	limit := new(big.Float).Mul(new(big.Float).Abs(previous), big.NewFloat(f.MaxDeviation))
+
+	kept := make([]ConvertedPrice, 0, len(prices))
+	rejected := make([]ConvertedPrice, 0)
+	for _, price := range prices {
+		deviation := new(big.Float).Abs(new(big.Float).Sub(price.Price, previous))
+		if deviation.Cmp(limit) > 0 {
+			rejected = append(rejected, price)
+			continue
+		}
+
+		kept = append(kept, price)
+	}
+
+	return kept, rejected
+}
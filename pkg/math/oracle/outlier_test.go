@@ -0,0 +1,240 @@
+package oracle_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	metricmocks "github.com/skip-mev/slinky/oracle/metrics/mocks"
+	"github.com/skip-mev/slinky/oracle/types"
+	"github.com/skip-mev/slinky/pkg/math/oracle"
+	"github.com/skip-mev/slinky/providers/apis/binance"
+	"github.com/skip-mev/slinky/providers/apis/coinbase"
+	"github.com/skip-mev/slinky/providers/websockets/kucoin"
+	mmtypes "github.com/skip-mev/slinky/x/marketmap/types"
+)
+
+func TestOutlierFilters(t *testing.T) {
+	testCases := []struct {
+		name        string
+		cfg         oracle.OutlierFilterConfig
+		prices      []oracle.ConvertedPrice
+		previous    *big.Float
+		expRejected []string
+		expErr      bool
+	}{
+		{
+			name:   "unknown method",
+			cfg:    oracle.OutlierFilterConfig{Method: "unknown", Threshold: 1},
+			expErr: true,
+		},
+		{
+			name:   "non-positive threshold",
+			cfg:    oracle.OutlierFilterConfig{Method: oracle.MADOutlierFilterName},
+			expErr: true,
+		},
+		{
+			name: "mad rejects a single deviating price",
+			cfg:  oracle.OutlierFilterConfig{Method: oracle.MADOutlierFilterName, Threshold: 3},
+			prices: []oracle.ConvertedPrice{
+				{Provider: coinbase.Name, Price: big.NewFloat(100)},
+				{Provider: binance.Name, Price: big.NewFloat(101)},
+				{Provider: kucoin.Name, Price: big.NewFloat(150)},
+			},
+			expRejected: []string{kucoin.Name},
+		},
+		{
+			name: "mad keeps prices within the threshold",
+			cfg:  oracle.OutlierFilterConfig{Method: oracle.MADOutlierFilterName, Threshold: 3},
+			prices: []oracle.ConvertedPrice{
+				{Provider: coinbase.Name, Price: big.NewFloat(100)},
+				{Provider: binance.Name, Price: big.NewFloat(101)},
+				{Provider: kucoin.Name, Price: big.NewFloat(103)},
+			},
+		},
+		{
+			name: "mad rejects large deviations when the mad is zero",
+			cfg:  oracle.OutlierFilterConfig{Method: oracle.MADOutlierFilterName, Threshold: 3},
+			prices: []oracle.ConvertedPrice{
+				{Provider: coinbase.Name, Price: big.NewFloat(100)},
+				{Provider: binance.Name, Price: big.NewFloat(100)},
+				{Provider: kucoin.Name, Price: big.NewFloat(100.5)},
+			},
+			expRejected: []string{kucoin.Name},
+		},
+		{
+			name: "mad keeps negligible deviations when the mad is zero",
+			cfg:  oracle.OutlierFilterConfig{Method: oracle.MADOutlierFilterName, Threshold: 3},
+			prices: []oracle.ConvertedPrice{
+				{Provider: coinbase.Name, Price: big.NewFloat(1)},
+				{Provider: binance.Name, Price: big.NewFloat(1)},
+				{Provider: kucoin.Name, Price: big.NewFloat(1.00000001)},
+			},
+		},
+		{
+			name: "mad does not filter when the mad and median are zero",
+			cfg:  oracle.OutlierFilterConfig{Method: oracle.MADOutlierFilterName, Threshold: 3},
+			prices: []oracle.ConvertedPrice{
+				{Provider: coinbase.Name, Price: big.NewFloat(0)},
+				{Provider: binance.Name, Price: big.NewFloat(0)},
+				{Provider: kucoin.Name, Price: big.NewFloat(1)},
+			},
+		},
+		{
+			name: "mad does not filter fewer than three prices",
+			cfg:  oracle.OutlierFilterConfig{Method: oracle.MADOutlierFilterName, Threshold: 3},
+			prices: []oracle.ConvertedPrice{
+				{Provider: coinbase.Name, Price: big.NewFloat(100)},
+				{Provider: kucoin.Name, Price: big.NewFloat(150)},
+			},
+		},
+		{
+			name: "percent band rejects prices outside of the band",
+			cfg:  oracle.OutlierFilterConfig{Method: oracle.PercentBandOutlierFilterName, Threshold: 0.05},
+			prices: []oracle.ConvertedPrice{
+				{Provider: coinbase.Name, Price: big.NewFloat(104)},
+				{Provider: binance.Name, Price: big.NewFloat(94)},
+				{Provider: kucoin.Name, Price: big.NewFloat(100)},
+			},
+			previous:    big.NewFloat(100),
+			expRejected: []string{binance.Name},
+		},
+		{
+			name: "percent band keeps all prices without a previous price",
+			cfg:  oracle.OutlierFilterConfig{Method: oracle.PercentBandOutlierFilterName, Threshold: 0.05},
+			prices: []oracle.ConvertedPrice{
+				{Provider: coinbase.Name, Price: big.NewFloat(104)},
+				{Provider: binance.Name, Price: big.NewFloat(94)},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			filter, err := oracle.NewOutlierFilter(tc.cfg)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.cfg.Method, filter.Name())
+
+			kept, rejected := filter.Filter(tc.prices, tc.previous)
+			require.Len(t, kept, len(tc.prices)-len(tc.expRejected))
+			require.Len(t, rejected, len(tc.expRejected))
+			for i, provider := range tc.expRejected {
+				require.Equal(t, provider, rejected[i].Provider)
+			}
+		})
+	}
+}
+
+func TestOutlierFilterFromMetadata(t *testing.T) {
+	filter, err := oracle.OutlierFilterFromMetadata("")
+	require.NoError(t, err)
+	require.Nil(t, filter)
+
+	filter, err = oracle.OutlierFilterFromMetadata(`{"aggregation": {"strategy": "median"}}`)
+	require.NoError(t, err)
+	require.Nil(t, filter)
+
+	filter, err = oracle.OutlierFilterFromMetadata(`{"outlier_filter": {"method": "percent_band", "threshold": 0.1}}`)
+	require.NoError(t, err)
+	require.Equal(t, oracle.PercentBandOutlierFilter{MaxDeviation: 0.1}, filter)
+
+	_, err = oracle.OutlierFilterFromMetadata(`{"outlier_filter": {"method": "mad"}}`)
+	require.Error(t, err)
+}
+
+func TestAggregatePricesWithOutlierFilter(t *testing.T) {
+	ticker := BTC_USD
+	ticker.Metadata_JSON = `{"outlier_filter": {"method": "mad", "threshold": 3}}`
+
+	mm := mmtypes.MarketMap{
+		Markets: map[string]mmtypes.Market{
+			ticker.String(): {
+				Ticker: ticker,
+				ProviderConfigs: []mmtypes.ProviderConfig{
+					{
+						Name:           coinbase.Name,
+						OffChainTicker: "BTC-USD",
+					},
+					{
+						Name:           binance.Name,
+						OffChainTicker: "BTCUSD",
+					},
+					{
+						Name:           kucoin.Name,
+						OffChainTicker: "BTC-USD",
+					},
+				},
+			},
+		},
+	}
+
+	t.Run("rejected outlier is excluded from the index price", func(t *testing.T) {
+		ticker := ticker
+		ticker.MinProviderCount = 2
+		mm := mmtypes.MarketMap{Markets: map[string]mmtypes.Market{
+			ticker.String(): {Ticker: ticker, ProviderConfigs: mm.Markets[ticker.String()].ProviderConfigs},
+		}}
+
+		metrics := metricmocks.NewMetrics(t)
+		metrics.On("AddProviderTick", mock.Anything, mock.Anything, mock.Anything).Return().Times(3)
+		metrics.On("UpdatePrice", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return().Times(3)
+		metrics.On("AddProviderOutlier", kucoin.Name, ticker.String()).Return().Once()
+		metrics.On("AddProviderCountForMarket", ticker.String(), 2).Return().Once()
+		metrics.On("AddTickerTick", ticker.String()).Return().Once()
+		metrics.On("UpdateAggregatePrice", mock.Anything, mock.Anything, mock.Anything).Return().Once()
+
+		m, err := oracle.NewIndexPriceAggregator(logger, mm, metrics)
+		require.NoError(t, err)
+
+		m.SetProviderPrices(coinbase.Name, types.Prices{"BTC-USD": big.NewFloat(70_000)})
+		m.SetProviderPrices(binance.Name, types.Prices{"BTCUSD": big.NewFloat(70_100)})
+		m.SetProviderPrices(kucoin.Name, types.Prices{"BTC-USD": big.NewFloat(100_000)})
+
+		m.AggregatePrices()
+		prices := m.GetIndexPrices()
+		require.Equal(t, big.NewFloat(70_050).SetPrec(36), prices[ticker.String()].SetPrec(36))
+	})
+
+	t.Run("min provider count is enforced after filtering", func(t *testing.T) {
+		m, err := oracle.NewIndexPriceAggregator(logger, mm, nil)
+		require.NoError(t, err)
+
+		m.SetProviderPrices(coinbase.Name, types.Prices{"BTC-USD": big.NewFloat(70_000)})
+		m.SetProviderPrices(binance.Name, types.Prices{"BTCUSD": big.NewFloat(70_100)})
+		m.SetProviderPrices(kucoin.Name, types.Prices{"BTC-USD": big.NewFloat(100_000)})
+
+		m.AggregatePrices()
+		require.Empty(t, m.GetIndexPrices())
+	})
+
+	t.Run("default outlier filter uses the previous index price", func(t *testing.T) {
+		ticker := BTC_USD
+		ticker.MinProviderCount = 1
+		mm := mmtypes.MarketMap{Markets: map[string]mmtypes.Market{
+			ticker.String(): {Ticker: ticker, ProviderConfigs: mm.Markets[ticker.String()].ProviderConfigs},
+		}}
+
+		m, err := oracle.NewIndexPriceAggregator(
+			logger,
+			mm,
+			nil,
+			oracle.WithDefaultOutlierFilter(oracle.PercentBandOutlierFilter{MaxDeviation: 0.1}),
+		)
+		require.NoError(t, err)
+		m.SetIndexPrices(types.Prices{ticker.String(): big.NewFloat(70_000)})
+
+		m.SetProviderPrices(coinbase.Name, types.Prices{"BTC-USD": big.NewFloat(71_000)})
+		m.SetProviderPrices(binance.Name, types.Prices{"BTCUSD": big.NewFloat(50_000)})
+		m.SetProviderPrices(kucoin.Name, types.Prices{"BTC-USD": big.NewFloat(100_000)})
+
+		m.AggregatePrices()
+		prices := m.GetIndexPrices()
+		require.Equal(t, big.NewFloat(71_000).SetPrec(36), prices[ticker.String()].SetPrec(36))
+	})
+}
@@ -0,0 +1,293 @@
+package oracle
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/skip-mev/slinky/pkg/math"
+)
+
+const (
+	// MedianStrategyName is the name of the median aggregation strategy. This is the
+	// default strategy used by the index price aggregator.
+	MedianStrategyName = "median"
+	// TrimmedMeanStrategyName is the name of the trimmed mean aggregation strategy.
+	TrimmedMeanStrategyName = "trimmed_mean"
+	// VolumeWeightedMeanStrategyName is the name of the volume-weighted mean aggregation
+	// strategy.
+	VolumeWeightedMeanStrategyName = "volume_weighted_mean"
+	// WeightedMedianStrategyName is the name of the weighted median aggregation strategy.
+	WeightedMedianStrategyName = "weighted_median"
+)
+
+// ConvertedPrice is a provider price that has been converted to the target ticker of
+// a market i.e. BTC/USDT * INDEX USDT/USD = BTC/USD.
+type ConvertedPrice struct {
+	// Provider is the name of the provider that supplied the price.
+	Provider string
+	// OffChainTicker is the ticker of the market on the provider.
+	OffChainTicker string
+	// Price is the converted price.
+	Price *big.Float
+	// Volume is the traded volume reported alongside the price. This is nil if the
+	// provider does not report volume.
+	Volume *big.Float
+}
+
+// AggregationStrategy defines how a set of converted prices for a single market are
+// aggregated into a single index price.
+type AggregationStrategy interface {
+	// Name returns the name of the strategy.
+	Name() string
+	// Aggregate returns the aggregated price for the given set of converted prices.
+	Aggregate(prices []ConvertedPrice) (*big.Float, error)
+}
+
+// AggregationConfig is the configuration used to select and configure the aggregation
+// strategy for a given market.
+type AggregationConfig struct {
+	// Strategy is the name of the aggregation strategy to use.
+	Strategy string `json:"strategy"`
+	// TrimFraction is the fraction of prices to remove from each end of the sorted
+	// set of prices before taking the mean. Only used by the trimmed mean strategy.
+	TrimFraction float64 `json:"trim_fraction,omitempty"`
+	// Weights is a map of provider name to the weight of the provider. Providers that
+	// are not included default to a weight of 1. Only used by the weighted median strategy.
+	Weights map[string]float64 `json:"weights,omitempty"`
+}
+
+// TickerMetadata is the subset of a ticker's metadata JSON that is utilized by the
+// index price aggregator.
+type TickerMetadata struct {
+	// Aggregation is the aggregation configuration for the market. If this is not set,
+	// the aggregator's default strategy is used.
+	Aggregation *AggregationConfig `json:"aggregation,omitempty"`
+	// OutlierFilter is the outlier filter configuration for the market. If this is not
+	// set, the aggregator's default outlier filter is used.
+	OutlierFilter *OutlierFilterConfig `json:"outlier_filter,omitempty"`
+}
+
+// ValidateBasic performs basic validation on the aggregation config.
+func (c AggregationConfig) ValidateBasic() error {
+	switch c.Strategy {
+	case MedianStrategyName, VolumeWeightedMeanStrategyName:
+	case TrimmedMeanStrategyName:
+		if c.TrimFraction < 0 || c.TrimFraction >= 0.5 {
+			return fmt.Errorf("trim fraction must be in the range [0, 0.5); got %f", c.TrimFraction)
+		}
+	case WeightedMedianStrategyName:
+		for provider, weight := range c.Weights {
+			if weight < 0 {
+				return fmt.Errorf("weight for provider %s must be non-negative; got %f", provider, weight)
+			}
+		}
+	default:
+		return fmt.Errorf("unknown aggregation strategy: %s", c.Strategy)
+	}
+
+	return nil
+}
+
+// NewAggregationStrategy returns the aggregation strategy described by the given config.
+func NewAggregationStrategy(cfg AggregationConfig) (AggregationStrategy, error) {
+	if err := cfg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	switch cfg.Strategy {
+	case MedianStrategyName:
+		return MedianStrategy{}, nil
+	case TrimmedMeanStrategyName:
+		return TrimmedMeanStrategy{TrimFraction: cfg.TrimFraction}, nil
+	case VolumeWeightedMeanStrategyName:
+		return VolumeWeightedMeanStrategy{}, nil
+	default:
+		return WeightedMedianStrategy{Weights: cfg.Weights}, nil
+	}
+}
+
+// AggregationStrategyFromMetadata returns the aggregation strategy configured in a
+// ticker's metadata JSON. If the metadata does not configure a strategy, nil is returned.
+func AggregationStrategyFromMetadata(metadataJSON string) (AggregationStrategy, error) {
+	if len(metadataJSON) == 0 {
+		return nil, nil
+	}
+
+	var metadata TickerMetadata
+	if err := json.Unmarshal([]byte(metadataJSON), &metadata); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal ticker metadata: %w", err)
+	}
+
+	if metadata.Aggregation == nil {
+		return nil, nil
+	}
+
+	return NewAggregationStrategy(*metadata.Aggregation)
+}
+
+// MedianStrategy aggregates prices by taking the median. The average of the two
+// middle prices is returned if the number of prices is even.
+type MedianStrategy struct{}
+
+// Name returns the name of the strategy.
+func (MedianStrategy) Name() string {
+	return MedianStrategyName
+}
+
+// Aggregate returns the median of the given prices.
+func (MedianStrategy) Aggregate(prices []ConvertedPrice) (*big.Float, error) {
+	if len(prices) == 0 {
+		return nil, fmt.Errorf("no prices to aggregate")
+	}
+
+	return math.CalculateMedian(rawPrices(prices)), nil
+}
+
+// TrimmedMeanStrategy aggregates prices by removing the TrimFraction highest and lowest
+// prices and taking the mean of the remaining prices.
+type TrimmedMeanStrategy struct {
+	TrimFraction float64
+}
+
+// Name returns the name of the strategy.
+func (TrimmedMeanStrategy) Name() string {
+	return TrimmedMeanStrategyName
+}
+
+// Aggregate returns the trimmed mean of the given prices.
+func (s TrimmedMeanStrategy) Aggregate(prices []ConvertedPrice) (*big.Float, error) {
+	if len(prices) == 0 {
+		return nil, fmt.Errorf("no prices to aggregate")
+	}
+
+	values := rawPrices(prices)
+	math.SortBigFloats(values)
+
+	trim := int(float64(len(values)) * s.TrimFraction)
+	values = values[trim : len(values)-trim]
+	if len(values) == 0 {
+		return nil, fmt.Errorf("no prices remaining after trimming %d prices from each end", trim)
+	}
+
+	sum := new(big.Float)
+	for _, value := range values {
+		sum.Add(sum, value)
+	}
+
+	return sum.Quo(sum, new(big.Float).SetInt64(int64(len(values)))), nil
+}
+
+// VolumeWeightedMeanStrategy aggregates prices by taking the mean of the prices weighted
+// by the volume reported alongside each price. Prices without volume are ignored.
+type VolumeWeightedMeanStrategy struct{}
+
+// Name returns the name of the strategy.
+func (VolumeWeightedMeanStrategy) Name() string {
+	return VolumeWeightedMeanStrategyName
+}
+
+// Aggregate returns the volume-weighted mean of the given prices.
+func (VolumeWeightedMeanStrategy) Aggregate(prices []ConvertedPrice) (*big.Float, error) {
+	var (
+		weightedSum = new(big.Float)
+		totalVolume = new(big.Float)
+	)
+
+	for _, price := range prices {
+		if price.Volume == nil || price.Volume.Sign() <= 0 {
+			continue
+		}
+
+		weightedSum.Add(weightedSum, new(big.Float).Mul(price.Price, price.Volume))
+		totalVolume.Add(totalVolume, price.Volume)
+	}
+
+	if totalVolume.Sign() == 0 {
+		return nil, fmt.Errorf("no volume data available to aggregate %d prices", len(prices))
+	}
+
+	return weightedSum.Quo(weightedSum, totalVolume), nil
+}
+
+// WeightedMedianStrategy aggregates prices by taking the median of the prices weighted
+// by a per-provider weight. Providers without a configured weight default to a weight of 1.
+type WeightedMedianStrategy struct {
+	Weights map[string]float64
+}
+
+// Name returns the name of the strategy.
+func (WeightedMedianStrategy) Name() string {
+	return WeightedMedianStrategyName
+}
+
+// Aggregate returns the weighted median of the given prices. If the cumulative weight
+// lands exactly on half of the total weight, the average of the price at the boundary
+// and the next weighted price is returned. With equal weights this is equivalent to
+// the median.
+func (s WeightedMedianStrategy) Aggregate(prices []ConvertedPrice) (*big.Float, error) {
+	type weightedPrice struct {
+		price  *big.Float
+		weight *big.Float
+	}
+
+	weighted := make([]weightedPrice, 0, len(prices))
+	total := new(big.Float)
+	for _, price := range prices {
+		weight := s.weight(price.Provider)
+		if weight.Sign() == 0 {
+			continue
+		}
+
+		weighted = append(weighted, weightedPrice{price: price.Price, weight: weight})
+		total.Add(total, weight)
+	}
+
+	if len(weighted) == 0 {
+		return nil, fmt.Errorf("no weighted prices to aggregate")
+	}
+
+	sort.SliceStable(weighted, func(i, j int) bool {
+		return weighted[i].price.Cmp(weighted[j].price) < 0
+	})
+
+	half := new(big.Float).Quo(total, big.NewFloat(2))
+	cumulative := new(big.Float)
+	for i, wp := range weighted {
+		cumulative.Add(cumulative, wp.weight)
+
+		switch cumulative.Cmp(half) {
+		case 1:
+			return wp.price, nil
+		case 0:
+			if i == len(weighted)-1 {
+				return wp.price, nil
+			}
+
+			median := new(big.Float).Add(wp.price, weighted[i+1].price)
+			return median.Quo(median, big.NewFloat(2)), nil
+		}
+	}
+
+	return weighted[len(weighted)-1].price, nil
+}
+
+// weight returns the weight configured for the given provider.
+func (s WeightedMedianStrategy) weight(provider string) *big.Float {
+	if weight, ok := s.Weights[provider]; ok {
+		return big.NewFloat(weight)
+	}
+
+	return big.NewFloat(1)
+}
+
+// rawPrices returns the prices of the converted prices.
+func rawPrices(prices []ConvertedPrice) []*big.Float {
+	values := make([]*big.Float, len(prices))
+	for i, price := range prices {
+		values[i] = price.Price
+	}
+
+	return values
+}
@@ -0,0 +1,301 @@
+package oracle_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/oracle/metrics"
+	"github.com/skip-mev/slinky/oracle/types"
+	"github.com/skip-mev/slinky/pkg/math/oracle"
+	"github.com/skip-mev/slinky/providers/apis/binance"
+	"github.com/skip-mev/slinky/providers/apis/coinbase"
+	"github.com/skip-mev/slinky/providers/websockets/kucoin"
+	mmtypes "github.com/skip-mev/slinky/x/marketmap/types"
+)
+
+func TestAggregationStrategies(t *testing.T) {
+	prices := []oracle.ConvertedPrice{
+		{Provider: coinbase.Name, Price: big.NewFloat(100), Volume: big.NewFloat(10)},
+		{Provider: binance.Name, Price: big.NewFloat(101), Volume: big.NewFloat(30)},
+		{Provider: kucoin.Name, Price: big.NewFloat(150), Volume: big.NewFloat(0)},
+	}
+
+	testCases := []struct {
+		name     string
+		cfg      oracle.AggregationConfig
+		prices   []oracle.ConvertedPrice
+		expected *big.Float
+		expErr   bool
+	}{
+		{
+			name:     "median",
+			cfg:      oracle.AggregationConfig{Strategy: oracle.MedianStrategyName},
+			prices:   prices,
+			expected: big.NewFloat(101),
+		},
+		{
+			name:   "median with no prices",
+			cfg:    oracle.AggregationConfig{Strategy: oracle.MedianStrategyName},
+			prices: nil,
+			expErr: true,
+		},
+		{
+			name:     "trimmed mean with no trimming",
+			cfg:      oracle.AggregationConfig{Strategy: oracle.TrimmedMeanStrategyName},
+			prices:   prices,
+			expected: big.NewFloat(117),
+		},
+		{
+			name:     "trimmed mean removes outliers",
+			cfg:      oracle.AggregationConfig{Strategy: oracle.TrimmedMeanStrategyName, TrimFraction: 0.34},
+			prices:   prices,
+			expected: big.NewFloat(101),
+		},
+		{
+			name:     "volume weighted mean ignores prices without volume",
+			cfg:      oracle.AggregationConfig{Strategy: oracle.VolumeWeightedMeanStrategyName},
+			prices:   prices,
+			expected: big.NewFloat(100.75),
+		},
+		{
+			name: "volume weighted mean with no volume",
+			cfg:  oracle.AggregationConfig{Strategy: oracle.VolumeWeightedMeanStrategyName},
+			prices: []oracle.ConvertedPrice{
+				{Provider: coinbase.Name, Price: big.NewFloat(100)},
+			},
+			expErr: true,
+		},
+		{
+			name:     "weighted median with equal weights is the median",
+			cfg:      oracle.AggregationConfig{Strategy: oracle.WeightedMedianStrategyName},
+			prices:   prices,
+			expected: big.NewFloat(101),
+		},
+		{
+			name:     "weighted median with equal weights and even number of prices",
+			cfg:      oracle.AggregationConfig{Strategy: oracle.WeightedMedianStrategyName},
+			prices:   prices[:2],
+			expected: big.NewFloat(100.5),
+		},
+		{
+			name: "weighted median favours heavier providers",
+			cfg: oracle.AggregationConfig{
+				Strategy: oracle.WeightedMedianStrategyName,
+				Weights:  map[string]float64{kucoin.Name: 3},
+			},
+			prices:   prices,
+			expected: big.NewFloat(150),
+		},
+		{
+			name: "weighted median with all providers excluded",
+			cfg: oracle.AggregationConfig{
+				Strategy: oracle.WeightedMedianStrategyName,
+				Weights:  map[string]float64{coinbase.Name: 0, binance.Name: 0, kucoin.Name: 0},
+			},
+			prices: prices,
+			expErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			strategy, err := oracle.NewAggregationStrategy(tc.cfg)
+			require.NoError(t, err)
+			require.Equal(t, tc.cfg.Strategy, strategy.Name())
+
+			cpy := make([]oracle.ConvertedPrice, len(tc.prices))
+			copy(cpy, tc.prices)
+
+			price, err := strategy.Aggregate(cpy)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected.SetPrec(36), price.SetPrec(36))
+		})
+	}
+}
+
+func TestAggregationStrategyFromMetadata(t *testing.T) {
+	testCases := []struct {
+		name     string
+		metadata string
+		expected string
+		expErr   bool
+	}{
+		{
+			name:     "empty metadata",
+			metadata: "",
+			expected: "",
+		},
+		{
+			name:     "metadata without aggregation config",
+			metadata: `{"foo": "bar"}`,
+			expected: "",
+		},
+		{
+			name:     "valid trimmed mean config",
+			metadata: `{"aggregation": {"strategy": "trimmed_mean", "trim_fraction": 0.2}}`,
+			expected: oracle.TrimmedMeanStrategyName,
+		},
+		{
+			name:     "valid weighted median config",
+			metadata: `{"aggregation": {"strategy": "weighted_median", "weights": {"binance_api": 2}}}`,
+			expected: oracle.WeightedMedianStrategyName,
+		},
+		{
+			name:     "invalid trim fraction",
+			metadata: `{"aggregation": {"strategy": "trimmed_mean", "trim_fraction": 0.5}}`,
+			expErr:   true,
+		},
+		{
+			name:     "negative weight",
+			metadata: `{"aggregation": {"strategy": "weighted_median", "weights": {"binance_api": -1}}}`,
+			expErr:   true,
+		},
+		{
+			name:     "unknown strategy",
+			metadata: `{"aggregation": {"strategy": "mode"}}`,
+			expErr:   true,
+		},
+		{
+			name:     "invalid json",
+			metadata: `{"aggregation": `,
+			expErr:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			strategy, err := oracle.AggregationStrategyFromMetadata(tc.metadata)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			if tc.expected == "" {
+				require.Nil(t, strategy)
+				return
+			}
+
+			require.Equal(t, tc.expected, strategy.Name())
+		})
+	}
+}
+
+func TestAggregatePricesWithStrategy(t *testing.T) {
+	ticker := BTC_USD
+	ticker.Metadata_JSON = `{"aggregation": {"strategy": "weighted_median", "weights": {"binance_api": 3}}}`
+
+	mm := mmtypes.MarketMap{
+		Markets: map[string]mmtypes.Market{
+			ticker.String(): {
+				Ticker: ticker,
+				ProviderConfigs: []mmtypes.ProviderConfig{
+					{
+						Name:           coinbase.Name,
+						OffChainTicker: "BTC-USD",
+					},
+					{
+						Name:           binance.Name,
+						OffChainTicker: "BTCUSD",
+					},
+					{
+						Name:           kucoin.Name,
+						OffChainTicker: "BTC-USD",
+					},
+				},
+			},
+		},
+	}
+
+	m, err := oracle.NewIndexPriceAggregator(logger, mm, metrics.NewNopMetrics())
+	require.NoError(t, err)
+	require.Equal(t, oracle.WeightedMedianStrategyName, m.GetAggregationStrategy(ticker.String()).Name())
+
+	m.SetProviderPrices(coinbase.Name, types.Prices{"BTC-USD": big.NewFloat(70_000)})
+	m.SetProviderPrices(binance.Name, types.Prices{"BTCUSD": big.NewFloat(71_000)})
+	m.SetProviderPrices(kucoin.Name, types.Prices{"BTC-USD": big.NewFloat(69_000)})
+	m.AggregatePrices()
+
+	prices := m.GetIndexPrices()
+	require.Len(t, prices, 1)
+	require.Equal(t, big.NewFloat(71_000).SetPrec(36), prices[ticker.String()].SetPrec(36))
+
+	// Falling back to the default strategy once the metadata is removed.
+	ticker.Metadata_JSON = ""
+	mm.Markets[ticker.String()] = mmtypes.Market{
+		Ticker:          ticker,
+		ProviderConfigs: mm.Markets[ticker.String()].ProviderConfigs,
+	}
+	m.UpdateMarketMap(mm)
+	require.Equal(t, oracle.MedianStrategyName, m.GetAggregationStrategy(ticker.String()).Name())
+
+	m.AggregatePrices()
+	prices = m.GetIndexPrices()
+	require.Equal(t, big.NewFloat(70_000).SetPrec(36), prices[ticker.String()].SetPrec(36))
+}
+
+func TestAggregatePricesWithVolume(t *testing.T) {
+	ticker := USDT_USD
+	ticker.Metadata_JSON = `{"aggregation": {"strategy": "volume_weighted_mean"}}`
+
+	mm := mmtypes.MarketMap{
+		Markets: map[string]mmtypes.Market{
+			ticker.String(): {
+				Ticker: ticker,
+				ProviderConfigs: []mmtypes.ProviderConfig{
+					{
+						Name:           coinbase.Name,
+						OffChainTicker: "USDT-USD",
+					},
+					{
+						Name:           binance.Name,
+						OffChainTicker: "USDUSDT",
+						Invert:         true,
+					},
+					{
+						Name:           kucoin.Name,
+						OffChainTicker: "USDT-USD",
+					},
+				},
+			},
+		},
+	}
+
+	m, err := oracle.NewIndexPriceAggregator(logger, mm, metrics.NewNopMetrics())
+	require.NoError(t, err)
+
+	m.SetProviderPricesAndVolumes(
+		coinbase.Name,
+		types.Prices{"USDT-USD": big.NewFloat(1.0)},
+		types.Prices{"USDT-USD": big.NewFloat(100)},
+	)
+	m.SetProviderPricesAndVolumes(
+		binance.Name,
+		types.Prices{"USDUSDT": big.NewFloat(0.5)},
+		types.Prices{"USDUSDT": big.NewFloat(200)},
+	)
+	m.SetProviderPrices(kucoin.Name, types.Prices{"USDT-USD": big.NewFloat(5)})
+
+	// The binance market is inverted so its volume is converted to USDT i.e. 200 * 0.5 = 100.
+	// The kucoin price does not have volume and is ignored.
+	converted := m.CalculateConvertedPrices(mm.Markets[ticker.String()])
+	require.Len(t, converted, 3)
+	require.Equal(t, big.NewFloat(100).SetPrec(36), converted[1].Volume.SetPrec(36))
+	require.Nil(t, converted[2].Volume)
+
+	m.AggregatePrices()
+	prices := m.GetIndexPrices()
+	require.Equal(t, big.NewFloat(1.5).SetPrec(36), prices[ticker.String()].SetPrec(36))
+
+	// Volumes are cleared on reset.
+	m.Reset()
+	m.SetProviderPrices(coinbase.Name, types.Prices{"USDT-USD": big.NewFloat(1.0)})
+	require.Nil(t, m.GetProviderVolume(mm.Markets[ticker.String()].ProviderConfigs[0]))
+}
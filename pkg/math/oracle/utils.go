@@ -5,6 +5,8 @@ import (
 	"maps"
 	"math/big"
 
+	"go.uber.org/zap"
+
 	"github.com/skip-mev/slinky/oracle/types"
 	pkgtypes "github.com/skip-mev/slinky/pkg/types"
 	mmtypes "github.com/skip-mev/slinky/x/marketmap/types"
@@ -36,6 +38,31 @@ func (m *IndexPriceAggregator) GetProviderPrice(
 	return price, nil
 }
 
+// GetProviderVolume returns the 24h volume reported by the provider for the given provider
+// config, denominated in the base asset of the (possibly inverted) market. Nil is returned
+// if the provider did not report volume. Normalization does not change the base asset, so
+// the volume only needs to be converted if the market is inverted.
+func (m *IndexPriceAggregator) GetProviderVolume(
+	cfg mmtypes.ProviderConfig,
+) *big.Float {
+	volume, ok := m.providerVolumes[cfg.Name][cfg.OffChainTicker]
+	if !ok || volume == nil {
+		return nil
+	}
+
+	if !cfg.Invert {
+		return volume
+	}
+
+	// The volume of an inverted market is the quote volume of the original market.
+	price, ok := m.providerPrices[cfg.Name][cfg.OffChainTicker]
+	if !ok || price == nil {
+		return nil
+	}
+
+	return new(big.Float).Mul(volume, price)
+}
+
 // GetIndexPrice returns the relevant index price. Note that the aggregator's
 // index price cache stores prices in the form of ticker -> price.
 func (m *IndexPriceAggregator) GetIndexPrice(
@@ -79,7 +106,65 @@ func (m *IndexPriceAggregator) UpdateMarketMap(marketMap mmtypes.MarketMap) {
 	m.mtx.Lock()
 	defer m.mtx.Unlock()
 
+	m.setMarketMap(marketMap)
+}
+
+// setMarketMap sets the market map and resolves the aggregation strategy and outlier filter
+// configured for each market. Markets with invalid configurations fall back to the defaults.
+func (m *IndexPriceAggregator) setMarketMap(marketMap mmtypes.MarketMap) {
+	strategies := make(map[string]AggregationStrategy)
+	outlierFilters := make(map[string]OutlierFilter)
+	for ticker, market := range marketMap.Markets {
+		filter, err := OutlierFilterFromMetadata(market.Ticker.Metadata_JSON)
+		if err != nil {
+			m.logger.Error(
+				"invalid outlier filter configured; using default outlier filter",
+				zap.String("ticker", ticker),
+				zap.Error(err),
+			)
+		} else if filter != nil {
+			outlierFilters[ticker] = filter
+		}
+
+		strategy, err := AggregationStrategyFromMetadata(market.Ticker.Metadata_JSON)
+		if err != nil {
+			m.logger.Error(
+				"invalid aggregation strategy configured; using default strategy",
+				zap.String("ticker", ticker),
+				zap.String("default_strategy", m.defaultStrategy.Name()),
+				zap.Error(err),
+			)
+
+			continue
+		}
+
+		if strategy != nil {
+			strategies[ticker] = strategy
+		}
+	}
+
 	m.cfg = marketMap
+	m.strategies = strategies
+	m.outlierFilters = outlierFilters
+}
+
+// GetAggregationStrategy returns the aggregation strategy for the given ticker.
+func (m *IndexPriceAggregator) GetAggregationStrategy(ticker string) AggregationStrategy {
+	if strategy, ok := m.strategies[ticker]; ok {
+		return strategy
+	}
+
+	return m.defaultStrategy
+}
+
+// GetOutlierFilter returns the outlier filter for the given ticker. Nil is returned if
+// outliers are not rejected for the ticker.
+func (m *IndexPriceAggregator) GetOutlierFilter(ticker string) OutlierFilter {
+	if filter, ok := m.outlierFilters[ticker]; ok {
+		return filter
+	}
+
+	return m.defaultOutlierFilter
 }
 
 // GetMarketMap returns the market map for the oracle.
@@ -102,12 +187,31 @@ func (m *IndexPriceAggregator) SetProviderPrices(provider string, data types.Pri
 	m.providerPrices[provider] = data
 }
 
+// SetProviderPricesAndVolumes updates the data aggregator with the prices and 24h volumes
+// reported by the given provider. Both are set under the same lock so that aggregation never
+// observes the prices of one fetch with the volumes of another.
+func (m *IndexPriceAggregator) SetProviderPricesAndVolumes(provider string, prices, volumes types.Prices) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if prices == nil {
+		prices = make(types.Prices)
+	}
+	if volumes == nil {
+		volumes = make(types.Prices)
+	}
+
+	m.providerPrices[provider] = prices
+	m.providerVolumes[provider] = volumes
+}
+
 // Reset resets the data aggregator for all providers.
 func (m *IndexPriceAggregator) Reset() {
 	m.mtx.Lock()
 	defer m.mtx.Unlock()
 
 	m.providerPrices = make(map[string]types.Prices)
+	m.providerVolumes = make(map[string]types.Prices)
 }
 
 // GetPrices returns the aggregated data the aggregator has. Specifically, the
@@ -122,3 +226,65 @@ func (m *IndexPriceAggregator) GetPrices() types.Prices {
 
 	return cpy
 }
+
+// GetProviderPriceReports returns the breakdown of the provider prices used to calculate the
+// index price of each ticker in the most recent aggregation.
+func (m *IndexPriceAggregator) GetProviderPriceReports() types.ProviderPriceReports {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	cpy := make(types.ProviderPriceReports, len(m.reports))
+	maps.Copy(cpy, m.reports)
+
+	return cpy
+}
+
+// providerPriceReports returns the breakdown of each provider price configured for the given
+// market. The converted prices are the prices calculated for the market before outliers are
+// filtered out, and the kept prices are the prices that remain after filtering.
+func (m *IndexPriceAggregator) providerPriceReports(
+	market mmtypes.Market,
+	converted, kept []ConvertedPrice,
+) []types.ProviderPriceReport {
+	type key struct {
+		provider, offChainTicker string
+	}
+
+	convertedPrices := make(map[key]*big.Float, len(converted))
+	for _, price := range converted {
+		convertedPrices[key{price.Provider, price.OffChainTicker}] = price.Price
+	}
+
+	keptPrices := make(map[key]struct{}, len(kept))
+	for _, price := range kept {
+		keptPrices[key{price.Provider, price.OffChainTicker}] = struct{}{}
+	}
+
+	reports := make([]types.ProviderPriceReport, 0, len(market.ProviderConfigs))
+	for _, cfg := range market.ProviderConfigs {
+		k := key{cfg.Name, cfg.OffChainTicker}
+		report := types.ProviderPriceReport{
+			Provider:       cfg.Name,
+			OffChainTicker: cfg.OffChainTicker,
+			Price:          m.providerPrices[cfg.Name][cfg.OffChainTicker],
+			Invert:         cfg.Invert,
+			ConvertedPrice: convertedPrices[k],
+		}
+
+		if report.ConvertedPrice != nil {
+			_, ok := keptPrices[k]
+			report.Outlier = !ok
+		}
+
+		for _, pair := range cfg.Normalizations() {
+			report.Normalizations = append(report.Normalizations, types.NormalizationPrice{
+				Ticker: pair.String(),
+				Price:  m.indexPrices[pair.String()],
+			})
+		}
+
+		reports = append(reports, report)
+	}
+
+	return reports
+}
@@ -38,6 +38,12 @@ func (m *MedianAggregator) SetProviderPrices(provider string, data types.Prices)
 	m.providerPrices[provider] = data
 }
 
+// SetProviderPricesAndVolumes updates the data aggregator with the given provider and prices.
+// The volumes are ignored as the median aggregator does not utilize volume.
+func (m *MedianAggregator) SetProviderPricesAndVolumes(provider string, prices, _ types.Prices) {
+	m.SetProviderPrices(provider, prices)
+}
+
 // AggregatePrices inputs the aggregated prices from all providers and computes
 // the median price for each asset.
 func (m *MedianAggregator) AggregatePrices() {
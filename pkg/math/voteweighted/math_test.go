@@ -407,6 +407,150 @@ func (s *MathTestSuite) TestComputeMedian() {
 	}
 }
 
+func (s *MathTestSuite) TestComputeInterquartileRange() {
+	cases := []struct {
+		name      string
+		priceInfo voteweighted.PriceInfo
+		expected  *big.Int
+	}{
+		{
+			name: "no prices",
+			priceInfo: voteweighted.PriceInfo{
+				TotalWeight: sdkmath.ZeroInt(),
+			},
+			expected: nil,
+		},
+		{
+			name: "single price",
+			priceInfo: voteweighted.PriceInfo{
+				Prices: []voteweighted.PricePerValidator{
+					{
+						VoteWeight: sdkmath.NewInt(1),
+						Price:      big.NewInt(100),
+					},
+				},
+				TotalWeight: sdkmath.NewInt(1),
+			},
+			expected: big.NewInt(0),
+		},
+		{
+			name: "four prices with different weights",
+			priceInfo: voteweighted.PriceInfo{
+				Prices: []voteweighted.PricePerValidator{
+					{
+						VoteWeight: sdkmath.NewInt(40),
+						Price:      big.NewInt(400),
+					},
+					{
+						VoteWeight: sdkmath.NewInt(10),
+						Price:      big.NewInt(100),
+					},
+					{
+						VoteWeight: sdkmath.NewInt(30),
+						Price:      big.NewInt(300),
+					},
+					{
+						VoteWeight: sdkmath.NewInt(20),
+						Price:      big.NewInt(200),
+					},
+				},
+				TotalWeight: sdkmath.NewInt(100),
+			},
+			// the 25th percentile is 200 and the 75th percentile is 400
+			expected: big.NewInt(200),
+		},
+		{
+			name: "outlier with little stake does not widen the range",
+			priceInfo: voteweighted.PriceInfo{
+				Prices: []voteweighted.PricePerValidator{
+					{
+						VoteWeight: sdkmath.NewInt(45),
+						Price:      big.NewInt(100),
+					},
+					{
+						VoteWeight: sdkmath.NewInt(50),
+						Price:      big.NewInt(101),
+					},
+					{
+						VoteWeight: sdkmath.NewInt(5),
+						Price:      big.NewInt(1000),
+					},
+				},
+				TotalWeight: sdkmath.NewInt(100),
+			},
+			expected: big.NewInt(1),
+		},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			result := voteweighted.ComputeInterquartileRange(tc.priceInfo)
+			if tc.expected == nil {
+				s.Require().Nil(result)
+				return
+			}
+
+			s.Require().Zero(tc.expected.Cmp(result))
+		})
+	}
+}
+
+func (s *MathTestSuite) TestStatistics() {
+	btc := slinkytypes.NewCurrencyPair("BTC", "USD")
+	eth := slinkytypes.NewCurrencyPair("ETH", "USD")
+
+	validators := []validator{
+		{
+			stake:    sdkmath.NewInt(30),
+			consAddr: validator1,
+		},
+		{
+			stake:    sdkmath.NewInt(30),
+			consAddr: validator2,
+		},
+		{
+			stake:    sdkmath.NewInt(40),
+			consAddr: validator3,
+		},
+	}
+	providerPrices := aggregator.AggregatedProviderData[string, map[slinkytypes.CurrencyPair]*big.Int]{
+		validator1.String(): {
+			btc: big.NewInt(100),
+		},
+		validator2.String(): {
+			btc: big.NewInt(200),
+			eth: big.NewInt(50),
+		},
+	}
+
+	s.Run("computes the dispersion and voting power fraction per currency pair", func() {
+		statsFn := voteweighted.Statistics(
+			s.ctx,
+			log.NewNopLogger(),
+			s.createMockValidatorStore(validators, sdkmath.NewInt(100)),
+		)
+
+		stats := statsFn(providerPrices)
+		s.Require().Len(stats, 2)
+
+		s.Require().Zero(big.NewInt(100).Cmp(stats[btc].InterquartileRange))
+		s.Require().Equal(sdkmath.LegacyNewDecWithPrec(6, 1), stats[btc].VotingPowerFraction)
+
+		s.Require().Zero(stats[eth].InterquartileRange.Sign())
+		s.Require().Equal(sdkmath.LegacyNewDecWithPrec(3, 1), stats[eth].VotingPowerFraction)
+	})
+
+	s.Run("no statistics without bonded tokens", func() {
+		statsFn := voteweighted.Statistics(
+			s.ctx,
+			log.NewNopLogger(),
+			s.createMockValidatorStore(validators, sdkmath.ZeroInt()),
+		)
+
+		s.Require().Empty(statsFn(providerPrices))
+	})
+}
+
 func (s *MathTestSuite) createMockValidatorStore(
 	validators []validator,
 	totalTokens sdkmath.Int,
@@ -30,6 +30,21 @@ type (
 		VoteWeight math.Int
 		Price      *big.Int
 	}
+
+	// PriceStatistics describes the spread of the prices reported by validators for a given currency pair and
+	// how much stake reported them.
+	PriceStatistics struct {
+		// InterquartileRange is the stake-weighted interquartile range of the reported prices.
+		InterquartileRange *big.Int
+		// VotingPowerFraction is the fraction of the total bonded tokens that reported a price.
+		VotingPowerFraction math.LegacyDec
+	}
+
+	// StatisticsFn computes the PriceStatistics for each currency pair given the prices reported by each validator.
+	StatisticsFn func(providers aggregator.AggregatedProviderData[string, map[slinkytypes.CurrencyPair]*big.Int]) map[slinkytypes.CurrencyPair]PriceStatistics
+
+	// StatisticsFnFromContext is used to parametrize a StatisticsFn by an sdk.Context.
+	StatisticsFnFromContext func(ctx sdk.Context) StatisticsFn
 )
 
 // MedianFromContext returns a new Median aggregate function that is parametrized by the
@@ -63,67 +78,7 @@ func Median(
 	threshold math.LegacyDec,
 ) aggregator.AggregateFn[string, map[slinkytypes.CurrencyPair]*big.Int] {
 	return func(providers aggregator.AggregatedProviderData[string, map[slinkytypes.CurrencyPair]*big.Int]) map[slinkytypes.CurrencyPair]*big.Int {
-		priceInfo := make(map[slinkytypes.CurrencyPair]PriceInfo)
-
-		// Iterate through all providers and store stake weight + price for each currency pair.
-		for valAddress, validatorPrices := range providers {
-			// Retrieve the validator from the validator store and get its vote weight.
-			address, err := sdk.ConsAddressFromBech32(valAddress)
-			if err != nil {
-				logger.Info(
-					"failed to parse validator address; skipping validator prices",
-					"validator_address", valAddress,
-					"err", err,
-				)
-
-				continue
-			}
-
-			validator, err := validatorStore.ValidatorByConsAddr(ctx, address)
-			if err != nil {
-				logger.Info(
-					"failed to retrieve validator from store; skipping validator prices",
-					"validator_address", valAddress,
-					"err", err,
-				)
-
-				continue
-			}
-
-			voteWeight := validator.GetBondedTokens()
-
-			// Iterate through all prices and store the price + vote weight for each currency pair.
-			for currencyPair, price := range validatorPrices {
-				// Only include prices that are not nil.
-				if price == nil {
-					logger.Info(
-						"price is nil",
-						"currency_pair", currencyPair.String(),
-						"validator_address", valAddress,
-					)
-
-					continue
-				}
-
-				// Initialize the price info if it does not exist for the given currency pair.
-				if _, ok := priceInfo[currencyPair]; !ok {
-					priceInfo[currencyPair] = PriceInfo{
-						Prices:      make([]PricePerValidator, 0),
-						TotalWeight: math.ZeroInt(),
-					}
-				}
-
-				// Update the price info.
-				cpInfo := priceInfo[currencyPair]
-				priceInfo[currencyPair] = PriceInfo{
-					Prices: append(cpInfo.Prices, PricePerValidator{
-						VoteWeight: voteWeight,
-						Price:      price,
-					}),
-					TotalWeight: cpInfo.TotalWeight.Add(voteWeight),
-				}
-			}
-		}
+		priceInfo := GetPriceInfo(ctx, logger, validatorStore, providers)
 
 		// Iterate through all prices and compute the median price for each asset.
 		prices := make(map[slinkytypes.CurrencyPair]*big.Int)
@@ -160,8 +115,132 @@ func Median(
 	}
 }
 
+// StatisticsFromContext returns a new Statistics function that is parametrized by the latest state of the
+// application.
+func StatisticsFromContext(
+	logger log.Logger,
+	validatorStore ValidatorStore,
+) StatisticsFnFromContext {
+	return func(ctx sdk.Context) StatisticsFn {
+		return Statistics(ctx, logger, validatorStore)
+	}
+}
+
+// Statistics returns a function that computes the PriceStatistics of the prices reported by validators for each
+// currency pair. This is meant to be used alongside Median, so that consumers of the oracle can gauge how much
+// validators agree on a price and how much stake contributed to it. Statistics are computed for every currency
+// pair with at least one reported price, regardless of whether the power threshold used by Median is met.
+func Statistics(
+	ctx sdk.Context,
+	logger log.Logger,
+	validatorStore ValidatorStore,
+) StatisticsFn {
+	return func(providers aggregator.AggregatedProviderData[string, map[slinkytypes.CurrencyPair]*big.Int]) map[slinkytypes.CurrencyPair]PriceStatistics {
+		stats := make(map[slinkytypes.CurrencyPair]PriceStatistics)
+
+		totalBondedTokens, err := validatorStore.TotalBondedTokens(ctx)
+		if err != nil || !totalBondedTokens.IsPositive() {
+			logger.Error(
+				"failed to retrieve total bonded tokens; skipping price statistics",
+				"total_bonded_tokens", totalBondedTokens,
+				"err", err,
+			)
+
+			return stats
+		}
+
+		for currencyPair, info := range GetPriceInfo(ctx, logger, validatorStore, providers) {
+			stats[currencyPair] = PriceStatistics{
+				InterquartileRange:  ComputeInterquartileRange(info),
+				VotingPowerFraction: math.LegacyNewDecFromInt(info.TotalWeight).Quo(math.LegacyNewDecFromInt(totalBondedTokens)),
+			}
+		}
+
+		return stats
+	}
+}
+
+// GetPriceInfo returns the stake weight + price reported by each validator for each currency pair. Prices from
+// validators that cannot be found in the validator store, as well as nil prices, are skipped.
+func GetPriceInfo(
+	ctx sdk.Context,
+	logger log.Logger,
+	validatorStore ValidatorStore,
+	providers aggregator.AggregatedProviderData[string, map[slinkytypes.CurrencyPair]*big.Int],
+) map[slinkytypes.CurrencyPair]PriceInfo {
+	priceInfo := make(map[slinkytypes.CurrencyPair]PriceInfo)
+
+	// Iterate through all providers and store stake weight + price for each currency pair.
+	for valAddress, validatorPrices := range providers {
+		// Retrieve the validator from the validator store and get its vote weight.
+		address, err := sdk.ConsAddressFromBech32(valAddress)
+		if err != nil {
+			logger.Info(
+				"failed to parse validator address; skipping validator prices",
+				"validator_address", valAddress,
+				"err", err,
+			)
+
+			continue
+		}
+
+		validator, err := validatorStore.ValidatorByConsAddr(ctx, address)
+		if err != nil {
+			logger.Info(
+				"failed to retrieve validator from store; skipping validator prices",
+				"validator_address", valAddress,
+				"err", err,
+			)
+
+			continue
+		}
+
+		voteWeight := validator.GetBondedTokens()
+
+		// Iterate through all prices and store the price + vote weight for each currency pair.
+		for currencyPair, price := range validatorPrices {
+			// Only include prices that are not nil.
+			if price == nil {
+				logger.Info(
+					"price is nil",
+					"currency_pair", currencyPair.String(),
+					"validator_address", valAddress,
+				)
+
+				continue
+			}
+
+			// Initialize the price info if it does not exist for the given currency pair.
+			if _, ok := priceInfo[currencyPair]; !ok {
+				priceInfo[currencyPair] = PriceInfo{
+					Prices:      make([]PricePerValidator, 0),
+					TotalWeight: math.ZeroInt(),
+				}
+			}
+
+			// Update the price info.
+			cpInfo := priceInfo[currencyPair]
+			priceInfo[currencyPair] = PriceInfo{
+				Prices: append(cpInfo.Prices, PricePerValidator{
+					VoteWeight: voteWeight,
+					Price:      price,
+				}),
+				TotalWeight: cpInfo.TotalWeight.Add(voteWeight),
+			}
+		}
+	}
+
+	return priceInfo
+}
+
 // ComputeMedian computes the stake-weighted median price for a given asset.
 func ComputeMedian(priceInfo PriceInfo) *big.Int {
+	return ComputePercentile(priceInfo, 50)
+}
+
+// ComputePercentile computes the stake-weighted percentile price for a given asset, i.e. the lowest price at which the
+// cumulative stake weight of all prices less than or equal to it reaches the given percentage of the total weight.
+func ComputePercentile(priceInfo PriceInfo, percentile int64) *big.Int {
 	// Sort the prices by price.
 	sort.SliceStable(priceInfo.Prices, func(i, j int) bool {
 		switch priceInfo.Prices[i].Price.Cmp(priceInfo.Prices[j].Price) {
@@ -174,15 +253,15 @@ func ComputeMedian(priceInfo PriceInfo) *big.Int {
 		}
 	})
 
-	// Compute the median weight.
-	middle := priceInfo.TotalWeight.QuoRaw(2)
+	// Compute the percentile weight.
+	target := priceInfo.TotalWeight.MulRaw(percentile).QuoRaw(100)
 
-	// Iterate through the prices and compute the median price.
+	// Iterate through the prices and compute the percentile price.
 	sum := math.ZeroInt()
 	for index, price := range priceInfo.Prices {
 		sum = sum.Add(price.VoteWeight)
 
-		if sum.GTE(middle) {
+		if sum.GTE(target) {
 			return price.Price
 		}
 
@@ -194,3 +273,15 @@ func ComputeMedian(priceInfo PriceInfo) *big.Int {
 
 	return nil
 }
+
+// ComputeInterquartileRange computes the stake-weighted interquartile range of the prices for a given asset, i.e.
+// the difference between the stake-weighted 75th and 25th percentile prices.
+func ComputeInterquartileRange(priceInfo PriceInfo) *big.Int {
+	upper := ComputePercentile(priceInfo, 75)
+	lower := ComputePercentile(priceInfo, 25)
+	if upper == nil || lower == nil {
+		return nil
+	}
+
+	return new(big.Int).Sub(upper, lower)
+}
@@ -12,10 +12,15 @@ import (
 
 	"github.com/skip-mev/slinky/oracle/config"
 	"github.com/skip-mev/slinky/oracle/types"
+	"github.com/skip-mev/slinky/providers/registry"
 )
 
 var _ types.PriceAPIDataHandler = (*APIHandler)(nil)
 
+func init() {
+	registry.RegisterAPIProvider(Name, registry.NewRestAPIConstructor(NewAPIHandler))
+}
+
 // APIHandler implements the PriceAPIDataHandler interface for Binance.
 // for more information about the Binance API, refer to the following link:
 // https://github.com/binance/binance-spot-api-docs/blob/master/rest-api.md#public-api-endpoints
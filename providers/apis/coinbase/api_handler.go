@@ -11,10 +11,15 @@ import (
 	"github.com/skip-mev/slinky/oracle/config"
 	"github.com/skip-mev/slinky/oracle/types"
 	"github.com/skip-mev/slinky/pkg/math"
+	"github.com/skip-mev/slinky/providers/registry"
 )
 
 var _ types.PriceAPIDataHandler = (*APIHandler)(nil)
 
+func init() {
+	registry.RegisterAPIProvider(Name, registry.NewRestAPIConstructor(NewAPIHandler))
+}
+
 // APIHandler implements the PriceAPIDataHandler interface for Coinbase, which can be used
 // by a base provider. The DataHandler fetches data from the spot price Coinbase API. It is
 // atomic in that it must request data from the Coinbase API sequentially for each ticker.
@@ -11,10 +11,15 @@ import (
 
 	"github.com/skip-mev/slinky/oracle/config"
 	"github.com/skip-mev/slinky/oracle/types"
+	"github.com/skip-mev/slinky/providers/registry"
 )
 
 var _ types.PriceAPIDataHandler = (*APIHandler)(nil)
 
+func init() {
+	registry.RegisterAPIProvider(Name, registry.NewRestAPIConstructor(NewAPIHandler))
+}
+
 // APIHandler implements the PriceAPIDataHandler interface for CoinGecko.
 type APIHandler struct {
 	// apiCfg is the config for the CoinGecko API.
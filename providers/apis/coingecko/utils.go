@@ -48,6 +48,12 @@ var DefaultAPIConfig = config.APIConfig{
 	ReconnectTimeout: 2000 * time.Millisecond,
 	MaxQueries:       1,
 	Endpoints:        []config.Endpoint{{URL: URL}},
+	// Coingecko regularly rate limits requests, so retry them within the timeout.
+	Retry: config.RetryConfig{
+		MaxRetries:     2,
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     200 * time.Millisecond,
+	},
 }
 
 type (
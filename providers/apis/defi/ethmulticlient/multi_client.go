@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"reflect"
 	"sync"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -15,8 +16,9 @@ import (
 )
 
 // MultiRPCClient implements the EVMClient interface by calling multiple underlying EVMClients and choosing
-// the best response. Specifically, it calls eth_blockNumber on each client and chooses the response with the
-// highest block number.
+// the best response. Specifically, it calls eth_blockNumber on each client and drops the responses of clients
+// that lag more than the configured max block lag behind the highest block number. Of the remaining responses,
+// it chooses the one with the fewest failed calls, preferring higher block numbers.
 type MultiRPCClient struct {
 	logger *zap.Logger
 	api    config.APIConfig
@@ -88,8 +90,10 @@ func NewMultiRPCClientFromEndpoints(
 }
 
 // BatchCallContext injects a call to eth_blockNumber, and makes batch calls to the underlying EVMClients.
-// It returns the response that has the greatest height from the eth_blockNumber call. An error is returned
-// only when no client was able to successfully provide a height or errored when sending the BatchCall.
+// Responses from clients whose height lags more than MaxBlockLag blocks behind the greatest height are
+// dropped, and the response with the fewest failed calls is returned from the rest, with ties broken by the
+// greatest height. An error is returned only when no client was able to successfully provide a height or
+// errored when sending the BatchCall.
 func (m *MultiRPCClient) BatchCallContext(ctx context.Context, batchElems []rpc.BatchElem) error {
 	if len(batchElems) == 0 {
 		m.logger.Debug("BatchCallContext called with 0 elems")
@@ -98,6 +102,7 @@ func (m *MultiRPCClient) BatchCallContext(ctx context.Context, batchElems []rpc.
 	// define a result struct that go routines will populate and append to a slice when they complete their request.
 	type result struct {
 		height  uint64
+		failed  int
 		results []rpc.BatchElem
 	}
 	results := make([]result, len(m.clients))
@@ -115,8 +120,13 @@ func (m *MultiRPCClient) BatchCallContext(ctx context.Context, batchElems []rpc.
 			url := m.api.Endpoints[i].URL
 
 			// append an eth_blockNumber call to the requests. we do this because we want the greatest height results only.
+			// each client unmarshals into its own results, so that the response of one client does not overwrite
+			// the response of another.
 			req := make([]rpc.BatchElem, len(batchElems)+1)
-			copy(req, batchElems)
+			for j, elem := range batchElems {
+				req[j] = elem
+				req[j].Result = newResult(elem.Result)
+			}
 			req[blockNumReqIndex] = EthBlockNumberBatchElem()
 
 			err := client.BatchCallContext(ctx, req)
@@ -162,21 +172,25 @@ func (m *MultiRPCClient) BatchCallContext(ctx context.Context, batchElems []rpc.
 				zap.Uint64("height", height),
 				zap.String("url", url),
 			)
+			// count the calls that failed, so that the most complete response can be chosen.
+			var failed int
+			for _, elem := range req[:blockNumReqIndex] {
+				if elem.Error != nil {
+					failed++
+				}
+			}
+
 			// append the results, minus the appended eth_blockNumber request.
-			results[i] = result{height, req[:blockNumReqIndex]}
+			results[i] = result{height, failed, req[:blockNumReqIndex]}
 		}(clientIdx)
 	}
 	wg.Wait()
 
-	// see which of the results had the largest height, and store the index of that result.
-	var (
-		maxHeight      uint64
-		maxHeightIndex int
-	)
-	for i, res := range results {
+	// see which of the results had the largest height.
+	var maxHeight uint64
+	for _, res := range results {
 		if res.height > maxHeight {
 			maxHeight = res.height
-			maxHeightIndex = i
 		}
 	}
 	// maxHeight being 0 means there were no results. something bad happened. return all the errors.
@@ -188,7 +202,49 @@ func (m *MultiRPCClient) BatchCallContext(ctx context.Context, batchElems []rpc.
 		// this should never happen... but who knows. maybe something terrible happened.
 		return errors.New("no errors were encountered, however no go routine was able to report a height")
 	}
-	// copy the results from the results that had the largest height.
-	copy(batchElems, results[maxHeightIndex].results)
+
+	// drop the results of clients that lag too far behind, and choose the result with the fewest failed calls
+	// from the rest, preferring greater heights.
+	best := -1
+	for i, res := range results {
+		if res.height == 0 {
+			continue
+		}
+
+		if res.height+m.api.MaxBlockLag < maxHeight {
+			m.logger.Debug(
+				"dropping response from lagging endpoint",
+				zap.String("url", m.api.Endpoints[i].URL),
+				zap.Uint64("height", res.height),
+				zap.Uint64("max_height", maxHeight),
+				zap.Uint64("max_block_lag", m.api.MaxBlockLag),
+			)
+			continue
+		}
+
+		if best == -1 ||
+			res.failed < results[best].failed ||
+			(res.failed == results[best].failed && res.height > results[best].height) {
+			best = i
+		}
+	}
+
+	// copy the results from the chosen client.
+	copy(batchElems, results[best].results)
 	return nil
 }
+
+// newResult returns a new value of the same type as the given result, which is expected to be a pointer. If the
+// result is nil or not a pointer, it is returned as is.
+func newResult(result interface{}) interface{} {
+	if result == nil {
+		return nil
+	}
+
+	t := reflect.TypeOf(result)
+	if t.Kind() != reflect.Ptr {
+		return result
+	}
+
+	return reflect.New(t.Elem()).Interface()
+}
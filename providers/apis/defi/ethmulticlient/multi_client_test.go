@@ -146,6 +146,86 @@ func TestMultiClient(t *testing.T) {
 			expectedResults: []interface{}{"value2"},
 			err:             nil,
 		},
+		{
+			name: "two clients at the same height, the response with fewer failed calls is chosen",
+			client: ethmulticlient.NewMultiRPCClient(
+				logger,
+				config.APIConfig{
+					Endpoints: []config.Endpoint{{URL: "http://localhost:8545"}, {URL: "http://localhost:8546"}},
+				},
+				[]ethmulticlient.EVMClient{
+					createEVMClientWithResponse(
+						t,
+						nil,
+						[]string{"", "0x12c781c"},
+						[]error{fmt.Errorf("call failed"), nil},
+					),
+					createEVMClientWithResponse(
+						t,
+						nil,
+						[]string{"value2", "0x12c781c"},
+						[]error{nil, nil},
+					),
+				},
+			),
+			args:            []rpc.BatchElem{{}},
+			expectedResults: []interface{}{"value2"},
+			err:             nil,
+		},
+		{
+			name: "client within the max block lag with fewer failed calls is chosen",
+			client: ethmulticlient.NewMultiRPCClient(
+				logger,
+				config.APIConfig{
+					Endpoints:   []config.Endpoint{{URL: "http://localhost:8545"}, {URL: "http://localhost:8546"}},
+					MaxBlockLag: 5,
+				},
+				[]ethmulticlient.EVMClient{
+					createEVMClientWithResponse(
+						t,
+						nil,
+						[]string{"", "0x12c781c"},
+						[]error{fmt.Errorf("call failed"), nil},
+					),
+					createEVMClientWithResponse(
+						t,
+						nil,
+						[]string{"value2", "0x12c7818"},
+						[]error{nil, nil},
+					),
+				},
+			),
+			args:            []rpc.BatchElem{{}},
+			expectedResults: []interface{}{"value2"},
+			err:             nil,
+		},
+		{
+			name: "client lagging more than the max block lag is dropped",
+			client: ethmulticlient.NewMultiRPCClient(
+				logger,
+				config.APIConfig{
+					Endpoints:   []config.Endpoint{{URL: "http://localhost:8545"}, {URL: "http://localhost:8546"}},
+					MaxBlockLag: 3,
+				},
+				[]ethmulticlient.EVMClient{
+					createEVMClientWithResponse(
+						t,
+						nil,
+						[]string{"", "0x12c781c"},
+						[]error{fmt.Errorf("call failed"), nil},
+					),
+					createEVMClientWithResponse(
+						t,
+						nil,
+						[]string{"value2", "0x12c7818"},
+						[]error{nil, nil},
+					),
+				},
+			),
+			args:            []rpc.BatchElem{{}},
+			expectedResults: []interface{}{""},
+			err:             nil,
+		},
 		{
 			name: "worst case scenario where no errors were returned but both clients returned height 0",
 			client: ethmulticlient.NewMultiRPCClient(
@@ -218,3 +298,40 @@ func createEVMClientWithResponse(
 
 	return c
 }
+
+func TestMultiClientResultsAreNotShared(t *testing.T) {
+	logger, err := zap.NewDevelopment()
+	require.NoError(t, err)
+
+	// writeResponse returns a client that writes the given response and height into the results of the batch call.
+	writeResponse := func(response, height string) ethmulticlient.EVMClient {
+		c := mocks.NewEVMClient(t)
+		c.On("BatchCallContext", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+			elems, ok := args.Get(1).([]rpc.BatchElem)
+			require.True(t, ok)
+			require.Len(t, elems, 2)
+
+			*elems[0].Result.(*string) = response
+			*elems[1].Result.(*string) = height
+		})
+		return c
+	}
+
+	client := ethmulticlient.NewMultiRPCClient(
+		logger,
+		config.APIConfig{
+			Endpoints: []config.Endpoint{{URL: "http://localhost:8545"}, {URL: "http://localhost:8546"}},
+		},
+		[]ethmulticlient.EVMClient{
+			writeResponse("lagging", "0x12c7818"),
+			writeResponse("latest", "0x12c781c"),
+		},
+	)
+
+	// the response of the lagging client must never be returned, regardless of which client responds last.
+	for i := 0; i < 10; i++ {
+		elems := []rpc.BatchElem{{Method: "eth_call", Result: new(string)}}
+		require.NoError(t, client.BatchCallContext(context.Background(), elems))
+		require.Equal(t, "latest", *elems[0].Result.(*string))
+	}
+}
@@ -1,6 +1,20 @@
 package ethmulticlient
 
-import "github.com/ethereum/go-ethereum/rpc"
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// BlockHeader is the subset of the block returned by eth_getBlockByNumber that is used to pin
+// calls to a block.
+type BlockHeader struct {
+	// Number is the number of the block.
+	Number hexutil.Uint64 `json:"number"`
+	// Timestamp is the unix timestamp of the block in seconds.
+	Timestamp hexutil.Uint64 `json:"timestamp"`
+}
 
 // EthBlockNumberBatchElem returns an initialized BatchElem for the eth_blockNumber call.
 func EthBlockNumberBatchElem() rpc.BatchElem {
@@ -10,3 +24,49 @@ func EthBlockNumberBatchElem() rpc.BatchElem {
 		Result: &result,
 	}
 }
+
+// EthGetBlockByNumberBatchElem returns an initialized BatchElem for the eth_getBlockByNumber call
+// for the given height, without the block's transactions. The result is a *BlockHeader.
+func EthGetBlockByNumberBatchElem(height uint64) rpc.BatchElem {
+	return rpc.BatchElem{
+		Method: "eth_getBlockByNumber",
+		Args: []interface{}{
+			hexutil.EncodeUint64(height),
+			false, // only return the transaction hashes.
+		},
+		Result: new(BlockHeader),
+	}
+}
+
+// ParseBlockNumber parses the height from the result of an eth_blockNumber call.
+func ParseBlockNumber(elem rpc.BatchElem) (uint64, error) {
+	if elem.Error != nil {
+		return 0, elem.Error
+	}
+
+	r, ok := elem.Result.(*string)
+	if !ok || r == nil {
+		return 0, fmt.Errorf("result from eth_blockNumber was not a string")
+	}
+
+	height, err := hexutil.DecodeUint64(*r)
+	if err != nil {
+		return 0, fmt.Errorf("could not decode hex eth height: %w", err)
+	}
+
+	return height, nil
+}
+
+// ParseBlockHeader parses the block header from the result of an eth_getBlockByNumber call.
+func ParseBlockHeader(elem rpc.BatchElem) (BlockHeader, error) {
+	if elem.Error != nil {
+		return BlockHeader{}, elem.Error
+	}
+
+	header, ok := elem.Result.(*BlockHeader)
+	if !ok || header == nil {
+		return BlockHeader{}, fmt.Errorf("result from eth_getBlockByNumber was not a block header")
+	}
+
+	return *header, nil
+}
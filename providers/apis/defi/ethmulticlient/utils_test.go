@@ -0,0 +1,60 @@
+package ethmulticlient_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/providers/apis/defi/ethmulticlient"
+)
+
+func TestParseBlockNumber(t *testing.T) {
+	t.Run("elem has an error", func(t *testing.T) {
+		elem := ethmulticlient.EthBlockNumberBatchElem()
+		elem.Error = fmt.Errorf("error")
+		_, err := ethmulticlient.ParseBlockNumber(elem)
+		require.Error(t, err)
+	})
+
+	t.Run("result is not a hex height", func(t *testing.T) {
+		elem := ethmulticlient.EthBlockNumberBatchElem()
+		*elem.Result.(*string) = "not a height"
+		_, err := ethmulticlient.ParseBlockNumber(elem)
+		require.ErrorContains(t, err, "could not decode hex eth height")
+	})
+
+	t.Run("valid height", func(t *testing.T) {
+		elem := ethmulticlient.EthBlockNumberBatchElem()
+		*elem.Result.(*string) = "0x1312d00"
+		height, err := ethmulticlient.ParseBlockNumber(elem)
+		require.NoError(t, err)
+		require.Equal(t, uint64(20_000_000), height)
+	})
+}
+
+func TestParseBlockHeader(t *testing.T) {
+	t.Run("request pins the height", func(t *testing.T) {
+		elem := ethmulticlient.EthGetBlockByNumberBatchElem(20_000_000)
+		require.Equal(t, "eth_getBlockByNumber", elem.Method)
+		require.Equal(t, []interface{}{"0x1312d00", false}, elem.Args)
+	})
+
+	t.Run("elem has an error", func(t *testing.T) {
+		elem := ethmulticlient.EthGetBlockByNumberBatchElem(1)
+		elem.Error = fmt.Errorf("error")
+		_, err := ethmulticlient.ParseBlockHeader(elem)
+		require.Error(t, err)
+	})
+
+	t.Run("valid header", func(t *testing.T) {
+		elem := ethmulticlient.EthGetBlockByNumberBatchElem(20_000_000)
+		require.NoError(t, json.Unmarshal([]byte(`{"number":"0x1312d00","timestamp":"0x66575740","hash":"0x01"}`), elem.Result))
+
+		header, err := ethmulticlient.ParseBlockHeader(elem)
+		require.NoError(t, err)
+		require.Equal(t, uint64(20_000_000), uint64(header.Number))
+		require.Equal(t, uint64(1_717_000_000), uint64(header.Timestamp))
+	})
+}
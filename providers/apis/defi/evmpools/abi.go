@@ -0,0 +1,103 @@
+package evmpools
+
+import (
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+const (
+	// CurveAmplificationMethod is the Curve pool method that returns the amplification
+	// coefficient of the pool.
+	CurveAmplificationMethod = "A"
+
+	// CurveBalancesMethod is the Curve pool method that returns the balance of the token at
+	// the given index.
+	CurveBalancesMethod = "balances"
+
+	// CurveIndexTypeUint256 is the type of the index argument of the balances method of most
+	// Curve pools.
+	CurveIndexTypeUint256 = "uint256"
+
+	// CurveIndexTypeInt128 is the type of the index argument of the balances method of older
+	// Curve pools, e.g. the compound and susd pools.
+	CurveIndexTypeInt128 = "int128"
+
+	// BalancerPoolTokensMethod is the Balancer vault method that returns the tokens and balances
+	// of the given pool.
+	BalancerPoolTokensMethod = "getPoolTokens"
+
+	// BalancerWeightsMethod is the Balancer weighted pool method that returns the normalized
+	// weights of the pool's tokens.
+	BalancerWeightsMethod = "getNormalizedWeights"
+)
+
+const (
+	// CurvePoolABI is the subset of the Curve stableswap pool ABI used by the provider.
+	CurvePoolABI = `[
+		{"name":"A","inputs":[],"outputs":[{"name":"","type":"uint256"}],"stateMutability":"view","type":"function"},
+		{"name":"balances","inputs":[{"name":"i","type":"uint256"}],"outputs":[{"name":"","type":"uint256"}],"stateMutability":"view","type":"function"}
+	]`
+
+	// CurvePoolInt128ABI is the subset of the ABI of older Curve stableswap pools - whose
+	// balances method takes an int128 index - used by the provider.
+	CurvePoolInt128ABI = `[
+		{"name":"A","inputs":[],"outputs":[{"name":"","type":"uint256"}],"stateMutability":"view","type":"function"},
+		{"name":"balances","inputs":[{"name":"i","type":"int128"}],"outputs":[{"name":"","type":"uint256"}],"stateMutability":"view","type":"function"}
+	]`
+
+	// BalancerVaultABI is the subset of the Balancer V2 vault ABI used by the provider.
+	BalancerVaultABI = `[
+		{"name":"getPoolTokens","inputs":[{"name":"poolId","type":"bytes32"}],"outputs":[{"name":"tokens","type":"address[]"},{"name":"balances","type":"uint256[]"},{"name":"lastChangeBlock","type":"uint256"}],"stateMutability":"view","type":"function"}
+	]`
+
+	// BalancerWeightedPoolABI is the subset of the Balancer V2 weighted pool ABI used by the
+	// provider.
+	BalancerWeightedPoolABI = `[
+		{"name":"getNormalizedWeights","inputs":[],"outputs":[{"name":"","type":"uint256[]"}],"stateMutability":"view","type":"function"}
+	]`
+)
+
+// ABIs contains the parsed ABIs of the contracts queried by the provider.
+type ABIs struct {
+	CurvePool            abi.ABI
+	CurvePoolInt128      abi.ABI
+	BalancerVault        abi.ABI
+	BalancerWeightedPool abi.ABI
+}
+
+// CurvePoolABI returns the Curve pool ABI that matches the given index type of the balances
+// method.
+func (a ABIs) CurvePoolABI(indexType string) abi.ABI {
+	if indexType == CurveIndexTypeInt128 {
+		return a.CurvePoolInt128
+	}
+
+	return a.CurvePool
+}
+
+// NewABIs parses the ABIs of the contracts queried by the provider.
+func NewABIs() (ABIs, error) {
+	var (
+		abis ABIs
+		err  error
+	)
+
+	if abis.CurvePool, err = abi.JSON(strings.NewReader(CurvePoolABI)); err != nil {
+		return abis, err
+	}
+
+	if abis.CurvePoolInt128, err = abi.JSON(strings.NewReader(CurvePoolInt128ABI)); err != nil {
+		return abis, err
+	}
+
+	if abis.BalancerVault, err = abi.JSON(strings.NewReader(BalancerVaultABI)); err != nil {
+		return abis, err
+	}
+
+	if abis.BalancerWeightedPool, err = abi.JSON(strings.NewReader(BalancerWeightedPoolABI)); err != nil {
+		return abis, err
+	}
+
+	return abis, nil
+}
@@ -0,0 +1,434 @@
+package evmpools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/skip-mev/slinky/oracle/config"
+	"github.com/skip-mev/slinky/oracle/types"
+	"github.com/skip-mev/slinky/providers/apis/defi/ethmulticlient"
+	apihandlers "github.com/skip-mev/slinky/providers/base/api/handlers"
+	"github.com/skip-mev/slinky/providers/base/api/metrics"
+	"github.com/skip-mev/slinky/providers/registry"
+	providertypes "github.com/skip-mev/slinky/providers/types"
+)
+
+var _ types.PriceAPIFetcher = (*PriceFetcher)(nil)
+
+func init() {
+	// Each supported protocol and chain is registered under its own dynamic provider name.
+	for _, names := range []map[string]string{CurveProviderNames, BalancerProviderNames} {
+		for _, name := range names {
+			registry.RegisterAPIProvider(name, func(
+				ctx context.Context,
+				logger *zap.Logger,
+				cfg config.ProviderConfig,
+				_ apihandlers.RequestHandler,
+				m metrics.APIMetrics,
+			) (types.PriceAPIFetcher, error) {
+				return NewPriceFetcher(ctx, logger, m, cfg.API)
+			})
+		}
+	}
+}
+
+// PriceFetcher is the price fetcher for Curve stableswap and Balancer weighted pools. The
+// protocol of the pools is determined by the name of the provider. The fetcher reads the state
+// of each pool, i.e. the amplification coefficient and balances of a Curve pool or the balances
+// and normalized weights of a Balancer pool, and computes the spot price of the pool off-chain.
+//
+// To read more about how the prices are calculated, see the Curve stableswap whitepaper
+// https://docs.curve.fi/references/whitepapers/stableswap/ and the Balancer documentation
+// https://docs.balancer.fi/concepts/explore-available-balancer-pools/weighted-pool/weighted-math.html.
+//
+// All of the calls required for a set of tickers are sent in a single batch with the eth
+// client's BatchCallContext.
+type PriceFetcher struct {
+	logger *zap.Logger
+	api    config.APIConfig
+
+	// protocol is the base name of the protocol of the pools queried by the fetcher.
+	protocol string
+	// client is the EVM client implementation. This is used to interact with the ethereum network.
+	client ethmulticlient.EVMClient
+	// abis are the ABIs used to pack the calls to the pool and vault contracts and parse their
+	// results.
+	abis ABIs
+	// poolCache is a cache of the tickers to pool configs. This is used to avoid unmarshalling
+	// the metadata for each ticker.
+	poolCache map[types.ProviderTicker]PoolConfig
+}
+
+// NewPriceFetcher returns a new Curve or Balancer price fetcher.
+func NewPriceFetcher(
+	ctx context.Context,
+	logger *zap.Logger,
+	apiMetrics metrics.APIMetrics,
+	api config.APIConfig,
+) (*PriceFetcher, error) {
+	if ctx == nil {
+		return nil, fmt.Errorf("context cannot be nil")
+	}
+
+	if logger == nil {
+		return nil, fmt.Errorf("logger cannot be nil")
+	}
+
+	if apiMetrics == nil {
+		return nil, fmt.Errorf("api metrics is nil")
+	}
+
+	if err := api.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid api config: %w", err)
+	}
+
+	if !IsValidProviderName(api.Name) {
+		return nil, fmt.Errorf("invalid api config name %s", api.Name)
+	}
+
+	if !api.Enabled {
+		return nil, fmt.Errorf("api config for %s is not enabled", api.Name)
+	}
+
+	var (
+		client ethmulticlient.EVMClient
+		err    error
+	)
+	switch {
+	case len(api.Endpoints) > 1:
+		client, err = ethmulticlient.NewMultiRPCClientFromEndpoints(
+			ctx,
+			logger,
+			api,
+			apiMetrics,
+		)
+	case len(api.Endpoints) == 1:
+		client, err = ethmulticlient.NewGoEthereumClientImpl(
+			ctx,
+			apiMetrics,
+			api,
+			0,
+		)
+	default:
+		err = fmt.Errorf("no endpoints were provided")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return NewPriceFetcherWithClient(
+		logger,
+		api,
+		client,
+	)
+}
+
+// NewPriceFetcherWithClient returns a new PriceFetcher.
+// It requires a pre-validated config, and initialized client.
+func NewPriceFetcherWithClient(
+	logger *zap.Logger,
+	api config.APIConfig,
+	client ethmulticlient.EVMClient,
+) (*PriceFetcher, error) {
+	protocol, ok := Protocol(api.Name)
+	if !ok {
+		return nil, fmt.Errorf("invalid api config name %s", api.Name)
+	}
+
+	abis, err := NewABIs()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse abis: %w", err)
+	}
+
+	return &PriceFetcher{
+		logger:    logger.With(zap.String("fetcher", api.Name)),
+		api:       api,
+		protocol:  protocol,
+		client:    client,
+		abis:      abis,
+		poolCache: make(map[types.ProviderTicker]PoolConfig),
+	}, nil
+}
+
+// Fetch returns the price of a given set of tickers. All of the calls required to read the state
+// of the pools are sent in a single batch call. If any of the calls for a given ticker fail, the
+// ticker is unresolved.
+func (f *PriceFetcher) Fetch(
+	ctx context.Context,
+	tickers []types.ProviderTicker,
+) types.PriceResponse {
+	var (
+		resolved   = make(types.ResolvedPrices)
+		unResolved = make(types.UnResolvedPrices)
+	)
+
+	// Create the batch elements for each ticker and pool. calls[i] is the range of batch
+	// elements that belong to tickers[i].
+	var (
+		batchElems []rpc.BatchElem
+		calls      = make([][2]int, len(tickers))
+		pools      = make([]PoolConfig, len(tickers))
+	)
+	for i, ticker := range tickers {
+		pool, err := f.GetPool(ticker)
+		if err != nil {
+			f.logger.Debug(
+				"failed to get pool for ticker",
+				zap.String("ticker", ticker.String()),
+				zap.Error(err),
+			)
+
+			return types.NewPriceResponseWithErr(
+				tickers,
+				providertypes.NewErrorWithCode(
+					fmt.Errorf("failed to get pool: %w", err),
+					providertypes.ErrorFailedToDecode,
+				),
+			)
+		}
+
+		elems, err := f.createBatchElems(pool)
+		if err != nil {
+			f.logger.Debug(
+				"failed to create calls for ticker",
+				zap.String("ticker", ticker.String()),
+				zap.Error(err),
+			)
+
+			return types.NewPriceResponseWithErr(
+				tickers,
+				providertypes.NewErrorWithCode(
+					fmt.Errorf("failed to create calls: %w", err),
+					providertypes.ErrorFailedToDecode,
+				),
+			)
+		}
+
+		calls[i] = [2]int{len(batchElems), len(batchElems) + len(elems)}
+		batchElems = append(batchElems, elems...)
+		pools[i] = pool
+	}
+
+	// Batch call to the EVM.
+	if err := f.client.BatchCallContext(ctx, batchElems); err != nil {
+		f.logger.Debug(
+			"failed to batch call to ethereum network for all tickers",
+			zap.Error(err),
+		)
+
+		return types.NewPriceResponseWithErr(
+			tickers,
+			providertypes.NewErrorWithCode(err, providertypes.ErrorAPIGeneral),
+		)
+	}
+
+	// Parse the results from the batch call for each ticker.
+	for i, ticker := range tickers {
+		elems := batchElems[calls[i][0]:calls[i][1]]
+
+		if err := batchError(elems); err != nil {
+			f.logger.Debug(
+				"failed to batch call to ethereum network for ticker",
+				zap.String("ticker", ticker.String()),
+				zap.Error(err),
+			)
+
+			unResolved[ticker] = providertypes.UnresolvedResult{
+				ErrorWithCode: providertypes.NewErrorWithCode(
+					err,
+					providertypes.ErrorUnknown,
+				),
+			}
+
+			continue
+		}
+
+		price, err := f.parsePrice(pools[i], elems)
+		if err != nil {
+			f.logger.Debug(
+				"failed to parse price",
+				zap.String("ticker", ticker.String()),
+				zap.Error(err),
+			)
+
+			unResolved[ticker] = providertypes.UnresolvedResult{
+				ErrorWithCode: providertypes.NewErrorWithCode(
+					err,
+					providertypes.ErrorFailedToParsePrice,
+				),
+			}
+
+			continue
+		}
+
+		resolved[ticker] = types.NewPriceResult(price, time.Now().UTC())
+	}
+
+	return types.NewPriceResponse(resolved, unResolved)
+}
+
+// GetPool returns the pool for the given ticker. This will unmarshal the metadata and validate
+// the pool config which contains all required information to query the EVM.
+func (f *PriceFetcher) GetPool(
+	ticker types.ProviderTicker,
+) (PoolConfig, error) {
+	if pool, ok := f.poolCache[ticker]; ok {
+		return pool, nil
+	}
+
+	var cfg PoolConfig
+	if err := json.Unmarshal([]byte(ticker.GetJSON()), &cfg); err != nil {
+		return cfg, fmt.Errorf("failed to unmarshal pool config on ticker: %w", err)
+	}
+	if err := cfg.ValidateBasic(); err != nil {
+		return cfg, fmt.Errorf("invalid ticker pool config: %w", err)
+	}
+	if f.protocol == BalancerBaseName && len(cfg.PoolID) == 0 {
+		return cfg, fmt.Errorf("invalid ticker pool config: balancer pools require a pool id")
+	}
+
+	f.poolCache[ticker] = cfg
+	return cfg, nil
+}
+
+// createBatchElems returns the calls required to read the state of the given pool.
+//
+//   - Curve: A() and balances(i) for each token on the pool contract. The type of i is given by the
+//     index type of the pool.
+//   - Balancer: getPoolTokens(poolId) on the vault contract and getNormalizedWeights() on the
+//     pool contract.
+func (f *PriceFetcher) createBatchElems(pool PoolConfig) ([]rpc.BatchElem, error) {
+	if f.protocol == BalancerBaseName {
+		tokens, err := f.abis.BalancerVault.Pack(BalancerPoolTokensMethod, common.HexToHash(pool.PoolID))
+		if err != nil {
+			return nil, err
+		}
+
+		weights, err := f.abis.BalancerWeightedPool.Pack(BalancerWeightsMethod)
+		if err != nil {
+			return nil, err
+		}
+
+		return []rpc.BatchElem{
+			newCall(pool.GetVault(), tokens),
+			newCall(pool.Address, weights),
+		}, nil
+	}
+
+	curvePool := f.abis.CurvePoolABI(pool.IndexType)
+	amp, err := curvePool.Pack(CurveAmplificationMethod)
+	if err != nil {
+		return nil, err
+	}
+
+	elems := []rpc.BatchElem{newCall(pool.Address, amp)}
+	for i := range pool.Decimals {
+		balance, err := curvePool.Pack(CurveBalancesMethod, big.NewInt(int64(i)))
+		if err != nil {
+			return nil, err
+		}
+
+		elems = append(elems, newCall(pool.Address, balance))
+	}
+
+	return elems, nil
+}
+
+// parsePrice parses the results of the calls for the given pool and computes its spot price.
+func (f *PriceFetcher) parsePrice(pool PoolConfig, elems []rpc.BatchElem) (*big.Float, error) {
+	if f.protocol == BalancerBaseName {
+		out, err := unpack(f.abis.BalancerVault, BalancerPoolTokensMethod, elems[0].Result)
+		if err != nil {
+			return nil, err
+		}
+		balances := *abi.ConvertType(out[1], new([]*big.Int)).(*[]*big.Int)
+
+		out, err = unpack(f.abis.BalancerWeightedPool, BalancerWeightsMethod, elems[1].Result)
+		if err != nil {
+			return nil, err
+		}
+		weights := *abi.ConvertType(out[0], new([]*big.Int)).(*[]*big.Int)
+
+		return BalancerSpotPrice(balances, weights, pool.Decimals, pool.BaseIndex, pool.QuoteIndex)
+	}
+
+	curvePool := f.abis.CurvePoolABI(pool.IndexType)
+	out, err := unpack(curvePool, CurveAmplificationMethod, elems[0].Result)
+	if err != nil {
+		return nil, err
+	}
+	amp := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	balances := make([]*big.Int, 0, len(elems)-1)
+	for _, elem := range elems[1:] {
+		out, err := unpack(curvePool, CurveBalancesMethod, elem.Result)
+		if err != nil {
+			return nil, err
+		}
+		balances = append(balances, *abi.ConvertType(out[0], new(*big.Int)).(**big.Int))
+	}
+
+	return CurveSpotPrice(amp, balances, pool.Decimals, pool.BaseIndex, pool.QuoteIndex)
+}
+
+// newCall returns an eth_call batch element that calls the given contract with the given data at
+// the latest block.
+func newCall(to string, data []byte) rpc.BatchElem {
+	var result string
+	return rpc.BatchElem{
+		Method: "eth_call",
+		Args: []interface{}{
+			map[string]interface{}{
+				"to":   common.HexToAddress(to),
+				"data": hexutil.Bytes(data),
+			},
+			"latest", // latest signifies the latest block.
+		},
+		Result: &result,
+	}
+}
+
+// batchError returns the first error of the given batch elements.
+func batchError(elems []rpc.BatchElem) error {
+	for _, elem := range elems {
+		if elem.Error != nil {
+			return elem.Error
+		}
+	}
+
+	return nil
+}
+
+// unpack decodes the hex encoded result of a call to the given method.
+func unpack(contract abi.ABI, method string, result interface{}) ([]interface{}, error) {
+	r, ok := result.(*string)
+	if !ok {
+		return nil, fmt.Errorf("expected result to be a string, got %T", result)
+	}
+
+	if r == nil {
+		return nil, fmt.Errorf("result is nil")
+	}
+
+	bz, err := hexutil.Decode(*r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode hex result of %s: %w", method, err)
+	}
+
+	out, err := contract.Methods[method].Outputs.UnpackValues(bz)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unpack values of %s: %w", method, err)
+	}
+
+	return out, nil
+}
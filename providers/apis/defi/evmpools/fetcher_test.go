@@ -0,0 +1,352 @@
+package evmpools_test
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/skip-mev/slinky/oracle/config"
+	"github.com/skip-mev/slinky/oracle/types"
+	"github.com/skip-mev/slinky/providers/apis/defi/ethmulticlient"
+	"github.com/skip-mev/slinky/providers/apis/defi/ethmulticlient/mocks"
+	"github.com/skip-mev/slinky/providers/apis/defi/evmpools"
+	"github.com/skip-mev/slinky/providers/base/api/metrics"
+)
+
+func TestFetchCurve(t *testing.T) {
+	testCases := []struct {
+		name       string
+		tickers    []types.ProviderTicker
+		client     func() ethmulticlient.EVMClient
+		resolved   map[types.ProviderTicker]float64
+		unresolved []types.ProviderTicker
+	}{
+		{
+			name:    "no tickers",
+			tickers: []types.ProviderTicker{},
+			client: func() ethmulticlient.EVMClient {
+				return createEVMClientWithResponse(t, nil, nil, nil)
+			},
+		},
+		{
+			name: "fails to retrieve pool for an empty ticker",
+			tickers: []types.ProviderTicker{
+				types.NewProviderTicker("USDC/USDT", ""),
+			},
+			client: func() ethmulticlient.EVMClient {
+				return mocks.NewEVMClient(t)
+			},
+			unresolved: []types.ProviderTicker{types.NewProviderTicker("USDC/USDT", "")},
+		},
+		{
+			name:    "fails to make a batch call",
+			tickers: []types.ProviderTicker{usdcusdtTicker},
+			client: func() ethmulticlient.EVMClient {
+				return createEVMClientWithResponse(t, fmt.Errorf("failed to make a batch call"), nil, nil)
+			},
+			unresolved: []types.ProviderTicker{usdcusdtTicker},
+		},
+		{
+			name:    "batch request has an error for a single call",
+			tickers: []types.ProviderTicker{usdcusdtTicker},
+			client: func() ethmulticlient.EVMClient {
+				responses := []string{
+					pack(t, "curve", evmpools.CurveAmplificationMethod, big.NewInt(200)),
+					pack(t, "curve", evmpools.CurveBalancesMethod, tokens(1000000, 18)),
+					"",
+					pack(t, "curve", evmpools.CurveBalancesMethod, tokens(900000, 6)),
+				}
+				errs := []error{nil, nil, fmt.Errorf("request did not return a result"), nil}
+				return createEVMClientWithResponse(t, nil, responses, errs)
+			},
+			unresolved: []types.ProviderTicker{usdcusdtTicker},
+		},
+		{
+			name:    "batch request returns a result that cannot be parsed",
+			tickers: []types.ProviderTicker{usdcusdtTicker},
+			client: func() ethmulticlient.EVMClient {
+				responses := []string{"0x1234", "0x", "0x", "0x"}
+				errs := []error{nil, nil, nil, nil}
+				return createEVMClientWithResponse(t, nil, responses, errs)
+			},
+			unresolved: []types.ProviderTicker{usdcusdtTicker},
+		},
+		{
+			name:    "three token pool",
+			tickers: []types.ProviderTicker{usdcusdtTicker},
+			client: func() ethmulticlient.EVMClient {
+				responses := []string{
+					pack(t, "curve", evmpools.CurveAmplificationMethod, big.NewInt(200)),
+					pack(t, "curve", evmpools.CurveBalancesMethod, tokens(1000000, 18)),
+					pack(t, "curve", evmpools.CurveBalancesMethod, tokens(1200000, 6)),
+					pack(t, "curve", evmpools.CurveBalancesMethod, tokens(900000, 6)),
+				}
+				errs := []error{nil, nil, nil, nil}
+				return createEVMClientWithResponse(t, nil, responses, errs)
+			},
+			resolved: map[types.ProviderTicker]float64{
+				usdcusdtTicker: 0.99854251580673587690,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fetcher := createPriceFetcherWithClient(t, evmpools.DefaultCurveETHAPIConfig, tc.client())
+			checkResponse(t, fetcher.Fetch(context.Background(), tc.tickers), tc.resolved, tc.unresolved)
+		})
+	}
+}
+
+func TestFetchCurveInt128Index(t *testing.T) {
+	abis, err := evmpools.NewABIs()
+	require.NoError(t, err)
+
+	cfg := usdcusdtCfg
+	cfg.IndexType = evmpools.CurveIndexTypeInt128
+	ticker := types.NewProviderTicker("USDC/USDT", cfg.MustToJSON())
+
+	responses := []string{
+		pack(t, "curve", evmpools.CurveAmplificationMethod, big.NewInt(200)),
+		pack(t, "curve", evmpools.CurveBalancesMethod, tokens(1000000, 18)),
+		pack(t, "curve", evmpools.CurveBalancesMethod, tokens(1200000, 6)),
+		pack(t, "curve", evmpools.CurveBalancesMethod, tokens(900000, 6)),
+	}
+
+	// the balances are read with the int128 variant of the balances method
+	client := mocks.NewEVMClient(t)
+	client.On("BatchCallContext", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		elems := args.Get(1).([]rpc.BatchElem)
+		require.Len(t, elems, len(responses))
+
+		for i, elem := range elems {
+			if i > 0 {
+				data := elem.Args[0].(map[string]interface{})["data"].(hexutil.Bytes)
+				require.Equal(t, abis.CurvePoolInt128.Methods[evmpools.CurveBalancesMethod].ID, []byte(data[:4]))
+			}
+
+			elem.Result = &responses[i]
+			elems[i] = elem
+		}
+	})
+
+	fetcher := createPriceFetcherWithClient(t, evmpools.DefaultCurveETHAPIConfig, client)
+	checkResponse(t, fetcher.Fetch(context.Background(), []types.ProviderTicker{ticker}), map[types.ProviderTicker]float64{
+		ticker: 0.99854251580673587690,
+	}, nil)
+}
+
+func TestFetchBalancer(t *testing.T) {
+	weights := []*big.Int{tokens(2, 17), tokens(8, 17)}
+
+	testCases := []struct {
+		name       string
+		tickers    []types.ProviderTicker
+		client     func() ethmulticlient.EVMClient
+		resolved   map[types.ProviderTicker]float64
+		unresolved []types.ProviderTicker
+	}{
+		{
+			name:    "pool without a pool id",
+			tickers: []types.ProviderTicker{usdcusdtTicker},
+			client: func() ethmulticlient.EVMClient {
+				return mocks.NewEVMClient(t)
+			},
+			unresolved: []types.ProviderTicker{usdcusdtTicker},
+		},
+		{
+			name:    "batch request has an error for a single call",
+			tickers: []types.ProviderTicker{wethusdcTicker},
+			client: func() ethmulticlient.EVMClient {
+				responses := []string{"", pack(t, "weighted", evmpools.BalancerWeightsMethod, weights)}
+				errs := []error{fmt.Errorf("request did not return a result"), nil}
+				return createEVMClientWithResponse(t, nil, responses, errs)
+			},
+			unresolved: []types.ProviderTicker{wethusdcTicker},
+		},
+		{
+			name:    "pool returns the wrong number of weights",
+			tickers: []types.ProviderTicker{wethusdcTicker},
+			client: func() ethmulticlient.EVMClient {
+				responses := []string{
+					pack(
+						t,
+						"vault",
+						evmpools.BalancerPoolTokensMethod,
+						addresses(2),
+						[]*big.Int{tokens(2000, 6), tokens(5, 18)},
+						big.NewInt(1),
+					),
+					pack(t, "weighted", evmpools.BalancerWeightsMethod, weights[:1]),
+				}
+				errs := []error{nil, nil}
+				return createEVMClientWithResponse(t, nil, responses, errs)
+			},
+			unresolved: []types.ProviderTicker{wethusdcTicker},
+		},
+		{
+			name:    "80/20 pool",
+			tickers: []types.ProviderTicker{wethusdcTicker},
+			client: func() ethmulticlient.EVMClient {
+				responses := []string{
+					pack(
+						t,
+						"vault",
+						evmpools.BalancerPoolTokensMethod,
+						addresses(2),
+						[]*big.Int{tokens(2000, 6), tokens(5, 18)},
+						big.NewInt(1),
+					),
+					pack(t, "weighted", evmpools.BalancerWeightsMethod, []*big.Int{tokens(8, 17), tokens(2, 17)}),
+				}
+				errs := []error{nil, nil}
+				return createEVMClientWithResponse(t, nil, responses, errs)
+			},
+			resolved: map[types.ProviderTicker]float64{
+				wethusdcTicker: 100,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fetcher := createPriceFetcherWithClient(t, evmpools.DefaultBalancerETHAPIConfig, tc.client())
+			checkResponse(t, fetcher.Fetch(context.Background(), tc.tickers), tc.resolved, tc.unresolved)
+		})
+	}
+}
+
+func TestGetPool(t *testing.T) {
+	fetcher := createPriceFetcherWithClient(t, evmpools.DefaultCurveETHAPIConfig, mocks.NewEVMClient(t))
+
+	t.Run("ticker is empty", func(t *testing.T) {
+		_, err := fetcher.GetPool(types.NewProviderTicker("", ""))
+		require.Error(t, err)
+	})
+
+	t.Run("ticker is not json formatted", func(t *testing.T) {
+		_, err := fetcher.GetPool(types.NewProviderTicker("USDC/USDT", "not json, something else"))
+		require.Error(t, err)
+	})
+
+	t.Run("ticker does not have valid metadata", func(t *testing.T) {
+		cfg := evmpools.PoolConfig{Address: "0x1234"}
+		_, err := fetcher.GetPool(types.NewProviderTicker("USDC/USDT", cfg.MustToJSON()))
+		require.Error(t, err)
+	})
+
+	t.Run("ticker has valid metadata", func(t *testing.T) {
+		pool, err := fetcher.GetPool(usdcusdtTicker)
+		require.NoError(t, err)
+		require.Equal(t, usdcusdtCfg, pool)
+	})
+}
+
+func TestNewPriceFetcher(t *testing.T) {
+	testCases := []struct {
+		name   string
+		logger *zap.Logger
+		api    config.APIConfig
+		err    error
+	}{
+		{
+			name:   "no logger errors",
+			logger: nil,
+			err:    fmt.Errorf("logger cannot be nil"),
+		},
+		{
+			name:   "invalid provider name errors",
+			logger: logger,
+			api: config.APIConfig{
+				Enabled:          true,
+				Timeout:          1,
+				ReconnectTimeout: 1,
+				Interval:         1,
+				MaxQueries:       1,
+				Endpoints:        []config.Endpoint{{URL: "http://localhost:0"}},
+				Name:             "curve_api-foobar",
+			},
+			err: fmt.Errorf("invalid api config name curve_api-foobar"),
+		},
+		{
+			name:   "disabled api errors",
+			logger: logger,
+			api: config.APIConfig{
+				Name: "balancer_api-ethereum",
+			},
+			err: fmt.Errorf("api config for balancer_api-ethereum is not enabled"),
+		},
+		{
+			name:   "curve url success",
+			logger: logger,
+			api: config.APIConfig{
+				Enabled:          true,
+				Timeout:          1,
+				ReconnectTimeout: 1,
+				Interval:         1,
+				MaxQueries:       1,
+				Endpoints:        []config.Endpoint{{URL: "http://localhost:0"}},
+				Name:             "curve_api-ethereum",
+			},
+		},
+		{
+			name:   "balancer url success",
+			logger: logger,
+			api: config.APIConfig{
+				Enabled:          true,
+				Timeout:          1,
+				ReconnectTimeout: 1,
+				Interval:         1,
+				MaxQueries:       1,
+				Endpoints:        []config.Endpoint{{URL: "http://localhost:0"}},
+				Name:             "balancer_api-ethereum",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			pf, err := evmpools.NewPriceFetcher(
+				context.TODO(),
+				tc.logger,
+				metrics.NewNopAPIMetrics(),
+				tc.api,
+			)
+			if tc.err != nil {
+				require.ErrorContains(t, err, tc.err.Error())
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, pf)
+			}
+		})
+	}
+}
+
+func checkResponse(
+	t *testing.T,
+	response types.PriceResponse,
+	resolved map[types.ProviderTicker]float64,
+	unresolved []types.ProviderTicker,
+) {
+	t.Helper()
+
+	require.Equal(t, len(resolved), len(response.Resolved))
+	require.Equal(t, len(unresolved), len(response.UnResolved))
+
+	for ticker, expected := range resolved {
+		require.Contains(t, response.Resolved, ticker)
+		actual, _ := response.Resolved[ticker].Value.Float64()
+		require.InDelta(t, expected, actual, 1e-12)
+	}
+
+	for _, ticker := range unresolved {
+		require.Contains(t, response.UnResolved, ticker)
+	}
+}
@@ -0,0 +1,124 @@
+package evmpools_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/skip-mev/slinky/oracle/config"
+	"github.com/skip-mev/slinky/oracle/types"
+	"github.com/skip-mev/slinky/providers/apis/defi/ethmulticlient"
+	"github.com/skip-mev/slinky/providers/apis/defi/ethmulticlient/mocks"
+	"github.com/skip-mev/slinky/providers/apis/defi/evmpools"
+)
+
+var (
+	logger, _ = zap.NewDevelopment()
+
+	// PoolConfigs used for testing.
+	usdcusdtCfg = evmpools.PoolConfig{
+		Address:    "0xbEbc44782C7dB0a1A60Cb6fe97d0b483032FF1C7",
+		Decimals:   []int64{18, 6, 6},
+		BaseIndex:  1,
+		QuoteIndex: 2,
+	}
+	wethusdcCfg = evmpools.PoolConfig{
+		Address:    "0x96646936b91d6B9D7D0c47C496AfBF3D6ec7B6f8",
+		PoolID:     "0x96646936b91d6b9d7d0c47c496afbf3d6ec7b6f8000200000000000000000019",
+		Decimals:   []int64{6, 18},
+		BaseIndex:  1,
+		QuoteIndex: 0,
+	}
+
+	// Tickers used for testing.
+	usdcusdtTicker = types.NewProviderTicker("USDC/USDT", usdcusdtCfg.MustToJSON())
+	wethusdcTicker = types.NewProviderTicker("WETH/USDC", wethusdcCfg.MustToJSON())
+)
+
+func createPriceFetcherWithClient(
+	t *testing.T,
+	api config.APIConfig,
+	client ethmulticlient.EVMClient,
+) *evmpools.PriceFetcher {
+	t.Helper()
+
+	fetcher, err := evmpools.NewPriceFetcherWithClient(
+		logger,
+		api,
+		client,
+	)
+	require.NoError(t, err)
+
+	return fetcher
+}
+
+func createEVMClientWithResponse(
+	t *testing.T,
+	failedRequestErr error,
+	responses []string,
+	errs []error,
+) ethmulticlient.EVMClient {
+	t.Helper()
+
+	c := mocks.NewEVMClient(t)
+	if failedRequestErr != nil {
+		c.On("BatchCallContext", mock.Anything, mock.Anything).Return(failedRequestErr)
+	} else {
+		c.On("BatchCallContext", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+			elems, ok := args.Get(1).([]rpc.BatchElem)
+			require.True(t, ok)
+			require.Equal(t, len(elems), len(responses))
+			require.Equal(t, len(elems), len(errs))
+
+			for i, elem := range elems {
+				elem.Result = &responses[i]
+				elem.Error = errs[i]
+				elems[i] = elem
+			}
+		})
+	}
+
+	return c
+}
+
+// pack returns the hex encoded outputs of the given method.
+func pack(t *testing.T, contract string, method string, values ...interface{}) string {
+	t.Helper()
+
+	abis, err := evmpools.NewABIs()
+	require.NoError(t, err)
+
+	var bz []byte
+	switch contract {
+	case "curve":
+		bz, err = abis.CurvePool.Methods[method].Outputs.Pack(values...)
+	case "vault":
+		bz, err = abis.BalancerVault.Methods[method].Outputs.Pack(values...)
+	case "weighted":
+		bz, err = abis.BalancerWeightedPool.Methods[method].Outputs.Pack(values...)
+	}
+	require.NoError(t, err)
+
+	return hexutil.Encode(bz)
+}
+
+// tokens returns the given number of token amounts with the given decimals.
+func tokens(amount int64, decimals int64) *big.Int {
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(decimals), nil)
+	return new(big.Int).Mul(big.NewInt(amount), scale)
+}
+
+// addresses returns n placeholder token addresses.
+func addresses(n int) []common.Address {
+	out := make([]common.Address, n)
+	for i := range out {
+		out[i] = common.BigToAddress(big.NewInt(int64(i + 1)))
+	}
+	return out
+}
@@ -0,0 +1,166 @@
+package evmpools
+
+import (
+	"fmt"
+	"math/big"
+)
+
+const (
+	// precision is the precision of the floats used to compute spot prices.
+	precision = 256
+
+	// maxIterations is the maximum number of newton iterations used to compute the Curve
+	// invariant. This matches the limit used by the Curve contracts.
+	maxIterations = 255
+)
+
+// tolerance is the relative tolerance at which the computation of the Curve invariant is
+// considered to have converged.
+var tolerance = new(big.Float).SetPrec(precision).SetFloat64(1e-40)
+
+// CurveSpotPrice returns the spot price of the base token in terms of the quote token in a Curve
+// stableswap pool with the given amplification coefficient and token balances. Balances are
+// scaled by the token decimals before the price is computed. The pool's invariant is
+//
+//	Ann * S + D = Ann * D + D^(n+1) / (n^n * P)
+//
+// where Ann = A * n, S is the sum and P is the product of the balances. The invariant D is
+// computed with newton's method, identically to the Curve contracts, and the spot price is the
+// ratio of the partial derivatives of the invariant with respect to the base and quote balances:
+//
+//	price = (Ann + D_P / x_base) / (Ann + D_P / x_quote), where D_P = D^(n+1) / (n^n * P).
+//
+// The returned price does not include the pool's swap fee.
+func CurveSpotPrice(
+	amp *big.Int,
+	balances []*big.Int,
+	decimals []int64,
+	base, quote int,
+) (*big.Float, error) {
+	if amp == nil || amp.Sign() <= 0 {
+		return nil, fmt.Errorf("amplification coefficient must be positive")
+	}
+
+	xp, err := scaleBalances(balances, decimals, base, quote)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		n   = newFloat().SetInt64(int64(len(xp)))
+		ann = newFloat().Mul(newFloat().SetInt(amp), n)
+		s   = newFloat()
+	)
+	for _, x := range xp {
+		s.Add(s, x)
+	}
+
+	// Compute the invariant D with newton's method, starting from the sum of the balances.
+	d := newFloat().Set(s)
+	converged := false
+	for i := 0; i < maxIterations && !converged; i++ {
+		dP := curveDP(d, xp, n)
+		prev := newFloat().Set(d)
+
+		// D = (Ann * S + D_P * n) * D / ((Ann - 1) * D + (n + 1) * D_P)
+		num := newFloat().Mul(ann, s)
+		num.Add(num, newFloat().Mul(dP, n))
+		num.Mul(num, d)
+
+		den := newFloat().Sub(ann, newFloat().SetInt64(1))
+		den.Mul(den, d)
+		den.Add(den, newFloat().Mul(newFloat().Add(n, newFloat().SetInt64(1)), dP))
+
+		d = newFloat().Quo(num, den)
+
+		diff := newFloat().Sub(d, prev)
+		converged = diff.Abs(diff).Cmp(newFloat().Mul(d, tolerance)) <= 0
+	}
+
+	if !converged {
+		return nil, fmt.Errorf("curve invariant did not converge")
+	}
+
+	dP := curveDP(d, xp, n)
+	num := newFloat().Add(ann, newFloat().Quo(dP, xp[base]))
+	den := newFloat().Add(ann, newFloat().Quo(dP, xp[quote]))
+
+	return newFloat().Quo(num, den), nil
+}
+
+// BalancerSpotPrice returns the spot price of the base token in terms of the quote token in a
+// Balancer weighted pool with the given token balances and normalized weights. Balances are
+// scaled by the token decimals before the price is computed:
+//
+//	price = (x_quote / w_quote) / (x_base / w_base).
+//
+// The returned price does not include the pool's swap fee.
+func BalancerSpotPrice(
+	balances []*big.Int,
+	weights []*big.Int,
+	decimals []int64,
+	base, quote int,
+) (*big.Float, error) {
+	if len(weights) != len(balances) {
+		return nil, fmt.Errorf("expected %d weights, got %d", len(balances), len(weights))
+	}
+
+	xp, err := scaleBalances(balances, decimals, base, quote)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, i := range []int{base, quote} {
+		if weights[i] == nil || weights[i].Sign() <= 0 {
+			return nil, fmt.Errorf("weight of token %d must be positive", i)
+		}
+	}
+
+	num := newFloat().Quo(xp[quote], newFloat().SetInt(weights[quote]))
+	den := newFloat().Quo(xp[base], newFloat().SetInt(weights[base]))
+
+	return newFloat().Quo(num, den), nil
+}
+
+// scaleBalances returns the balances scaled by the token decimals, validating that they match
+// the pool's configuration.
+func scaleBalances(
+	balances []*big.Int,
+	decimals []int64,
+	base, quote int,
+) ([]*big.Float, error) {
+	if len(balances) != len(decimals) {
+		return nil, fmt.Errorf("expected %d balances, got %d", len(decimals), len(balances))
+	}
+
+	if base < 0 || base >= len(balances) || quote < 0 || quote >= len(balances) || base == quote {
+		return nil, fmt.Errorf("invalid base index %d and quote index %d", base, quote)
+	}
+
+	xp := make([]*big.Float, len(balances))
+	for i, balance := range balances {
+		if balance == nil || balance.Sign() <= 0 {
+			return nil, fmt.Errorf("balance of token %d must be positive", i)
+		}
+
+		scale := newFloat().SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(decimals[i]), nil))
+		xp[i] = newFloat().Quo(newFloat().SetInt(balance), scale)
+	}
+
+	return xp, nil
+}
+
+// curveDP returns D^(n+1) / (n^n * P) for the given invariant and balances.
+func curveDP(d *big.Float, xp []*big.Float, n *big.Float) *big.Float {
+	dP := newFloat().Set(d)
+	for _, x := range xp {
+		dP.Mul(dP, d)
+		dP.Quo(dP, newFloat().Mul(x, n))
+	}
+
+	return dP
+}
+
+func newFloat() *big.Float {
+	return new(big.Float).SetPrec(precision)
+}
@@ -0,0 +1,189 @@
+package evmpools_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/providers/apis/defi/evmpools"
+)
+
+func TestCurveSpotPrice(t *testing.T) {
+	testCases := []struct {
+		name     string
+		amp      *big.Int
+		balances []*big.Int
+		decimals []int64
+		base     int
+		quote    int
+		expected float64
+		err      bool
+	}{
+		{
+			name:     "balanced pool has a price of 1",
+			amp:      big.NewInt(100),
+			balances: []*big.Int{tokens(1000, 18), tokens(1000, 6)},
+			decimals: []int64{18, 6},
+			base:     0,
+			quote:    1,
+			expected: 1,
+		},
+		{
+			name:     "scarce base token is more expensive",
+			amp:      big.NewInt(100),
+			balances: []*big.Int{tokens(1000, 18), tokens(2000, 6)},
+			decimals: []int64{18, 6},
+			base:     0,
+			quote:    1,
+			expected: 1.0083515392250513871,
+		},
+		{
+			name:     "three token pool",
+			amp:      big.NewInt(200),
+			balances: []*big.Int{tokens(1000000, 18), tokens(1200000, 6), tokens(900000, 6)},
+			decimals: []int64{18, 6, 6},
+			base:     1,
+			quote:    2,
+			expected: 0.99854251580673587690,
+		},
+		{
+			name:     "zero amplification coefficient",
+			amp:      big.NewInt(0),
+			balances: []*big.Int{tokens(1000, 18), tokens(1000, 6)},
+			decimals: []int64{18, 6},
+			base:     0,
+			quote:    1,
+			err:      true,
+		},
+		{
+			name:     "empty balance",
+			amp:      big.NewInt(100),
+			balances: []*big.Int{tokens(1000, 18), big.NewInt(0)},
+			decimals: []int64{18, 6},
+			base:     0,
+			quote:    1,
+			err:      true,
+		},
+		{
+			name:     "mismatched balances and decimals",
+			amp:      big.NewInt(100),
+			balances: []*big.Int{tokens(1000, 18)},
+			decimals: []int64{18, 6},
+			base:     0,
+			quote:    1,
+			err:      true,
+		},
+		{
+			name:     "base and quote are the same token",
+			amp:      big.NewInt(100),
+			balances: []*big.Int{tokens(1000, 18), tokens(1000, 6)},
+			decimals: []int64{18, 6},
+			base:     1,
+			quote:    1,
+			err:      true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			price, err := evmpools.CurveSpotPrice(tc.amp, tc.balances, tc.decimals, tc.base, tc.quote)
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			actual, _ := price.Float64()
+			require.InDelta(t, tc.expected, actual, 1e-15)
+		})
+	}
+
+	t.Run("inverse prices multiply to 1", func(t *testing.T) {
+		balances := []*big.Int{tokens(1234567, 18), tokens(7654321, 6), tokens(3333333, 8)}
+		decimals := []int64{18, 6, 8}
+
+		price, err := evmpools.CurveSpotPrice(big.NewInt(50), balances, decimals, 0, 2)
+		require.NoError(t, err)
+		inverse, err := evmpools.CurveSpotPrice(big.NewInt(50), balances, decimals, 2, 0)
+		require.NoError(t, err)
+
+		actual, _ := new(big.Float).Mul(price, inverse).Float64()
+		require.InDelta(t, 1, actual, 1e-15)
+	})
+}
+
+func TestBalancerSpotPrice(t *testing.T) {
+	weights := []*big.Int{tokens(2, 17), tokens(8, 17)}
+
+	testCases := []struct {
+		name     string
+		balances []*big.Int
+		weights  []*big.Int
+		decimals []int64
+		base     int
+		quote    int
+		expected float64
+		err      bool
+	}{
+		{
+			name:     "80/20 pool",
+			balances: []*big.Int{tokens(2000, 6), tokens(5, 18)},
+			weights:  weights,
+			decimals: []int64{6, 18},
+			base:     1,
+			quote:    0,
+			expected: 1600,
+		},
+		{
+			name:     "80/20 pool inverted",
+			balances: []*big.Int{tokens(2000, 6), tokens(5, 18)},
+			weights:  weights,
+			decimals: []int64{6, 18},
+			base:     0,
+			quote:    1,
+			expected: 1.0 / 1600,
+		},
+		{
+			name:     "missing weights",
+			balances: []*big.Int{tokens(2000, 6), tokens(5, 18)},
+			weights:  weights[:1],
+			decimals: []int64{6, 18},
+			base:     1,
+			quote:    0,
+			err:      true,
+		},
+		{
+			name:     "zero weight",
+			balances: []*big.Int{tokens(2000, 6), tokens(5, 18)},
+			weights:  []*big.Int{big.NewInt(0), tokens(1, 18)},
+			decimals: []int64{6, 18},
+			base:     1,
+			quote:    0,
+			err:      true,
+		},
+		{
+			name:     "empty balance",
+			balances: []*big.Int{tokens(2000, 6), big.NewInt(0)},
+			weights:  weights,
+			decimals: []int64{6, 18},
+			base:     1,
+			quote:    0,
+			err:      true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			price, err := evmpools.BalancerSpotPrice(tc.balances, tc.weights, tc.decimals, tc.base, tc.quote)
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			actual, _ := price.Float64()
+			require.InDelta(t, tc.expected, actual, 1e-12)
+		})
+	}
+}
@@ -0,0 +1,188 @@
+package evmpools
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/skip-mev/slinky/oracle/config"
+	"github.com/skip-mev/slinky/oracle/constants"
+)
+
+const (
+	// CurveBaseName is the name of the Curve stableswap pool API.
+	CurveBaseName = "curve_api"
+
+	// BalancerBaseName is the name of the Balancer weighted pool API.
+	BalancerBaseName = "balancer_api"
+
+	// NameSeparator is the character used to separate elements of dynamic naming for the provider.
+	NameSeparator = "-"
+
+	// DefaultBalancerVault is the address of the Balancer V2 vault, which holds the balances of
+	// all Balancer V2 pools. The vault is deployed at the same address on every supported chain.
+	DefaultBalancerVault = "0xBA12222222228d8Ba445958a75a0704d566BF2C8"
+
+	// ETH_URL is the URL for the Curve and Balancer APIs. This uses a free public RPC provider on
+	// Ethereum Mainnet.
+	ETH_URL = "https://eth.public-rpc.com/"
+)
+
+var (
+	// CurveProviderNames is the set of all supported "dynamic" names of the Curve provider mapped
+	// by chain.
+	CurveProviderNames = map[string]string{
+		constants.ETHEREUM: strings.Join([]string{CurveBaseName, constants.ETHEREUM}, NameSeparator),
+	}
+
+	// BalancerProviderNames is the set of all supported "dynamic" names of the Balancer provider
+	// mapped by chain.
+	BalancerProviderNames = map[string]string{
+		constants.ETHEREUM: strings.Join([]string{BalancerBaseName, constants.ETHEREUM}, NameSeparator),
+	}
+)
+
+// Protocol returns the base name of the protocol of the provider with the given name, or false if
+// the name is not a supported "dynamic" name. Dynamic provider naming is supported via
+// `BaseName“NameSeparator“SupportedChain`.
+func Protocol(name string) (string, bool) {
+	for _, providerName := range CurveProviderNames {
+		if name == providerName {
+			return CurveBaseName, true
+		}
+	}
+
+	for _, providerName := range BalancerProviderNames {
+		if name == providerName {
+			return BalancerBaseName, true
+		}
+	}
+
+	return "", false
+}
+
+// IsValidProviderName returns a bool based on the validity of the passed in name.
+func IsValidProviderName(name string) bool {
+	_, ok := Protocol(name)
+	return ok
+}
+
+// PoolConfig is the configuration for a Curve stableswap or Balancer weighted pool. This is
+// specific to each pair of tokens.
+type PoolConfig struct {
+	// Address is the address of the pool contract.
+	Address string `json:"address"`
+	// PoolID is the id of the pool in the Balancer vault. This is required for Balancer pools.
+	PoolID string `json:"pool_id,omitempty"`
+	// Vault is the address of the Balancer vault. This defaults to DefaultBalancerVault.
+	Vault string `json:"vault,omitempty"`
+	// Decimals are the number of decimals of each token in the pool, in the order in which the
+	// pool stores the tokens. This should be derived from the token contracts.
+	Decimals []int64 `json:"decimals"`
+	// BaseIndex is the index of the base token in the pool.
+	BaseIndex int `json:"base_index"`
+	// QuoteIndex is the index of the quote token in the pool.
+	QuoteIndex int `json:"quote_index"`
+	// IndexType is the type of the index argument of the balances method of a Curve pool. This
+	// must be either "uint256" or "int128", and defaults to "uint256". Older Curve pools, e.g.
+	// the compound and susd pools, take an int128 index.
+	IndexType string `json:"index_type,omitempty"`
+}
+
+// ValidateBasic validates the pool configuration.
+func (pc *PoolConfig) ValidateBasic() error {
+	if !common.IsHexAddress(pc.Address) {
+		return fmt.Errorf("pool address is not a valid ethereum address")
+	}
+
+	if len(pc.PoolID) > 0 {
+		bz, err := hexutil.Decode(pc.PoolID)
+		if err != nil || len(bz) != common.HashLength {
+			return fmt.Errorf("pool id must be a %d byte hex string", common.HashLength)
+		}
+	}
+
+	if len(pc.Vault) > 0 && !common.IsHexAddress(pc.Vault) {
+		return fmt.Errorf("vault address is not a valid ethereum address")
+	}
+
+	if len(pc.Decimals) < 2 {
+		return fmt.Errorf("pool must have at least 2 tokens")
+	}
+
+	for _, decimals := range pc.Decimals {
+		if decimals < 0 {
+			return fmt.Errorf("token decimals must be non-negative")
+		}
+	}
+
+	if pc.BaseIndex < 0 || pc.BaseIndex >= len(pc.Decimals) {
+		return fmt.Errorf("base index %d is out of range", pc.BaseIndex)
+	}
+
+	if pc.QuoteIndex < 0 || pc.QuoteIndex >= len(pc.Decimals) {
+		return fmt.Errorf("quote index %d is out of range", pc.QuoteIndex)
+	}
+
+	if pc.BaseIndex == pc.QuoteIndex {
+		return fmt.Errorf("base and quote index must be different")
+	}
+
+	switch pc.IndexType {
+	case "", CurveIndexTypeUint256, CurveIndexTypeInt128:
+	default:
+		return fmt.Errorf("index type must be one of %s or %s, got %s", CurveIndexTypeUint256, CurveIndexTypeInt128, pc.IndexType)
+	}
+
+	return nil
+}
+
+// GetVault returns the address of the Balancer vault that holds the pool's balances.
+func (pc PoolConfig) GetVault() string {
+	if len(pc.Vault) > 0 {
+		return pc.Vault
+	}
+
+	return DefaultBalancerVault
+}
+
+// MustToJSON converts the pool configuration to JSON.
+func (pc PoolConfig) MustToJSON() string {
+	b, err := json.Marshal(pc)
+	if err != nil {
+		panic(err)
+	}
+	return string(b)
+}
+
+var (
+	// DefaultCurveETHAPIConfig is the default configuration for the Curve API. Specifically this
+	// is for Ethereum mainnet.
+	DefaultCurveETHAPIConfig = config.APIConfig{
+		Name:             CurveProviderNames[constants.ETHEREUM],
+		Atomic:           true,
+		Enabled:          true,
+		Timeout:          1000 * time.Millisecond,
+		Interval:         2000 * time.Millisecond,
+		ReconnectTimeout: 2000 * time.Millisecond,
+		MaxQueries:       1,
+		Endpoints:        []config.Endpoint{{URL: ETH_URL}},
+	}
+
+	// DefaultBalancerETHAPIConfig is the default configuration for the Balancer API. Specifically
+	// this is for Ethereum mainnet.
+	DefaultBalancerETHAPIConfig = config.APIConfig{
+		Name:             BalancerProviderNames[constants.ETHEREUM],
+		Atomic:           true,
+		Enabled:          true,
+		Timeout:          1000 * time.Millisecond,
+		Interval:         2000 * time.Millisecond,
+		ReconnectTimeout: 2000 * time.Millisecond,
+		MaxQueries:       1,
+		Endpoints:        []config.Endpoint{{URL: ETH_URL}},
+	}
+)
@@ -0,0 +1,147 @@
+package evmpools_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/oracle/constants"
+	"github.com/skip-mev/slinky/providers/apis/defi/evmpools"
+)
+
+func TestPoolConfig(t *testing.T) {
+	valid := func() evmpools.PoolConfig {
+		return evmpools.PoolConfig{
+			Address:    "0xbEbc44782C7dB0a1A60Cb6fe97d0b483032FF1C7",
+			Decimals:   []int64{18, 6, 6},
+			BaseIndex:  1,
+			QuoteIndex: 2,
+		}
+	}
+
+	testCases := []struct {
+		name   string
+		modify func(*evmpools.PoolConfig)
+		err    bool
+	}{
+		{
+			name:   "valid config",
+			modify: func(*evmpools.PoolConfig) {},
+		},
+		{
+			name: "valid config with pool id and vault",
+			modify: func(cfg *evmpools.PoolConfig) {
+				cfg.PoolID = "0x96646936b91d6b9d7d0c47c496afbf3d6ec7b6f8000200000000000000000019"
+				cfg.Vault = evmpools.DefaultBalancerVault
+			},
+		},
+		{
+			name: "invalid address",
+			modify: func(cfg *evmpools.PoolConfig) {
+				cfg.Address = "invalid"
+			},
+			err: true,
+		},
+		{
+			name: "pool id is too short",
+			modify: func(cfg *evmpools.PoolConfig) {
+				cfg.PoolID = "0x1234"
+			},
+			err: true,
+		},
+		{
+			name: "invalid vault",
+			modify: func(cfg *evmpools.PoolConfig) {
+				cfg.Vault = "invalid"
+			},
+			err: true,
+		},
+		{
+			name: "single token",
+			modify: func(cfg *evmpools.PoolConfig) {
+				cfg.Decimals = []int64{18}
+				cfg.BaseIndex = 0
+				cfg.QuoteIndex = 0
+			},
+			err: true,
+		},
+		{
+			name: "negative decimals",
+			modify: func(cfg *evmpools.PoolConfig) {
+				cfg.Decimals = []int64{18, -1, 6}
+			},
+			err: true,
+		},
+		{
+			name: "base index out of range",
+			modify: func(cfg *evmpools.PoolConfig) {
+				cfg.BaseIndex = 3
+			},
+			err: true,
+		},
+		{
+			name: "quote index out of range",
+			modify: func(cfg *evmpools.PoolConfig) {
+				cfg.QuoteIndex = -1
+			},
+			err: true,
+		},
+		{
+			name: "valid int128 index type",
+			modify: func(cfg *evmpools.PoolConfig) {
+				cfg.IndexType = evmpools.CurveIndexTypeInt128
+			},
+		},
+		{
+			name: "invalid index type",
+			modify: func(cfg *evmpools.PoolConfig) {
+				cfg.IndexType = "int256"
+			},
+			err: true,
+		},
+		{
+			name: "same base and quote index",
+			modify: func(cfg *evmpools.PoolConfig) {
+				cfg.QuoteIndex = cfg.BaseIndex
+			},
+			err: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := valid()
+			tc.modify(&cfg)
+
+			if tc.err {
+				require.Error(t, cfg.ValidateBasic())
+			} else {
+				require.NoError(t, cfg.ValidateBasic())
+			}
+		})
+	}
+
+	t.Run("vault defaults to the balancer v2 vault", func(t *testing.T) {
+		cfg := valid()
+		require.Equal(t, evmpools.DefaultBalancerVault, cfg.GetVault())
+
+		cfg.Vault = "0x0000000000000000000000000000000000000001"
+		require.Equal(t, cfg.Vault, cfg.GetVault())
+	})
+}
+
+func TestProtocol(t *testing.T) {
+	protocol, ok := evmpools.Protocol(evmpools.CurveProviderNames[constants.ETHEREUM])
+	require.True(t, ok)
+	require.Equal(t, evmpools.CurveBaseName, protocol)
+
+	protocol, ok = evmpools.Protocol(evmpools.BalancerProviderNames[constants.ETHEREUM])
+	require.True(t, ok)
+	require.Equal(t, evmpools.BalancerBaseName, protocol)
+
+	for _, name := range []string{evmpools.CurveBaseName, "curve_api-foobar", "uniswapv3_api-ethereum"} {
+		_, ok := evmpools.Protocol(name)
+		require.False(t, ok)
+		require.False(t, evmpools.IsValidProviderName(name))
+	}
+}
@@ -15,12 +15,26 @@ import (
 	"github.com/skip-mev/slinky/oracle/config"
 	oracletypes "github.com/skip-mev/slinky/oracle/types"
 	"github.com/skip-mev/slinky/pkg/math"
+	apihandlers "github.com/skip-mev/slinky/providers/base/api/handlers"
 	"github.com/skip-mev/slinky/providers/base/api/metrics"
+	"github.com/skip-mev/slinky/providers/registry"
 	providertypes "github.com/skip-mev/slinky/providers/types"
 )
 
 var _ oracletypes.PriceAPIFetcher = (*APIPriceFetcher)(nil)
 
+func init() {
+	registry.RegisterAPIProvider(Name, func(
+		_ context.Context,
+		logger *zap.Logger,
+		cfg config.ProviderConfig,
+		_ apihandlers.RequestHandler,
+		m metrics.APIMetrics,
+	) (oracletypes.PriceAPIFetcher, error) {
+		return NewAPIPriceFetcher(logger, cfg.API, m)
+	})
+}
+
 // SolanaJSONRPCClient is the expected interface for a solana JSON-RPC client according
 // to the APIPriceFetcher.
 //
@@ -151,6 +165,8 @@ func NewAPIPriceFetcherWithClient(
 //   - Query the raydium API base (coin) / quote (pc) token vault addresses
 //   - Normalize the token balances by 1e18
 //   - Calculate the price as quote / base, and scale by ticker.Decimals
+//   - Calculate the liquidity of the pool from the quote token balance, and drop the price
+//     if it is below the minimum liquidity configured for the ticker
 func (pf *APIPriceFetcher) Fetch(
 	ctx context.Context,
 	tickers []oracletypes.ProviderTicker,
@@ -269,8 +285,25 @@ func (pf *APIPriceFetcher) Fetch(
 			zap.String("price", price.String()),
 		)
 
+		// drop the price if the pool does not have enough liquidity
+		liquidity := calculateLiquidity(quoteTokenBalance, metadata.QuoteTokenVault.TokenDecimals)
+		if metadata.MinLiquidity > 0 && liquidity.Cmp(big.NewFloat(metadata.MinLiquidity)) < 0 {
+			pf.logger.Debug(
+				"pool liquidity is below the minimum",
+				zap.String("ticker", ticker.String()),
+				zap.String("liquidity", liquidity.String()),
+			)
+			unresolved[ticker] = providertypes.UnresolvedResult{
+				ErrorWithCode: providertypes.NewErrorWithCode(
+					InsufficientLiquidityError(ticker.String(), liquidity, metadata.MinLiquidity),
+					providertypes.ErrorInsufficientLiquidity,
+				),
+			}
+			continue
+		}
+
 		// return the price
-		resolved[ticker] = oracletypes.NewPriceResult(price, time.Now().UTC())
+		resolved[ticker] = oracletypes.NewPriceResultWithLiquidity(price, time.Now().UTC(), liquidity)
 	}
 
 	return oracletypes.NewPriceResponse(resolved, unresolved)
@@ -312,3 +345,20 @@ func calculatePrice(
 
 	return new(big.Float).Mul(quo, scalingFactor)
 }
+
+// calculateLiquidity returns the liquidity of the pool denominated in the quote token. The base
+// and quote token reserves of the pool are worth the same at the pool's price, so the liquidity
+// is twice the quote token balance, normalized by the quote token decimals.
+func calculateLiquidity(
+	quoteTokenBalance *big.Int,
+	quoteTokenDecimals uint64,
+) *big.Float {
+	scale := new(big.Int).Exp(big.NewInt(10), new(big.Int).SetUint64(quoteTokenDecimals), nil)
+
+	liquidity := new(big.Float).Quo(
+		new(big.Float).SetInt(quoteTokenBalance),
+		new(big.Float).SetInt(scale),
+	)
+
+	return liquidity.Mul(liquidity, big.NewFloat(2))
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/skip-mev/slinky/providers/apis/defi/raydium"
 	"github.com/skip-mev/slinky/providers/apis/defi/raydium/mocks"
 	"github.com/skip-mev/slinky/providers/base/api/metrics"
+	providertypes "github.com/skip-mev/slinky/providers/types"
 )
 
 const (
@@ -68,6 +69,21 @@ func TestTickerMetadataValidateBasic(t *testing.T) {
 			},
 			expFail: true,
 		},
+		{
+			name: "negative min liquidity",
+			TickerMetadata: raydium.TickerMetadata{
+				BaseTokenVault: raydium.AMMTokenVaultMetadata{
+					TokenVaultAddress: USDCVaultAddress,
+					TokenDecimals:     6,
+				},
+				QuoteTokenVault: raydium.AMMTokenVaultMetadata{
+					TokenVaultAddress: USDCVaultAddress,
+					TokenDecimals:     6,
+				},
+				MinLiquidity: -1,
+			},
+			expFail: true,
+		},
 		{
 			name: "valid",
 			TickerMetadata: raydium.TickerMetadata{
@@ -364,6 +380,73 @@ func TestProviderFetch(t *testing.T) {
 		require.True(t, strings.Contains(resp.UnResolved[tickers[0]].Error(), "solana json-rpc error"))
 		result := resp.Resolved[tickers[1]]
 		require.Equal(t, result.Value.SetPrec(30), big.NewFloat(3).SetPrec(30))
+		require.Equal(t, result.Liquidity.SetPrec(30), big.NewFloat(6).SetPrec(30))
+	})
+
+	t.Run("prices of pools below the minimum liquidity are dropped", func(t *testing.T) {
+		ctx := context.Background()
+		ethVaultPk := solana.MustPublicKeyFromBase58(ETHVaultAddress)
+		usdtVaultPk := solana.MustPublicKeyFromBase58(USDTVaultAddress)
+
+		ethVaultBz := new(bytes.Buffer)
+		ethVaultTokenMetadata := token.Account{
+			Amount: uint64(1e18),
+		}
+		ethVaultTokenMetadata.MarshalWithEncoder(bin.NewBinEncoder(ethVaultBz))
+
+		usdtVaultBz := new(bytes.Buffer)
+		usdtTokenVaultMetadata := token.Account{
+			Amount: 3 * (1e6),
+		}
+		usdtTokenVaultMetadata.MarshalWithEncoder(bin.NewBinEncoder(usdtVaultBz))
+
+		client.On("GetMultipleAccountsWithOpts", mock.Anything, []solana.PublicKey{
+			ethVaultPk, usdtVaultPk,
+		}, &rpc.GetMultipleAccountsOpts{
+			Commitment: rpc.CommitmentFinalized,
+		}).Return(
+			&rpc.GetMultipleAccountsResult{
+				Value: []*rpc.Account{
+					{
+						Data: rpc.DataBytesOrJSONFromBytes(ethVaultBz.Bytes()),
+					},
+					{
+						Data: rpc.DataBytesOrJSONFromBytes(usdtVaultBz.Bytes()),
+					},
+				},
+			}, nil,
+		)
+
+		thinMetadata := ethUSDTMetadata
+		thinMetadata.MinLiquidity = 6.5
+		thin := types.DefaultProviderTicker{
+			OffChainTicker: "ETH/USDT",
+			JSON:           marshalDataToJSON(thinMetadata),
+		}
+
+		// metadata is cached per ticker, so each ticker is fetched with a new price fetcher.
+		thinPf, err := newPriceFetcher(client)
+		require.NoError(t, err)
+
+		resp := thinPf.Fetch(ctx, []types.ProviderTicker{thin})
+		require.Equal(t, len(resp.Resolved), 0)
+		require.Equal(t, len(resp.UnResolved), 1)
+		require.Equal(t, providertypes.ErrorInsufficientLiquidity, resp.UnResolved[thin].Code())
+
+		deepMetadata := ethUSDTMetadata
+		deepMetadata.MinLiquidity = 6
+		deep := types.DefaultProviderTicker{
+			OffChainTicker: "ETH/USDT",
+			JSON:           marshalDataToJSON(deepMetadata),
+		}
+
+		deepPf, err := newPriceFetcher(client)
+		require.NoError(t, err)
+
+		resp = deepPf.Fetch(ctx, []types.ProviderTicker{deep})
+		require.Equal(t, len(resp.Resolved), 1)
+		require.Equal(t, len(resp.UnResolved), 0)
+		require.Equal(t, resp.Resolved[deep].Value.SetPrec(30), big.NewFloat(3).SetPrec(30))
 	})
 
 	t.Run("incorrectly encoded accounts are handled gracefully", func(t *testing.T) {
@@ -3,6 +3,7 @@ package raydium
 import (
 	"encoding/json"
 	"fmt"
+	"math/big"
 	"time"
 
 	"github.com/gagliardetto/solana-go"
@@ -46,9 +47,15 @@ type TickerMetadata struct {
 
 	// QuoteTokenVault is the metadata associated with the quote token's token vault
 	QuoteTokenVault AMMTokenVaultMetadata `json:"quote_token_vault"`
+
+	// MinLiquidity is the minimum liquidity of the pool, denominated in the quote token, required
+	// for its price to be reported. Prices of pools with less liquidity are dropped. This is
+	// optional and no minimum is enforced if it is not set.
+	MinLiquidity float64 `json:"min_liquidity,omitempty"`
 }
 
-// ValidateBasic checks that the solana token vault addresses are valid.
+// ValidateBasic checks that the solana token vault addresses are valid and that the minimum
+// liquidity is non-negative.
 func (metadata TickerMetadata) ValidateBasic() error {
 	if _, err := solana.PublicKeyFromBase58(metadata.BaseTokenVault.TokenVaultAddress); err != nil {
 		return err
@@ -58,6 +65,10 @@ func (metadata TickerMetadata) ValidateBasic() error {
 		return err
 	}
 
+	if metadata.MinLiquidity < 0 {
+		return fmt.Errorf("min liquidity must be non-negative")
+	}
+
 	return nil
 }
 
@@ -91,6 +102,17 @@ func NoRaydiumMetadataForTickerError(ticker string) error {
 	return fmt.Errorf("no raydium metadata for ticker: %s", ticker)
 }
 
+// InsufficientLiquidityError is returned when the liquidity of a ticker's pool is below the
+// minimum liquidity configured for the ticker.
+func InsufficientLiquidityError(ticker string, liquidity *big.Float, minLiquidity float64) error {
+	return fmt.Errorf(
+		"liquidity %s of pool for ticker %s is below the minimum of %s",
+		liquidity.Text('f', 6),
+		ticker,
+		big.NewFloat(minLiquidity).Text('f', 6),
+	)
+}
+
 // SolanaJSONRPCError is returned when there is an error querying the solana JSON-RPC client.
 func SolanaJSONRPCError(err error) error {
 	return fmt.Errorf("solana json-rpc error: %s", err.Error())
@@ -3,6 +3,7 @@ package uniswapv3
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math/big"
 	"time"
@@ -18,15 +19,37 @@ import (
 	"github.com/skip-mev/slinky/oracle/types"
 	"github.com/skip-mev/slinky/providers/apis/defi/ethmulticlient"
 	uniswappool "github.com/skip-mev/slinky/providers/apis/defi/uniswapv3/pool"
+	apihandlers "github.com/skip-mev/slinky/providers/base/api/handlers"
 	"github.com/skip-mev/slinky/providers/base/api/metrics"
+	"github.com/skip-mev/slinky/providers/registry"
 	providertypes "github.com/skip-mev/slinky/providers/types"
 )
 
 var _ types.PriceAPIFetcher = (*PriceFetcher)(nil)
 
+func init() {
+	// Each chain is configured under a dynamic name of the form `uniswapv3_api-<chain>`.
+	registry.RegisterDynamicAPIProvider(BaseName, func(
+		ctx context.Context,
+		logger *zap.Logger,
+		cfg config.ProviderConfig,
+		_ apihandlers.RequestHandler,
+		m metrics.APIMetrics,
+	) (types.PriceAPIFetcher, error) {
+		return NewPriceFetcher(ctx, logger, m, cfg.API)
+	})
+}
+
 // UniswapV3PriceFetcher is the Uniswap V3 price fetcher. This fetcher is responsible for
 // querying Uniswap V3 pool contracts and returning the price of a given ticker. The price is
-// derived from the slot 0 data of the pool contract.
+// derived from the slot 0 data of the pool contract. The in-range liquidity of the pool is
+// reported alongside the price, and prices of pools with less liquidity than the minimum
+// configured for the pool are dropped.
+//
+// All calls are pinned to the latest block height reported by the EVM client, and the prices are
+// timestamped with the time of that block, so that prices read from a stale block are filtered
+// by the oracle. When multiple endpoints are configured, responses from endpoints that lag
+// behind are dropped by the MultiRPCClient.
 //
 // To read more about how the price is calculated, see the Uniswap V3 documentation
 // https://blog.uniswap.org/uniswap-v3-math-primer.
@@ -46,6 +69,9 @@ type PriceFetcher struct {
 	// payload is the packed slot0 call to the pool contract. Since the slot0 payload is the same
 	// for all pools, we can reuse this payload for all pools.
 	payload []byte
+	// liquidityPayload is the packed liquidity call to the pool contract. This is likewise the
+	// same for all pools.
+	liquidityPayload []byte
 	// poolCache is a cache of the tickers to pool configs. This is used to avoid unmarshalling
 	// the metadata for each ticker.
 	poolCache map[types.ProviderTicker]PoolConfig
@@ -132,20 +158,26 @@ func NewPriceFetcherWithClient(
 		return nil, fmt.Errorf("failed to pack slot0: %w", err)
 	}
 
+	liquidityPayload, err := abi.Pack(LiquidityMethod)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack liquidity: %w", err)
+	}
+
 	return &PriceFetcher{
-		logger:    logger.With(zap.String("fetcher", api.Name)),
-		api:       api,
-		client:    client,
-		abi:       abi,
-		payload:   payload,
-		poolCache: make(map[types.ProviderTicker]PoolConfig),
+		logger:           logger.With(zap.String("fetcher", api.Name)),
+		api:              api,
+		client:           client,
+		abi:              abi,
+		payload:          payload,
+		liquidityPayload: liquidityPayload,
+		poolCache:        make(map[types.ProviderTicker]PoolConfig),
 	}, nil
 }
 
 // Fetch returns the price of a given set of tickers. This fetch utilizes the batch call to lower
 // overhead of making individual RPC calls for each ticker. The fetcher will query the Uniswap V3
-// pool contract for the price of the pool. The price is derived from the slot 0 data of the pool
-// contract, specifically the sqrtPriceX96 value.
+// pool contract for the price and liquidity of the pool at the latest block height. The price is
+// derived from the slot 0 data of the pool contract, specifically the sqrtPriceX96 value.
 func (u *PriceFetcher) Fetch(
 	ctx context.Context,
 	tickers []types.ProviderTicker,
@@ -155,8 +187,9 @@ func (u *PriceFetcher) Fetch(
 		unResolved = make(types.UnResolvedPrices)
 	)
 
-	// Create a batch element for each ticker and pool.
-	batchElems := make([]rpc.BatchElem, len(tickers))
+	// Create a slot0 and a liquidity batch element for each ticker and pool. The first batch
+	// element is reserved for the header of the block that the calls are pinned to.
+	batchElems := make([]rpc.BatchElem, 1+2*len(tickers))
 	pools := make([]PoolConfig, len(tickers))
 	for i, ticker := range tickers {
 		pool, err := u.GetPool(ticker)
@@ -176,22 +209,29 @@ func (u *PriceFetcher) Fetch(
 			)
 		}
 
-		// Create a batch element for the ticker and pool.
-		var result string
-		batchElems[i] = rpc.BatchElem{
-			Method: "eth_call",
-			Args: []interface{}{
-				map[string]interface{}{
-					"to":   common.HexToAddress(pool.Address),
-					"data": hexutil.Bytes(u.payload), // slot0 call to the pool contract.
-				},
-				"latest", // latest signifies the latest block.
-			},
-			Result: &result,
-		}
 		pools[i] = pool
 	}
 
+	// Pin the calls to the latest block height.
+	height, err := u.GetHeight(ctx)
+	if err != nil {
+		u.logger.Debug(
+			"failed to get block height",
+			zap.Error(err),
+		)
+
+		return types.NewPriceResponseWithErr(
+			tickers,
+			providertypes.NewErrorWithCode(err, providertypes.ErrorAPIGeneral),
+		)
+	}
+
+	batchElems[0] = ethmulticlient.EthGetBlockByNumberBatchElem(height)
+	for i, pool := range pools {
+		batchElems[1+2*i] = newCall(pool.Address, u.payload, height)          // slot0 call to the pool contract.
+		batchElems[2+2*i] = newCall(pool.Address, u.liquidityPayload, height) // liquidity call to the pool contract.
+	}
+
 	// Batch call to the EVM.
 	if err := u.client.BatchCallContext(ctx, batchElems); err != nil {
 		u.logger.Debug(
@@ -205,19 +245,38 @@ func (u *PriceFetcher) Fetch(
 		)
 	}
 
+	// The prices are timestamped with the time of the block that the calls are pinned to.
+	header, err := ethmulticlient.ParseBlockHeader(batchElems[0])
+	if err == nil && uint64(header.Number) != height {
+		err = fmt.Errorf("expected block %d, got block %d", height, header.Number)
+	}
+	if err != nil {
+		u.logger.Debug(
+			"failed to get block header",
+			zap.Uint64("height", height),
+			zap.Error(err),
+		)
+
+		return types.NewPriceResponseWithErr(
+			tickers,
+			providertypes.NewErrorWithCode(err, providertypes.ErrorAPIGeneral),
+		)
+	}
+	timestamp := time.Unix(int64(header.Timestamp), 0).UTC()
+
 	// Parse the result from the batch call for each ticker.
 	for i, ticker := range tickers {
-		result := batchElems[i]
-		if result.Error != nil {
+		result, liquidityResult := batchElems[1+2*i], batchElems[2+2*i]
+		if err := errors.Join(result.Error, liquidityResult.Error); err != nil {
 			u.logger.Debug(
 				"failed to batch call to ethereum network for ticker",
 				zap.String("ticker", ticker.String()),
-				zap.Error(result.Error),
+				zap.Error(err),
 			)
 
 			unResolved[ticker] = providertypes.UnresolvedResult{
 				ErrorWithCode: providertypes.NewErrorWithCode(
-					result.Error,
+					err,
 					providertypes.ErrorUnknown,
 				),
 			}
@@ -225,7 +284,7 @@ func (u *PriceFetcher) Fetch(
 			continue
 		}
 
-		// Parse the sqrtPriceX96 from the result.
+		// Parse the sqrtPriceX96 and liquidity from the results.
 		sqrtPriceX96, err := u.ParseSqrtPriceX96(result.Result)
 		if err != nil {
 			u.logger.Debug(
@@ -244,18 +303,65 @@ func (u *PriceFetcher) Fetch(
 			continue
 		}
 
+		liquidity, err := u.ParseLiquidity(liquidityResult.Result)
+		if err != nil {
+			u.logger.Debug(
+				"failed to parse liquidity",
+				zap.String("ticker", ticker.String()),
+				zap.Error(err),
+			)
+
+			unResolved[ticker] = providertypes.UnresolvedResult{
+				ErrorWithCode: providertypes.NewErrorWithCode(
+					err,
+					providertypes.ErrorFailedToParsePrice,
+				),
+			}
+
+			continue
+		}
+
+		// Drop the price if the pool does not have enough liquidity.
+		depth := ConvertLiquidity(pools[i], sqrtPriceX96, liquidity)
+		if err := CheckLiquidity(pools[i], depth); err != nil {
+			u.logger.Debug(
+				"pool liquidity is below the minimum",
+				zap.String("ticker", ticker.String()),
+				zap.Error(err),
+			)
+
+			unResolved[ticker] = providertypes.UnresolvedResult{
+				ErrorWithCode: providertypes.NewErrorWithCode(
+					err,
+					providertypes.ErrorInsufficientLiquidity,
+				),
+			}
+
+			continue
+		}
+
 		// Convert the sqrtPriceX96 to a price. This is the raw, unscaled price.
 		price := ConvertSquareRootX96Price(sqrtPriceX96)
 
 		// Scale the price to the respective token decimals.
 		scaledPrice := ScalePrice(pools[i], price)
-		resolved[ticker] = types.NewPriceResult(scaledPrice, time.Now().UTC())
+		resolved[ticker] = types.NewPriceResultWithLiquidity(scaledPrice, timestamp, depth)
 	}
 
 	// Add the price to the resolved prices.
 	return types.NewPriceResponse(resolved, unResolved)
 }
 
+// GetHeight returns the latest block height reported by the EVM client.
+func (u *PriceFetcher) GetHeight(ctx context.Context) (uint64, error) {
+	elems := []rpc.BatchElem{ethmulticlient.EthBlockNumberBatchElem()}
+	if err := u.client.BatchCallContext(ctx, elems); err != nil {
+		return 0, err
+	}
+
+	return ethmulticlient.ParseBlockNumber(elems[0])
+}
+
 // GetPool returns the uniswap pool for the given ticker. This will unmarshal the metadata
 // and validate the pool config which contains all required information to query the EVM.
 func (u *PriceFetcher) GetPool(
@@ -281,6 +387,34 @@ func (u *PriceFetcher) GetPool(
 func (u *PriceFetcher) ParseSqrtPriceX96(
 	result interface{},
 ) (*big.Int, error) {
+	out, err := u.unpack(ContractMethod, result)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse the sqrtPriceX96 from the result.
+	sqrtPriceX96 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	return sqrtPriceX96, nil
+}
+
+// ParseLiquidity parses the in-range liquidity of the pool from the result of the batch call.
+func (u *PriceFetcher) ParseLiquidity(
+	result interface{},
+) (*big.Int, error) {
+	out, err := u.unpack(LiquidityMethod, result)
+	if err != nil {
+		return nil, err
+	}
+
+	liquidity := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	return liquidity, nil
+}
+
+// unpack decodes the hex encoded result of a call to the given pool contract method.
+func (u *PriceFetcher) unpack(
+	method string,
+	result interface{},
+) ([]interface{}, error) {
 	r, ok := result.(*string)
 	if !ok {
 		return nil, fmt.Errorf("expected result to be a string, got %T", result)
@@ -295,12 +429,27 @@ func (u *PriceFetcher) ParseSqrtPriceX96(
 		return nil, fmt.Errorf("failed to decode hex result: %w", err)
 	}
 
-	out, err := u.abi.Methods[ContractMethod].Outputs.UnpackValues(bz)
+	out, err := u.abi.Methods[method].Outputs.UnpackValues(bz)
 	if err != nil {
 		return nil, fmt.Errorf("failed to unpack values: %w", err)
 	}
 
-	// Parse the sqrtPriceX96 from the result.
-	sqrtPriceX96 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
-	return sqrtPriceX96, nil
+	return out, nil
+}
+
+// newCall returns an eth_call batch element that calls the given pool contract with the given
+// payload at the given block height.
+func newCall(address string, payload []byte, height uint64) rpc.BatchElem {
+	var result string
+	return rpc.BatchElem{
+		Method: "eth_call",
+		Args: []interface{}{
+			map[string]interface{}{
+				"to":   common.HexToAddress(address),
+				"data": hexutil.Bytes(payload),
+			},
+			hexutil.EncodeUint64(height),
+		},
+		Result: &result,
+	}
 }
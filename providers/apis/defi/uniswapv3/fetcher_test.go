@@ -8,7 +8,9 @@ import (
 
 	"go.uber.org/zap"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/skip-mev/slinky/oracle/config"
@@ -31,9 +33,7 @@ func TestFetch(t *testing.T) {
 			name:    "no tickers",
 			tickers: []types.ProviderTicker{},
 			client: func() ethmulticlient.EVMClient {
-				c := mocks.NewEVMClient(t)
-				c.On("BatchCallContext", context.Background(), []rpc.BatchElem{}).Return(nil)
-				return c
+				return createEVMClientWithResponse(t, nil, []string{testBlockHeader}, []error{nil})
 			},
 			expected: types.PriceResponse{
 				Resolved:   map[types.ProviderTicker]providertypes.ResolvedResult[*big.Float]{},
@@ -70,6 +70,56 @@ func TestFetch(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "block header cannot be retrieved",
+			tickers: []types.ProviderTicker{
+				wethusdcTicker,
+			},
+			client: func() ethmulticlient.EVMClient {
+				batchErrors := []error{
+					fmt.Errorf("block not found"),
+					nil,
+					nil,
+				}
+				responses := []string{
+					"null",
+					wethusdcSlot0,
+					wethusdcLiquidity,
+				}
+				return createEVMClientWithResponse(t, nil, responses, batchErrors)
+			},
+			expected: types.PriceResponse{
+				Resolved: map[types.ProviderTicker]providertypes.ResolvedResult[*big.Float]{},
+				UnResolved: map[types.ProviderTicker]providertypes.UnresolvedResult{
+					wethusdcTicker: {},
+				},
+			},
+		},
+		{
+			name: "block header does not match the pinned height",
+			tickers: []types.ProviderTicker{
+				wethusdcTicker,
+			},
+			client: func() ethmulticlient.EVMClient {
+				batchErrors := []error{
+					nil,
+					nil,
+					nil,
+				}
+				responses := []string{
+					`{"number":"0x1312cff","timestamp":"0x66575734"}`,
+					wethusdcSlot0,
+					wethusdcLiquidity,
+				}
+				return createEVMClientWithResponse(t, nil, responses, batchErrors)
+			},
+			expected: types.PriceResponse{
+				Resolved: map[types.ProviderTicker]providertypes.ResolvedResult[*big.Float]{},
+				UnResolved: map[types.ProviderTicker]providertypes.UnresolvedResult{
+					wethusdcTicker: {},
+				},
+			},
+		},
 		{
 			name: "batch request has an error for a single ticker",
 			tickers: []types.ProviderTicker{
@@ -77,10 +127,14 @@ func TestFetch(t *testing.T) {
 			},
 			client: func() ethmulticlient.EVMClient {
 				batchErrors := []error{
+					nil,
 					fmt.Errorf("request for ticker did not return a result"),
+					nil,
 				}
 				responses := []string{
+					testBlockHeader,
 					"",
+					wethusdcLiquidity,
 				}
 				return createEVMClientWithResponse(t, nil, responses, batchErrors)
 			},
@@ -99,8 +153,37 @@ func TestFetch(t *testing.T) {
 			client: func() ethmulticlient.EVMClient {
 				batchErrors := []error{
 					nil,
+					nil,
+					nil,
+				}
+				responses := []string{
+					testBlockHeader,
+					"not a valid result",
+					wethusdcLiquidity,
+				}
+				return createEVMClientWithResponse(t, nil, responses, batchErrors)
+			},
+			expected: types.PriceResponse{
+				Resolved: map[types.ProviderTicker]providertypes.ResolvedResult[*big.Float]{},
+				UnResolved: map[types.ProviderTicker]providertypes.UnresolvedResult{
+					wethusdcTicker: {},
+				},
+			},
+		},
+		{
+			name: "batch request returns a liquidity that cannot be parsed",
+			tickers: []types.ProviderTicker{
+				wethusdcTicker,
+			},
+			client: func() ethmulticlient.EVMClient {
+				batchErrors := []error{
+					nil,
+					nil,
+					nil,
 				}
 				responses := []string{
+					testBlockHeader,
+					wethusdcSlot0,
 					"not a valid result",
 				}
 				return createEVMClientWithResponse(t, nil, responses, batchErrors)
@@ -112,6 +195,31 @@ func TestFetch(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "pool liquidity is below the minimum",
+			tickers: []types.ProviderTicker{
+				wethusdcMinLiquidityTicker,
+			},
+			client: func() ethmulticlient.EVMClient {
+				batchErrors := []error{
+					nil,
+					nil,
+					nil,
+				}
+				responses := []string{
+					testBlockHeader,
+					wethusdcSlot0,
+					wethusdcLiquidity,
+				}
+				return createEVMClientWithResponse(t, nil, responses, batchErrors)
+			},
+			expected: types.PriceResponse{
+				Resolved: map[types.ProviderTicker]providertypes.ResolvedResult[*big.Float]{},
+				UnResolved: map[types.ProviderTicker]providertypes.UnresolvedResult{
+					wethusdcMinLiquidityTicker: {},
+				},
+			},
+		},
 		{
 			name: "weth/usdc mainnet result",
 			tickers: []types.ProviderTicker{
@@ -120,16 +228,21 @@ func TestFetch(t *testing.T) {
 			client: func() ethmulticlient.EVMClient {
 				batchErrors := []error{
 					nil,
+					nil,
+					nil,
 				}
 				responses := []string{
-					"0x00000000000000000000000000000000000043dd3b966e761000000000000000000000000000000000000000000000000000000000000000000000000002fabf000000000000000000000000000000000000000000000000000000000000057900000000000000000000000000000000000000000000000000000000000005a000000000000000000000000000000000000000000000000000000000000005a000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000001",
+					testBlockHeader,
+					wethusdcSlot0,
+					wethusdcLiquidity,
 				}
 				return createEVMClientWithResponse(t, nil, responses, batchErrors)
 			},
 			expected: types.PriceResponse{
 				Resolved: map[types.ProviderTicker]providertypes.ResolvedResult[*big.Float]{
 					wethusdcTicker: {
-						Value: big.NewFloat(3313.131879703878971626114658316303),
+						Value:     big.NewFloat(3313.131879703878971626114658316303),
+						Liquidity: big.NewFloat(115119622.64885824358199959329673482786557209279228),
 					},
 				},
 				UnResolved: map[types.ProviderTicker]providertypes.UnresolvedResult{},
@@ -148,6 +261,8 @@ func TestFetch(t *testing.T) {
 			for ticker, result := range tc.expected.Resolved {
 				require.Contains(t, response.Resolved, ticker)
 				require.Equal(t, result.Value.SetPrec(40), response.Resolved[ticker].Value.SetPrec(40))
+				require.Equal(t, result.Liquidity.SetPrec(40), response.Resolved[ticker].Liquidity.SetPrec(40))
+				require.Equal(t, testTimestamp, response.Resolved[ticker].Timestamp)
 			}
 
 			for ticker := range tc.expected.UnResolved {
@@ -248,6 +363,71 @@ func TestParseSqrtPriceX96(t *testing.T) {
 	})
 }
 
+func TestGetHeight(t *testing.T) {
+	t.Run("batch call fails", func(t *testing.T) {
+		fetcher := createPriceFetcherWithClient(t, createEVMClientWithResponse(t, fmt.Errorf("error"), nil, nil))
+		_, err := fetcher.GetHeight(context.Background())
+		require.Error(t, err)
+	})
+
+	t.Run("height cannot be decoded", func(t *testing.T) {
+		c := mocks.NewEVMClient(t)
+		c.On("BatchCallContext", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+			*args.Get(1).([]rpc.BatchElem)[0].Result.(*string) = "not a height"
+		})
+
+		fetcher := createPriceFetcherWithClient(t, c)
+		_, err := fetcher.GetHeight(context.Background())
+		require.Error(t, err)
+	})
+
+	t.Run("valid height", func(t *testing.T) {
+		c := mocks.NewEVMClient(t)
+		c.On("BatchCallContext", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+			elems, ok := args.Get(1).([]rpc.BatchElem)
+			require.True(t, ok)
+			require.Len(t, elems, 1)
+			require.Equal(t, "eth_blockNumber", elems[0].Method)
+
+			*elems[0].Result.(*string) = hexutil.EncodeUint64(testHeight)
+		})
+
+		fetcher := createPriceFetcherWithClient(t, c)
+		height, err := fetcher.GetHeight(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, testHeight, height)
+	})
+}
+
+func TestParseLiquidity(t *testing.T) {
+	fetcher := createPriceFetcher(t)
+
+	t.Run("result does not map to a string pointer", func(t *testing.T) {
+		_, err := fetcher.ParseLiquidity(42)
+		require.Error(t, err)
+	})
+
+	t.Run("result is a nil string pointer", func(t *testing.T) {
+		_, err := fetcher.ParseLiquidity((*string)(nil))
+		require.Error(t, err)
+	})
+
+	t.Run("result cannot be unpacked by the uniswap abi", func(t *testing.T) {
+		result := new(string)
+		*result = "0x1234"
+		_, err := fetcher.ParseLiquidity(result)
+		require.Error(t, err)
+	})
+
+	t.Run("valid result", func(t *testing.T) {
+		result := new(string)
+		*result = wethusdcLiquidity
+		liquidity, err := fetcher.ParseLiquidity(result)
+		require.NoError(t, err)
+		require.Equal(t, big.NewInt(1e18), liquidity)
+	})
+}
+
 func TestNewPriceFetcher(t *testing.T) {
 	ctx := context.TODO()
 
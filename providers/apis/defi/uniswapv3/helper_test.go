@@ -1,8 +1,11 @@
 package uniswapv3_test
 
 import (
+	"encoding/json"
 	"testing"
+	"time"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -25,8 +28,26 @@ var (
 		Invert:        true,
 	}
 
+	wethusdcMinLiquidityCfg = uniswapv3.PoolConfig{
+		Address:       "0x8ad599c3A0ff1De082011EFDDc58f1908eb6e6D8",
+		BaseDecimals:  18,
+		QuoteDecimals: 6,
+		Invert:        true,
+		MinLiquidity:  200_000_000,
+	}
+
 	// Tickers used for testing.
-	wethusdcTicker = types.NewProviderTicker("WETH/USDC", wethusdcCfg.MustToJSON())
+	wethusdcTicker             = types.NewProviderTicker("WETH/USDC", wethusdcCfg.MustToJSON())
+	wethusdcMinLiquidityTicker = types.NewProviderTicker("WETH/USDC", wethusdcMinLiquidityCfg.MustToJSON())
+
+	// Height and header of the block that the calls are pinned to.
+	testHeight      = uint64(20_000_000)
+	testTimestamp   = time.Unix(1_717_000_000, 0).UTC()
+	testBlockHeader = `{"number":"0x1312d00","timestamp":"0x66575740"}`
+
+	// Mainnet result of the slot0 call and a liquidity of 1e18 for the WETH/USDC pool.
+	wethusdcSlot0     = "0x00000000000000000000000000000000000043dd3b966e761000000000000000000000000000000000000000000000000000000000000000000000000002fabf000000000000000000000000000000000000000000000000000000000000057900000000000000000000000000000000000000000000000000000000000005a000000000000000000000000000000000000000000000000000000000000005a000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000001"
+	wethusdcLiquidity = "0x0000000000000000000000000000000000000000000000000de0b6b3a7640000"
 )
 
 func createPriceFetcher(
@@ -77,12 +98,22 @@ func createEVMClientWithResponse(
 			elems, ok := args.Get(1).([]rpc.BatchElem)
 			require.True(t, ok)
 
-			require.True(t, ok)
+			// The fetcher first queries the height that the calls are pinned to.
+			if len(elems) == 1 && elems[0].Method == "eth_blockNumber" {
+				*elems[0].Result.(*string) = hexutil.EncodeUint64(testHeight)
+				return
+			}
+
 			require.Equal(t, len(elems), len(responses))
 			require.Equal(t, len(elems), len(errs))
 
 			for i, elem := range elems {
-				elem.Result = &responses[i]
+				switch result := elem.Result.(type) {
+				case *string:
+					*result = responses[i]
+				default:
+					require.NoError(t, json.Unmarshal([]byte(responses[i]), result))
+				}
 				elem.Error = errs[i]
 				elems[i] = elem
 			}
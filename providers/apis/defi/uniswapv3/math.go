@@ -1,6 +1,7 @@
 package uniswapv3
 
 import (
+	"fmt"
 	"math/big"
 
 	"github.com/skip-mev/slinky/pkg/math"
@@ -47,3 +48,62 @@ func ScalePrice(
 	}
 	return new(big.Float).Mul(price, erc20ScalingFactor)
 }
+
+// ConvertLiquidity converts the in-range liquidity of the pool to the value of the pool's virtual
+// reserves, denominated in the quote token and scaled to the quote token decimals. The virtual
+// reserves of token0 and token1 are L / sqrtPrice and L * sqrtPrice respectively, and both are
+// worth the same at the current price, so the value of the reserves is twice the value of the
+// quote token reserves:
+//
+// liquidity = 2 * L * sqrtPrice / 10^quoteDecimals, or 2 * L / sqrtPrice / 10^quoteDecimals if
+// the pool is inverted i.e. the quote token is token0.
+func ConvertLiquidity(
+	cfg PoolConfig,
+	sqrtPriceX96 *big.Int,
+	liquidity *big.Int,
+) *big.Float {
+	if sqrtPriceX96.Sign() <= 0 || liquidity.Sign() <= 0 {
+		return new(big.Float)
+	}
+
+	// sqrtPrice is the square root of the raw price of token0 in terms of token1.
+	sqrtPrice := new(big.Float).Quo(
+		new(big.Float).SetInt(sqrtPriceX96),
+		new(big.Float).SetInt(new(big.Int).Exp(big.NewInt(2), big.NewInt(96), nil)),
+	)
+
+	reserves := new(big.Float).SetInt(liquidity)
+	if cfg.Invert {
+		reserves.Quo(reserves, sqrtPrice)
+	} else {
+		reserves.Mul(reserves, sqrtPrice)
+	}
+
+	quoteScale := new(big.Float).SetInt(
+		new(big.Int).Exp(big.NewInt(10), big.NewInt(cfg.QuoteDecimals), nil),
+	)
+
+	reserves.Quo(reserves, quoteScale)
+	return reserves.Mul(reserves, big.NewFloat(2))
+}
+
+// CheckLiquidity returns an error if the liquidity of the pool, as returned by ConvertLiquidity,
+// is below the minimum liquidity configured for the pool.
+func CheckLiquidity(
+	cfg PoolConfig,
+	liquidity *big.Float,
+) error {
+	if cfg.MinLiquidity <= 0 {
+		return nil
+	}
+
+	if liquidity.Cmp(big.NewFloat(cfg.MinLiquidity)) < 0 {
+		return fmt.Errorf(
+			"pool liquidity %s is below the minimum of %s",
+			liquidity.Text('f', 6),
+			big.NewFloat(cfg.MinLiquidity).Text('f', 6),
+		)
+	}
+
+	return nil
+}
@@ -110,3 +110,83 @@ func TestScalePrice(t *testing.T) {
 		})
 	}
 }
+
+func TestConvertLiquidity(t *testing.T) {
+	x96 := new(big.Int).Exp(big.NewInt(2), big.NewInt(96), nil)
+
+	testCases := []struct {
+		name         string
+		sqrtPriceX96 *big.Int
+		liquidity    *big.Int
+		cfg          uniswapv3.PoolConfig
+		expected     *big.Float
+	}{
+		{
+			name:         "quote token is token1",
+			sqrtPriceX96: new(big.Int).Mul(x96, big.NewInt(2)),
+			liquidity:    big.NewInt(1e18),
+			cfg: uniswapv3.PoolConfig{
+				BaseDecimals:  18,
+				QuoteDecimals: 18,
+			},
+			expected: big.NewFloat(4),
+		},
+		{
+			name:         "quote token is token0",
+			sqrtPriceX96: new(big.Int).Mul(x96, big.NewInt(2)),
+			liquidity:    big.NewInt(1e18),
+			cfg: uniswapv3.PoolConfig{
+				BaseDecimals:  18,
+				QuoteDecimals: 6,
+				Invert:        true,
+			},
+			expected: big.NewFloat(1e12),
+		},
+		{
+			name:         "no liquidity",
+			sqrtPriceX96: x96,
+			liquidity:    big.NewInt(0),
+			cfg: uniswapv3.PoolConfig{
+				BaseDecimals:  18,
+				QuoteDecimals: 6,
+				Invert:        true,
+			},
+			expected: big.NewFloat(0),
+		},
+		{
+			name:         "uninitialized pool",
+			sqrtPriceX96: big.NewInt(0),
+			liquidity:    big.NewInt(1e18),
+			cfg: uniswapv3.PoolConfig{
+				BaseDecimals:  18,
+				QuoteDecimals: 6,
+				Invert:        true,
+			},
+			expected: big.NewFloat(0),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := uniswapv3.ConvertLiquidity(tc.cfg, tc.sqrtPriceX96, tc.liquidity).SetPrec(40)
+			require.Equal(t, tc.expected.SetPrec(40), actual)
+		})
+	}
+}
+
+func TestCheckLiquidity(t *testing.T) {
+	t.Run("no minimum liquidity", func(t *testing.T) {
+		cfg := uniswapv3.PoolConfig{}
+		require.NoError(t, uniswapv3.CheckLiquidity(cfg, big.NewFloat(0)))
+	})
+
+	t.Run("liquidity is above the minimum", func(t *testing.T) {
+		cfg := uniswapv3.PoolConfig{MinLiquidity: 100}
+		require.NoError(t, uniswapv3.CheckLiquidity(cfg, big.NewFloat(100)))
+	})
+
+	t.Run("liquidity is below the minimum", func(t *testing.T) {
+		cfg := uniswapv3.PoolConfig{MinLiquidity: 100}
+		require.Error(t, uniswapv3.CheckLiquidity(cfg, big.NewFloat(99.99)))
+	})
+}
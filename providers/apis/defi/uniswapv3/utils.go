@@ -22,6 +22,9 @@ const (
 	// ContractMethod is the contract method to call for the Uniswap V3 API.
 	ContractMethod = "slot0"
 
+	// LiquidityMethod is the contract method to call for the in-range liquidity of the pool.
+	LiquidityMethod = "liquidity"
+
 	// ETH_URL is the URL for the Uniswap V3 API. This uses a free public RPC provider on Ethereum Mainnet.
 	ETH_URL = "https://eth.public-rpc.com/"
 
@@ -60,6 +63,10 @@ type PoolConfig struct {
 	// pools as the price is derived based on the sorted order of the ERC20 addresses of the tokens
 	// in the pool.
 	Invert bool `json:"invert"`
+	// MinLiquidity is the minimum liquidity of the pool, denominated in the quote token, required
+	// for its price to be reported. Prices of pools with less liquidity are dropped. This is
+	// optional and no minimum is enforced if it is not set.
+	MinLiquidity float64 `json:"min_liquidity,omitempty"`
 }
 
 // ValidateBasic validates the pool configuration.
@@ -76,6 +83,10 @@ func (pc *PoolConfig) ValidateBasic() error {
 		return fmt.Errorf("quote decimals must be non-negative")
 	}
 
+	if pc.MinLiquidity < 0 {
+		return fmt.Errorf("min liquidity must be non-negative")
+	}
+
 	return nil
 }
 
@@ -40,6 +40,16 @@ func TestPoolConfig(t *testing.T) {
 		require.Error(t, cfg.ValidateBasic())
 	})
 
+	t.Run("invalid min liquidity", func(t *testing.T) {
+		cfg := uniswapv3.PoolConfig{
+			Address:       "0x8ad599c3A0ff1De082011EFDDc58f1908eb6e6D8",
+			BaseDecimals:  18,
+			QuoteDecimals: 18,
+			MinLiquidity:  -1,
+		}
+		require.Error(t, cfg.ValidateBasic())
+	})
+
 	t.Run("valid config", func(t *testing.T) {
 		cfg := uniswapv3.PoolConfig{
 			Address:       "0x8ad599c3A0ff1De082011EFDDc58f1908eb6e6D8",
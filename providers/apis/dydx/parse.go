@@ -3,6 +3,7 @@ package dydx
 import (
 	"encoding/json"
 	"fmt"
+	"slices"
 	"strings"
 
 	"go.uber.org/zap"
@@ -145,15 +146,15 @@ func (h *APIHandler) ConvertExchangeConfigJSON(
 ) ([]mmtypes.ProviderConfig, error) {
 	var (
 		providers = make([]mmtypes.ProviderConfig, 0, len(config.Exchanges))
-		seen      = make(map[dydxtypes.ExchangeMarketConfigJson]struct{})
+		seen      = make([]dydxtypes.ExchangeMarketConfigJson, 0, len(config.Exchanges))
 	)
 
 	for _, cfg := range config.Exchanges {
 		// Ignore duplicates.
-		if _, ok := seen[cfg]; ok {
+		if slices.ContainsFunc(seen, cfg.Equal) {
 			continue
 		}
-		seen[cfg] = struct{}{}
+		seen = append(seen, cfg)
 
 		// This means we have seen an exchange that slinky cannot support.
 		exchange, ok := ProviderMapping[cfg.ExchangeName]
@@ -183,6 +184,32 @@ func (h *APIHandler) ConvertExchangeConfigJSON(
 			normalizeByPair = &temp
 		}
 
+		// Determine if the exchange needs a multi-hop normalization path.
+		var normalizationPath []slinkytypes.CurrencyPair
+		if len(cfg.AdjustByMarkets) > 0 {
+			if normalizeByPair != nil {
+				return nil, fmt.Errorf(
+					"cannot set both adjust by market %s and adjust by markets %v",
+					cfg.AdjustByMarket,
+					cfg.AdjustByMarkets,
+				)
+			}
+
+			normalizationPath = make([]slinkytypes.CurrencyPair, 0, len(cfg.AdjustByMarkets))
+			for _, market := range cfg.AdjustByMarkets {
+				pair, err := h.CreateCurrencyPairFromPair(market)
+				if err != nil {
+					return nil, fmt.Errorf(
+						"failed to create normalization path pair for %s: %w",
+						market,
+						err,
+					)
+				}
+
+				normalizationPath = append(normalizationPath, pair)
+			}
+		}
+
 		// Convert the ticker to the provider's format.
 		denom, err := ConvertDenomByProvider(cfg.Ticker, exchange)
 		if err != nil {
@@ -196,11 +223,12 @@ func (h *APIHandler) ConvertExchangeConfigJSON(
 
 		// Convert to a provider config.
 		providers = append(providers, mmtypes.ProviderConfig{
-			Name:            exchange,
-			OffChainTicker:  denom,
-			Invert:          cfg.Invert,
-			NormalizeByPair: normalizeByPair,
-			Metadata_JSON:   metaData,
+			Name:              exchange,
+			OffChainTicker:    denom,
+			Invert:            cfg.Invert,
+			NormalizeByPair:   normalizeByPair,
+			NormalizationPath: normalizationPath,
+			Metadata_JSON:     metaData,
 		})
 	}
 
@@ -308,6 +308,64 @@ func TestConvertExchangeConfigJSON(t *testing.T) {
 			expectedProviders: []mmtypes.ProviderConfig{},
 			expectedErr:       true,
 		},
+		{
+			name: "multi-hop path with adjustable markets",
+			config: dydxtypes.ExchangeConfigJson{
+				Exchanges: []dydxtypes.ExchangeMarketConfigJson{
+					{
+						ExchangeName:    "Okx",
+						Ticker:          "PEPE-ETH",
+						AdjustByMarkets: []string{"ETH-USDT", "USDT-USD"},
+					},
+				},
+			},
+			expectedProviders: []mmtypes.ProviderConfig{
+				{
+					Name:           okx.Name,
+					OffChainTicker: "PEPE-ETH",
+					NormalizationPath: []slinkytypes.CurrencyPair{
+						{
+							Base:  "ETH",
+							Quote: "USDT",
+						},
+						{
+							Base:  "USDT",
+							Quote: "USD",
+						},
+					},
+				},
+			},
+			expectedErr: false,
+		},
+		{
+			name: "adjustable market and adjustable markets are mutually exclusive",
+			config: dydxtypes.ExchangeConfigJson{
+				Exchanges: []dydxtypes.ExchangeMarketConfigJson{
+					{
+						ExchangeName:    "Okx",
+						Ticker:          "PEPE-ETH",
+						AdjustByMarket:  "ETH-USD",
+						AdjustByMarkets: []string{"ETH-USDT", "USDT-USD"},
+					},
+				},
+			},
+			expectedProviders: []mmtypes.ProviderConfig{},
+			expectedErr:       true,
+		},
+		{
+			name: "invalid adjustable markets",
+			config: dydxtypes.ExchangeConfigJson{
+				Exchanges: []dydxtypes.ExchangeMarketConfigJson{
+					{
+						ExchangeName:    "Okx",
+						Ticker:          "PEPE-ETH",
+						AdjustByMarkets: []string{"ETHUSDT"},
+					},
+				},
+			},
+			expectedProviders: []mmtypes.ProviderConfig{},
+			expectedErr:       true,
+		},
 		{
 			name: "invalid exchange name - should ignore",
 			config: dydxtypes.ExchangeConfigJson{
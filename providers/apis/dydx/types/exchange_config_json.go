@@ -1,5 +1,7 @@
 package types
 
+import "slices"
+
 // ExchangeConfigJson demarshals the exchange configuration json for a particular market.
 // The result is a list of parameters that define how the market is resolved on
 // each supported exchange.
@@ -14,10 +16,21 @@ type ExchangeConfigJson struct { //nolint
 
 // ExchangeMarketConfigJson captures per-exchange information for resolving a market, including
 // the ticker and conversion details. It demarshals JSON parameters from the chain for a
-// particular market on a specific exchange.
+// particular market on a specific exchange. AdjustByMarkets is an ordered list of markets
+// used to normalize the ticker over multiple hops and cannot be combined with AdjustByMarket.
 type ExchangeMarketConfigJson struct { //nolint
-	ExchangeName   string `json:"exchangeName"`
-	Ticker         string `json:"ticker"`
-	AdjustByMarket string `json:"adjustByMarket,omitempty"`
-	Invert         bool   `json:"invert,omitempty"`
+	ExchangeName    string   `json:"exchangeName"`
+	Ticker          string   `json:"ticker"`
+	AdjustByMarket  string   `json:"adjustByMarket,omitempty"`
+	AdjustByMarkets []string `json:"adjustByMarkets,omitempty"`
+	Invert          bool     `json:"invert,omitempty"`
+}
+
+// Equal returns true if the two exchange market configs are identical.
+func (c ExchangeMarketConfigJson) Equal(other ExchangeMarketConfigJson) bool {
+	return c.ExchangeName == other.ExchangeName &&
+		c.Ticker == other.Ticker &&
+		c.AdjustByMarket == other.AdjustByMarket &&
+		slices.Equal(c.AdjustByMarkets, other.AdjustByMarkets) &&
+		c.Invert == other.Invert
 }
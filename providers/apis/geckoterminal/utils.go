@@ -35,6 +35,12 @@ var DefaultETHAPIConfig = config.APIConfig{
 	ReconnectTimeout: 2000 * time.Millisecond,
 	MaxQueries:       1,
 	Endpoints:        []config.Endpoint{{URL: ETH_URL}},
+	// GeckoTerminal regularly rate limits requests, so retry them within the timeout.
+	Retry: config.RetryConfig{
+		MaxRetries:     2,
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     200 * time.Millisecond,
+	},
 }
 
 type (
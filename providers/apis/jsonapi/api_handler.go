@@ -0,0 +1,247 @@
+package jsonapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/skip-mev/slinky/oracle/config"
+	"github.com/skip-mev/slinky/oracle/types"
+	"github.com/skip-mev/slinky/pkg/math"
+	apihandlers "github.com/skip-mev/slinky/providers/base/api/handlers"
+	"github.com/skip-mev/slinky/providers/registry"
+	providertypes "github.com/skip-mev/slinky/providers/types"
+)
+
+var (
+	_ types.PriceAPIDataHandler                           = (*APIHandler)(nil)
+	_ apihandlers.APIRequestCreator[types.ProviderTicker] = (*APIHandler)(nil)
+)
+
+func init() {
+	// Each venue is configured under a dynamic name of the form `json_api-<venue>`.
+	registry.RegisterDynamicAPIProvider(Name, registry.NewRestAPIConstructor(NewAPIHandler))
+}
+
+// APIHandler implements the PriceAPIDataHandler interface for any venue that exposes the
+// price of its markets in a JSON response. The URL to query and the location of the price
+// (and optionally the timestamp) in the response are configured per market via the market
+// map metadata, which allows new venues to be supported without any code changes.
+type APIHandler struct {
+	// api is the config for the venue's API.
+	api config.APIConfig
+}
+
+// NewAPIHandler returns a new generic JSON PriceAPIDataHandler.
+func NewAPIHandler(
+	api config.APIConfig,
+) (types.PriceAPIDataHandler, error) {
+	if !IsValidProviderName(api.Name) {
+		return nil, fmt.Errorf("expected api config name %s or %s%s<venue>, got %s", Name, Name, registry.NameSeparator, api.Name)
+	}
+
+	if !api.Enabled {
+		return nil, fmt.Errorf("api config for %s is not enabled", api.Name)
+	}
+
+	if err := api.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid api config for %s: %w", api.Name, err)
+	}
+
+	return &APIHandler{
+		api: api,
+	}, nil
+}
+
+// CreateURL returns the URL that is used to fetch data for the given tickers.
+func (h *APIHandler) CreateURL(
+	tickers []types.ProviderTicker,
+) (string, error) {
+	req, err := h.CreateRequest(tickers)
+	if err != nil {
+		return "", err
+	}
+
+	return req.URL, nil
+}
+
+// CreateRequest returns the request that is used to fetch data for the given tickers. The URL
+// template of each ticker (i.e. the URL in its metadata or the URL of the provider's first
+// endpoint) and its body template are populated with the ticker's off-chain ticker. Tickers can
+// only be queried in the same request if their requests are identical, i.e. the venue returns
+// the prices of all markets in one response.
+func (h *APIHandler) CreateRequest(
+	tickers []types.ProviderTicker,
+) (apihandlers.Request, error) {
+	if len(tickers) == 0 {
+		return apihandlers.Request{}, fmt.Errorf("no tickers provided")
+	}
+
+	var req apihandlers.Request
+	for i, ticker := range tickers {
+		metadata, err := h.getMetadata(ticker)
+		if err != nil {
+			return apihandlers.Request{}, err
+		}
+
+		tickerReq := h.createTickerRequest(ticker, metadata)
+		if i > 0 && !reflect.DeepEqual(tickerReq, req) {
+			return apihandlers.Request{}, fmt.Errorf("tickers %s and %s must be queried with different requests", tickers[0], ticker)
+		}
+		req = tickerReq
+	}
+
+	return req, nil
+}
+
+// ParseResponse parses the JSON response and returns the price of each ticker located at the
+// price path configured in its metadata.
+func (h *APIHandler) ParseResponse(
+	tickers []types.ProviderTicker,
+	resp *http.Response,
+) types.PriceResponse {
+	var body interface{}
+	decoder := json.NewDecoder(resp.Body)
+	decoder.UseNumber()
+	if err := decoder.Decode(&body); err != nil {
+		return types.NewPriceResponseWithErr(
+			tickers,
+			providertypes.NewErrorWithCode(err, providertypes.ErrorFailedToDecode),
+		)
+	}
+
+	var (
+		resolved   = make(types.ResolvedPrices)
+		unresolved = make(types.UnResolvedPrices)
+		now        = time.Now().UTC()
+	)
+
+	for _, ticker := range tickers {
+		result, err := h.parseTicker(ticker, body, now)
+		if err != nil {
+			unresolved[ticker] = providertypes.UnresolvedResult{
+				ErrorWithCode: providertypes.NewErrorWithCode(err, providertypes.ErrorFailedToParsePrice),
+			}
+			continue
+		}
+
+		resolved[ticker] = result
+	}
+
+	return types.NewPriceResponse(resolved, unresolved)
+}
+
+// parseTicker returns the price of the ticker in the decoded response.
+func (h *APIHandler) parseTicker(
+	ticker types.ProviderTicker,
+	body interface{},
+	now time.Time,
+) (providertypes.ResolvedResult[*big.Float], error) {
+	metadata, err := h.getMetadata(ticker)
+	if err != nil {
+		return providertypes.ResolvedResult[*big.Float]{}, err
+	}
+
+	value, err := Lookup(body, metadata.PricePath)
+	if err != nil {
+		return providertypes.ResolvedResult[*big.Float]{}, fmt.Errorf("failed to find price for %s: %w", ticker, err)
+	}
+
+	raw, err := toString(value)
+	if err != nil {
+		return providertypes.ResolvedResult[*big.Float]{}, fmt.Errorf("invalid price for %s: %w", ticker, err)
+	}
+
+	price, err := math.Float64StringToBigFloat(raw)
+	if err != nil {
+		return providertypes.ResolvedResult[*big.Float]{}, err
+	}
+
+	timestamp := now
+	if len(metadata.TimestampPath) != 0 {
+		value, err := Lookup(body, metadata.TimestampPath)
+		if err != nil {
+			return providertypes.ResolvedResult[*big.Float]{}, fmt.Errorf("failed to find timestamp for %s: %w", ticker, err)
+		}
+
+		if timestamp, err = parseTimestamp(value, metadata.TimestampFormat); err != nil {
+			return providertypes.ResolvedResult[*big.Float]{}, fmt.Errorf("invalid timestamp for %s: %w", ticker, err)
+		}
+	}
+
+	return types.NewPriceResult(price, timestamp), nil
+}
+
+// createTickerRequest returns the request used to query the given ticker. The ticker is escaped
+// for the part of the URL, or the JSON body, that it is substituted into.
+func (h *APIHandler) createTickerRequest(ticker types.ProviderTicker, metadata TickerMetadata) apihandlers.Request {
+	template := h.api.Endpoints[0].URL
+	if len(metadata.URL) != 0 {
+		template = metadata.URL
+	}
+
+	req := apihandlers.Request{
+		Method: strings.ToUpper(metadata.Method),
+		URL:    replaceURLTicker(template, ticker.GetOffChainTicker()),
+	}
+
+	if len(metadata.Headers) != 0 {
+		req.Header = make(http.Header, len(metadata.Headers))
+		for key, value := range metadata.Headers {
+			req.Header.Set(key, value)
+		}
+	}
+
+	if len(metadata.Body) != 0 {
+		req.Body = []byte(replaceJSONTicker(metadata.Body, ticker.GetOffChainTicker()))
+	}
+
+	return req
+}
+
+// replaceURLTicker replaces the ticker placeholders in the given URL template with the ticker. The
+// ticker is query escaped in the query string of the URL and path escaped elsewhere.
+func replaceURLTicker(template, ticker string) string {
+	path, query, found := strings.Cut(template, "?")
+	path = strings.ReplaceAll(path, TickerPlaceholder, url.PathEscape(ticker))
+	if !found {
+		return path
+	}
+
+	return path + "?" + strings.ReplaceAll(query, TickerPlaceholder, url.QueryEscape(ticker))
+}
+
+// replaceJSONTicker replaces the ticker placeholders in the given JSON body template with the
+// ticker, escaped to be placed within a JSON string.
+func replaceJSONTicker(template, ticker string) string {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(ticker); err != nil {
+		return strings.ReplaceAll(template, TickerPlaceholder, ticker)
+	}
+
+	// strip the quotes and trailing newline of the encoded string
+	escaped := strings.TrimSuffix(buf.String(), "\n")
+	return strings.ReplaceAll(template, TickerPlaceholder, escaped[1:len(escaped)-1])
+}
+
+// getMetadata unmarshals and validates the metadata of the given ticker.
+func (h *APIHandler) getMetadata(ticker types.ProviderTicker) (TickerMetadata, error) {
+	var metadata TickerMetadata
+	if err := json.Unmarshal([]byte(ticker.GetJSON()), &metadata); err != nil {
+		return metadata, fmt.Errorf("failed to unmarshal metadata for %s: %w", ticker, err)
+	}
+
+	if err := metadata.ValidateBasic(); err != nil {
+		return metadata, fmt.Errorf("invalid metadata for %s: %w", ticker, err)
+	}
+
+	return metadata, nil
+}
@@ -0,0 +1,584 @@
+package jsonapi_test
+
+import (
+	"fmt"
+	"math/big"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/oracle/config"
+	"github.com/skip-mev/slinky/oracle/types"
+	"github.com/skip-mev/slinky/providers/apis/jsonapi"
+	apihandlers "github.com/skip-mev/slinky/providers/base/api/handlers"
+	"github.com/skip-mev/slinky/providers/base/testutils"
+	providertypes "github.com/skip-mev/slinky/providers/types"
+)
+
+var (
+	apiConfig = config.APIConfig{
+		Name:             jsonapi.Name + "-venue",
+		Atomic:           false,
+		Enabled:          true,
+		Timeout:          3000 * time.Millisecond,
+		Interval:         750 * time.Millisecond,
+		ReconnectTimeout: 2000 * time.Millisecond,
+		MaxQueries:       1,
+		Endpoints:        []config.Endpoint{{URL: "https://api.venue.com/v1/ticker?symbol={ticker}"}},
+	}
+
+	btcusd = types.NewProviderTicker(
+		"BTCUSD",
+		`{"price_path":"data.last"}`,
+	)
+	ethusd = types.NewProviderTicker(
+		"ETHUSD",
+		`{"price_path":"data.last"}`,
+	)
+	solusd = types.NewProviderTicker(
+		"SOL_USD",
+		`{"url":"https://api.venue.com/v2/tickers","price_path":"result.1.price","timestamp_path":"result.1.time","timestamp_format":"unix_milli"}`,
+	)
+	atomusd = types.NewProviderTicker(
+		"ATOM_USD",
+		`{"url":"https://api.venue.com/v2/tickers","price_path":"result.0.price","timestamp_path":"result.0.updated","timestamp_format":"rfc3339"}`,
+	)
+	rpcbtc = types.NewProviderTicker(
+		"BTC",
+		`{"url":"https://rpc.venue.com","method":"post","headers":{"x-api-key":"key"},"body":"{\"method\":\"price\",\"params\":[\"{ticker}\"]}","price_path":"result"}`,
+	)
+	rpceth = types.NewProviderTicker(
+		"ETH",
+		`{"url":"https://rpc.venue.com","method":"post","headers":{"x-api-key":"key"},"body":"{\"method\":\"price\",\"params\":[\"{ticker}\"]}","price_path":"result"}`,
+	)
+	escaped = types.NewProviderTicker(
+		`BTC/USD & "co"`,
+		`{"url":"https://api.venue.com/v1/{ticker}/price?symbol={ticker}","method":"post","body":"{\"params\":[\"{ticker}\"]}","price_path":"price"}`,
+	)
+	invalid = types.NewProviderTicker(
+		"INVALID",
+		`{"price_path":""}`,
+	)
+)
+
+func TestNewAPIHandler(t *testing.T) {
+	testCases := []struct {
+		name        string
+		cfg         func() config.APIConfig
+		expectedErr bool
+	}{
+		{
+			name:        "valid dynamic name",
+			cfg:         func() config.APIConfig { return apiConfig },
+			expectedErr: false,
+		},
+		{
+			name: "valid base name",
+			cfg: func() config.APIConfig {
+				cfg := apiConfig
+				cfg.Name = jsonapi.Name
+				return cfg
+			},
+			expectedErr: false,
+		},
+		{
+			name: "invalid name",
+			cfg: func() config.APIConfig {
+				cfg := apiConfig
+				cfg.Name = "venue"
+				return cfg
+			},
+			expectedErr: true,
+		},
+		{
+			name: "disabled",
+			cfg: func() config.APIConfig {
+				cfg := apiConfig
+				cfg.Enabled = false
+				return cfg
+			},
+			expectedErr: true,
+		},
+		{
+			name: "no endpoints",
+			cfg: func() config.APIConfig {
+				cfg := apiConfig
+				cfg.Endpoints = nil
+				return cfg
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := jsonapi.NewAPIHandler(tc.cfg())
+			if tc.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCreateURL(t *testing.T) {
+	testCases := []struct {
+		name        string
+		cps         []types.ProviderTicker
+		url         string
+		expectedErr bool
+	}{
+		{
+			name:        "empty",
+			cps:         []types.ProviderTicker{},
+			url:         "",
+			expectedErr: true,
+		},
+		{
+			name: "endpoint url template",
+			cps: []types.ProviderTicker{
+				btcusd,
+			},
+			url:         "https://api.venue.com/v1/ticker?symbol=BTCUSD",
+			expectedErr: false,
+		},
+		{
+			name: "metadata url",
+			cps: []types.ProviderTicker{
+				solusd,
+			},
+			url:         "https://api.venue.com/v2/tickers",
+			expectedErr: false,
+		},
+		{
+			name: "multiple tickers with the same url",
+			cps: []types.ProviderTicker{
+				solusd,
+				atomusd,
+			},
+			url:         "https://api.venue.com/v2/tickers",
+			expectedErr: false,
+		},
+		{
+			name: "multiple tickers with different urls",
+			cps: []types.ProviderTicker{
+				btcusd,
+				ethusd,
+			},
+			url:         "",
+			expectedErr: true,
+		},
+		{
+			name: "invalid metadata",
+			cps: []types.ProviderTicker{
+				invalid,
+			},
+			url:         "",
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			h, err := jsonapi.NewAPIHandler(apiConfig)
+			require.NoError(t, err)
+
+			url, err := h.CreateURL(tc.cps)
+			if tc.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.url, url)
+			}
+		})
+	}
+}
+
+func TestCreateRequest(t *testing.T) {
+	testCases := []struct {
+		name        string
+		cps         []types.ProviderTicker
+		req         apihandlers.Request
+		expectedErr bool
+	}{
+		{
+			name:        "empty",
+			cps:         []types.ProviderTicker{},
+			expectedErr: true,
+		},
+		{
+			name: "get request",
+			cps: []types.ProviderTicker{
+				btcusd,
+			},
+			req: apihandlers.Request{
+				URL: "https://api.venue.com/v1/ticker?symbol=BTCUSD",
+			},
+			expectedErr: false,
+		},
+		{
+			name: "post request with headers and body",
+			cps: []types.ProviderTicker{
+				rpcbtc,
+			},
+			req: apihandlers.Request{
+				Method: http.MethodPost,
+				URL:    "https://rpc.venue.com",
+				Header: http.Header{"X-Api-Key": []string{"key"}},
+				Body:   []byte(`{"method":"price","params":["BTC"]}`),
+			},
+			expectedErr: false,
+		},
+		{
+			name: "ticker is escaped in the url and body",
+			cps: []types.ProviderTicker{
+				escaped,
+			},
+			req: apihandlers.Request{
+				Method: http.MethodPost,
+				URL:    "https://api.venue.com/v1/BTC%2FUSD%20&%20%22co%22/price?symbol=BTC%2FUSD+%26+%22co%22",
+				Body:   []byte(`{"params":["BTC/USD & \"co\""]}`),
+			},
+			expectedErr: false,
+		},
+		{
+			name: "multiple tickers with different bodies",
+			cps: []types.ProviderTicker{
+				rpcbtc,
+				rpceth,
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			h, err := jsonapi.NewAPIHandler(apiConfig)
+			require.NoError(t, err)
+
+			req, err := h.(apihandlers.APIRequestCreator[types.ProviderTicker]).CreateRequest(tc.cps)
+			if tc.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.req, req)
+			}
+		})
+	}
+}
+
+func TestParseResponse(t *testing.T) {
+	testCases := []struct {
+		name     string
+		cps      []types.ProviderTicker
+		response *http.Response
+		expected types.PriceResponse
+	}{
+		{
+			name: "valid nested object",
+			cps: []types.ProviderTicker{
+				btcusd,
+			},
+			response: testutils.CreateResponseFromJSON(
+				`
+{
+	"data": {
+		"last": "67012.25",
+		"volume": "1000"
+	}
+}
+	`,
+			),
+			expected: types.NewPriceResponse(
+				types.ResolvedPrices{
+					btcusd: {
+						Value: big.NewFloat(67012.25),
+					},
+				},
+				types.UnResolvedPrices{},
+			),
+		},
+		{
+			name: "valid numeric price",
+			cps: []types.ProviderTicker{
+				ethusd,
+			},
+			response: testutils.CreateResponseFromJSON(
+				`
+{
+	"data": {
+		"last": 3012.123456789
+	}
+}
+	`,
+			),
+			expected: types.NewPriceResponse(
+				types.ResolvedPrices{
+					ethusd: {
+						Value: big.NewFloat(3012.123456789),
+					},
+				},
+				types.UnResolvedPrices{},
+			),
+		},
+		{
+			name: "valid array with timestamps",
+			cps: []types.ProviderTicker{
+				solusd,
+				atomusd,
+			},
+			response: testutils.CreateResponseFromJSON(
+				`
+{
+	"result": [
+		{ "price": "8.5", "updated": "2024-05-01T12:00:00Z" },
+		{ "price": 150.5, "time": 1714564800000 }
+	]
+}
+	`,
+			),
+			expected: types.NewPriceResponse(
+				types.ResolvedPrices{
+					solusd: {
+						Value:     big.NewFloat(150.5),
+						Timestamp: time.UnixMilli(1714564800000).UTC(),
+					},
+					atomusd: {
+						Value:     big.NewFloat(8.5),
+						Timestamp: time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC),
+					},
+				},
+				types.UnResolvedPrices{},
+			),
+		},
+		{
+			name: "missing price path",
+			cps: []types.ProviderTicker{
+				btcusd,
+			},
+			response: testutils.CreateResponseFromJSON(
+				`
+{
+	"data": {
+		"close": "67012.25"
+	}
+}
+	`,
+			),
+			expected: types.NewPriceResponse(
+				types.ResolvedPrices{},
+				types.UnResolvedPrices{
+					btcusd: providertypes.UnresolvedResult{
+						ErrorWithCode: providertypes.NewErrorWithCode(fmt.Errorf("not found"), providertypes.ErrorFailedToParsePrice),
+					},
+				},
+			),
+		},
+		{
+			name: "array index out of range",
+			cps: []types.ProviderTicker{
+				solusd,
+			},
+			response: testutils.CreateResponseFromJSON(
+				`
+{
+	"result": [
+		{ "price": "8.5" }
+	]
+}
+	`,
+			),
+			expected: types.NewPriceResponse(
+				types.ResolvedPrices{},
+				types.UnResolvedPrices{
+					solusd: providertypes.UnresolvedResult{
+						ErrorWithCode: providertypes.NewErrorWithCode(fmt.Errorf("out of range"), providertypes.ErrorFailedToParsePrice),
+					},
+				},
+			),
+		},
+		{
+			name: "unable to parse price",
+			cps: []types.ProviderTicker{
+				btcusd,
+			},
+			response: testutils.CreateResponseFromJSON(
+				`
+{
+	"data": {
+		"last": "$67012.25"
+	}
+}
+	`,
+			),
+			expected: types.NewPriceResponse(
+				types.ResolvedPrices{},
+				types.UnResolvedPrices{
+					btcusd: providertypes.UnresolvedResult{
+						ErrorWithCode: providertypes.NewErrorWithCode(fmt.Errorf("bad format"), providertypes.ErrorFailedToParsePrice),
+					},
+				},
+			),
+		},
+		{
+			name: "price is an object",
+			cps: []types.ProviderTicker{
+				btcusd,
+			},
+			response: testutils.CreateResponseFromJSON(
+				`
+{
+	"data": {
+		"last": { "value": "67012.25" }
+	}
+}
+	`,
+			),
+			expected: types.NewPriceResponse(
+				types.ResolvedPrices{},
+				types.UnResolvedPrices{
+					btcusd: providertypes.UnresolvedResult{
+						ErrorWithCode: providertypes.NewErrorWithCode(fmt.Errorf("bad format"), providertypes.ErrorFailedToParsePrice),
+					},
+				},
+			),
+		},
+		{
+			name: "invalid metadata",
+			cps: []types.ProviderTicker{
+				invalid,
+				btcusd,
+			},
+			response: testutils.CreateResponseFromJSON(
+				`
+{
+	"data": {
+		"last": "67012.25"
+	}
+}
+	`,
+			),
+			expected: types.NewPriceResponse(
+				types.ResolvedPrices{
+					btcusd: {
+						Value: big.NewFloat(67012.25),
+					},
+				},
+				types.UnResolvedPrices{
+					invalid: providertypes.UnresolvedResult{
+						ErrorWithCode: providertypes.NewErrorWithCode(fmt.Errorf("bad format"), providertypes.ErrorFailedToParsePrice),
+					},
+				},
+			),
+		},
+		{
+			name: "unable to parse json",
+			cps: []types.ProviderTicker{
+				btcusd,
+			},
+			response: testutils.CreateResponseFromJSON(
+				`
+toms obvious but not minimal language
+	`,
+			),
+			expected: types.NewPriceResponse(
+				types.ResolvedPrices{},
+				types.UnResolvedPrices{
+					btcusd: providertypes.UnresolvedResult{
+						ErrorWithCode: providertypes.NewErrorWithCode(fmt.Errorf("bad format"), providertypes.ErrorFailedToDecode),
+					},
+				},
+			),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			h, err := jsonapi.NewAPIHandler(apiConfig)
+			require.NoError(t, err)
+
+			now := time.Now()
+			resp := h.ParseResponse(tc.cps, tc.response)
+
+			require.Len(t, resp.Resolved, len(tc.expected.Resolved))
+			require.Len(t, resp.UnResolved, len(tc.expected.UnResolved))
+
+			for cp, result := range tc.expected.Resolved {
+				require.Contains(t, resp.Resolved, cp)
+				r := resp.Resolved[cp]
+				require.Equal(t, result.Value.SetPrec(18), r.Value.SetPrec(18))
+
+				if result.Timestamp.IsZero() {
+					require.True(t, r.Timestamp.After(now))
+				} else {
+					require.Equal(t, result.Timestamp, r.Timestamp)
+				}
+			}
+
+			for cp, result := range tc.expected.UnResolved {
+				require.Contains(t, resp.UnResolved, cp)
+				require.Error(t, resp.UnResolved[cp])
+				require.Equal(t, result.Code(), resp.UnResolved[cp].Code())
+			}
+		})
+	}
+}
+
+func TestLookup(t *testing.T) {
+	value := map[string]interface{}{
+		"data": []interface{}{
+			map[string]interface{}{
+				"price": "1.5",
+			},
+		},
+	}
+
+	testCases := []struct {
+		name        string
+		path        string
+		expected    interface{}
+		expectedErr bool
+	}{
+		{
+			name:        "nested object and array",
+			path:        "data.0.price",
+			expected:    "1.5",
+			expectedErr: false,
+		},
+		{
+			name:        "missing key",
+			path:        "data.0.volume",
+			expectedErr: true,
+		},
+		{
+			name:        "invalid array index",
+			path:        "data.first.price",
+			expectedErr: true,
+		},
+		{
+			name:        "array index out of range",
+			path:        "data.1.price",
+			expectedErr: true,
+		},
+		{
+			name:        "path past a leaf value",
+			path:        "data.0.price.value",
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := jsonapi.Lookup(value, tc.path)
+			if tc.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, result)
+			}
+		})
+	}
+}
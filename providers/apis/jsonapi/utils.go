@@ -0,0 +1,162 @@
+package jsonapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/skip-mev/slinky/providers/registry"
+)
+
+const (
+	// Name is the base name of the generic JSON API provider. Each venue that is queried with
+	// the generic provider is configured under a dynamic name of the form `json_api-<venue>`.
+	Name = "json_api"
+
+	// TickerPlaceholder is the placeholder in a URL template that is replaced with the
+	// off-chain ticker of the market being queried.
+	TickerPlaceholder = "{ticker}"
+
+	// PathSeparator separates the keys (or array indices) of a JSON path.
+	PathSeparator = "."
+)
+
+const (
+	// TimestampFormatUnix indicates that the timestamp is the number of seconds since the
+	// unix epoch.
+	TimestampFormatUnix = "unix"
+
+	// TimestampFormatUnixMilli indicates that the timestamp is the number of milliseconds
+	// since the unix epoch.
+	TimestampFormatUnixMilli = "unix_milli"
+
+	// TimestampFormatRFC3339 indicates that the timestamp is an RFC3339 formatted string.
+	TimestampFormatRFC3339 = "rfc3339"
+)
+
+// IsValidProviderName returns true if the name is the base name of the generic JSON API
+// provider or a dynamic name derived from it.
+func IsValidProviderName(name string) bool {
+	return name == Name || strings.HasPrefix(name, Name+registry.NameSeparator)
+}
+
+// TickerMetadata is the metadata that must be set on each market's provider config (i.e. the
+// `metadata_JSON` field of the market map) to query the market with the generic JSON API
+// provider.
+//
+//	{
+//		"url": "https://api.venue.com/ticker?symbol={ticker}",
+//		"price_path": "data.last",
+//		"timestamp_path": "data.time",
+//		"timestamp_format": "unix_milli"
+//	}
+type TickerMetadata struct {
+	// URL is an optional URL template that overrides the URL of the provider's first
+	// endpoint. The {ticker} placeholder is replaced with the off-chain ticker.
+	URL string `json:"url,omitempty"`
+
+	// Method is the optional HTTP method of the request, e.g. "POST". Defaults to "GET".
+	Method string `json:"method,omitempty"`
+
+	// Headers are optional headers that are sent with the request.
+	Headers map[string]string `json:"headers,omitempty"`
+
+	// Body is an optional template of the request body, e.g. a JSON-RPC or GraphQL query. The
+	// {ticker} placeholder is replaced with the off-chain ticker.
+	Body string `json:"body,omitempty"`
+
+	// PricePath is the path to the price in the JSON response, e.g. "result.0.price".
+	// Object keys and array indices are separated by a ".".
+	PricePath string `json:"price_path"`
+
+	// TimestampPath is the optional path to the time at which the price was last updated
+	// in the JSON response. If empty, the price is timestamped with the time the response
+	// was received.
+	TimestampPath string `json:"timestamp_path,omitempty"`
+
+	// TimestampFormat is the format of the timestamp. This must be one of "unix",
+	// "unix_milli" or "rfc3339". Defaults to "unix".
+	TimestampFormat string `json:"timestamp_format,omitempty"`
+}
+
+// ValidateBasic performs basic validation of the ticker metadata.
+func (m TickerMetadata) ValidateBasic() error {
+	if len(m.PricePath) == 0 {
+		return fmt.Errorf("price path cannot be empty")
+	}
+
+	switch m.TimestampFormat {
+	case "", TimestampFormatUnix, TimestampFormatUnixMilli, TimestampFormatRFC3339:
+	default:
+		return fmt.Errorf("invalid timestamp format %q", m.TimestampFormat)
+	}
+
+	return nil
+}
+
+// Lookup returns the value at the given path in the decoded JSON value. Numbers are expected
+// to be decoded as json.Number.
+func Lookup(value interface{}, path string) (interface{}, error) {
+	for _, key := range strings.Split(path, PathSeparator) {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			next, ok := v[key]
+			if !ok {
+				return nil, fmt.Errorf("key %s not found", key)
+			}
+			value = next
+		case []interface{}:
+			index, err := strconv.Atoi(key)
+			if err != nil {
+				return nil, fmt.Errorf("invalid array index %s", key)
+			}
+			if index < 0 || index >= len(v) {
+				return nil, fmt.Errorf("array index %d out of range", index)
+			}
+			value = v[index]
+		default:
+			return nil, fmt.Errorf("cannot look up %s in %T", key, value)
+		}
+	}
+
+	return value, nil
+}
+
+// toString returns the string representation of a JSON number or string.
+func toString(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case json.Number:
+		return v.String(), nil
+	case string:
+		return v, nil
+	default:
+		return "", fmt.Errorf("expected a number or string, got %T", value)
+	}
+}
+
+// parseTimestamp parses the timestamp in the given format.
+func parseTimestamp(value interface{}, format string) (time.Time, error) {
+	raw, err := toString(value)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if format == TimestampFormatRFC3339 {
+		return time.Parse(time.RFC3339, raw)
+	}
+
+	ts, err := strconv.ParseFloat(raw, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if format == TimestampFormatUnixMilli {
+		return time.UnixMilli(int64(ts)).UTC(), nil
+	}
+
+	seconds, fraction := math.Modf(ts)
+	return time.Unix(int64(seconds), int64(fraction*float64(time.Second))).UTC(), nil
+}
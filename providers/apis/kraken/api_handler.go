@@ -10,11 +10,16 @@ import (
 	"github.com/skip-mev/slinky/oracle/config"
 	"github.com/skip-mev/slinky/oracle/types"
 	"github.com/skip-mev/slinky/pkg/math"
+	"github.com/skip-mev/slinky/providers/registry"
 	providertypes "github.com/skip-mev/slinky/providers/types"
 )
 
 var _ types.PriceAPIDataHandler = (*APIHandler)(nil)
 
+func init() {
+	registry.RegisterAPIProvider(Name, registry.NewRestAPIConstructor(NewAPIHandler))
+}
+
 // APIHandler implements the PriceAPIDataHandler interface for Kraken.
 // for more information about the Kraken API, refer to the following link:
 // https://docs.kraken.com/rest/
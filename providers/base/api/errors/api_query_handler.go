@@ -22,6 +22,10 @@ var (
 	// ErrRateLimit is returned when the APIQueryHandler encounters a rate limit.
 	ErrRateLimit = errors.New("api query handler encountered a rate limit")
 
+	// ErrCircuitOpen is returned when a request is rejected without being sent because the
+	// API has failed repeatedly and the circuit breaker is open.
+	ErrCircuitOpen = errors.New("api circuit breaker is open")
+
 	// ErrUnexpectedStatusCode is returned when the APIQueryHandler encounters an unexpected status code.
 	ErrUnexpectedStatusCode = errors.New("api query handler encountered an unexpected status code")
 )
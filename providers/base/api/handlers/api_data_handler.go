@@ -23,3 +23,13 @@ type APIDataHandler[K providertypes.ResponseKey, V providertypes.ResponseValue]
 	// reflect either the time the data was fetched or the time the API last updated the data.
 	ParseResponse(ids []K, response *http.Response) providertypes.GetResponse[K, V]
 }
+
+// APIRequestCreator is an optional interface that can be implemented by an APIDataHandler that
+// must send more than a URL to the data provider, e.g. the POST body of a JSON-RPC or GraphQL
+// request. If implemented, the RestAPIFetcher sends the request returned by CreateRequest instead
+// of a request to the URL returned by CreateURL.
+type APIRequestCreator[K providertypes.ResponseKey] interface {
+	// CreateRequest is used to create the request to be sent to the http client. The function
+	// should utilize the IDs passed in as references to the data that needs to be fetched.
+	CreateRequest(ids []K) (Request, error)
+}
@@ -5,6 +5,8 @@ package mocks
 import (
 	context "context"
 
+	handlers "github.com/skip-mev/slinky/providers/base/api/handlers"
+
 	http "net/http"
 
 	mock "github.com/stretchr/testify/mock"
@@ -45,6 +47,36 @@ func (_m *RequestHandler) Do(ctx context.Context, url string) (*http.Response, e
 	return r0, r1
 }
 
+// DoRequest provides a mock function with given fields: ctx, req
+func (_m *RequestHandler) DoRequest(ctx context.Context, req handlers.Request) (*http.Response, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DoRequest")
+	}
+
+	var r0 *http.Response
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, handlers.Request) (*http.Response, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, handlers.Request) *http.Response); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*http.Response)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, handlers.Request) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Type provides a mock function with given fields:
 func (_m *RequestHandler) Type() string {
 	ret := _m.Called()
@@ -0,0 +1,200 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/skip-mev/slinky/providers/base/recorder"
+)
+
+// ErrReplayExhausted is returned by the ReplayRequestHandler once every recorded response for
+// a URL has been replayed.
+var ErrReplayExhausted = errors.New("no recorded responses left to replay")
+
+var (
+	_ RequestHandler = (*RecordingRequestHandler)(nil)
+	_ RequestHandler = (*ReplayRequestHandler)(nil)
+)
+
+// RecordingRequestHandler wraps a RequestHandler and records the raw response of every request,
+// along with the time at which it was received.
+type RecordingRequestHandler struct {
+	handler  RequestHandler
+	recorder *recorder.Recorder
+}
+
+// NewRecordingRequestHandler returns a new RecordingRequestHandler that records the responses
+// of the given request handler with the given recorder.
+func NewRecordingRequestHandler(handler RequestHandler, rec *recorder.Recorder) (*RecordingRequestHandler, error) {
+	if handler == nil {
+		return nil, fmt.Errorf("request handler cannot be nil")
+	}
+
+	if rec == nil {
+		return nil, fmt.Errorf("recorder cannot be nil")
+	}
+
+	return &RecordingRequestHandler{
+		handler:  handler,
+		recorder: rec,
+	}, nil
+}
+
+// Do sends the request with the underlying request handler and records the response. The body
+// of the response is buffered so that it can be read by the caller after it is recorded.
+func (r *RecordingRequestHandler) Do(ctx context.Context, url string) (*http.Response, error) {
+	resp, err := r.handler.Do(ctx, url)
+
+	return r.record(recorder.Record{URL: url}, resp, err)
+}
+
+// DoRequest sends the request with the underlying request handler and records the response
+// along with the method and body of the request.
+func (r *RecordingRequestHandler) DoRequest(ctx context.Context, req Request) (*http.Response, error) {
+	resp, err := r.handler.DoRequest(ctx, req)
+
+	return r.record(recorder.Record{
+		URL:         req.URL,
+		Method:      req.Method,
+		RequestBody: req.Body,
+	}, resp, err)
+}
+
+// record records the response of a request. The body of the response is buffered so that it
+// can be read by the caller after it is recorded.
+func (r *RecordingRequestHandler) record(record recorder.Record, resp *http.Response, err error) (*http.Response, error) {
+	record.Timestamp = time.Now().UTC()
+	if resp != nil {
+		record.StatusCode = resp.StatusCode
+
+		if resp.Body != nil {
+			body, readErr := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			if readErr != nil {
+				return resp, readErr
+			}
+
+			record.Body = body
+			resp.Body = io.NopCloser(bytes.NewReader(body))
+		}
+	}
+	if err != nil {
+		record.Error = err.Error()
+	}
+
+	if recordErr := r.recorder.Record(record); recordErr != nil {
+		return resp, errors.Join(err, fmt.Errorf("failed to record response: %w", recordErr))
+	}
+
+	return resp, err
+}
+
+// Type returns the HTTP method used by the underlying request handler.
+func (r *RecordingRequestHandler) Type() string {
+	return r.handler.Type()
+}
+
+// ReplayRequestHandler is a RequestHandler that replays recorded responses instead of sending
+// requests. The recorded responses for each URL are replayed in the order in which they were
+// recorded, which allows the APIDataHandler of a provider to be exercised deterministically.
+type ReplayRequestHandler struct {
+	mtx sync.Mutex
+
+	// responses are the recorded responses that have not been replayed, indexed by URL and
+	// request body.
+	responses map[string][]recorder.Record
+
+	// method is the HTTP method of the recorded requests.
+	method string
+}
+
+// NewReplayRequestHandler returns a new ReplayRequestHandler that replays the given records.
+func NewReplayRequestHandler(records []recorder.Record, opts ...Option) (*ReplayRequestHandler, error) {
+	impl := &RequestHandlerImpl{
+		method: http.MethodGet,
+	}
+	for _, opt := range opts {
+		opt(impl)
+	}
+
+	if impl.method == "" {
+		return nil, fmt.Errorf("http request method cannot be empty")
+	}
+
+	h := &ReplayRequestHandler{
+		responses: make(map[string][]recorder.Record),
+		method:    impl.method,
+	}
+	for _, record := range records {
+		key := replayKey(record.URL, record.RequestBody)
+		h.responses[key] = append(h.responses[key], record)
+	}
+
+	return h, nil
+}
+
+// Do returns the next recorded response for the given URL. ErrReplayExhausted is returned once
+// every recorded response for the URL has been replayed.
+func (r *ReplayRequestHandler) Do(ctx context.Context, url string) (*http.Response, error) {
+	return r.replay(ctx, url, nil)
+}
+
+// DoRequest returns the next recorded response for the given request. Requests are matched to
+// recorded responses by their URL and body.
+func (r *ReplayRequestHandler) DoRequest(ctx context.Context, req Request) (*http.Response, error) {
+	return r.replay(ctx, req.URL, req.Body)
+}
+
+// replay returns the next recorded response for the given URL and request body.
+func (r *ReplayRequestHandler) replay(ctx context.Context, url string, body []byte) (*http.Response, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	key := replayKey(url, body)
+	responses := r.responses[key]
+	if len(responses) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrReplayExhausted, url)
+	}
+
+	record := responses[0]
+	r.responses[key] = responses[1:]
+
+	var resp *http.Response
+	if record.StatusCode != 0 {
+		resp = &http.Response{
+			StatusCode: record.StatusCode,
+			Status:     http.StatusText(record.StatusCode),
+			Body:       io.NopCloser(bytes.NewReader(record.Body)),
+		}
+	}
+
+	if len(record.Error) > 0 {
+		return resp, errors.New(record.Error)
+	}
+
+	return resp, nil
+}
+
+// Type returns the HTTP method of the recorded requests.
+func (r *ReplayRequestHandler) Type() string {
+	return r.method
+}
+
+// replayKey returns the key that recorded responses are indexed by.
+func replayKey(url string, body []byte) string {
+	if len(body) == 0 {
+		return url
+	}
+
+	return url + "\n" + string(body)
+}
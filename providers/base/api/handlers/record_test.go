@@ -0,0 +1,148 @@
+package handlers_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/oracle/types"
+	"github.com/skip-mev/slinky/providers/apis/coinbase"
+	"github.com/skip-mev/slinky/providers/base/api/handlers"
+	"github.com/skip-mev/slinky/providers/base/api/handlers/mocks"
+	"github.com/skip-mev/slinky/providers/base/recorder"
+)
+
+func TestRecordAndReplayRequestHandler(t *testing.T) {
+	const body = `{"data": {"amount": "1020.25", "currency": "USD"}}`
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(body))
+	}))
+	defer srv.Close()
+
+	path := filepath.Join(t.TempDir(), "coinbase.jsonl")
+	rec, err := recorder.NewRecorder(path)
+	require.NoError(t, err)
+
+	// Record a successful response and a failed request.
+	impl, err := handlers.NewRequestHandlerImpl(http.DefaultClient)
+	require.NoError(t, err)
+	recording, err := handlers.NewRecordingRequestHandler(impl, rec)
+	require.NoError(t, err)
+	require.Equal(t, http.MethodGet, recording.Type())
+
+	resp, err := recording.Do(context.Background(), srv.URL)
+	require.NoError(t, err)
+	bz, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Equal(t, body, string(bz))
+
+	failing := mocks.NewRequestHandler(t)
+	failing.On("Do", mock.Anything, "http://unreachable").Return(nil, fmt.Errorf("connection refused"))
+	recordingFailures, err := handlers.NewRecordingRequestHandler(failing, rec)
+	require.NoError(t, err)
+	_, err = recordingFailures.Do(context.Background(), "http://unreachable")
+	require.EqualError(t, err, "connection refused")
+
+	require.NoError(t, rec.Close())
+
+	records, err := recorder.ReadRecords(path)
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	require.Equal(t, srv.URL, records[0].URL)
+	require.Equal(t, http.StatusOK, records[0].StatusCode)
+	require.Equal(t, body, string(records[0].Body))
+	require.False(t, records[0].Timestamp.IsZero())
+	require.Equal(t, "connection refused", records[1].Error)
+
+	// Replay the recorded responses into the provider's data handler.
+	replay, err := handlers.NewReplayRequestHandler(records)
+	require.NoError(t, err)
+	require.Equal(t, http.MethodGet, replay.Type())
+
+	resp, err = replay.Do(context.Background(), srv.URL)
+	require.NoError(t, err)
+
+	ticker := types.NewProviderTicker("BTC-USD", "{}")
+	apiHandler, err := coinbase.NewAPIHandler(coinbase.DefaultAPIConfig)
+	require.NoError(t, err)
+	parsed := apiHandler.ParseResponse([]types.ProviderTicker{ticker}, resp)
+	require.Len(t, parsed.Resolved, 1)
+	require.Equal(t, big.NewFloat(1020.25).SetPrec(18), parsed.Resolved[ticker].Value.SetPrec(18))
+
+	_, err = replay.Do(context.Background(), "http://unreachable")
+	require.EqualError(t, err, "connection refused")
+
+	// Every recorded response has been replayed.
+	_, err = replay.Do(context.Background(), srv.URL)
+	require.ErrorIs(t, err, handlers.ErrReplayExhausted)
+}
+
+func TestRecordAndReplayRequestsWithBody(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.Copy(w, r.Body)
+	}))
+	defer srv.Close()
+
+	path := filepath.Join(t.TempDir(), "rpc.jsonl")
+	rec, err := recorder.NewRecorder(path)
+	require.NoError(t, err)
+
+	impl, err := handlers.NewRequestHandlerImpl(http.DefaultClient)
+	require.NoError(t, err)
+	recording, err := handlers.NewRecordingRequestHandler(impl, rec)
+	require.NoError(t, err)
+
+	for _, body := range []string{`{"id":1}`, `{"id":2}`} {
+		resp, err := recording.DoRequest(context.Background(), handlers.Request{
+			Method: http.MethodPost,
+			URL:    srv.URL,
+			Body:   []byte(body),
+		})
+		require.NoError(t, err)
+		resp.Body.Close()
+	}
+	require.NoError(t, rec.Close())
+
+	records, err := recorder.ReadRecords(path)
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	require.Equal(t, http.MethodPost, records[0].Method)
+	require.Equal(t, `{"id":1}`, string(records[0].RequestBody))
+
+	// Requests to the same URL are matched to recorded responses by their body.
+	replay, err := handlers.NewReplayRequestHandler(records)
+	require.NoError(t, err)
+
+	resp, err := replay.DoRequest(context.Background(), handlers.Request{URL: srv.URL, Body: []byte(`{"id":2}`)})
+	require.NoError(t, err)
+	bz, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Equal(t, `{"id":2}`, string(bz))
+
+	_, err = replay.Do(context.Background(), srv.URL)
+	require.ErrorIs(t, err, handlers.ErrReplayExhausted)
+}
+
+func TestNewRecordingRequestHandler(t *testing.T) {
+	rec, err := recorder.NewRecorder(filepath.Join(t.TempDir(), "recording.jsonl"))
+	require.NoError(t, err)
+	defer rec.Close()
+
+	_, err = handlers.NewRecordingRequestHandler(nil, rec)
+	require.Error(t, err)
+
+	_, err = handlers.NewRecordingRequestHandler(mocks.NewRequestHandler(t), nil)
+	require.Error(t, err)
+
+	_, err = handlers.NewReplayRequestHandler(nil, handlers.WithHTTPMethod(""))
+	require.Error(t, err)
+}
@@ -1,11 +1,32 @@
 package handlers
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 )
 
+// Request is a full HTTP request to a data provider. It is used by data providers that must
+// send more than a URL, e.g. the POST body of a JSON-RPC or GraphQL request.
+type Request struct {
+	// Method is the HTTP method of the request. If empty, the method of the request handler
+	// is used.
+	Method string
+
+	// URL is the URL of the request.
+	URL string
+
+	// Header contains the headers of the request. If the request has a body and no
+	// Content-Type header is set, the body is sent as application/json.
+	Header http.Header
+
+	// Body is the body of the request. The body is buffered so that the request can be
+	// retried.
+	Body []byte
+}
+
 // RequestHandler is an interface that encapsulates sending an HTTP request to a data provider.
 //
 //go:generate mockery --name RequestHandler --output ./mocks/ --case underscore
@@ -13,6 +34,9 @@ type RequestHandler interface {
 	// Do is used to send a request with the given URL to the data provider.
 	Do(ctx context.Context, url string) (*http.Response, error)
 
+	// DoRequest is used to send the given request to the data provider.
+	DoRequest(ctx context.Context, req Request) (*http.Response, error)
+
 	// Type defines the type of the RequestHandler based on the type of
 	// HTTP requests it makes  - GET, POST, etc.
 	Type() string
@@ -57,6 +81,37 @@ func (r *RequestHandlerImpl) Do(ctx context.Context, url string) (*http.Response
 	return r.client.Do(req)
 }
 
+// DoRequest is used to send the given request to the data provider. The request is sent
+// with the request handler's HTTP method unless the request specifies its own.
+func (r *RequestHandlerImpl) DoRequest(ctx context.Context, request Request) (*http.Response, error) {
+	method := request.Method
+	if len(method) == 0 {
+		method = r.method
+	}
+
+	var body io.Reader
+	if len(request.Body) > 0 {
+		body = bytes.NewReader(request.Body)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, request.URL, body)
+	if err != nil {
+		return nil, err
+	}
+
+	for key, values := range request.Header {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+
+	if body != nil && len(req.Header.Get("Content-Type")) == 0 {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	return r.client.Do(req)
+}
+
 // Type returns the HTTP method used to send requests.
 func (r *RequestHandlerImpl) Type() string {
 	return r.method
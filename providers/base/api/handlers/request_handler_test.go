@@ -0,0 +1,145 @@
+package handlers_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/skip-mev/slinky/oracle/config"
+	"github.com/skip-mev/slinky/oracle/types"
+	"github.com/skip-mev/slinky/providers/apis/jsonapi"
+	"github.com/skip-mev/slinky/providers/base/api/handlers"
+	"github.com/skip-mev/slinky/providers/base/api/handlers/mocks"
+	"github.com/skip-mev/slinky/providers/base/api/metrics"
+)
+
+func TestRequestHandlerImplDoRequest(t *testing.T) {
+	type received struct {
+		method      string
+		contentType string
+		apiKey      string
+		body        string
+	}
+
+	requests := make(chan received, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+
+		requests <- received{
+			method:      r.Method,
+			contentType: r.Header.Get("Content-Type"),
+			apiKey:      r.Header.Get("X-Api-Key"),
+			body:        string(body),
+		}
+	}))
+	defer srv.Close()
+
+	h, err := handlers.NewRequestHandlerImpl(http.DefaultClient)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		req      handlers.Request
+		expected received
+	}{
+		{
+			name: "defaults to the request handler's method",
+			req:  handlers.Request{URL: srv.URL},
+			expected: received{
+				method: http.MethodGet,
+			},
+		},
+		{
+			name: "json body",
+			req: handlers.Request{
+				Method: http.MethodPost,
+				URL:    srv.URL,
+				Header: http.Header{"X-Api-Key": []string{"key"}},
+				Body:   []byte(`{"jsonrpc":"2.0","method":"getPrice","id":1}`),
+			},
+			expected: received{
+				method:      http.MethodPost,
+				contentType: "application/json",
+				apiKey:      "key",
+				body:        `{"jsonrpc":"2.0","method":"getPrice","id":1}`,
+			},
+		},
+		{
+			name: "custom content type",
+			req: handlers.Request{
+				Method: http.MethodPost,
+				URL:    srv.URL,
+				Header: http.Header{"Content-Type": []string{"application/graphql"}},
+				Body:   []byte(`{ price }`),
+			},
+			expected: received{
+				method:      http.MethodPost,
+				contentType: "application/graphql",
+				body:        `{ price }`,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := h.DoRequest(context.Background(), tc.req)
+			require.NoError(t, err)
+			resp.Body.Close()
+
+			require.Equal(t, tc.expected, <-requests)
+		})
+	}
+}
+
+func TestRestAPIFetcherWithRequestCreator(t *testing.T) {
+	cfg := config.APIConfig{
+		Name:             jsonapi.Name + "-rpc",
+		Enabled:          true,
+		Timeout:          time.Second,
+		Interval:         time.Second,
+		ReconnectTimeout: time.Second,
+		MaxQueries:       1,
+		Endpoints:        []config.Endpoint{{URL: "https://rpc.venue.com"}},
+	}
+
+	dataHandler, err := jsonapi.NewAPIHandler(cfg)
+	require.NoError(t, err)
+
+	ticker := types.NewProviderTicker(
+		"BTC",
+		`{"method":"post","body":"{\"method\":\"price\",\"params\":[\"{ticker}\"]}","price_path":"result"}`,
+	)
+
+	requestHandler := mocks.NewRequestHandler(t)
+	requestHandler.On("DoRequest", mock.Anything, handlers.Request{
+		Method: http.MethodPost,
+		URL:    "https://rpc.venue.com",
+		Body:   []byte(`{"method":"price","params":["BTC"]}`),
+	}).Return(&http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(`{"result": "67000.5"}`)),
+	}, nil).Once()
+
+	fetcher, err := handlers.NewRestAPIFetcher(
+		requestHandler,
+		dataHandler,
+		metrics.NewNopAPIMetrics(),
+		cfg,
+		zap.NewNop(),
+	)
+	require.NoError(t, err)
+
+	resp := fetcher.Fetch(context.Background(), []types.ProviderTicker{ticker})
+	require.Empty(t, resp.UnResolved)
+	require.Len(t, resp.Resolved, 1)
+	require.Equal(t, "67000.5", resp.Resolved[ticker].Value.Text('f', 1))
+}
@@ -0,0 +1,356 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/skip-mev/slinky/oracle/config"
+	apierrors "github.com/skip-mev/slinky/providers/base/api/errors"
+	providertypes "github.com/skip-mev/slinky/providers/types"
+)
+
+var _ RequestHandler = (*ResilientRequestHandler)(nil)
+
+// ResilientRequestHandler wraps a RequestHandler with the rate limiting, retry, and circuit
+// breaking policies configured in a provider's APIConfig.
+//
+//   - Requests wait for a token from a token bucket before they are sent. Requests that cannot
+//     acquire a token before their context expires fail with ErrRateLimit.
+//   - Requests that fail, are rate limited (429), or return a 5xx status code are retried with
+//     a jittered exponential backoff. If the API responds with a Retry-After header, no requests
+//     are sent until the requested time has passed.
+//   - After a number of consecutive failed requests, the circuit opens and requests fail with
+//     ErrCircuitOpen without being sent until the cooldown has passed.
+type ResilientRequestHandler struct {
+	handler RequestHandler
+	cfg     config.APIConfig
+
+	// limiter is nil if rate limiting is disabled.
+	limiter *rate.Limiter
+
+	mtx sync.Mutex
+	// retryAfter is the time before which no requests are sent, as requested by the API.
+	retryAfter time.Time
+	// failures is the number of consecutive failed requests.
+	failures int
+	// openUntil is the time until which the circuit is open.
+	openUntil time.Time
+	// probing is true while the trial request of a half-open circuit is in flight.
+	probing bool
+}
+
+// NewResilientRequestHandler returns a new ResilientRequestHandler that sends requests with the
+// given request handler, using the rate limit, retry, and circuit breaker configs of the given
+// APIConfig.
+func NewResilientRequestHandler(handler RequestHandler, cfg config.APIConfig) (*ResilientRequestHandler, error) {
+	if handler == nil {
+		return nil, fmt.Errorf("request handler cannot be nil")
+	}
+
+	if err := cfg.RateLimit.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if err := cfg.Retry.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if err := cfg.CircuitBreaker.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	h := &ResilientRequestHandler{
+		handler: handler,
+		cfg:     cfg,
+	}
+
+	if cfg.RateLimit.Enabled() {
+		burst := cfg.RateLimit.Burst
+		if burst < 1 {
+			burst = 1
+		}
+
+		h.limiter = rate.NewLimiter(rate.Limit(cfg.RateLimit.RequestsPerSecond), burst)
+	}
+
+	return h, nil
+}
+
+// Do sends the request with the underlying request handler, retrying it according to the retry
+// config. The response of the last attempt is returned.
+func (r *ResilientRequestHandler) Do(ctx context.Context, url string) (*http.Response, error) {
+	return r.do(ctx, func(ctx context.Context) (*http.Response, error) {
+		return r.handler.Do(ctx, url)
+	})
+}
+
+// DoRequest sends the request with the underlying request handler, retrying it according to the
+// retry config. The response of the last attempt is returned.
+func (r *ResilientRequestHandler) DoRequest(ctx context.Context, req Request) (*http.Response, error) {
+	return r.do(ctx, func(ctx context.Context) (*http.Response, error) {
+		return r.handler.DoRequest(ctx, req)
+	})
+}
+
+// Type returns the HTTP method used by the underlying request handler.
+func (r *ResilientRequestHandler) Type() string {
+	return r.handler.Type()
+}
+
+// do sends the request if the circuit is closed and records its outcome.
+func (r *ResilientRequestHandler) do(
+	ctx context.Context,
+	send func(context.Context) (*http.Response, error),
+) (*http.Response, error) {
+	trial, err := r.allow()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.retry(ctx, send)
+	r.record(trial, isFailure(resp, err))
+
+	return resp, err
+}
+
+// retry sends the request, retrying it until it succeeds, the retries are exhausted, or the next
+// attempt cannot be made before the context expires.
+func (r *ResilientRequestHandler) retry(
+	ctx context.Context,
+	send func(context.Context) (*http.Response, error),
+) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		if err := r.wait(ctx); err != nil {
+			return nil, err
+		}
+
+		resp, err := send(ctx)
+		if !isRetryable(ctx, resp, err) {
+			return resp, err
+		}
+
+		// Respect the backoff requested by the API even if the request is not retried, so that
+		// subsequent requests are not sent before the API is ready to accept them.
+		delay := r.backoff(attempt)
+		if retryAfter, ok := parseRetryAfter(resp); ok {
+			r.pause(retryAfter)
+			if retryAfter > delay {
+				delay = retryAfter
+			}
+		}
+
+		if attempt >= r.cfg.Retry.MaxRetries {
+			return resp, err
+		}
+
+		if deadline, ok := ctx.Deadline(); ok && time.Now().Add(delay).After(deadline) {
+			return resp, err
+		}
+
+		if resp != nil && resp.Body != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			_ = resp.Body.Close()
+		}
+
+		if err := sleep(ctx, delay); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// wait blocks until a request can be sent. It returns ErrRateLimit if the request cannot be sent
+// before the context expires.
+func (r *ResilientRequestHandler) wait(ctx context.Context) error {
+	r.mtx.Lock()
+	retryAfter := r.retryAfter
+	r.mtx.Unlock()
+
+	if delay := time.Until(retryAfter); delay > 0 {
+		if deadline, ok := ctx.Deadline(); ok && deadline.Before(retryAfter) {
+			return apierrors.ErrRateLimit
+		}
+
+		if err := sleep(ctx, delay); err != nil {
+			return err
+		}
+	}
+
+	if r.limiter == nil {
+		return nil
+	}
+
+	if err := r.limiter.Wait(ctx); err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		return errors.Join(apierrors.ErrRateLimit, err)
+	}
+
+	return nil
+}
+
+// backoff returns the jittered exponential backoff before the retry following the given attempt.
+// The backoff is drawn uniformly from [d/2, d], where d is the initial backoff doubled once per
+// attempt and capped at the max backoff.
+func (r *ResilientRequestHandler) backoff(attempt int) time.Duration {
+	if !r.cfg.Retry.Enabled() {
+		return 0
+	}
+
+	d := r.cfg.Retry.InitialBackoff
+	for i := 0; i < attempt && d < r.cfg.Retry.MaxBackoff; i++ {
+		d *= 2
+	}
+
+	if d > r.cfg.Retry.MaxBackoff {
+		d = r.cfg.Retry.MaxBackoff
+	}
+
+	half := d / 2
+	return half + time.Duration(rand.Int63n(int64(d-half)+1)) //nolint:gosec
+}
+
+// pause stops requests from being sent until the given duration has passed.
+func (r *ResilientRequestHandler) pause(d time.Duration) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if until := time.Now().Add(d); until.After(r.retryAfter) {
+		r.retryAfter = until
+	}
+}
+
+// allow returns ErrCircuitOpen if the circuit is open. Once the cooldown has passed, a single
+// trial request is allowed, in which case trial is true.
+func (r *ResilientRequestHandler) allow() (trial bool, err error) {
+	if !r.cfg.CircuitBreaker.Enabled() {
+		return false, nil
+	}
+
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if r.failures < r.cfg.CircuitBreaker.FailureThreshold {
+		return false, nil
+	}
+
+	if r.probing || time.Now().Before(r.openUntil) {
+		return false, apierrors.ErrCircuitOpen
+	}
+
+	r.probing = true
+	return true, nil
+}
+
+// record updates the state of the circuit breaker with the outcome of a request.
+func (r *ResilientRequestHandler) record(trial, failed bool) {
+	if !r.cfg.CircuitBreaker.Enabled() {
+		return
+	}
+
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if trial {
+		r.probing = false
+	}
+
+	if !failed {
+		r.failures = 0
+		return
+	}
+
+	r.failures++
+	if r.failures >= r.cfg.CircuitBreaker.FailureThreshold {
+		r.openUntil = time.Now().Add(r.cfg.CircuitBreaker.Cooldown)
+	}
+}
+
+// isRetryable returns true if the request failed, was rate limited, or returned a 5xx status
+// code, and the context has not expired.
+func isRetryable(ctx context.Context, resp *http.Response, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	if err != nil {
+		return true
+	}
+
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError
+}
+
+// isFailure returns true if the request should count towards opening the circuit i.e. the API
+// could not be reached, timed out, or returned a 5xx status code. Rate limited requests and
+// requests that were canceled by the caller are not counted.
+func isFailure(resp *http.Response, err error) bool {
+	if err != nil {
+		return !errors.Is(err, apierrors.ErrRateLimit) && !errors.Is(err, context.Canceled)
+	}
+
+	return resp.StatusCode >= http.StatusInternalServerError
+}
+
+// parseRetryAfter returns the backoff requested by the Retry-After header of the response, which
+// is either a number of seconds or an HTTP date.
+func parseRetryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+
+	value := resp.Header.Get("Retry-After")
+	if len(value) == 0 {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		return time.Until(date), true
+	}
+
+	return 0, false
+}
+
+// sleep blocks for the given duration or until the context is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// errorCode returns the error code of a request that could not be sent.
+func errorCode(resp *http.Response, err error) providertypes.ErrorCode {
+	switch {
+	case resp != nil:
+		return providertypes.ErrorCode(resp.StatusCode)
+	case errors.Is(err, apierrors.ErrRateLimit):
+		return providertypes.ErrorRateLimitExceeded
+	case errors.Is(err, apierrors.ErrCircuitOpen):
+		return providertypes.ErrorCircuitOpen
+	default:
+		return providertypes.ErrorUnknown
+	}
+}
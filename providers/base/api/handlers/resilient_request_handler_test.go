@@ -0,0 +1,270 @@
+package handlers_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/oracle/config"
+	apierrors "github.com/skip-mev/slinky/providers/base/api/errors"
+	"github.com/skip-mev/slinky/providers/base/api/handlers"
+	"github.com/skip-mev/slinky/providers/base/api/handlers/mocks"
+)
+
+// newStatusServer returns a server that responds with the given status codes in order, repeating
+// the last status code once they are exhausted, and a counter of the requests it received.
+func newStatusServer(t *testing.T, header http.Header, statuses ...int) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		i := int(requests.Add(1)) - 1
+		if i >= len(statuses) {
+			i = len(statuses) - 1
+		}
+
+		for key, values := range header {
+			for _, value := range values {
+				w.Header().Add(key, value)
+			}
+		}
+		w.WriteHeader(statuses[i])
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv, &requests
+}
+
+func newResilientRequestHandler(t *testing.T, cfg config.APIConfig) *handlers.ResilientRequestHandler {
+	t.Helper()
+
+	impl, err := handlers.NewRequestHandlerImpl(http.DefaultClient)
+	require.NoError(t, err)
+
+	h, err := handlers.NewResilientRequestHandler(impl, cfg)
+	require.NoError(t, err)
+
+	return h
+}
+
+func TestNewResilientRequestHandler(t *testing.T) {
+	_, err := handlers.NewResilientRequestHandler(nil, config.APIConfig{})
+	require.Error(t, err)
+
+	_, err = handlers.NewResilientRequestHandler(mocks.NewRequestHandler(t), config.APIConfig{
+		Retry: config.RetryConfig{MaxRetries: 1},
+	})
+	require.Error(t, err)
+
+	impl, err := handlers.NewRequestHandlerImpl(http.DefaultClient, handlers.WithHTTPMethod(http.MethodPost))
+	require.NoError(t, err)
+	h, err := handlers.NewResilientRequestHandler(impl, config.APIConfig{})
+	require.NoError(t, err)
+	require.Equal(t, http.MethodPost, h.Type())
+}
+
+func TestResilientRequestHandlerRetries(t *testing.T) {
+	retry := config.RetryConfig{
+		MaxRetries:     2,
+		InitialBackoff: 10 * time.Millisecond,
+		MaxBackoff:     20 * time.Millisecond,
+	}
+
+	testCases := []struct {
+		name             string
+		statuses         []int
+		retry            config.RetryConfig
+		expectedStatus   int
+		expectedRequests int32
+	}{
+		{
+			name:             "success is not retried",
+			statuses:         []int{http.StatusOK},
+			retry:            retry,
+			expectedStatus:   http.StatusOK,
+			expectedRequests: 1,
+		},
+		{
+			name:             "server errors are retried until success",
+			statuses:         []int{http.StatusInternalServerError, http.StatusBadGateway, http.StatusOK},
+			retry:            retry,
+			expectedStatus:   http.StatusOK,
+			expectedRequests: 3,
+		},
+		{
+			name:             "rate limited requests are retried",
+			statuses:         []int{http.StatusTooManyRequests, http.StatusOK},
+			retry:            retry,
+			expectedStatus:   http.StatusOK,
+			expectedRequests: 2,
+		},
+		{
+			name:             "last response is returned once retries are exhausted",
+			statuses:         []int{http.StatusServiceUnavailable},
+			retry:            retry,
+			expectedStatus:   http.StatusServiceUnavailable,
+			expectedRequests: 3,
+		},
+		{
+			name:             "client errors are not retried",
+			statuses:         []int{http.StatusNotFound, http.StatusOK},
+			retry:            retry,
+			expectedStatus:   http.StatusNotFound,
+			expectedRequests: 1,
+		},
+		{
+			name:             "retries disabled",
+			statuses:         []int{http.StatusInternalServerError, http.StatusOK},
+			retry:            config.RetryConfig{},
+			expectedStatus:   http.StatusInternalServerError,
+			expectedRequests: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			srv, requests := newStatusServer(t, nil, tc.statuses...)
+			h := newResilientRequestHandler(t, config.APIConfig{Retry: tc.retry})
+
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+
+			resp, err := h.Do(ctx, srv.URL)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			require.Equal(t, tc.expectedStatus, resp.StatusCode)
+			require.Equal(t, tc.expectedRequests, requests.Load())
+		})
+	}
+}
+
+func TestResilientRequestHandlerRetriesWithinTimeout(t *testing.T) {
+	srv, requests := newStatusServer(t, nil, http.StatusInternalServerError)
+	h := newResilientRequestHandler(t, config.APIConfig{
+		Retry: config.RetryConfig{
+			MaxRetries:     5,
+			InitialBackoff: time.Second,
+			MaxBackoff:     time.Second,
+		},
+	})
+
+	// The backoff exceeds the timeout, so the failed response is returned without retrying.
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	resp, err := h.Do(ctx, srv.URL)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	require.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+	require.Equal(t, int32(1), requests.Load())
+}
+
+func TestResilientRequestHandlerRetryAfter(t *testing.T) {
+	srv, requests := newStatusServer(
+		t,
+		http.Header{"Retry-After": []string{"1"}},
+		http.StatusTooManyRequests,
+		http.StatusOK,
+	)
+	h := newResilientRequestHandler(t, config.APIConfig{})
+
+	resp, err := h.Do(context.Background(), srv.URL)
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+
+	// Requests that cannot wait for the requested backoff are rejected without being sent.
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	_, err = h.Do(ctx, srv.URL)
+	require.ErrorIs(t, err, apierrors.ErrRateLimit)
+	require.Equal(t, int32(1), requests.Load())
+
+	// Requests that can wait are sent once the backoff has passed.
+	ctx, cancel = context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	start := time.Now()
+	resp, err = h.Do(ctx, srv.URL)
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, int32(2), requests.Load())
+	require.Greater(t, time.Since(start), 500*time.Millisecond)
+}
+
+func TestResilientRequestHandlerRateLimit(t *testing.T) {
+	srv, requests := newStatusServer(t, nil, http.StatusOK)
+	h := newResilientRequestHandler(t, config.APIConfig{
+		RateLimit: config.RateLimitConfig{
+			RequestsPerSecond: 1,
+			Burst:             2,
+		},
+	})
+
+	// The burst is sent immediately.
+	for i := 0; i < 2; i++ {
+		resp, err := h.Do(context.Background(), srv.URL)
+		require.NoError(t, err)
+		resp.Body.Close()
+	}
+
+	// The next request would exceed the timeout waiting for a token.
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	_, err := h.Do(ctx, srv.URL)
+	require.ErrorIs(t, err, apierrors.ErrRateLimit)
+	require.Equal(t, int32(2), requests.Load())
+}
+
+func TestResilientRequestHandlerCircuitBreaker(t *testing.T) {
+	const url = "http://unreachable"
+
+	failing := mocks.NewRequestHandler(t)
+	h, err := handlers.NewResilientRequestHandler(failing, config.APIConfig{
+		CircuitBreaker: config.CircuitBreakerConfig{
+			FailureThreshold: 2,
+			Cooldown:         100 * time.Millisecond,
+		},
+	})
+	require.NoError(t, err)
+
+	// The circuit opens after two consecutive failures.
+	failing.On("Do", mock.Anything, url).Return(nil, fmt.Errorf("connection refused")).Times(2)
+	for i := 0; i < 2; i++ {
+		_, err = h.Do(context.Background(), url)
+		require.EqualError(t, err, "connection refused")
+	}
+
+	_, err = h.Do(context.Background(), url)
+	require.ErrorIs(t, err, apierrors.ErrCircuitOpen)
+
+	// A failed trial request re-opens the circuit.
+	time.Sleep(150 * time.Millisecond)
+	failing.On("Do", mock.Anything, url).Return(nil, fmt.Errorf("connection refused")).Once()
+	_, err = h.Do(context.Background(), url)
+	require.EqualError(t, err, "connection refused")
+
+	_, err = h.Do(context.Background(), url)
+	require.ErrorIs(t, err, apierrors.ErrCircuitOpen)
+
+	// A successful trial request closes the circuit.
+	time.Sleep(150 * time.Millisecond)
+	failing.On("Do", mock.Anything, url).Return(&http.Response{StatusCode: http.StatusOK}, nil).Twice()
+	for i := 0; i < 2; i++ {
+		resp, err := h.Do(context.Background(), url)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+	}
+}
@@ -23,6 +23,9 @@ type RestAPIFetcher[K providertypes.ResponseKey, V providertypes.ResponseValue]
 	// apiDataHandler is responsible for creating URLs and parsing the API response.
 	apiDataHandler APIDataHandler[K, V]
 
+	// requestCreator is set if the apiDataHandler creates full requests rather than URLs.
+	requestCreator APIRequestCreator[K]
+
 	// metrics is responsible for tracking metrics related to the API.
 	metrics metrics.APIMetrics
 
@@ -62,9 +65,12 @@ func NewRestAPIFetcher[K providertypes.ResponseKey, V providertypes.ResponseValu
 		return nil, fmt.Errorf("metrics is nil")
 	}
 
+	requestCreator, _ := apiDataHandler.(APIRequestCreator[K])
+
 	return &RestAPIFetcher[K, V]{
 		requestHandler: requestHandler,
 		apiDataHandler: apiDataHandler,
+		requestCreator: requestCreator,
 		metrics:        metrics,
 		config:         config,
 		logger:         logger.With(zap.String("fetcher", config.Name)),
@@ -83,8 +89,8 @@ func (pf *RestAPIFetcher[K, V]) Fetch(
 		pf.metrics.ObserveProviderResponseLatency(pf.config.Name, metrics.RedactedURL, time.Since(start))
 	}()
 
-	// Create the URL for the request.
-	url, err := pf.apiDataHandler.CreateURL(ids)
+	// Create the request.
+	req, err := pf.createRequest(ids)
 	if err != nil {
 		return providertypes.NewGetResponseWithErr[K, V](
 			ids,
@@ -95,6 +101,7 @@ func (pf *RestAPIFetcher[K, V]) Fetch(
 		)
 	}
 
+	url := req.URL
 	pf.logger.Debug("created url", zap.String("url", url))
 
 	// Make the request.
@@ -104,13 +111,10 @@ func (pf *RestAPIFetcher[K, V]) Fetch(
 	pf.logger.Debug("making request", zap.String("url", url))
 
 	// Record the status code in the metrics.
-	resp, err := pf.requestHandler.Do(apiCtx, url)
+	resp, err := pf.doRequest(apiCtx, req)
 	pf.metrics.AddHTTPStatusCode(pf.config.Name, resp)
 	if err != nil {
-		status := providertypes.ErrorUnknown
-		if resp != nil {
-			status = providertypes.ErrorCode(resp.StatusCode)
-		}
+		status := errorCode(resp, err)
 
 		pf.logger.Error(
 			"failed to make request",
@@ -130,7 +134,9 @@ func (pf *RestAPIFetcher[K, V]) Fetch(
 
 	pf.logger.Debug("received response", zap.Int("status_code", resp.StatusCode))
 	// TODO: add more error handling here.
-	// TODO(nikhil): move this logic to a shared HTTPClient
+	//
+	// Rate limits, retries and backoff are handled by the request handler (see the
+	// ResilientRequestHandler), so a rate limited response at this point has exhausted its retries.
 	var response providertypes.GetResponse[K, V]
 	switch {
 	case resp.StatusCode == http.StatusTooManyRequests:
@@ -163,3 +169,23 @@ func (pf *RestAPIFetcher[K, V]) Fetch(
 
 	return response
 }
+
+// createRequest creates the request for the given IDs. Data handlers that do not implement the
+// APIRequestCreator interface only create the URL of the request.
+func (pf *RestAPIFetcher[K, V]) createRequest(ids []K) (Request, error) {
+	if pf.requestCreator != nil {
+		return pf.requestCreator.CreateRequest(ids)
+	}
+
+	url, err := pf.apiDataHandler.CreateURL(ids)
+	return Request{URL: url}, err
+}
+
+// doRequest sends the request with the request handler.
+func (pf *RestAPIFetcher[K, V]) doRequest(ctx context.Context, req Request) (*http.Response, error) {
+	if pf.requestCreator != nil {
+		return pf.requestHandler.DoRequest(ctx, req)
+	}
+
+	return pf.requestHandler.Do(ctx, req.URL)
+}
@@ -0,0 +1,104 @@
+package recorder
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// Direction is the direction of a recorded websocket message.
+type Direction string
+
+const (
+	// DirectionRead is a message that was read from the data provider.
+	DirectionRead Direction = "read"
+	// DirectionWrite is a message that was written to the data provider.
+	DirectionWrite Direction = "write"
+)
+
+// Record is a single raw response or message exchanged with a data provider.
+type Record struct {
+	// Timestamp is the time at which the response or message was received or sent.
+	Timestamp time.Time `json:"timestamp"`
+	// URL is the URL of the request. This is only set for API records.
+	URL string `json:"url,omitempty"`
+	// Method is the HTTP method of the request, if it was set by the data provider. This is
+	// only set for API records.
+	Method string `json:"method,omitempty"`
+	// RequestBody is the body of the request, if any. This is only set for API records.
+	RequestBody []byte `json:"request_body,omitempty"`
+	// StatusCode is the HTTP status code of the response. This is only set for API records.
+	StatusCode int `json:"status_code,omitempty"`
+	// Connection identifies the websocket connection the message was exchanged on. This is
+	// only set for websocket records.
+	Connection int `json:"connection,omitempty"`
+	// Direction is the direction of the message. This is only set for websocket records.
+	Direction Direction `json:"direction,omitempty"`
+	// Body is the raw response body or message.
+	Body []byte `json:"body,omitempty"`
+	// Error is the error returned instead of a response or message, if any.
+	Error string `json:"error,omitempty"`
+}
+
+// Recorder persists records to a file as newline delimited JSON. It is safe for concurrent use.
+type Recorder struct {
+	mtx  sync.Mutex
+	file *os.File
+	enc  *json.Encoder
+}
+
+// NewRecorder returns a new Recorder that appends records to the file at the given path. The
+// file is created if it does not exist.
+func NewRecorder(path string) (*Recorder, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open recording %s: %w", path, err)
+	}
+
+	return &Recorder{
+		file: file,
+		enc:  json.NewEncoder(file),
+	}, nil
+}
+
+// Record persists the given record.
+func (r *Recorder) Record(record Record) error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	return r.enc.Encode(record)
+}
+
+// Close closes the underlying file.
+func (r *Recorder) Close() error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	return r.file.Close()
+}
+
+// ReadRecords reads all records from the recording at the given path, in the order in which
+// they were recorded.
+func ReadRecords(path string) ([]Record, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open recording %s: %w", path, err)
+	}
+	defer file.Close()
+
+	var records []Record
+	dec := json.NewDecoder(bufio.NewReader(file))
+	for dec.More() {
+		var record Record
+		if err := dec.Decode(&record); err != nil {
+			return nil, fmt.Errorf("failed to decode record %d of %s: %w", len(records), path, err)
+		}
+
+		records = append(records, record)
+	}
+
+	return records, nil
+}
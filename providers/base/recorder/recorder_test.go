@@ -0,0 +1,54 @@
+package recorder_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/providers/base/recorder"
+)
+
+func TestRecorder(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "recording.jsonl")
+
+	records := []recorder.Record{
+		{
+			Timestamp:  time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			URL:        "https://api.exchange.com/prices",
+			StatusCode: 200,
+			Body:       []byte(`{"price": "1"}`),
+		},
+		{
+			Timestamp:  time.Date(2024, 1, 1, 0, 0, 1, 0, time.UTC),
+			Connection: 1,
+			Direction:  recorder.DirectionRead,
+			Body:       []byte{0x1f, 0x8b, 0x00},
+			Error:      "unexpected EOF",
+		},
+	}
+
+	// Records are appended to existing recordings.
+	for _, record := range records {
+		rec, err := recorder.NewRecorder(path)
+		require.NoError(t, err)
+		require.NoError(t, rec.Record(record))
+		require.NoError(t, rec.Close())
+	}
+
+	read, err := recorder.ReadRecords(path)
+	require.NoError(t, err)
+	require.Equal(t, records, read)
+}
+
+func TestReadRecordsInvalid(t *testing.T) {
+	_, err := recorder.ReadRecords(filepath.Join(t.TempDir(), "missing.jsonl"))
+	require.Error(t, err)
+
+	path := filepath.Join(t.TempDir(), "invalid.jsonl")
+	require.NoError(t, os.WriteFile(path, []byte("{\"url\": 1}\n"), 0o600))
+	_, err = recorder.ReadRecords(path)
+	require.Error(t, err)
+}
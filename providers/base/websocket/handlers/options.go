@@ -1,5 +1,9 @@
 package handlers
 
+import (
+	"github.com/skip-mev/slinky/providers/base/websocket/metrics"
+)
+
 // Option is a function that is used to configure a WebSocketConnHandler.
 type Option func(*WebSocketConnHandlerImpl)
 
@@ -13,3 +17,27 @@ func WithPreDialHook(hook PreDialHook) Option {
 		r.preDialHook = hook
 	}
 }
+
+// WithLoginHook is an option that is used to set a login hook for a websocket connection. The
+// messages returned by the hook are sent every time a connection is established.
+func WithLoginHook(hook LoginHook) Option {
+	return func(r *WebSocketConnHandlerImpl) {
+		if hook == nil {
+			panic("login hook cannot be nil")
+		}
+
+		r.loginHook = hook
+	}
+}
+
+// WithMetrics is an option that is used to set the metrics used to track the health of each
+// endpoint of a websocket connection.
+func WithMetrics(m metrics.WebSocketMetrics) Option {
+	return func(r *WebSocketConnHandlerImpl) {
+		if m == nil {
+			panic("metrics cannot be nil")
+		}
+
+		r.metrics = m
+	}
+}
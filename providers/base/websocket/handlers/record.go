@@ -0,0 +1,191 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/skip-mev/slinky/providers/base/recorder"
+)
+
+// ErrReplayExhausted is returned by the ReplayWebSocketConnHandler once every recorded message
+// on the connection has been replayed.
+var ErrReplayExhausted = errors.New("no recorded messages left to replay")
+
+var (
+	_ WebSocketConnHandler = (*RecordingWebSocketConnHandler)(nil)
+	_ WebSocketConnHandler = (*ReplayWebSocketConnHandler)(nil)
+)
+
+// RecordingWebSocketConnHandler wraps a WebSocketConnHandler and records every message that is
+// read from or written to the data provider, along with the time at which it was exchanged.
+// Copies of the handler record to the same recorder with a distinct connection identifier.
+type RecordingWebSocketConnHandler struct {
+	handler  WebSocketConnHandler
+	recorder *recorder.Recorder
+
+	// connection identifies the connection in the recorded messages.
+	connection int
+	// connections is the number of connections created from the original handler.
+	connections *atomic.Int64
+}
+
+// NewRecordingWebSocketConnHandler returns a new RecordingWebSocketConnHandler that records the
+// messages exchanged by the given connection handler with the given recorder.
+func NewRecordingWebSocketConnHandler(
+	handler WebSocketConnHandler,
+	rec *recorder.Recorder,
+) (*RecordingWebSocketConnHandler, error) {
+	if handler == nil {
+		return nil, fmt.Errorf("websocket connection handler cannot be nil")
+	}
+
+	if rec == nil {
+		return nil, fmt.Errorf("recorder cannot be nil")
+	}
+
+	return &RecordingWebSocketConnHandler{
+		handler:     handler,
+		recorder:    rec,
+		connections: &atomic.Int64{},
+	}, nil
+}
+
+// Read reads a message with the underlying connection handler and records it.
+func (h *RecordingWebSocketConnHandler) Read() ([]byte, error) {
+	message, err := h.handler.Read()
+	return message, h.record(recorder.DirectionRead, message, err)
+}
+
+// Write records the message and writes it with the underlying connection handler.
+func (h *RecordingWebSocketConnHandler) Write(message []byte) error {
+	err := h.handler.Write(message)
+	return h.record(recorder.DirectionWrite, message, err)
+}
+
+// Close closes the underlying connection handler.
+func (h *RecordingWebSocketConnHandler) Close() error {
+	return h.handler.Close()
+}
+
+// Dial dials the underlying connection handler.
+func (h *RecordingWebSocketConnHandler) Dial() error {
+	return h.handler.Dial()
+}
+
+// Copy returns a copy of the connection handler that records to the same recorder under a new
+// connection identifier.
+func (h *RecordingWebSocketConnHandler) Copy() WebSocketConnHandler {
+	return &RecordingWebSocketConnHandler{
+		handler:     h.handler.Copy(),
+		recorder:    h.recorder,
+		connection:  int(h.connections.Add(1)),
+		connections: h.connections,
+	}
+}
+
+// record records the given message and returns the error of the underlying connection handler
+// joined with any error encountered while recording.
+func (h *RecordingWebSocketConnHandler) record(direction recorder.Direction, message []byte, err error) error {
+	record := recorder.Record{
+		Timestamp:  time.Now().UTC(),
+		Connection: h.connection,
+		Direction:  direction,
+		Body:       message,
+	}
+	if err != nil {
+		record.Error = err.Error()
+	}
+
+	if recordErr := h.recorder.Record(record); recordErr != nil {
+		return errors.Join(err, fmt.Errorf("failed to record message: %w", recordErr))
+	}
+
+	return err
+}
+
+// ReplayWebSocketConnHandler is a WebSocketConnHandler that replays recorded messages instead of
+// connecting to the data provider. Messages read on each connection are replayed in the order in
+// which they were recorded, which allows the WebSocketDataHandler of a provider to be exercised
+// deterministically. Messages written to the handler are discarded.
+type ReplayWebSocketConnHandler struct {
+	mtx sync.Mutex
+
+	// records are all of the recorded messages, shared by all copies of the handler.
+	records []recorder.Record
+	// messages are the recorded messages read on this connection that have not been replayed.
+	messages []recorder.Record
+
+	// connection identifies the recorded connection that is replayed.
+	connection int
+	// connections is the number of connections created from the original handler.
+	connections *atomic.Int64
+}
+
+// NewReplayWebSocketConnHandler returns a new ReplayWebSocketConnHandler that replays the given
+// records. Copies of the handler replay the recorded connections in the order in which they were
+// created.
+func NewReplayWebSocketConnHandler(records []recorder.Record) *ReplayWebSocketConnHandler {
+	return newReplayWebSocketConnHandler(records, 0, &atomic.Int64{})
+}
+
+func newReplayWebSocketConnHandler(
+	records []recorder.Record,
+	connection int,
+	connections *atomic.Int64,
+) *ReplayWebSocketConnHandler {
+	h := &ReplayWebSocketConnHandler{
+		records:     records,
+		connection:  connection,
+		connections: connections,
+	}
+	for _, record := range records {
+		if record.Connection == connection && record.Direction == recorder.DirectionRead {
+			h.messages = append(h.messages, record)
+		}
+	}
+
+	return h
+}
+
+// Read returns the next recorded message on the connection. ErrReplayExhausted is returned once
+// every recorded message on the connection has been replayed.
+func (h *ReplayWebSocketConnHandler) Read() ([]byte, error) {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	if len(h.messages) == 0 {
+		return nil, ErrReplayExhausted
+	}
+
+	record := h.messages[0]
+	h.messages = h.messages[1:]
+
+	if len(record.Error) > 0 {
+		return record.Body, errors.New(record.Error)
+	}
+
+	return record.Body, nil
+}
+
+// Write discards the message.
+func (h *ReplayWebSocketConnHandler) Write([]byte) error {
+	return nil
+}
+
+// Close is a no-op.
+func (h *ReplayWebSocketConnHandler) Close() error {
+	return nil
+}
+
+// Dial is a no-op.
+func (h *ReplayWebSocketConnHandler) Dial() error {
+	return nil
+}
+
+// Copy returns a copy of the connection handler that replays the next recorded connection.
+func (h *ReplayWebSocketConnHandler) Copy() WebSocketConnHandler {
+	return newReplayWebSocketConnHandler(h.records, int(h.connections.Add(1)), h.connections)
+}
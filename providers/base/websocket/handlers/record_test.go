@@ -0,0 +1,82 @@
+package handlers_test
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/providers/base/recorder"
+	"github.com/skip-mev/slinky/providers/base/websocket/handlers"
+	"github.com/skip-mev/slinky/providers/base/websocket/handlers/mocks"
+)
+
+func TestRecordAndReplayWebSocketConnHandler(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "okx.jsonl")
+	rec, err := recorder.NewRecorder(path)
+	require.NoError(t, err)
+
+	first := mocks.NewWebSocketConnHandler(t)
+	second := mocks.NewWebSocketConnHandler(t)
+	first.On("Copy").Return(second).Once()
+	first.On("Dial").Return(nil).Once()
+	first.On("Write", []byte("subscribe")).Return(nil).Once()
+	first.On("Read").Return([]byte("price 1"), nil).Once()
+	first.On("Read").Return(nil, fmt.Errorf("connection reset")).Once()
+	first.On("Close").Return(nil).Once()
+	second.On("Read").Return([]byte("price 2"), nil).Once()
+
+	recording, err := handlers.NewRecordingWebSocketConnHandler(first, rec)
+	require.NoError(t, err)
+	recordingCopy := recording.Copy()
+
+	// Record the traffic of the first connection.
+	require.NoError(t, recording.Dial())
+	require.NoError(t, recording.Write([]byte("subscribe")))
+	message, err := recording.Read()
+	require.NoError(t, err)
+	require.Equal(t, []byte("price 1"), message)
+	_, err = recording.Read()
+	require.EqualError(t, err, "connection reset")
+	require.NoError(t, recording.Close())
+
+	// Record the traffic of the copied connection.
+	message, err = recordingCopy.Read()
+	require.NoError(t, err)
+	require.Equal(t, []byte("price 2"), message)
+
+	require.NoError(t, rec.Close())
+
+	records, err := recorder.ReadRecords(path)
+	require.NoError(t, err)
+	require.Len(t, records, 4)
+	require.Equal(t, recorder.DirectionWrite, records[0].Direction)
+	require.Equal(t, []byte("subscribe"), records[0].Body)
+	require.Equal(t, recorder.DirectionRead, records[1].Direction)
+	require.Equal(t, 0, records[1].Connection)
+	require.Equal(t, "connection reset", records[2].Error)
+	require.Equal(t, 1, records[3].Connection)
+
+	// Replay the recorded messages. Writes are discarded and each copy replays the next
+	// recorded connection.
+	replay := handlers.NewReplayWebSocketConnHandler(records)
+	replayCopy := replay.Copy()
+
+	require.NoError(t, replay.Dial())
+	require.NoError(t, replay.Write([]byte("subscribe")))
+	message, err = replay.Read()
+	require.NoError(t, err)
+	require.Equal(t, []byte("price 1"), message)
+	_, err = replay.Read()
+	require.EqualError(t, err, "connection reset")
+	_, err = replay.Read()
+	require.ErrorIs(t, err, handlers.ErrReplayExhausted)
+	require.NoError(t, replay.Close())
+
+	message, err = replayCopy.Read()
+	require.NoError(t, err)
+	require.Equal(t, []byte("price 2"), message)
+	_, err = replayCopy.Read()
+	require.ErrorIs(t, err, handlers.ErrReplayExhausted)
+}
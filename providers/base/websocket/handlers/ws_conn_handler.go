@@ -1,14 +1,17 @@
 package handlers
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
 	"sync"
 	"time"
 
 	"github.com/gorilla/websocket"
 
 	"github.com/skip-mev/slinky/oracle/config"
+	"github.com/skip-mev/slinky/providers/base/websocket/metrics"
 )
 
 type (
@@ -18,6 +21,11 @@ type (
 	// PreDialHook is a function that is called before the connection is established. This
 	// is useful for dynamically generating configurations for the connection.
 	PreDialHook func(*WebSocketConnHandlerImpl) error
+
+	// LoginHook is a function that is called after a connection to an endpoint is established.
+	// It returns the messages, e.g. signed login requests, that must be sent to the endpoint
+	// before any subscriptions are made.
+	LoginHook func(endpoint config.Endpoint) ([]WebsocketEncodedMessage, error)
 )
 
 // WebSocketConnHandler is an interface the encapsulates the functionality of a websocket
@@ -56,8 +64,21 @@ type WebSocketConnHandlerImpl struct {
 	// conn is the connection to the data provider.
 	conn *websocket.Conn
 
+	// endpoint is the endpoint that the connection was established with.
+	endpoint config.Endpoint
+
+	// endpointIndex is the index of the endpoint that was last dialed. This is used to
+	// rotate through the endpoints when round-robin endpoint selection is configured.
+	endpointIndex int
+
 	// preDialHook is a function that is called before the connection is established.
 	preDialHook PreDialHook
+
+	// loginHook is a function that is called after the connection is established.
+	loginHook LoginHook
+
+	// metrics is used to track the health of each endpoint.
+	metrics metrics.WebSocketMetrics
 }
 
 // NewWebSocketHandlerImpl returns a new WebSocketConnHandlerImpl.
@@ -67,7 +88,9 @@ func NewWebSocketHandlerImpl(cfg config.WebSocketConfig, opts ...Option) (*WebSo
 	}
 
 	h := &WebSocketConnHandlerImpl{
-		cfg: cfg,
+		cfg:           cfg,
+		endpointIndex: -1,
+		metrics:       metrics.NewNopWebSocketMetrics(),
 	}
 
 	for _, opt := range opts {
@@ -88,7 +111,10 @@ func (h *WebSocketConnHandlerImpl) CreateDialer() *websocket.Dialer {
 	}
 }
 
-// Dial is used to create a new connection to the data provider with the given URL.
+// Dial is used to create a new connection to the data provider. The configured endpoints are
+// dialed in the order determined by the endpoint selection strategy until a connection is
+// successfully established. If the endpoint requires authentication, the API key is sent as a
+// header in the handshake and any login messages are sent once the connection is established.
 func (h *WebSocketConnHandlerImpl) Dial() error {
 	if h.preDialHook != nil {
 		if err := h.preDialHook(h); err != nil {
@@ -96,13 +122,95 @@ func (h *WebSocketConnHandlerImpl) Dial() error {
 		}
 	}
 
+	h.Lock()
+	defer h.Unlock()
+
 	if len(h.cfg.Endpoints) == 0 {
 		return fmt.Errorf("no endpoints provided")
 	}
 
-	var err error
-	h.conn, _, err = h.CreateDialer().Dial(h.cfg.Endpoints[0].URL, nil)
-	return err
+	var errs []error
+	for _, index := range h.endpointOrder() {
+		endpoint := h.cfg.Endpoints[index]
+		label := EndpointLabel(endpoint.URL)
+
+		h.endpointIndex = index
+		if err := h.dial(endpoint); err != nil {
+			h.metrics.AddWebSocketEndpointStatus(h.cfg.Name, label, metrics.DialErr)
+			h.metrics.SetWebSocketEndpointHealth(h.cfg.Name, label, false)
+			errs = append(errs, fmt.Errorf("failed to connect to %s: %w", label, err))
+			continue
+		}
+
+		h.metrics.AddWebSocketEndpointStatus(h.cfg.Name, label, metrics.DialSuccess)
+		h.metrics.SetWebSocketEndpointHealth(h.cfg.Name, label, true)
+		return nil
+	}
+
+	return errors.Join(errs...)
+}
+
+// dial establishes a connection with the given endpoint and sends any login messages. The
+// caller must hold the lock.
+func (h *WebSocketConnHandlerImpl) dial(endpoint config.Endpoint) error {
+	var header http.Header
+	if endpoint.Authentication.Enabled() {
+		header = http.Header{}
+		header.Set(endpoint.Authentication.APIKeyHeader, endpoint.Authentication.APIKey)
+	}
+
+	conn, _, err := h.CreateDialer().Dial(endpoint.URL, header)
+	if err != nil {
+		return err
+	}
+
+	h.conn = conn
+	h.endpoint = endpoint
+
+	if h.loginHook == nil {
+		return nil
+	}
+
+	if err := h.login(endpoint); err != nil {
+		_ = conn.Close()
+		h.conn = nil
+		return err
+	}
+
+	return nil
+}
+
+// login sends the login messages for the given endpoint over the established connection.
+func (h *WebSocketConnHandlerImpl) login(endpoint config.Endpoint) error {
+	messages, err := h.loginHook(endpoint)
+	if err != nil {
+		return fmt.Errorf("failed to create login messages: %w", err)
+	}
+
+	for _, message := range messages {
+		if err := h.write(message); err != nil {
+			return fmt.Errorf("failed to send login message: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// endpointOrder returns the order in which the endpoints should be dialed. Priority endpoint
+// selection always starts with the first endpoint, whereas round-robin endpoint selection
+// starts with the endpoint after the one that was last dialed.
+func (h *WebSocketConnHandlerImpl) endpointOrder() []int {
+	start := 0
+	if h.cfg.EndpointSelection == config.EndpointSelectionRoundRobin {
+		start = (h.endpointIndex + 1) % len(h.cfg.Endpoints)
+	}
+
+	order := make([]int, len(h.cfg.Endpoints))
+	for i := range order {
+		order[i] = (start + i) % len(h.cfg.Endpoints)
+	}
+
+	return order
 }
 
 // Read is used to read data from the data provider. Each websocket data handler is responsible
@@ -121,6 +229,13 @@ func (h *WebSocketConnHandlerImpl) Read() ([]byte, error) {
 	}
 
 	_, message, err := h.conn.ReadMessage()
+	if err != nil {
+		// The connection is corrupt after a failed read, so the endpoint is no longer healthy.
+		label := EndpointLabel(h.endpoint.URL)
+		h.metrics.AddWebSocketEndpointStatus(h.cfg.Name, label, metrics.ReadErr)
+		h.metrics.SetWebSocketEndpointHealth(h.cfg.Name, label, false)
+	}
+
 	return message, err
 }
 
@@ -130,6 +245,11 @@ func (h *WebSocketConnHandlerImpl) Write(message []byte) error {
 	h.Lock()
 	defer h.Unlock()
 
+	return h.write(message)
+}
+
+// write sends the message to the data provider. The caller must hold the lock.
+func (h *WebSocketConnHandlerImpl) write(message []byte) error {
 	if h.conn == nil {
 		return fmt.Errorf("connection has not been established")
 	}
@@ -163,6 +283,7 @@ func (h *WebSocketConnHandlerImpl) Close() error {
 		return err
 	}
 
+	h.metrics.SetWebSocketEndpointHealth(h.cfg.Name, EndpointLabel(h.endpoint.URL), false)
 	return h.conn.Close()
 }
 
@@ -173,8 +294,11 @@ func (h *WebSocketConnHandlerImpl) Copy() WebSocketConnHandler {
 	defer h.Unlock()
 
 	return &WebSocketConnHandlerImpl{
-		cfg:         h.cfg,
-		preDialHook: h.preDialHook,
+		cfg:           h.cfg,
+		endpointIndex: -1,
+		preDialHook:   h.preDialHook,
+		loginHook:     h.loginHook,
+		metrics:       h.metrics,
 	}
 }
 
@@ -186,6 +310,14 @@ func (h *WebSocketConnHandlerImpl) GetConfig() config.WebSocketConfig {
 	return h.cfg
 }
 
+// GetEndpoint returns the endpoint that the connection was last established with.
+func (h *WebSocketConnHandlerImpl) GetEndpoint() config.Endpoint {
+	h.Lock()
+	defer h.Unlock()
+
+	return h.endpoint
+}
+
 // SetConfig is used to set the configuration for the connection handler.
 func (h *WebSocketConnHandlerImpl) SetConfig(cfg config.WebSocketConfig) {
 	h.Lock()
@@ -193,3 +325,14 @@ func (h *WebSocketConnHandlerImpl) SetConfig(cfg config.WebSocketConfig) {
 
 	h.cfg = cfg
 }
+
+// EndpointLabel returns the label used to identify the endpoint with the given URL in metrics
+// and errors. Query parameters and user info are omitted as they may contain credentials.
+func EndpointLabel(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "unknown"
+	}
+
+	return u.Host + u.Path
+}
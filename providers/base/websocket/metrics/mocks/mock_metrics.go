@@ -25,11 +25,21 @@ func (_m *WebSocketMetrics) AddWebSocketDataHandlerStatus(provider string, statu
 	_m.Called(provider, status)
 }
 
+// AddWebSocketEndpointStatus provides a mock function with given fields: provider, endpoint, status
+func (_m *WebSocketMetrics) AddWebSocketEndpointStatus(provider string, endpoint string, status metrics.ConnectionStatus) {
+	_m.Called(provider, endpoint, status)
+}
+
 // ObserveWebSocketLatency provides a mock function with given fields: provider, duration
 func (_m *WebSocketMetrics) ObserveWebSocketLatency(provider string, duration time.Duration) {
 	_m.Called(provider, duration)
 }
 
+// SetWebSocketEndpointHealth provides a mock function with given fields: provider, endpoint, healthy
+func (_m *WebSocketMetrics) SetWebSocketEndpointHealth(provider string, endpoint string, healthy bool) {
+	_m.Called(provider, endpoint, healthy)
+}
+
 // NewWebSocketMetrics creates a new instance of WebSocketMetrics. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewWebSocketMetrics(t interface {
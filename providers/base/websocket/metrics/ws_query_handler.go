@@ -13,6 +13,9 @@ import (
 const (
 	// StatusLabel is the label used for the status of a provider response.
 	StatusLabel = "status"
+
+	// EndpointLabel is the label used for the websocket endpoint a provider is connected to.
+	EndpointLabel = "endpoint"
 )
 
 // WebSocketMetrics is an interface that defines the API for metrics collection for providers
@@ -31,6 +34,14 @@ type WebSocketMetrics interface {
 	// ObserveWebSocketLatency adds a latency observation to the metrics collector for the
 	// given provider.
 	ObserveWebSocketLatency(provider string, duration time.Duration)
+
+	// AddWebSocketEndpointStatus adds a status response to the metrics collector for the given
+	// provider and endpoint. Specifically, this tracks the dial and read errors of each endpoint.
+	AddWebSocketEndpointStatus(provider, endpoint string, status ConnectionStatus)
+
+	// SetWebSocketEndpointHealth sets whether the connection to the given provider's endpoint
+	// is currently healthy.
+	SetWebSocketEndpointHealth(provider, endpoint string, healthy bool)
 }
 
 // WebSocketMetricsImpl contains metrics exposed by this package.
@@ -43,6 +54,12 @@ type WebSocketMetricsImpl struct {
 
 	// Histogram paginated by provider, measuring the latency between invocation and collection.
 	responseTimePerProvider *prometheus.HistogramVec
+
+	// Number of connection statuses per endpoint.
+	endpointStatusPerProvider *prometheus.CounterVec
+
+	// Health of the connection to each endpoint.
+	endpointHealthPerProvider *prometheus.GaugeVec
 }
 
 // NewWebSocketMetricsFromConfig returns a new Metrics struct given the main oracle metrics config.
@@ -72,12 +89,24 @@ func NewWebSocketMetrics() WebSocketMetrics {
 			Help:      "Response time per web socket provider.",
 			Buckets:   []float64{50, 100, 250, 500, 1000, 2000},
 		}, []string{providermetrics.ProviderLabel}),
+		endpointStatusPerProvider: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: oraclemetrics.OracleSubsystem,
+			Name:      "web_socket_endpoint_status",
+			Help:      "Statuses associated with the connections made to each web socket endpoint.",
+		}, []string{providermetrics.ProviderLabel, EndpointLabel, StatusLabel}),
+		endpointHealthPerProvider: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: oraclemetrics.OracleSubsystem,
+			Name:      "web_socket_endpoint_health",
+			Help:      "Whether the connection to each web socket endpoint is healthy (1) or not (0).",
+		}, []string{providermetrics.ProviderLabel, EndpointLabel}),
 	}
 
 	// register the above metrics
 	prometheus.MustRegister(m.connectionStatusPerProvider)
 	prometheus.MustRegister(m.dataHandlerStatusPerProvider)
 	prometheus.MustRegister(m.responseTimePerProvider)
+	prometheus.MustRegister(m.endpointStatusPerProvider)
+	prometheus.MustRegister(m.endpointHealthPerProvider)
 
 	return m
 }
@@ -98,6 +127,12 @@ func (m *noOpWebSocketMetricsImpl) AddWebSocketDataHandlerStatus(_ string, _ Han
 func (m *noOpWebSocketMetricsImpl) ObserveWebSocketLatency(_ string, _ time.Duration) {
 }
 
+func (m *noOpWebSocketMetricsImpl) AddWebSocketEndpointStatus(_, _ string, _ ConnectionStatus) {
+}
+
+func (m *noOpWebSocketMetricsImpl) SetWebSocketEndpointHealth(_, _ string, _ bool) {
+}
+
 // AddWebSocketConnectionStatus adds a method / status response to the metrics collector for the
 // given provider. Specifically, this tracks various connection related errors.
 func (m *WebSocketMetricsImpl) AddWebSocketConnectionStatus(provider string, status ConnectionStatus) {
@@ -125,3 +160,29 @@ func (m *WebSocketMetricsImpl) ObserveWebSocketLatency(provider string, duration
 	},
 	).Observe(float64(duration.Milliseconds()))
 }
+
+// AddWebSocketEndpointStatus adds a status response to the metrics collector for the given
+// provider and endpoint.
+func (m *WebSocketMetricsImpl) AddWebSocketEndpointStatus(provider, endpoint string, status ConnectionStatus) {
+	m.endpointStatusPerProvider.With(prometheus.Labels{
+		providermetrics.ProviderLabel: provider,
+		EndpointLabel:                 endpoint,
+		StatusLabel:                   status.String(),
+	},
+	).Add(1)
+}
+
+// SetWebSocketEndpointHealth sets whether the connection to the given provider's endpoint is
+// currently healthy.
+func (m *WebSocketMetricsImpl) SetWebSocketEndpointHealth(provider, endpoint string, healthy bool) {
+	value := 0.0
+	if healthy {
+		value = 1
+	}
+
+	m.endpointHealthPerProvider.With(prometheus.Labels{
+		providermetrics.ProviderLabel: provider,
+		EndpointLabel:                 endpoint,
+	},
+	).Set(value)
+}
@@ -1,8 +1,6 @@
 package oracle
 
 import (
-	"net/http"
-
 	"go.uber.org/zap"
 
 	"github.com/skip-mev/slinky/oracle/config"
@@ -12,6 +10,7 @@ import (
 	apihandlers "github.com/skip-mev/slinky/providers/base/api/handlers"
 	apimetrics "github.com/skip-mev/slinky/providers/base/api/metrics"
 	providermetrics "github.com/skip-mev/slinky/providers/base/metrics"
+	"github.com/skip-mev/slinky/providers/registry"
 	"github.com/skip-mev/slinky/service/clients/marketmap/types"
 	mmtypes "github.com/skip-mev/slinky/x/marketmap/types"
 )
@@ -30,21 +29,13 @@ func MarketMapProviderFactory(
 		return nil, err
 	}
 
-	client := &http.Client{
-		Transport: &http.Transport{
-			MaxConnsPerHost: cfg.API.MaxQueries,
-			Proxy:           http.ProxyFromEnvironment,
-		},
-		Timeout: cfg.API.Timeout,
-	}
-
 	var (
 		apiDataHandler   types.MarketMapAPIDataHandler
 		ids              []types.Chain
 		marketMapFetcher types.MarketMapFetcher
 	)
 
-	requestHandler, err := apihandlers.NewRequestHandlerImpl(client)
+	requestHandler, err := registry.NewRequestHandler(cfg.API)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,30 @@
+package oracle
+
+// The price providers supported out of the box register themselves with the provider registry
+// when their packages are initialized. Additional providers can be supported by the factories by
+// importing their packages, which register the providers in the same way.
+import (
+	_ "github.com/skip-mev/slinky/providers/apis/binance"
+	_ "github.com/skip-mev/slinky/providers/apis/coinbase"
+	_ "github.com/skip-mev/slinky/providers/apis/coingecko"
+	_ "github.com/skip-mev/slinky/providers/apis/defi/evmpools"
+	_ "github.com/skip-mev/slinky/providers/apis/defi/raydium"
+	_ "github.com/skip-mev/slinky/providers/apis/defi/uniswapv3"
+	_ "github.com/skip-mev/slinky/providers/apis/geckoterminal"
+	_ "github.com/skip-mev/slinky/providers/apis/jsonapi"
+	_ "github.com/skip-mev/slinky/providers/apis/kraken"
+	_ "github.com/skip-mev/slinky/providers/static"
+	_ "github.com/skip-mev/slinky/providers/volatile"
+	_ "github.com/skip-mev/slinky/providers/websockets/binance"
+	_ "github.com/skip-mev/slinky/providers/websockets/bitfinex"
+	_ "github.com/skip-mev/slinky/providers/websockets/bitstamp"
+	_ "github.com/skip-mev/slinky/providers/websockets/bybit"
+	_ "github.com/skip-mev/slinky/providers/websockets/coinbase"
+	_ "github.com/skip-mev/slinky/providers/websockets/cryptodotcom"
+	_ "github.com/skip-mev/slinky/providers/websockets/gate"
+	_ "github.com/skip-mev/slinky/providers/websockets/huobi"
+	_ "github.com/skip-mev/slinky/providers/websockets/kraken"
+	_ "github.com/skip-mev/slinky/providers/websockets/kucoin"
+	_ "github.com/skip-mev/slinky/providers/websockets/mexc"
+	_ "github.com/skip-mev/slinky/providers/websockets/okx"
+)
@@ -0,0 +1,261 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/skip-mev/slinky/oracle/config"
+	"github.com/skip-mev/slinky/oracle/types"
+	apihandlers "github.com/skip-mev/slinky/providers/base/api/handlers"
+	apimetrics "github.com/skip-mev/slinky/providers/base/api/metrics"
+	wshandlers "github.com/skip-mev/slinky/providers/base/websocket/handlers"
+	wsmetrics "github.com/skip-mev/slinky/providers/base/websocket/metrics"
+)
+
+type (
+	// APIConstructor is a function that constructs the price fetcher for an API based price
+	// provider. The request handler is the default request handler created for the provider's
+	// configuration and may be ignored by providers that interact with the data source directly.
+	APIConstructor func(
+		ctx context.Context,
+		logger *zap.Logger,
+		cfg config.ProviderConfig,
+		requestHandler apihandlers.RequestHandler,
+		metrics apimetrics.APIMetrics,
+	) (types.PriceAPIFetcher, error)
+
+	// WebSocketConstructor is a function that constructs the data handler and connection handler
+	// for a websocket based price provider.
+	WebSocketConstructor func(
+		logger *zap.Logger,
+		cfg config.ProviderConfig,
+		metrics wsmetrics.WebSocketMetrics,
+	) (types.PriceWebSocketDataHandler, wshandlers.WebSocketConnHandler, error)
+
+	// APIDataHandlerConstructor is a function that constructs the data handler of an API based
+	// price provider.
+	APIDataHandlerConstructor func(cfg config.APIConfig) (types.PriceAPIDataHandler, error)
+
+	// WebSocketDataHandlerConstructor is a function that constructs the data handler of a websocket
+	// based price provider.
+	WebSocketDataHandlerConstructor func(logger *zap.Logger, cfg config.WebSocketConfig) (types.PriceWebSocketDataHandler, error)
+)
+
+// NameSeparator separates the base name of a provider from the suffix of a dynamic provider
+// name, e.g. "json_api-myexchange". Dynamic provider names resolve to the provider registered
+// under their base name - if that provider was registered with RegisterDynamicAPIProvider -
+// unless a provider is registered under the full name.
+const NameSeparator = "-"
+
+var (
+	mtx          sync.RWMutex
+	apiProviders = make(map[string]APIConstructor)
+	wsProviders  = make(map[string]WebSocketConstructor)
+
+	// dynamicAPIProviders is the set of API based price providers that can be configured under
+	// dynamic provider names.
+	dynamicAPIProviders = make(map[string]struct{})
+)
+
+// RegisterAPIProvider registers the constructor of the API based price provider with the given
+// name. This is meant to be called from the init function of the provider's package. It panics
+// if the name is empty, the constructor is nil, or a provider with the same name has already
+// been registered.
+func RegisterAPIProvider(name string, constructor APIConstructor) {
+	mtx.Lock()
+	defer mtx.Unlock()
+
+	registerAPIProvider(name, constructor)
+}
+
+// RegisterDynamicAPIProvider registers the constructor of the API based price provider with the
+// given name, and resolves any dynamic provider name of the form `<name>-<suffix>` that is not
+// registered itself to the provider. This is meant for generic providers that are configured
+// once per venue. It panics under the same conditions as RegisterAPIProvider.
+func RegisterDynamicAPIProvider(name string, constructor APIConstructor) {
+	mtx.Lock()
+	defer mtx.Unlock()
+
+	registerAPIProvider(name, constructor)
+	dynamicAPIProviders[name] = struct{}{}
+}
+
+func registerAPIProvider(name string, constructor APIConstructor) {
+	if len(name) == 0 {
+		panic("api provider name cannot be empty")
+	}
+
+	if constructor == nil {
+		panic(fmt.Sprintf("api provider %s constructor cannot be nil", name))
+	}
+
+	if _, ok := apiProviders[name]; ok {
+		panic(fmt.Sprintf("api provider %s is already registered", name))
+	}
+
+	apiProviders[name] = constructor
+}
+
+// RegisterWebSocketProvider registers the constructor of the websocket based price provider with
+// the given name. This is meant to be called from the init function of the provider's package. It
+// panics if the name is empty, the constructor is nil, or a provider with the same name has already
+// been registered.
+func RegisterWebSocketProvider(name string, constructor WebSocketConstructor) {
+	mtx.Lock()
+	defer mtx.Unlock()
+
+	if len(name) == 0 {
+		panic("websocket provider name cannot be empty")
+	}
+
+	if constructor == nil {
+		panic(fmt.Sprintf("websocket provider %s constructor cannot be nil", name))
+	}
+
+	if _, ok := wsProviders[name]; ok {
+		panic(fmt.Sprintf("websocket provider %s is already registered", name))
+	}
+
+	wsProviders[name] = constructor
+}
+
+// GetAPIProvider returns the constructor of the API based price provider with the given name.
+// Dynamic provider names resolve to the provider registered under their base name if it was
+// registered with RegisterDynamicAPIProvider.
+func GetAPIProvider(name string) (APIConstructor, bool) {
+	mtx.RLock()
+	defer mtx.RUnlock()
+
+	if constructor, ok := apiProviders[name]; ok {
+		return constructor, true
+	}
+
+	base, _, found := strings.Cut(name, NameSeparator)
+	if _, dynamic := dynamicAPIProviders[base]; !found || !dynamic {
+		return nil, false
+	}
+
+	return apiProviders[base], true
+}
+
+// GetWebSocketProvider returns the constructor of the websocket based price provider with the
+// given name.
+func GetWebSocketProvider(name string) (WebSocketConstructor, bool) {
+	mtx.RLock()
+	defer mtx.RUnlock()
+
+	constructor, ok := wsProviders[name]
+	return constructor, ok
+}
+
+// APIProviders returns the sorted names of all registered API based price providers.
+func APIProviders() []string {
+	mtx.RLock()
+	defer mtx.RUnlock()
+
+	return sortedKeys(apiProviders)
+}
+
+// WebSocketProviders returns the sorted names of all registered websocket based price providers.
+func WebSocketProviders() []string {
+	mtx.RLock()
+	defer mtx.RUnlock()
+
+	return sortedKeys(wsProviders)
+}
+
+// NewRestAPIConstructor returns an APIConstructor for providers that only implement an
+// APIDataHandler. The data handler is paired with the default request handler to create a
+// REST API price fetcher.
+func NewRestAPIConstructor(newDataHandler APIDataHandlerConstructor) APIConstructor {
+	return func(
+		_ context.Context,
+		logger *zap.Logger,
+		cfg config.ProviderConfig,
+		requestHandler apihandlers.RequestHandler,
+		metrics apimetrics.APIMetrics,
+	) (types.PriceAPIFetcher, error) {
+		dataHandler, err := newDataHandler(cfg.API)
+		if err != nil {
+			return nil, err
+		}
+
+		return apihandlers.NewRestAPIFetcher(
+			requestHandler,
+			dataHandler,
+			metrics,
+			cfg.API,
+			logger,
+		)
+	}
+}
+
+// NewWebSocketConstructor returns a WebSocketConstructor for providers that only implement a
+// WebSocketDataHandler. The data handler is paired with the default connection handler, which
+// is configured with the given options.
+func NewWebSocketConstructor(
+	newDataHandler WebSocketDataHandlerConstructor,
+	opts ...wshandlers.Option,
+) WebSocketConstructor {
+	return func(
+		logger *zap.Logger,
+		cfg config.ProviderConfig,
+		metrics wsmetrics.WebSocketMetrics,
+	) (types.PriceWebSocketDataHandler, wshandlers.WebSocketConnHandler, error) {
+		dataHandler, err := newDataHandler(logger, cfg.WebSocket)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		connHandler, err := wshandlers.NewWebSocketHandlerImpl(
+			cfg.WebSocket,
+			append([]wshandlers.Option{wshandlers.WithMetrics(metrics)}, opts...)...,
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return dataHandler, connHandler, nil
+	}
+}
+
+// NewHTTPClient returns the HTTP client used to query the API of the given provider. The client
+// limits the number of concurrent connections and uses the configured timeout to ensure requests
+// do not hang.
+func NewHTTPClient(cfg config.APIConfig) *http.Client {
+	return &http.Client{
+		Transport: &http.Transport{
+			MaxConnsPerHost: cfg.MaxQueries,
+			Proxy:           http.ProxyFromEnvironment,
+		},
+		Timeout: cfg.Timeout,
+	}
+}
+
+// NewRequestHandler returns the default request handler used to query the API of the given
+// provider. Requests are sent with the client returned by NewHTTPClient and are rate limited,
+// retried and circuit broken according to the provider's config.
+func NewRequestHandler(cfg config.APIConfig, opts ...apihandlers.Option) (apihandlers.RequestHandler, error) {
+	requestHandler, err := apihandlers.NewRequestHandlerImpl(NewHTTPClient(cfg), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return apihandlers.NewResilientRequestHandler(requestHandler, cfg)
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
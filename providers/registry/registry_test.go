@@ -0,0 +1,148 @@
+package registry_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/skip-mev/slinky/cmd/constants"
+	"github.com/skip-mev/slinky/oracle/config"
+	"github.com/skip-mev/slinky/oracle/types"
+	apihandlers "github.com/skip-mev/slinky/providers/base/api/handlers"
+	apimetrics "github.com/skip-mev/slinky/providers/base/api/metrics"
+	wshandlers "github.com/skip-mev/slinky/providers/base/websocket/handlers"
+	wsmetrics "github.com/skip-mev/slinky/providers/base/websocket/metrics"
+	_ "github.com/skip-mev/slinky/providers/factories/oracle"
+	"github.com/skip-mev/slinky/providers/registry"
+	mmtypes "github.com/skip-mev/slinky/service/clients/marketmap/types"
+)
+
+func noopAPIConstructor(
+	context.Context,
+	*zap.Logger,
+	config.ProviderConfig,
+	apihandlers.RequestHandler,
+	apimetrics.APIMetrics,
+) (types.PriceAPIFetcher, error) {
+	return nil, nil
+}
+
+func noopWebSocketConstructor(
+	*zap.Logger,
+	config.ProviderConfig,
+	wsmetrics.WebSocketMetrics,
+) (types.PriceWebSocketDataHandler, wshandlers.WebSocketConnHandler, error) {
+	return nil, nil, nil
+}
+
+func TestRegisterAPIProvider(t *testing.T) {
+	const name = "test_registry_api"
+
+	_, ok := registry.GetAPIProvider(name)
+	require.False(t, ok)
+
+	registry.RegisterAPIProvider(name, noopAPIConstructor)
+
+	constructor, ok := registry.GetAPIProvider(name)
+	require.True(t, ok)
+	require.NotNil(t, constructor)
+	require.Contains(t, registry.APIProviders(), name)
+	require.NotContains(t, registry.WebSocketProviders(), name)
+
+	// Dynamic provider names do not resolve to providers that were not registered as dynamic.
+	_, ok = registry.GetAPIProvider(name + registry.NameSeparator + "venue")
+	require.False(t, ok)
+
+	require.Panics(t, func() { registry.RegisterAPIProvider(name, noopAPIConstructor) })
+	require.Panics(t, func() { registry.RegisterAPIProvider("", noopAPIConstructor) })
+	require.Panics(t, func() { registry.RegisterAPIProvider("test_registry_api_nil", nil) })
+}
+
+func TestRegisterDynamicAPIProvider(t *testing.T) {
+	const name = "test_registry_dynamic_api"
+
+	registry.RegisterDynamicAPIProvider(name, noopAPIConstructor)
+
+	constructor, ok := registry.GetAPIProvider(name)
+	require.True(t, ok)
+	require.NotNil(t, constructor)
+	require.Contains(t, registry.APIProviders(), name)
+
+	// Dynamic provider names resolve to the provider registered under their base name.
+	constructor, ok = registry.GetAPIProvider(name + registry.NameSeparator + "venue")
+	require.True(t, ok)
+	require.NotNil(t, constructor)
+	_, ok = registry.GetAPIProvider("unknown" + registry.NameSeparator + name)
+	require.False(t, ok)
+
+	// Providers registered under the full dynamic name take precedence.
+	registry.RegisterAPIProvider(name+registry.NameSeparator+"registered", noopAPIConstructor)
+	_, ok = registry.GetAPIProvider(name + registry.NameSeparator + "registered")
+	require.True(t, ok)
+
+	require.Panics(t, func() { registry.RegisterDynamicAPIProvider(name, noopAPIConstructor) })
+	require.Panics(t, func() { registry.RegisterDynamicAPIProvider("", noopAPIConstructor) })
+}
+
+func TestDynamicProviderNames(t *testing.T) {
+	// The JSON API and Uniswap V3 providers opt in to dynamic naming.
+	_, ok := registry.GetAPIProvider("json_api" + registry.NameSeparator + "myexchange")
+	require.True(t, ok)
+	_, ok = registry.GetAPIProvider("uniswapv3_api" + registry.NameSeparator + "arbitrum")
+	require.True(t, ok)
+
+	// Other providers do not, so typos are not resolved to the base provider.
+	_, ok = registry.GetAPIProvider("binance_api" + registry.NameSeparator + "foo")
+	require.False(t, ok)
+	_, ok = registry.GetWebSocketProvider("okx_ws" + registry.NameSeparator + "foo")
+	require.False(t, ok)
+}
+
+func TestRegisterWebSocketProvider(t *testing.T) {
+	const name = "test_registry_ws"
+
+	_, ok := registry.GetWebSocketProvider(name)
+	require.False(t, ok)
+
+	registry.RegisterWebSocketProvider(name, noopWebSocketConstructor)
+
+	constructor, ok := registry.GetWebSocketProvider(name)
+	require.True(t, ok)
+	require.NotNil(t, constructor)
+	require.Contains(t, registry.WebSocketProviders(), name)
+	require.NotContains(t, registry.APIProviders(), name)
+
+	require.Panics(t, func() { registry.RegisterWebSocketProvider(name, noopWebSocketConstructor) })
+	require.Panics(t, func() { registry.RegisterWebSocketProvider("", noopWebSocketConstructor) })
+	require.Panics(t, func() { registry.RegisterWebSocketProvider("test_registry_ws_nil", nil) })
+}
+
+func TestDefaultProvidersAreRegistered(t *testing.T) {
+	for _, provider := range constants.Providers {
+		if provider.Type == mmtypes.ConfigType {
+			continue
+		}
+
+		t.Run(provider.Name, func(t *testing.T) {
+			if provider.WebSocket.Enabled {
+				_, ok := registry.GetWebSocketProvider(provider.Name)
+				require.True(t, ok)
+				return
+			}
+
+			constructor, ok := registry.GetAPIProvider(provider.Name)
+			require.True(t, ok)
+
+			// The default request handler is ignored by providers that do not implement an
+			// APIDataHandler.
+			requestHandler, err := apihandlers.NewRequestHandlerImpl(registry.NewHTTPClient(provider.API))
+			require.NoError(t, err)
+
+			fetcher, err := constructor(context.Background(), zap.NewNop(), provider, requestHandler, apimetrics.NewNopAPIMetrics())
+			require.NoError(t, err)
+			require.NotNil(t, fetcher)
+		})
+	}
+}
@@ -6,11 +6,21 @@ import (
 	"net/http"
 	"strings"
 
+	"go.uber.org/zap"
+
+	"github.com/skip-mev/slinky/oracle/config"
+	"github.com/skip-mev/slinky/oracle/types"
 	"github.com/skip-mev/slinky/providers/base/api/handlers"
+	"github.com/skip-mev/slinky/providers/base/api/metrics"
+	"github.com/skip-mev/slinky/providers/registry"
 )
 
 var _ handlers.RequestHandler = (*MockClient)(nil)
 
+func init() {
+	registry.RegisterAPIProvider(Name, NewMockAPIConstructor(NewAPIHandler))
+}
+
 // MockClient is meant to be paired with the MockAPIHandler. It
 // should only be used for testing.
 type MockClient struct{}
@@ -27,7 +37,32 @@ func (s *MockClient) Do(_ context.Context, _ string) (*http.Response, error) {
 	}, nil
 }
 
+// DoRequest is a no-op.
+func (s *MockClient) DoRequest(ctx context.Context, _ handlers.Request) (*http.Response, error) {
+	return s.Do(ctx, "")
+}
+
 // Type returns the HTTP method used to send requests.
 func (s *MockClient) Type() string {
 	return http.MethodGet
 }
+
+// NewMockAPIConstructor returns an APIConstructor that pairs the data handler returned by
+// newDataHandler with the static mock client. It should only be used for testing.
+func NewMockAPIConstructor(newDataHandler func() types.PriceAPIDataHandler) registry.APIConstructor {
+	return func(
+		_ context.Context,
+		logger *zap.Logger,
+		cfg config.ProviderConfig,
+		_ handlers.RequestHandler,
+		m metrics.APIMetrics,
+	) (types.PriceAPIFetcher, error) {
+		return handlers.NewRestAPIFetcher(
+			NewStaticMockClient(),
+			newDataHandler(),
+			m,
+			cfg.API,
+			logger,
+		)
+	}
+}
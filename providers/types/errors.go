@@ -25,6 +25,8 @@ const (
 	ErrorWebSocketGeneral      ErrorCode = 14
 	ErrorGRPCGeneral           ErrorCode = 15
 	ErrorNoExistingPrice       ErrorCode = 16
+	ErrorCircuitOpen           ErrorCode = 17
+	ErrorInsufficientLiquidity ErrorCode = 18
 )
 
 // Error returns the error representation of the ErrorCode.
@@ -62,6 +64,10 @@ func (e ErrorCode) Error() error {
 		return errors.New("general grpc error")
 	case ErrorNoExistingPrice:
 		return errors.New("no existing price")
+	case ErrorCircuitOpen:
+		return errors.New("circuit breaker open")
+	case ErrorInsufficientLiquidity:
+		return errors.New("insufficient liquidity")
 	case ErrorUnknown:
 		fallthrough
 	default:
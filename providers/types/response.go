@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"math/big"
 	"time"
 )
 
@@ -56,6 +57,12 @@ type ResolvedResult[V ResponseValue] struct {
 	// ResponseCode is an optional code that can be attached to responses to provide
 	// additional context.
 	ResponseCode ResponseCode
+	// Volume is the optional 24h traded volume of the base asset reported alongside
+	// the value. This is nil if the provider does not report volume.
+	Volume *big.Float
+	// Liquidity is the optional liquidity backing the value, e.g. the depth of a DEX pool
+	// denominated in the quote asset. This is nil if the provider does not report liquidity.
+	Liquidity *big.Float
 }
 
 // UnresolvedResult is an unresolved (failed) result of a single requested ID.
@@ -122,6 +129,24 @@ func NewResultWithCode[V ResponseValue](value V, timestamp time.Time, code Respo
 	}
 }
 
+// NewResultWithVolume creates a new ResolvedResult with the given 24h base asset volume.
+func NewResultWithVolume[V ResponseValue](value V, timestamp time.Time, volume *big.Float) ResolvedResult[V] {
+	return ResolvedResult[V]{
+		Value:     value,
+		Timestamp: timestamp,
+		Volume:    volume,
+	}
+}
+
+// NewResultWithLiquidity creates a new ResolvedResult with the given liquidity.
+func NewResultWithLiquidity[V ResponseValue](value V, timestamp time.Time, liquidity *big.Float) ResolvedResult[V] {
+	return ResolvedResult[V]{
+		Value:     value,
+		Timestamp: timestamp,
+		Liquidity: liquidity,
+	}
+}
+
 // String returns a string representation of the ResolvedResult. This is mostly used for logging
 // and testing purposes.
 func (r ResolvedResult[V]) String() string {
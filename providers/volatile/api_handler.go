@@ -7,8 +7,14 @@ import (
 	"github.com/skip-mev/slinky/oracle/config"
 
 	"github.com/skip-mev/slinky/oracle/types"
+	"github.com/skip-mev/slinky/providers/registry"
+	"github.com/skip-mev/slinky/providers/static"
 )
 
+func init() {
+	registry.RegisterAPIProvider(Name, static.NewMockAPIConstructor(NewAPIHandler))
+}
+
 const (
 	// Name is the name of the provider.
 	Name = "volatile-exchange-provider"
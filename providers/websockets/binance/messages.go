@@ -198,6 +198,8 @@ type TickerMessageResponse struct {
 		Ticker string `json:"s"`
 		// LastPrice is the last price.
 		LastPrice string `json:"c"`
+		// Volume is the total traded base asset volume over the last 24 hours.
+		Volume string `json:"v"`
 		// StatisticsCloseTime is the statistics close time.
 		//
 		// Note: This is unused but is included since json.Unmarshal requires all fields with same character but different casing
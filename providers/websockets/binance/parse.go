@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"time"
 
+	"go.uber.org/zap"
+
 	providertypes "github.com/skip-mev/slinky/providers/types"
 
 	"github.com/skip-mev/slinky/oracle/types"
@@ -11,8 +13,10 @@ import (
 )
 
 // parsePriceUpdateMessage parses a price update message from the Binance websocket feed.
-// This is repurposed for ticker and aggregate trade messages.
-func (h *WebSocketHandler) parsePriceUpdateMessage(offChainTicker string, price string) (types.PriceResponse, error) {
+// This is repurposed for ticker and aggregate trade messages. The volume is only included
+// in ticker messages and is empty otherwise, in which case the latest volume seen for the
+// ticker is attached to the price.
+func (h *WebSocketHandler) parsePriceUpdateMessage(offChainTicker, price, volume string) (types.PriceResponse, error) {
 	var (
 		resolved   = make(types.ResolvedPrices)
 		unResolved = make(types.UnResolvedPrices)
@@ -33,6 +37,15 @@ func (h *WebSocketHandler) parsePriceUpdateMessage(offChainTicker string, price
 		return types.NewPriceResponse(resolved, unResolved), err
 	}
 
-	resolved[ticker] = types.NewPriceResult(priceFloat, time.Now().UTC())
+	if len(volume) > 0 {
+		volumeFloat, err := math.Float64StringToBigFloat(volume)
+		if err != nil {
+			h.logger.Debug("failed to parse volume", zap.String("ticker", offChainTicker), zap.Error(err))
+		} else {
+			h.volumes[ticker] = volumeFloat
+		}
+	}
+
+	resolved[ticker] = types.NewPriceResultWithVolume(priceFloat, time.Now().UTC(), h.volumes[ticker])
 	return types.NewPriceResponse(resolved, unResolved), nil
 }
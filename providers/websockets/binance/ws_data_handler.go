@@ -3,16 +3,22 @@ package binance
 import (
 	"encoding/json"
 	"fmt"
+	"math/big"
 	"math/rand"
 
 	"github.com/skip-mev/slinky/oracle/config"
 	"github.com/skip-mev/slinky/oracle/types"
 	"github.com/skip-mev/slinky/providers/base/websocket/handlers"
+	"github.com/skip-mev/slinky/providers/registry"
 	"go.uber.org/zap"
 )
 
 var _ types.PriceWebSocketDataHandler = (*WebSocketHandler)(nil)
 
+func init() {
+	registry.RegisterWebSocketProvider(Name, registry.NewWebSocketConstructor(NewWebSocketDataHandler))
+}
+
 // WebSocketHandler implements the WebSocketDataHandler interface. This is used to handle
 // messages received from the Binance websocket API.
 type WebSocketHandler struct {
@@ -26,6 +32,10 @@ type WebSocketHandler struct {
 	messageIDs map[int64][]string
 	// nextID is the next message ID to use for the Binance websocket API.
 	nextID int64
+	// volumes is the latest 24h base asset volume seen for each ticker. Volume is only
+	// included in ticker stream messages, so this is used to attach volume to aggregate
+	// trade updates.
+	volumes map[types.ProviderTicker]*big.Float
 }
 
 // NewWebSocketDataHandler returns a new Binance PriceWebSocketDataHandler.
@@ -51,6 +61,7 @@ func NewWebSocketDataHandler(
 		cache:      types.NewProviderTickers(),
 		messageIDs: make(map[int64][]string),
 		nextID:     rand.Int63() + 1,
+		volumes:    make(map[types.ProviderTicker]*big.Float),
 	}, nil
 }
 
@@ -112,7 +123,7 @@ func (h *WebSocketHandler) HandleMessage(
 		}
 
 		h.logger.Debug("received ticker message", zap.String("ticker", tickerResp.Data.Ticker))
-		resp, err := h.parsePriceUpdateMessage(tickerResp.Data.Ticker, tickerResp.Data.LastPrice)
+		resp, err := h.parsePriceUpdateMessage(tickerResp.Data.Ticker, tickerResp.Data.LastPrice, tickerResp.Data.Volume)
 		return resp, nil, err
 	case AggregateTradeStream:
 		// Aggregate trade stream is sent when a trade is executed on the Binance exchange.
@@ -122,7 +133,7 @@ func (h *WebSocketHandler) HandleMessage(
 		}
 
 		h.logger.Debug("received aggregate trade message", zap.String("ticker", aggTradeResp.Data.Ticker))
-		resp, err := h.parsePriceUpdateMessage(aggTradeResp.Data.Ticker, aggTradeResp.Data.Price)
+		resp, err := h.parsePriceUpdateMessage(aggTradeResp.Data.Ticker, aggTradeResp.Data.Price, "")
 		return resp, nil, err
 	default:
 		return resp, nil, fmt.Errorf("unknown stream type %s", streamMsg.Stream)
@@ -162,5 +173,6 @@ func (h *WebSocketHandler) Copy() types.PriceWebSocketDataHandler {
 		cache:      types.NewProviderTickers(),
 		messageIDs: make(map[int64][]string),
 		nextID:     rand.Int63() + 1,
+		volumes:    make(map[types.ProviderTicker]*big.Float),
 	}
 }
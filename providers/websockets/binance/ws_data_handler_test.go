@@ -125,6 +125,34 @@ func TestHandleMessage(t *testing.T) {
 			updateMessage: func() []handlers.WebsocketEncodedMessage { return nil },
 			expErr:        false,
 		},
+		{
+			name: "ticker stream message with good price and volume",
+			msg: func() []byte {
+				msg := `
+				{
+					"stream": "btcusdt@ticker",
+					"data": {
+						"s": "btcusdt",
+						"c": "10000.00000000",
+						"v": "1234.50000000",
+						"C": 1600000000000
+						}
+				}`
+
+				return []byte(msg)
+			},
+			resp: types.NewPriceResponse(
+				types.ResolvedPrices{
+					btcusdt: {
+						Value:  big.NewFloat(10000.0),
+						Volume: big.NewFloat(1234.5),
+					},
+				},
+				types.UnResolvedPrices{},
+			),
+			updateMessage: func() []handlers.WebsocketEncodedMessage { return nil },
+			expErr:        false,
+		},
 		{
 			name: "ticker stream message with bad price",
 			msg: func() []byte {
@@ -289,6 +317,10 @@ func TestHandleMessage(t *testing.T) {
 			for cp, result := range tc.resp.Resolved {
 				require.Contains(t, resp.Resolved, cp)
 				require.Equal(t, result.Value.SetPrec(18), resp.Resolved[cp].Value.SetPrec(18))
+				if result.Volume != nil {
+					require.NotNil(t, resp.Resolved[cp].Volume)
+					require.Equal(t, result.Volume.SetPrec(18), resp.Resolved[cp].Volume.SetPrec(18))
+				}
 			}
 
 			for cp := range tc.resp.UnResolved {
@@ -392,3 +424,27 @@ func TestCreateMessages(t *testing.T) {
 		})
 	}
 }
+
+func TestHandleMessageVolume(t *testing.T) {
+	wsHandler, err := binance.NewWebSocketDataHandler(logger, binance.DefaultWebSocketConfig)
+	require.NoError(t, err)
+
+	_, err = wsHandler.CreateMessages([]types.ProviderTicker{btcusdt})
+	require.NoError(t, err)
+
+	// Aggregate trade messages do not include volume.
+	resp, _, err := wsHandler.HandleMessage([]byte(`{"stream": "btcusdt@aggTrade", "data": {"s": "btcusdt", "p": "10000.00000000"}}`))
+	require.NoError(t, err)
+	require.Nil(t, resp.Resolved[btcusdt].Volume)
+
+	// Ticker messages include volume.
+	resp, _, err = wsHandler.HandleMessage([]byte(`{"stream": "btcusdt@ticker", "data": {"s": "btcusdt", "c": "10001.00000000", "v": "500.00000000", "C": 1600000000000}}`))
+	require.NoError(t, err)
+	require.Equal(t, big.NewFloat(500).SetPrec(18), resp.Resolved[btcusdt].Volume.SetPrec(18))
+
+	// Subsequent aggregate trade messages carry the latest volume.
+	resp, _, err = wsHandler.HandleMessage([]byte(`{"stream": "btcusdt@aggTrade", "data": {"s": "btcusdt", "p": "10002.00000000"}}`))
+	require.NoError(t, err)
+	require.Equal(t, big.NewFloat(10002).SetPrec(18), resp.Resolved[btcusdt].Value.SetPrec(18))
+	require.Equal(t, big.NewFloat(500).SetPrec(18), resp.Resolved[btcusdt].Volume.SetPrec(18))
+}
@@ -2,6 +2,7 @@ package bybit
 
 import (
 	"fmt"
+	"math/big"
 	"strings"
 	"time"
 
@@ -66,6 +67,15 @@ func (h *WebSocketHandler) parseTickerUpdate(
 		return types.NewPriceResponse(resolved, unresolved), nil
 	}
 
-	resolved[ticker] = types.NewPriceResult(price, time.Now().UTC())
+	// Volume is optional and does not invalidate the price if it cannot be parsed.
+	var volume *big.Float
+	if len(data.Volume24H) > 0 {
+		if volume, err = math.Float64StringToBigFloat(data.Volume24H); err != nil {
+			h.logger.Debug("failed to parse volume", zap.String("ticker", data.Symbol), zap.Error(err))
+			volume = nil
+		}
+	}
+
+	resolved[ticker] = types.NewPriceResultWithVolume(price, time.Now().UTC(), volume)
 	return types.NewPriceResponse(resolved, unresolved), nil
 }
@@ -228,6 +228,9 @@ type TickerResponseMessage struct {
 	// Price is the price of the ticker.
 	Price string `json:"price"`
 
+	// Volume24H is the traded base asset volume over the last 24 hours.
+	Volume24H string `json:"volume_24h"`
+
 	// TradeID is the trade ID of the ticker.
 	TradeID int64 `json:"trade_id"`
 }
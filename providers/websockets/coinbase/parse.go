@@ -5,6 +5,8 @@ import (
 	"math/big"
 	"time"
 
+	"go.uber.org/zap"
+
 	providertypes "github.com/skip-mev/slinky/providers/types"
 
 	"github.com/skip-mev/slinky/oracle/types"
@@ -51,8 +53,17 @@ func (h *WebSocketHandler) parseTickerResponseMessage(
 	// Update the trade ID.
 	h.tradeIDs[ticker] = msg.TradeID
 
+	// Volume is optional and does not invalidate the price if it cannot be parsed.
+	var volume *big.Float
+	if len(msg.Volume24H) > 0 {
+		if volume, err = math.Float64StringToBigFloat(msg.Volume24H); err != nil {
+			h.logger.Debug("failed to parse volume", zap.String("ticker", msg.Ticker), zap.Error(err))
+			volume = nil
+		}
+	}
+
 	// Convert the time to a time object and resolve the price into the response.
-	resolved[ticker] = types.NewPriceResult(price, time.Now().UTC())
+	resolved[ticker] = types.NewPriceResultWithVolume(price, time.Now().UTC(), volume)
 	return types.NewPriceResponse(resolved, unResolved), nil
 }
 
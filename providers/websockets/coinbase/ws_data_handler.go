@@ -9,10 +9,15 @@ import (
 	"github.com/skip-mev/slinky/oracle/config"
 	"github.com/skip-mev/slinky/oracle/types"
 	"github.com/skip-mev/slinky/providers/base/websocket/handlers"
+	"github.com/skip-mev/slinky/providers/registry"
 )
 
 var _ types.PriceWebSocketDataHandler = (*WebSocketHandler)(nil)
 
+func init() {
+	registry.RegisterWebSocketProvider(Name, registry.NewWebSocketConstructor(NewWebSocketDataHandler))
+}
+
 // WebSocketHandler implements the WebSocketDataHandler interface. This is used to
 // handle messages received from the Coinbase websocket API.
 type WebSocketHandler struct {
@@ -48,10 +48,11 @@ func TestHandleMessage(t *testing.T) {
 			name: "ticker message",
 			msg: func() []byte {
 				msg := coinbase.TickerResponseMessage{
-					Type:     string(coinbase.TickerMessage),
-					Ticker:   "BTC-USD",
-					Price:    "10000.00",
-					Sequence: 1,
+					Type:      string(coinbase.TickerMessage),
+					Ticker:    "BTC-USD",
+					Price:     "10000.00",
+					Volume24H: "245532.79269678",
+					Sequence:  1,
 				}
 
 				bz, err := json.Marshal(msg)
@@ -62,7 +63,8 @@ func TestHandleMessage(t *testing.T) {
 			resp: types.PriceResponse{
 				Resolved: types.ResolvedPrices{
 					btcusd: {
-						Value: big.NewFloat(10000.00),
+						Value:  big.NewFloat(10000.00),
+						Volume: big.NewFloat(245532.79269678),
 					},
 				},
 			},
@@ -310,6 +312,10 @@ func TestHandleMessage(t *testing.T) {
 			for cp, result := range tc.resp.Resolved {
 				require.Contains(t, resp.Resolved, cp)
 				require.Equal(t, result.Value.SetPrec(18), resp.Resolved[cp].Value.SetPrec(18))
+				if result.Volume != nil {
+					require.NotNil(t, resp.Resolved[cp].Volume)
+					require.Equal(t, result.Volume.SetPrec(18), resp.Resolved[cp].Volume.SetPrec(18))
+				}
 				require.Equal(t, result.ResponseCode, resp.Resolved[cp].ResponseCode)
 			}
 
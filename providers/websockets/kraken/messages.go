@@ -331,6 +331,9 @@ const (
 type TickerData struct {
 	// VolumeWeightedAveragePrice is the volume weighted average price.
 	VolumeWeightedAveragePrice []string `json:"p"`
+
+	// Volume is the traded base asset volume.
+	Volume []string `json:"v"`
 }
 
 const (
@@ -338,6 +341,10 @@ const (
 	// VolumeWeightedAveragePrice array.
 	TodayPriceIndex = 0
 
+	// Last24HoursVolumeIndex is the index of the volume over the last 24 hours in the
+	// ticker's Volume array.
+	Last24HoursVolumeIndex = 1
+
 	// ExpectedVolumeWeightedAveragePriceLength is the expected length of the ticker's
 	// VolumeWeightedAveragePrice array.
 	ExpectedVolumeWeightedAveragePriceLength = 2
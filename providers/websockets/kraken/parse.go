@@ -3,6 +3,7 @@ package kraken
 import (
 	"encoding/json"
 	"fmt"
+	"math/big"
 	"time"
 
 	providertypes "github.com/skip-mev/slinky/providers/types"
@@ -116,7 +117,17 @@ func (h *WebSocketHandler) parseTickerMessage(
 		return types.NewPriceResponse(resolved, unResolved), unResolved[ticker]
 	}
 
-	resolved[ticker] = types.NewPriceResult(price, time.Now().UTC())
+	// Volume is optional and does not invalidate the price if it cannot be parsed.
+	var volume *big.Float
+	if len(resp.TickerData.Volume) > Last24HoursVolumeIndex {
+		volumeStr := resp.TickerData.Volume[Last24HoursVolumeIndex]
+		if volume, err = math.Float64StringToBigFloat(volumeStr); err != nil {
+			h.logger.Debug("failed to parse volume", zap.String("volume", volumeStr), zap.Error(err))
+			volume = nil
+		}
+	}
+
+	resolved[ticker] = types.NewPriceResultWithVolume(price, time.Now().UTC(), volume)
 	return types.NewPriceResponse(resolved, unResolved), nil
 }
 
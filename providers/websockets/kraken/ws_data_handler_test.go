@@ -39,7 +39,8 @@ func TestHandleMessage(t *testing.T) {
 			resp: types.PriceResponse{
 				Resolved: types.ResolvedPrices{
 					btcusd: {
-						Value: big.NewFloat(42596.41907000),
+						Value:  big.NewFloat(42596.41907000),
+						Volume: big.NewFloat(2075.61202911),
 					},
 				},
 				UnResolved: types.UnResolvedPrices{},
@@ -220,6 +221,10 @@ func TestHandleMessage(t *testing.T) {
 			for cp, result := range tc.resp.Resolved {
 				require.Contains(t, resp.Resolved, cp)
 				require.Equal(t, result.Value.SetPrec(18), resp.Resolved[cp].Value.SetPrec(18))
+				if result.Volume != nil {
+					require.NotNil(t, resp.Resolved[cp].Volume)
+					require.Equal(t, result.Volume.SetPrec(18), resp.Resolved[cp].Volume.SetPrec(18))
+				}
 			}
 
 			for cp := range tc.resp.UnResolved {
@@ -325,6 +330,7 @@ func TestDecodeTickerResponseMessage(t *testing.T) {
 				ChannelID: 340,
 				TickerData: kraken.TickerData{
 					VolumeWeightedAveragePrice: []string{"42596.41907", "42598.31137"},
+					Volume:                     []string{"2068.49653432", "2075.61202911"},
 				},
 				ChannelName: "ticker",
 				Pair:        "XBT/USD",
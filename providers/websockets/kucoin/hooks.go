@@ -7,9 +7,14 @@ import (
 	"net/http"
 	"time"
 
+	"go.uber.org/zap"
+
 	"github.com/skip-mev/slinky/oracle/config"
+	"github.com/skip-mev/slinky/oracle/types"
 	apihandlers "github.com/skip-mev/slinky/providers/base/api/handlers"
 	wshandlers "github.com/skip-mev/slinky/providers/base/websocket/handlers"
+	wsmetrics "github.com/skip-mev/slinky/providers/base/websocket/metrics"
+	"github.com/skip-mev/slinky/providers/registry"
 )
 
 const (
@@ -166,3 +171,37 @@ func fetchCredentials(cfg config.APIConfig, requestHandler apihandlers.RequestHa
 
 	return &resp, nil
 }
+
+// NewWebSocketHandlers returns the KuCoin websocket data handler along with a connection handler
+// that fetches the token and URL required to connect to the websocket from the KuCoin API
+// before dialing.
+func NewWebSocketHandlers(
+	logger *zap.Logger,
+	cfg config.ProviderConfig,
+	metrics wsmetrics.WebSocketMetrics,
+) (types.PriceWebSocketDataHandler, wshandlers.WebSocketConnHandler, error) {
+	dataHandler, err := NewWebSocketDataHandler(logger, cfg.WebSocket)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// The request handler requires POST requests when first establishing the connection.
+	requestHandler, err := registry.NewRequestHandler(
+		cfg.API,
+		apihandlers.WithHTTPMethod(http.MethodPost),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	connHandler, err := wshandlers.NewWebSocketHandlerImpl(
+		cfg.WebSocket,
+		wshandlers.WithPreDialHook(PreDialHook(cfg.API, requestHandler)),
+		wshandlers.WithMetrics(metrics),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return dataHandler, connHandler, nil
+}
@@ -1,9 +1,15 @@
 package okx
 
 import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"strconv"
+	"time"
 
+	"github.com/skip-mev/slinky/oracle/config"
 	"github.com/skip-mev/slinky/providers/base/websocket/handlers"
 )
 
@@ -14,7 +20,8 @@ type (
 	Operation string
 	// Channel is the channel to subscribe to. The channel is used to determine the type of
 	// price data that we want. This can later be extended to support other channels. Currently,
-	// only the index tickers (spot markets) channel is supported.
+	// the index tickers (spot markets) channel is used for prices and the tickers channel is
+	// used for volume.
 	Channel string
 	// EventType is the event type. This is the expected event type that we want to receive
 	// from the websocket. The event types pertain to subscription events.
@@ -24,11 +31,16 @@ type (
 const (
 	// OperationSubscribe is the operation to subscribe to a channel.
 	OperationSubscribe Operation = "subscribe"
+	// OperationLogin is the operation to log in to the websocket.
+	OperationLogin Operation = "login"
 )
 
 const (
 	// IndexTickersChannel is the channel for mark price updates.
 	IndexTickersChannel Channel = "index-tickers"
+	// TickersChannel is the channel for ticker updates. This is used to retrieve the 24h
+	// traded volume of each instrument.
+	TickersChannel Channel = "tickers"
 )
 
 const (
@@ -39,12 +51,18 @@ const (
 	EventTickers EventType = ""
 	// EventError is the event for an error.
 	EventError EventType = "error"
+	// EventLogin is the event denoting that we have successfully logged in.
+	EventLogin EventType = "login"
 )
 
 // BaseMessage is utilized to determine the type of message that was received.
 type BaseMessage struct {
 	// Event is the event that occurred.
 	Event string `json:"event" validate:"required"`
+
+	// Arguments is the channel and instrument the message pertains to. This is only
+	// populated for subscription and ticker messages.
+	Arguments SubscriptionTopic `json:"arg"`
 }
 
 // SubscribeRequestMessage is the request message for subscribing to a channel. The
@@ -115,6 +133,76 @@ func NewSubscribeToTickersRequestMessage(
 	return msgs, nil
 }
 
+// LoginRequestMessage is the request message for logging in to the websocket. This is only
+// required when connecting to endpoints that require authentication. The format of the
+// message is:
+//
+//	{
+//		"op": "login",
+//		"args": [
+//			{
+//				"apiKey": "985d5b66-57ce-40fb-b714-afc0b9787083",
+//				"passphrase": "123456",
+//				"timestamp": "1538054050",
+//				"sign": "7L+zFQ+CEgGu5rzCj4+BdV2/uUHGqddA9pI6ztsRRPs="
+//			}
+//		]
+//	}
+//
+// For more information, see https://www.okx.com/docs-v5/en/#overview-websocket-login
+type LoginRequestMessage struct {
+	// Operation is the operation to perform.
+	Operation string `json:"op" validate:"required"`
+
+	// Arguments is the list of login credentials.
+	Arguments []LoginArguments `json:"args" validate:"required"`
+}
+
+// LoginArguments are the credentials used to log in to the websocket.
+type LoginArguments struct {
+	// APIKey is the API key.
+	APIKey string `json:"apiKey" validate:"required"`
+
+	// Passphrase is the passphrase of the API key.
+	Passphrase string `json:"passphrase" validate:"required"`
+
+	// Timestamp is the unix time of the request in seconds.
+	Timestamp string `json:"timestamp" validate:"required"`
+
+	// Sign is the base64 encoded HMAC SHA256 signature of the timestamp, method and
+	// request path, signed with the API secret.
+	Sign string `json:"sign" validate:"required"`
+}
+
+// NewLoginRequestMessage returns a new LoginRequestMessage signed with the given
+// authentication at the given time.
+func NewLoginRequestMessage(
+	auth config.Authentication,
+	now time.Time,
+) (handlers.WebsocketEncodedMessage, error) {
+	if !auth.Signed() {
+		return nil, fmt.Errorf("api key and secret must be set to log in")
+	}
+
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+	mac := hmac.New(sha256.New, []byte(auth.APISecret))
+	mac.Write([]byte(timestamp + LoginMethod + LoginRequestPath))
+
+	return json.Marshal(
+		LoginRequestMessage{
+			Operation: string(OperationLogin),
+			Arguments: []LoginArguments{
+				{
+					APIKey:     auth.APIKey,
+					Passphrase: auth.Passphrase,
+					Timestamp:  timestamp,
+					Sign:       base64.StdEncoding.EncodeToString(mac.Sum(nil)),
+				},
+			},
+		},
+	)
+}
+
 // SubscribeResponseMessage is the response message for subscribing to a channel. The
 // format of the message is:
 // Good Response:
@@ -197,3 +285,52 @@ type IndexTicker struct {
 	// IndexPrice is the index price.
 	IndexPrice string `json:"idxPx" validate:"required"`
 }
+
+// TickersResponseMessage is the response message for ticker updates. This message type is
+// sent when a trade occurs or the best bid/ask changes. The format of the message is:
+//
+//	{
+//		"arg": {
+//			"channel": "tickers",
+//			"instId": "BTC-USDT"
+//		},
+//		"data": [
+//			{
+//				"instType": "SPOT",
+//				"instId": "BTC-USDT",
+//				"last": "9999.99",
+//				"lastSz": "0.1",
+//				"askPx": "9999.99",
+//				"askSz": "11",
+//				"bidPx": "8888.88",
+//				"bidSz": "5",
+//				"open24h": "9000",
+//				"high24h": "10000",
+//				"low24h": "8888.88",
+//				"volCcy24h": "2222",
+//				"vol24h": "2222",
+//				"sodUtc0": "2222",
+//				"sodUtc8": "2222",
+//				"ts": "1597026383085"
+//			}
+//		]
+//	}
+//
+// For more information, see https://www.okx.com/docs-v5/en/?shell#public-data-websocket-tickers-channel
+type TickersResponseMessage struct {
+	// Arguments is the list of arguments for the operation.
+	Arguments SubscriptionTopic `json:"arg" validate:"required"`
+
+	// Data is the list of ticker data.
+	Data []Ticker `json:"data" validate:"required"`
+}
+
+// Ticker is the ticker data.
+type Ticker struct {
+	// ID is the instrument ID.
+	ID string `json:"instId" validate:"required"`
+
+	// Volume24H is the 24h traded volume. For spot instruments, this is denominated in
+	// the base currency.
+	Volume24H string `json:"vol24h"`
+}
@@ -100,8 +100,31 @@ func (h *WebSocketHandler) parseTickerResponseMessage(
 			continue
 		}
 
-		resolved[ticker] = types.NewPriceResult(price, time.Now().UTC())
+		resolved[ticker] = types.NewPriceResultWithVolume(price, time.Now().UTC(), h.volumes[ticker])
 	}
 
 	return types.NewPriceResponse(resolved, unresolved), nil
 }
+
+// parseTickersResponseMessage parses a tickers response message. The format of the message is
+// defined in the messages.go file. This message is only used to update the latest 24h volume
+// seen for a set of instruments, which is attached to subsequent index ticker price updates.
+func (h *WebSocketHandler) parseTickersResponseMessage(
+	resp TickersResponseMessage,
+) {
+	for _, instrument := range resp.Data {
+		ticker, ok := h.cache.FromOffChainTicker(instrument.ID)
+		if !ok {
+			h.logger.Debug("ticker not found for instrument ID", zap.String("instrument_id", instrument.ID))
+			continue
+		}
+
+		volume, err := math.Float64StringToBigFloat(instrument.Volume24H)
+		if err != nil {
+			h.logger.Debug("failed to parse volume", zap.String("instrument_id", instrument.ID), zap.Error(err))
+			continue
+		}
+
+		h.volumes[ticker] = volume
+	}
+}
@@ -1,7 +1,10 @@
 package okx
 
 import (
+	"time"
+
 	"github.com/skip-mev/slinky/oracle/config"
+	"github.com/skip-mev/slinky/providers/base/websocket/handlers"
 )
 
 const (
@@ -21,6 +24,12 @@ const (
 
 	// URL_DEMO is the public OKX Websocket URL for test usage.
 	URL_DEMO = "wss://wspap.okx.com:8443/ws/v5/public?brokerId=9999"
+
+	// LoginMethod is the HTTP method included in the login signature.
+	LoginMethod = "GET"
+
+	// LoginRequestPath is the request path included in the login signature.
+	LoginRequestPath = "/users/self/verify"
 )
 
 // DefaultWebSocketConfig is the default configuration for the OKX Websocket.
@@ -42,3 +51,18 @@ var DefaultWebSocketConfig = config.WebSocketConfig{
 	WriteTimeout:      config.DefaultWriteTimeout,
 	MaxReadErrorCount: config.DefaultMaxReadErrorCount,
 }
+
+// LoginHook returns the login message for endpoints that are configured with an API key and
+// secret. No messages are sent to endpoints that do not require authentication.
+func LoginHook(endpoint config.Endpoint) ([]handlers.WebsocketEncodedMessage, error) {
+	if !endpoint.Authentication.Signed() {
+		return nil, nil
+	}
+
+	msg, err := NewLoginRequestMessage(endpoint.Authentication, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	return []handlers.WebsocketEncodedMessage{msg}, nil
+}
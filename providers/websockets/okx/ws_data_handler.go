@@ -3,16 +3,26 @@ package okx
 import (
 	"encoding/json"
 	"fmt"
+	"math/big"
 
 	"go.uber.org/zap"
 
 	"github.com/skip-mev/slinky/oracle/config"
 	"github.com/skip-mev/slinky/oracle/types"
 	"github.com/skip-mev/slinky/providers/base/websocket/handlers"
+	"github.com/skip-mev/slinky/providers/registry"
 )
 
 var _ types.PriceWebSocketDataHandler = (*WebSocketHandler)(nil)
 
+func init() {
+	// Authenticated endpoints require a signed login message once connected.
+	registry.RegisterWebSocketProvider(
+		Name,
+		registry.NewWebSocketConstructor(NewWebSocketDataHandler, handlers.WithLoginHook(LoginHook)),
+	)
+}
+
 // WebSocketHandler implements the WebSocketDataHandler interface. This is used to
 // handle messages received from the OKX websocket API.
 type WebSocketHandler struct {
@@ -22,6 +32,9 @@ type WebSocketHandler struct {
 	ws config.WebSocketConfig
 	// cache maintains the latest set of tickers seen by the handler.
 	cache types.ProviderTickers
+	// volumes is the latest 24h base asset volume seen for each ticker on the tickers
+	// channel. This is attached to the index ticker price updates.
+	volumes map[types.ProviderTicker]*big.Float
 }
 
 // NewWebSocketDataHandler returns a new OKX PriceWebSocketDataHandler.
@@ -42,19 +55,22 @@ func NewWebSocketDataHandler(
 	}
 
 	return &WebSocketHandler{
-		logger: logger,
-		ws:     ws,
-		cache:  types.NewProviderTickers(),
+		logger:  logger,
+		ws:      ws,
+		cache:   types.NewProviderTickers(),
+		volumes: make(map[types.ProviderTicker]*big.Float),
 	}, nil
 }
 
-// HandleMessage is used to handle a message received from the data provider. The OKX
-// provider sends two types of messages:
+// HandleMessage is used to handle a message received from the data provider. Apart from
+// the login response sent to authenticated connections, the OKX provider sends two types
+// of messages:
 //
 //  1. Subscribe response message. The subscribe response message is used to determine if
 //     the subscription was successful.
-//  2. Ticker response message. This is sent when a ticker update is received from the
-//     OKX websocket API.
+//  2. Ticker response message. This is sent when an index ticker or ticker update is
+//     received from the OKX websocket API. Index ticker updates contain the price and
+//     ticker updates contain the 24h volume.
 //
 // Heartbeat messages are NOT sent by the OKX websocket. The connection is only closed
 // iff no data is received within a 30-second interval or if all subscriptions
@@ -76,6 +92,9 @@ func (h *WebSocketHandler) HandleMessage(
 
 	eventType := EventType(baseMessage.Event)
 	switch {
+	case eventType == EventLogin:
+		h.logger.Debug("successfully logged in")
+		return types.NewPriceResponse(nil, nil), nil, nil
 	case eventType == EventSubscribe || eventType == EventError:
 		h.logger.Debug("received subscribe response message")
 
@@ -90,6 +109,16 @@ func (h *WebSocketHandler) HandleMessage(
 		}
 
 		return resp, updateMessage, nil
+	case eventType == EventTickers && Channel(baseMessage.Arguments.Channel) == TickersChannel:
+		h.logger.Debug("received tickers response message")
+
+		var tickersMessage TickersResponseMessage
+		if err := json.Unmarshal(message, &tickersMessage); err != nil {
+			return resp, nil, fmt.Errorf("failed to unmarshal tickers response message: %w", err)
+		}
+
+		h.parseTickersResponseMessage(tickersMessage)
+		return types.NewPriceResponse(nil, nil), nil, nil
 	case eventType == EventTickers:
 		h.logger.Debug("received ticker response message")
 
@@ -110,8 +139,10 @@ func (h *WebSocketHandler) HandleMessage(
 }
 
 // CreateMessages is used to create an initial subscription message to send to the data provider.
-// Only the currency pairs that are specified in the config are subscribed to. The only channel
-// that is subscribed to is the index tickers channel - which supports spot markets.
+// Only the currency pairs that are specified in the config are subscribed to. Each currency pair
+// is subscribed to the index tickers channel - which supports spot markets. If the config enables
+// volume, each currency pair is additionally subscribed to the tickers channel, which is used to
+// retrieve the 24h volume.
 func (h *WebSocketHandler) CreateMessages(
 	tickers []types.ProviderTicker,
 ) ([]handlers.WebsocketEncodedMessage, error) {
@@ -121,6 +152,12 @@ func (h *WebSocketHandler) CreateMessages(
 			Channel:      string(IndexTickersChannel),
 			InstrumentID: ticker.GetOffChainTicker(),
 		})
+		if h.ws.SubscribeToVolume {
+			instruments = append(instruments, SubscriptionTopic{
+				Channel:      string(TickersChannel),
+				InstrumentID: ticker.GetOffChainTicker(),
+			})
+		}
 		h.cache.Add(ticker)
 	}
 
@@ -135,8 +172,9 @@ func (h *WebSocketHandler) HeartBeatMessages() ([]handlers.WebsocketEncodedMessa
 // Copy is used to create a copy of the WebSocketHandler.
 func (h *WebSocketHandler) Copy() types.PriceWebSocketDataHandler {
 	return &WebSocketHandler{
-		logger: h.logger,
-		ws:     h.ws,
-		cache:  types.NewProviderTickers(),
+		logger:  h.logger,
+		ws:      h.ws,
+		cache:   types.NewProviderTickers(),
+		volumes: make(map[types.ProviderTicker]*big.Float),
 	}
 }
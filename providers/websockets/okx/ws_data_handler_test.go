@@ -1,14 +1,19 @@
 package okx_test
 
 import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"math/big"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 
+	"github.com/skip-mev/slinky/oracle/config"
 	"github.com/skip-mev/slinky/oracle/types"
 	"github.com/skip-mev/slinky/providers/base/websocket/handlers"
 	"github.com/skip-mev/slinky/providers/websockets/okx"
@@ -180,6 +185,18 @@ func TestHandleMessage(t *testing.T) {
 			updateMessage: func() []handlers.WebsocketEncodedMessage { return nil },
 			expErr:        false,
 		},
+		{
+			name: "successful login",
+			msg: func() []byte {
+				return []byte(`{"event":"login","code":"0","msg":"","connId":"a4d3ae55"}`)
+			},
+			resp: types.NewPriceResponse(
+				types.ResolvedPrices{},
+				types.UnResolvedPrices{},
+			),
+			updateMessage: func() []handlers.WebsocketEncodedMessage { return nil },
+			expErr:        false,
+		},
 		{
 			name: "subscription error",
 			msg: func() []byte {
@@ -324,10 +341,41 @@ func TestHandleMessage(t *testing.T) {
 	}
 }
 
+func TestHandleMessageVolume(t *testing.T) {
+	cfg := okx.DefaultWebSocketConfig
+	cfg.SubscribeToVolume = true
+
+	wsHandler, err := okx.NewWebSocketDataHandler(logger, cfg)
+	require.NoError(t, err)
+
+	_, err = wsHandler.CreateMessages([]types.ProviderTicker{btcusdt})
+	require.NoError(t, err)
+
+	indexTickerMsg := []byte(`{"arg": {"channel": "index-tickers", "instId": "BTC-USDT"}, "data": [{"instId": "BTC-USDT", "idxPx": "42000.5"}]}`)
+
+	// Index ticker updates received before any ticker update do not include volume.
+	resp, _, err := wsHandler.HandleMessage(indexTickerMsg)
+	require.NoError(t, err)
+	require.Equal(t, big.NewFloat(42000.5).SetPrec(18), resp.Resolved[btcusdt].Value.SetPrec(18))
+	require.Nil(t, resp.Resolved[btcusdt].Volume)
+
+	// Ticker updates only update the volume.
+	resp, _, err = wsHandler.HandleMessage([]byte(`{"arg": {"channel": "tickers", "instId": "BTC-USDT"}, "data": [{"instId": "BTC-USDT", "last": "42001", "vol24h": "1234.5"}]}`))
+	require.NoError(t, err)
+	require.Empty(t, resp.Resolved)
+	require.Empty(t, resp.UnResolved)
+
+	// Subsequent index ticker updates include the latest volume.
+	resp, _, err = wsHandler.HandleMessage(indexTickerMsg)
+	require.NoError(t, err)
+	require.Equal(t, big.NewFloat(1234.5).SetPrec(18), resp.Resolved[btcusdt].Volume.SetPrec(18))
+}
+
 func TestCreateMessage(t *testing.T) {
 	testCases := []struct {
 		name        string
 		cps         []types.ProviderTicker
+		volume      bool
 		expected    func() []handlers.WebsocketEncodedMessage
 		expectedErr bool
 	}{
@@ -363,23 +411,24 @@ func TestCreateMessage(t *testing.T) {
 			expectedErr: false,
 		},
 		{
-			name: "two currency pairs",
+			name: "one currency pair with volume",
 			cps: []types.ProviderTicker{
 				btcusdt,
-				ethusdt,
 			},
+			volume: true,
 			expected: func() []handlers.WebsocketEncodedMessage {
 				msgs := make([]handlers.WebsocketEncodedMessage, 2)
-				for i, ticker := range []string{"BTC-USDT", "ETH-USDT"} {
+				for i, channel := range []okx.Channel{okx.IndexTickersChannel, okx.TickersChannel} {
 					msg := okx.SubscribeRequestMessage{
 						Operation: string(okx.OperationSubscribe),
 						Arguments: []okx.SubscriptionTopic{
 							{
-								Channel:      string(okx.IndexTickersChannel),
-								InstrumentID: ticker,
+								Channel:      string(channel),
+								InstrumentID: "BTC-USDT",
 							},
 						},
 					}
+
 					bz, err := json.Marshal(msg)
 					require.NoError(t, err)
 					msgs[i] = bz
@@ -389,11 +438,44 @@ func TestCreateMessage(t *testing.T) {
 			},
 			expectedErr: false,
 		},
+		{
+			name: "two currency pairs with volume",
+			cps: []types.ProviderTicker{
+				btcusdt,
+				ethusdt,
+			},
+			volume: true,
+			expected: func() []handlers.WebsocketEncodedMessage {
+				msgs := make([]handlers.WebsocketEncodedMessage, 0, 4)
+				for _, ticker := range []string{"BTC-USDT", "ETH-USDT"} {
+					for _, channel := range []okx.Channel{okx.IndexTickersChannel, okx.TickersChannel} {
+						msg := okx.SubscribeRequestMessage{
+							Operation: string(okx.OperationSubscribe),
+							Arguments: []okx.SubscriptionTopic{
+								{
+									Channel:      string(channel),
+									InstrumentID: ticker,
+								},
+							},
+						}
+						bz, err := json.Marshal(msg)
+						require.NoError(t, err)
+						msgs = append(msgs, bz)
+					}
+				}
+
+				return msgs
+			},
+			expectedErr: false,
+		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			wsHandler, err := okx.NewWebSocketDataHandler(logger, okx.DefaultWebSocketConfig)
+			cfg := okx.DefaultWebSocketConfig
+			cfg.SubscribeToVolume = tc.volume
+
+			wsHandler, err := okx.NewWebSocketDataHandler(logger, cfg)
 			require.NoError(t, err)
 
 			msgs, err := wsHandler.CreateMessages(tc.cps)
@@ -406,3 +488,52 @@ func TestCreateMessage(t *testing.T) {
 		})
 	}
 }
+
+func TestLoginHook(t *testing.T) {
+	t.Run("no login messages for unauthenticated endpoints", func(t *testing.T) {
+		msgs, err := okx.LoginHook(config.Endpoint{URL: okx.URL_PROD})
+		require.NoError(t, err)
+		require.Empty(t, msgs)
+	})
+
+	t.Run("signed login message for authenticated endpoints", func(t *testing.T) {
+		auth := config.Authentication{
+			APIKey:     "key",
+			APISecret:  "secret",
+			Passphrase: "passphrase",
+		}
+		msgs, err := okx.LoginHook(config.Endpoint{URL: okx.URL_PROD, Authentication: auth})
+		require.NoError(t, err)
+		require.Len(t, msgs, 1)
+
+		var msg okx.LoginRequestMessage
+		require.NoError(t, json.Unmarshal(msgs[0], &msg))
+		require.Equal(t, string(okx.OperationLogin), msg.Operation)
+		require.Len(t, msg.Arguments, 1)
+		require.Equal(t, "key", msg.Arguments[0].APIKey)
+		require.Equal(t, "passphrase", msg.Arguments[0].Passphrase)
+	})
+
+	t.Run("login message is signed with the api secret", func(t *testing.T) {
+		auth := config.Authentication{
+			APIKey:     "key",
+			APISecret:  "secret",
+			Passphrase: "passphrase",
+		}
+		bz, err := okx.NewLoginRequestMessage(auth, time.Unix(1538054050, 0))
+		require.NoError(t, err)
+
+		var msg okx.LoginRequestMessage
+		require.NoError(t, json.Unmarshal(bz, &msg))
+		require.Equal(t, "1538054050", msg.Arguments[0].Timestamp)
+
+		mac := hmac.New(sha256.New, []byte("secret"))
+		mac.Write([]byte("1538054050GET/users/self/verify"))
+		require.Equal(t, base64.StdEncoding.EncodeToString(mac.Sum(nil)), msg.Arguments[0].Sign)
+	})
+
+	t.Run("login message requires an api secret", func(t *testing.T) {
+		_, err := okx.NewLoginRequestMessage(config.Authentication{APIKey: "key"}, time.Now())
+		require.Error(t, err)
+	})
+}
@@ -197,3 +197,44 @@ func (c *GRPCClient) Prices(
 
 	return c.client.Prices(ctx, req, grpc.WaitForReady(true))
 }
+
+// ProviderPrices returns the breakdown of the provider prices used by the remote oracle service to
+// calculate the price of each market. This method blocks for the timeout duration configured on the
+// client, otherwise it returns the response from the remote oracle.
+func (c *GRPCClient) ProviderPrices(
+	ctx context.Context,
+	req *types.QueryProviderPricesRequest,
+	_ ...grpc.CallOption,
+) (*types.QueryProviderPricesResponse, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	// set deadline on the context
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	if c.client == nil {
+		return nil, fmt.Errorf("oracle client not started")
+	}
+
+	return c.client.ProviderPrices(ctx, req, grpc.WaitForReady(true))
+}
+
+// StreamPrices subscribes to the prices aggregated by the remote oracle service. A response is
+// received after every oracle update until the context is cancelled or the stream is closed by
+// the server. Unlike Prices, the stream is not bound by the client's timeout.
+func (c *GRPCClient) StreamPrices(
+	ctx context.Context,
+	req *types.StreamPricesRequest,
+	opts ...grpc.CallOption,
+) (types.Oracle_StreamPricesClient, error) {
+	c.mutex.Lock()
+	client := c.client
+	c.mutex.Unlock()
+
+	if client == nil {
+		return nil, fmt.Errorf("oracle client not started")
+	}
+
+	return client.StreamPrices(ctx, req, append(opts, grpc.WaitForReady(true))...)
+}
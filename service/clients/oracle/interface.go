@@ -2,6 +2,7 @@ package oracle
 
 import (
 	"context"
+	"fmt"
 
 	"google.golang.org/grpc"
 
@@ -46,3 +47,21 @@ func (NoOpClient) Prices(
 ) (*types.QueryPricesResponse, error) {
 	return nil, nil
 }
+
+// ProviderPrices is a no-op.
+func (NoOpClient) ProviderPrices(
+	_ context.Context,
+	_ *types.QueryProviderPricesRequest,
+	_ ...grpc.CallOption,
+) (*types.QueryProviderPricesResponse, error) {
+	return nil, nil
+}
+
+// StreamPrices returns an error, since the no-op client has no stream to return.
+func (NoOpClient) StreamPrices(
+	_ context.Context,
+	_ *types.StreamPricesRequest,
+	_ ...grpc.CallOption,
+) (types.Oracle_StreamPricesClient, error) {
+	return nil, fmt.Errorf("oracle client not started")
+}
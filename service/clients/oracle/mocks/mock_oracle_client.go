@@ -54,6 +54,43 @@ func (_m *OracleClient) Prices(ctx context.Context, in *types.QueryPricesRequest
 	return r0, r1
 }
 
+// ProviderPrices provides a mock function with given fields: ctx, in, opts
+func (_m *OracleClient) ProviderPrices(ctx context.Context, in *types.QueryProviderPricesRequest, opts ...grpc.CallOption) (*types.QueryProviderPricesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProviderPrices")
+	}
+
+	var r0 *types.QueryProviderPricesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryProviderPricesRequest, ...grpc.CallOption) (*types.QueryProviderPricesResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryProviderPricesRequest, ...grpc.CallOption) *types.QueryProviderPricesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryProviderPricesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryProviderPricesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Start provides a mock function with given fields: _a0
 func (_m *OracleClient) Start(_a0 context.Context) error {
 	ret := _m.Called(_a0)
@@ -90,6 +127,43 @@ func (_m *OracleClient) Stop() error {
 	return r0
 }
 
+// StreamPrices provides a mock function with given fields: ctx, in, opts
+func (_m *OracleClient) StreamPrices(ctx context.Context, in *types.StreamPricesRequest, opts ...grpc.CallOption) (types.Oracle_StreamPricesClient, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamPrices")
+	}
+
+	var r0 types.Oracle_StreamPricesClient
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.StreamPricesRequest, ...grpc.CallOption) (types.Oracle_StreamPricesClient, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.StreamPricesRequest, ...grpc.CallOption) types.Oracle_StreamPricesClient); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(types.Oracle_StreamPricesClient)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.StreamPricesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewOracleClient creates a new instance of OracleClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewOracleClient(t interface {
@@ -0,0 +1,251 @@
+package oracle
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+
+	"github.com/skip-mev/slinky/oracle/orchestrator"
+	mmtypes "github.com/skip-mev/slinky/x/marketmap/types"
+)
+
+const (
+	// HealthzPath is the HTTP path that reports whether the oracle is alive.
+	HealthzPath = "/healthz"
+	// ReadyzPath is the HTTP path that reports whether the oracle is ready to serve prices.
+	ReadyzPath = "/readyz"
+
+	// OracleServiceName is the name of the oracle gRPC service reported by the gRPC health
+	// service.
+	OracleServiceName = "slinky.service.v1.Oracle"
+
+	// DefaultMaxSyncAge is the default maximum amount of time since the last oracle sync
+	// before the oracle server reports that it is not ready.
+	DefaultMaxSyncAge = time.Minute
+
+	// healthWatchInterval is the interval at which the health status is re-evaluated for
+	// clients watching the gRPC health service.
+	healthWatchInterval = time.Second
+)
+
+// ProviderOrchestrator defines the expected interface of the provider orchestrator that is used
+// to report the state of the providers and market map in the health checks.
+type ProviderOrchestrator interface {
+	GetProviderState() map[string]orchestrator.ProviderState
+	GetMarketMap() mmtypes.MarketMap
+}
+
+// ProviderHealth is the state of a single provider.
+type ProviderHealth struct {
+	// Name is the name of the provider.
+	Name string `json:"name"`
+	// Type is the type of data handler used by the provider i.e. api or websocket.
+	Type string `json:"type"`
+	// Running is true if the provider is running.
+	Running bool `json:"running"`
+}
+
+// HealthStatus is the health of the oracle that is reported by the health and readiness checks.
+type HealthStatus struct {
+	// Healthy is true if the check passed.
+	Healthy bool `json:"healthy"`
+	// Running is true if the oracle is running.
+	Running bool `json:"running"`
+	// LastSync is the last time the oracle updated its prices.
+	LastSync time.Time `json:"last_sync"`
+	// LastSyncAge is the amount of time since the last oracle sync.
+	LastSyncAge string `json:"last_sync_age"`
+	// Stale is true if the last sync is older than the max sync age.
+	Stale bool `json:"stale"`
+	// MarketMapLoaded is true if the orchestrator has loaded a market map with at least one
+	// market.
+	MarketMapLoaded bool `json:"market_map_loaded"`
+	// Providers is the state of each provider.
+	Providers []ProviderHealth `json:"providers"`
+	// Reasons is the list of reasons the check failed.
+	Reasons []string `json:"reasons,omitempty"`
+}
+
+// Liveness returns whether the oracle is alive. The oracle is alive as long as the main oracle
+// process is running.
+func (os *OracleServer) Liveness() HealthStatus {
+	status := os.healthStatus()
+	status.Healthy = status.Running
+	if !status.Running {
+		status.Reasons = append(status.Reasons, "oracle is not running")
+	}
+
+	return status
+}
+
+// Readiness returns whether the oracle is ready to serve prices. The oracle is ready if it is
+// running and has synced within the max sync age. If a provider orchestrator is configured, a
+// market map must also be loaded and at least one provider must be running.
+func (os *OracleServer) Readiness() HealthStatus {
+	status := os.healthStatus()
+
+	if !status.Running {
+		status.Reasons = append(status.Reasons, "oracle is not running")
+	}
+
+	if status.Stale {
+		status.Reasons = append(status.Reasons, "last oracle sync is stale")
+	}
+
+	if os.orchestrator != nil {
+		if !status.MarketMapLoaded {
+			status.Reasons = append(status.Reasons, "market map has not been loaded")
+		}
+
+		running := false
+		for _, provider := range status.Providers {
+			running = running || provider.Running
+		}
+		if !running {
+			status.Reasons = append(status.Reasons, "no providers are running")
+		}
+	}
+
+	status.Healthy = len(status.Reasons) == 0
+	return status
+}
+
+// healthStatus returns the current state of the oracle, its providers and the market map.
+func (os *OracleServer) healthStatus() HealthStatus {
+	lastSync := os.o.GetLastSyncTime()
+	age := time.Since(lastSync)
+
+	status := HealthStatus{
+		Running:   os.o.IsRunning(),
+		LastSync:  lastSync,
+		Stale:     lastSync.IsZero() || age > os.maxSyncAge,
+		Providers: make([]ProviderHealth, 0),
+	}
+	if !lastSync.IsZero() {
+		status.LastSyncAge = age.String()
+	}
+
+	if os.orchestrator == nil {
+		return status
+	}
+
+	status.MarketMapLoaded = len(os.orchestrator.GetMarketMap().Markets) > 0
+	for name, state := range os.orchestrator.GetProviderState() {
+		provider := ProviderHealth{
+			Name: name,
+		}
+		if state.Provider != nil {
+			provider.Type = string(state.Provider.Type())
+			provider.Running = state.Provider.IsRunning()
+		}
+
+		status.Providers = append(status.Providers, provider)
+	}
+
+	sort.Slice(status.Providers, func(i, j int) bool {
+		return status.Providers[i].Name < status.Providers[j].Name
+	})
+
+	return status
+}
+
+// ServeHealthz serves the liveness check over HTTP. A 200 is returned if the oracle is alive,
+// otherwise a 503 is returned. The body contains the JSON encoded HealthStatus.
+func (os *OracleServer) ServeHealthz(w http.ResponseWriter, _ *http.Request) {
+	os.writeHealthStatus(w, os.Liveness())
+}
+
+// ServeReadyz serves the readiness check over HTTP. A 200 is returned if the oracle is ready,
+// otherwise a 503 is returned. The body contains the JSON encoded HealthStatus.
+func (os *OracleServer) ServeReadyz(w http.ResponseWriter, _ *http.Request) {
+	os.writeHealthStatus(w, os.Readiness())
+}
+
+// writeHealthStatus writes the given health status to the response.
+func (os *OracleServer) writeHealthStatus(w http.ResponseWriter, status HealthStatus) {
+	bz, err := json.Marshal(status)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if status.Healthy {
+		w.WriteHeader(http.StatusOK)
+	} else {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+
+	if _, err := w.Write(bz); err != nil {
+		os.logger.Debug("failed to write health status", zap.Error(err))
+	}
+}
+
+// healthServer implements the gRPC health service. The overall health and the health of the
+// oracle service both reflect the readiness of the oracle.
+type healthServer struct {
+	healthpb.UnimplementedHealthServer
+
+	os *OracleServer
+}
+
+// Check returns the serving status of the requested service.
+func (hs *healthServer) Check(_ context.Context, req *healthpb.HealthCheckRequest) (*healthpb.HealthCheckResponse, error) {
+	servingStatus, err := hs.servingStatus(req.Service)
+	if err != nil {
+		return nil, err
+	}
+
+	return &healthpb.HealthCheckResponse{Status: servingStatus}, nil
+}
+
+// Watch streams the serving status of the requested service whenever it changes.
+func (hs *healthServer) Watch(req *healthpb.HealthCheckRequest, stream healthpb.Health_WatchServer) error {
+	ticker := time.NewTicker(healthWatchInterval)
+	defer ticker.Stop()
+
+	last := healthpb.HealthCheckResponse_UNKNOWN
+	for {
+		servingStatus, err := hs.servingStatus(req.Service)
+		if err != nil {
+			servingStatus = healthpb.HealthCheckResponse_SERVICE_UNKNOWN
+		}
+
+		if servingStatus != last {
+			if err := stream.Send(&healthpb.HealthCheckResponse{Status: servingStatus}); err != nil {
+				return err
+			}
+			last = servingStatus
+		}
+
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case <-hs.os.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// servingStatus returns the serving status of the given service.
+func (hs *healthServer) servingStatus(service string) (healthpb.HealthCheckResponse_ServingStatus, error) {
+	switch service {
+	case "", OracleServiceName:
+	default:
+		return healthpb.HealthCheckResponse_SERVICE_UNKNOWN, status.Errorf(codes.NotFound, "unknown service %s", service)
+	}
+
+	if hs.os.Readiness().Healthy {
+		return healthpb.HealthCheckResponse_SERVING, nil
+	}
+
+	return healthpb.HealthCheckResponse_NOT_SERVING, nil
+}
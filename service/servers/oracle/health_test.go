@@ -0,0 +1,247 @@
+package oracle_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+
+	"github.com/skip-mev/slinky/oracle/config"
+	"github.com/skip-mev/slinky/oracle/mocks"
+	"github.com/skip-mev/slinky/oracle/orchestrator"
+	"github.com/skip-mev/slinky/oracle/types"
+	"github.com/skip-mev/slinky/providers/base/testutils"
+	providertypes "github.com/skip-mev/slinky/providers/types"
+	server "github.com/skip-mev/slinky/service/servers/oracle"
+	mmtypes "github.com/skip-mev/slinky/x/marketmap/types"
+)
+
+// staticOrchestrator reports a fixed set of providers and market map.
+type staticOrchestrator struct {
+	providers map[string]orchestrator.ProviderState
+	marketMap mmtypes.MarketMap
+}
+
+func (o staticOrchestrator) GetProviderState() map[string]orchestrator.ProviderState {
+	return o.providers
+}
+
+func (o staticOrchestrator) GetMarketMap() mmtypes.MarketMap {
+	return o.marketMap
+}
+
+// getWithStatus retries the HTTP request until the server responds with the expected status code.
+func (s *ServerTestSuite) getWithStatus(path string, expStatus int) []byte {
+	var bz []byte
+	s.Require().Eventually(func() bool {
+		httpResp, err := s.httpClient.Get(fmt.Sprintf("http://%s:%s%s", localhost, port, path))
+		if err != nil {
+			return false
+		}
+		defer httpResp.Body.Close()
+
+		bz, err = io.ReadAll(httpResp.Body)
+		return err == nil && httpResp.StatusCode == expStatus
+	}, 5*time.Second, 50*time.Millisecond)
+
+	return bz
+}
+
+func (s *ServerTestSuite) TestOracleServerHealth() {
+	s.mockOracle.On("IsRunning").Return(true)
+	s.mockOracle.On("GetLastSyncTime").Return(time.Now())
+
+	for _, path := range []string{server.HealthzPath, server.ReadyzPath} {
+		bz := s.getWithStatus(path, http.StatusOK)
+
+		var status server.HealthStatus
+		s.Require().NoError(json.Unmarshal(bz, &status))
+		s.Require().True(status.Healthy)
+		s.Require().True(status.Running)
+		s.Require().False(status.Stale)
+	}
+
+	conn, err := grpc.NewClient(localhost+":"+port, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	s.Require().NoError(err)
+	defer conn.Close()
+
+	client := healthpb.NewHealthClient(conn)
+	for _, service := range []string{"", server.OracleServiceName} {
+		resp, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+		s.Require().NoError(err)
+		s.Require().Equal(healthpb.HealthCheckResponse_SERVING, resp.Status)
+	}
+
+	_, err = client.Check(context.Background(), &healthpb.HealthCheckRequest{Service: "unknown"})
+	s.Require().Equal(codes.NotFound, status.Code(err))
+}
+
+func (s *ServerTestSuite) TestOracleServerNotReady() {
+	s.mockOracle.On("IsRunning").Return(true)
+	s.mockOracle.On("GetLastSyncTime").Return(time.Time{})
+
+	// the oracle is alive but has never synced
+	s.getWithStatus(server.HealthzPath, http.StatusOK)
+	bz := s.getWithStatus(server.ReadyzPath, http.StatusServiceUnavailable)
+	s.Require().Contains(string(bz), "last oracle sync is stale")
+
+	conn, err := grpc.NewClient(localhost+":"+port, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	s.Require().NoError(err)
+	defer conn.Close()
+
+	resp, err := healthpb.NewHealthClient(conn).Check(context.Background(), &healthpb.HealthCheckRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(healthpb.HealthCheckResponse_NOT_SERVING, resp.Status)
+}
+
+func TestReadiness(t *testing.T) {
+	cfg := config.ProviderConfig{
+		Name: "api1",
+		API: config.APIConfig{
+			Interval:         500 * time.Millisecond,
+			Timeout:          250 * time.Millisecond,
+			ReconnectTimeout: 250 * time.Millisecond,
+			MaxQueries:       10,
+			Enabled:          true,
+			Name:             "api1",
+			Endpoints:        []config.Endpoint{{URL: "http://test.com"}},
+		},
+		Type: types.ConfigType,
+	}
+
+	newProvider := func(t *testing.T, running bool) *types.PriceProvider {
+		t.Helper()
+
+		provider := testutils.CreateAPIProviderWithGetResponses[types.ProviderTicker, *big.Float](
+			t,
+			zap.NewNop(),
+			cfg,
+			[]types.ProviderTicker{types.NewProviderTicker("BTC/USD", "{}")},
+			nil,
+			time.Second,
+		)
+		if !running {
+			return provider
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		t.Cleanup(cancel)
+		go provider.Start(ctx) //nolint:errcheck
+		require.Eventually(t, provider.IsRunning, 5*time.Second, 10*time.Millisecond)
+
+		return provider
+	}
+
+	marketMap := mmtypes.MarketMap{
+		Markets: map[string]mmtypes.Market{
+			"BTC/USD": {},
+		},
+	}
+
+	testCases := []struct {
+		name         string
+		running      bool
+		lastSync     time.Time
+		orchestrator func(t *testing.T) server.ProviderOrchestrator
+		expReasons   []string
+	}{
+		{
+			name:     "ready without an orchestrator",
+			running:  true,
+			lastSync: time.Now(),
+		},
+		{
+			name:       "oracle is not running",
+			running:    false,
+			lastSync:   time.Now(),
+			expReasons: []string{"oracle is not running"},
+		},
+		{
+			name:       "oracle has never synced",
+			running:    true,
+			expReasons: []string{"last oracle sync is stale"},
+		},
+		{
+			name:       "last sync is stale",
+			running:    true,
+			lastSync:   time.Now().Add(-time.Hour),
+			expReasons: []string{"last oracle sync is stale"},
+		},
+		{
+			name:     "market map has not been loaded",
+			running:  true,
+			lastSync: time.Now(),
+			orchestrator: func(t *testing.T) server.ProviderOrchestrator {
+				return staticOrchestrator{
+					providers: map[string]orchestrator.ProviderState{
+						cfg.Name: {Provider: newProvider(t, true), Cfg: cfg},
+					},
+				}
+			},
+			expReasons: []string{"market map has not been loaded"},
+		},
+		{
+			name:     "no providers are running",
+			running:  true,
+			lastSync: time.Now(),
+			orchestrator: func(t *testing.T) server.ProviderOrchestrator {
+				return staticOrchestrator{
+					providers: map[string]orchestrator.ProviderState{
+						cfg.Name: {Provider: newProvider(t, false), Cfg: cfg},
+					},
+					marketMap: marketMap,
+				}
+			},
+			expReasons: []string{"no providers are running"},
+		},
+		{
+			name:     "ready with an orchestrator",
+			running:  true,
+			lastSync: time.Now(),
+			orchestrator: func(t *testing.T) server.ProviderOrchestrator {
+				return staticOrchestrator{
+					providers: map[string]orchestrator.ProviderState{
+						cfg.Name: {Provider: newProvider(t, true), Cfg: cfg},
+					},
+					marketMap: marketMap,
+				}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockOracle := mocks.NewOracle(t)
+			mockOracle.On("IsRunning").Return(tc.running)
+			mockOracle.On("GetLastSyncTime").Return(tc.lastSync)
+
+			opts := []server.Option{server.WithMaxSyncAge(time.Minute)}
+			if tc.orchestrator != nil {
+				opts = append(opts, server.WithProviderOrchestrator(tc.orchestrator(t)))
+			}
+			srv := server.NewOracleServer(mockOracle, zap.NewNop(), opts...)
+
+			status := srv.Readiness()
+			require.Equal(t, len(tc.expReasons) == 0, status.Healthy)
+			require.Equal(t, tc.expReasons, status.Reasons)
+
+			if tc.orchestrator != nil {
+				require.Len(t, status.Providers, 1)
+				require.Equal(t, cfg.Name, status.Providers[0].Name)
+				require.Equal(t, string(providertypes.API), status.Providers[0].Type)
+			}
+		})
+	}
+}
@@ -45,6 +45,36 @@ func (_m *OracleService) Prices(_a0 context.Context, _a1 *types.QueryPricesReque
 	return r0, r1
 }
 
+// ProviderPrices provides a mock function with given fields: _a0, _a1
+func (_m *OracleService) ProviderPrices(_a0 context.Context, _a1 *types.QueryProviderPricesRequest) (*types.QueryProviderPricesResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProviderPrices")
+	}
+
+	var r0 *types.QueryProviderPricesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryProviderPricesRequest) (*types.QueryProviderPricesResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryProviderPricesRequest) *types.QueryProviderPricesResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryProviderPricesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryProviderPricesRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Start provides a mock function with given fields: _a0
 func (_m *OracleService) Start(_a0 context.Context) error {
 	ret := _m.Called(_a0)
@@ -81,6 +111,24 @@ func (_m *OracleService) Stop(_a0 context.Context) error {
 	return r0
 }
 
+// StreamPrices provides a mock function with given fields: _a0, _a1
+func (_m *OracleService) StreamPrices(_a0 *types.StreamPricesRequest, _a1 types.Oracle_StreamPricesServer) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamPrices")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*types.StreamPricesRequest, types.Oracle_StreamPricesServer) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewOracleService creates a new instance of OracleService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewOracleService(t interface {
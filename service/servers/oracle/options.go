@@ -0,0 +1,30 @@
+package oracle
+
+import "time"
+
+// Option is a functional option for the oracle server.
+type Option func(*OracleServer)
+
+// WithProviderOrchestrator sets the provider orchestrator that is used to report the state of
+// the providers and market map in the health checks of the oracle server.
+func WithProviderOrchestrator(orchestrator ProviderOrchestrator) Option {
+	return func(os *OracleServer) {
+		if orchestrator == nil {
+			panic("provider orchestrator cannot be nil")
+		}
+
+		os.orchestrator = orchestrator
+	}
+}
+
+// WithMaxSyncAge sets the maximum amount of time since the last oracle sync before the oracle
+// server reports that it is not ready.
+func WithMaxSyncAge(maxSyncAge time.Duration) Option {
+	return func(os *OracleServer) {
+		if maxSyncAge <= 0 {
+			panic("max sync age must be positive")
+		}
+
+		os.maxSyncAge = maxSyncAge
+	}
+}
@@ -0,0 +1,124 @@
+package oracle
+
+import (
+	"context"
+	"math/big"
+	"sort"
+	"strings"
+
+	"go.uber.org/zap"
+
+	oracletypes "github.com/skip-mev/slinky/oracle/types"
+	"github.com/skip-mev/slinky/service/servers/oracle/types"
+)
+
+// ProviderPrices returns the breakdown of the provider prices used to calculate the price of each
+// market in the most recent oracle update. The markets can be filtered by ticker. Markets and
+// their provider prices are returned in a deterministic order.
+func (os *OracleServer) ProviderPrices(
+	ctx context.Context,
+	req *types.QueryProviderPricesRequest,
+) (*types.QueryProviderPricesResponse, error) {
+	// check that the request is non-nil
+	if req == nil {
+		return nil, ErrNilRequest
+	}
+
+	os.logger.Debug("received request for provider prices", zap.Strings("tickers", req.Tickers))
+
+	// check that oracle is running
+	if !os.o.IsRunning() {
+		os.logger.Error("oracle not running")
+		return nil, ErrOracleNotRunning
+	}
+
+	resCh := make(chan *types.QueryProviderPricesResponse)
+
+	// run the request in a goroutine, to unblock server + ctx cancellation
+	go func() {
+		reports := os.o.GetProviderPrices()
+		timestamp := os.o.GetLastSyncTime()
+
+		resCh <- &types.QueryProviderPricesResponse{
+			Markets:   ToMarketProviderPrices(reports, req.Tickers),
+			Timestamp: timestamp,
+		}
+	}()
+
+	// defer to context closure
+	select {
+	case <-ctx.Done():
+		os.logger.Error("context cancelled")
+		return nil, context.Canceled
+	case resp := <-resCh:
+		return resp, nil
+	}
+}
+
+// ToMarketProviderPrices converts the provider price reports of the oracle to their response
+// representation. Only the given tickers are returned if any are provided.
+func ToMarketProviderPrices(reports oracletypes.ProviderPriceReports, tickers []string) []types.MarketProviderPrices {
+	filter := make(map[string]struct{}, len(tickers))
+	for _, ticker := range tickers {
+		filter[strings.ToUpper(strings.TrimSpace(ticker))] = struct{}{}
+	}
+
+	markets := make([]types.MarketProviderPrices, 0, len(reports))
+	for ticker, providerReports := range reports {
+		if _, ok := filter[ticker]; len(filter) > 0 && !ok {
+			continue
+		}
+
+		providerPrices := make([]types.ProviderPrice, 0, len(providerReports))
+		for _, report := range providerReports {
+			normalizations := make([]types.NormalizationPrice, 0, len(report.Normalizations))
+			for _, normalization := range report.Normalizations {
+				normalizations = append(normalizations, types.NormalizationPrice{
+					Ticker: normalization.Ticker,
+					Price:  formatPrice(normalization.Price),
+				})
+			}
+
+			providerPrices = append(providerPrices, types.ProviderPrice{
+				Provider:       report.Provider,
+				OffChainTicker: report.OffChainTicker,
+				Price:          formatPrice(report.Price),
+				Timestamp:      report.Timestamp,
+				Stale:          report.Stale,
+				Invert:         report.Invert,
+				Normalizations: normalizations,
+				ConvertedPrice: formatPrice(report.ConvertedPrice),
+				Outlier:        report.Outlier,
+				Liquidity:      formatPrice(report.Liquidity),
+			})
+		}
+
+		sort.SliceStable(providerPrices, func(i, j int) bool {
+			if providerPrices[i].Provider != providerPrices[j].Provider {
+				return providerPrices[i].Provider < providerPrices[j].Provider
+			}
+			return providerPrices[i].OffChainTicker < providerPrices[j].OffChainTicker
+		})
+
+		markets = append(markets, types.MarketProviderPrices{
+			Ticker:         ticker,
+			ProviderPrices: providerPrices,
+		})
+	}
+
+	sort.Slice(markets, func(i, j int) bool {
+		return markets[i].Ticker < markets[j].Ticker
+	})
+
+	return markets
+}
+
+// formatPrice returns the decimal representation of the given price, or an empty string if
+// the price is nil.
+func formatPrice(price *big.Float) string {
+	if price == nil {
+		return ""
+	}
+
+	return price.Text('f', -1)
+}
@@ -15,6 +15,7 @@ import (
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 
 	"github.com/skip-mev/slinky/oracle"
 	"github.com/skip-mev/slinky/pkg/sync"
@@ -45,15 +46,25 @@ type OracleServer struct { //nolint
 
 	// logger to log incoming requests
 	logger *zap.Logger
+
+	// orchestrator reports the state of the providers and market map in health checks
+	orchestrator ProviderOrchestrator
+
+	// maxSyncAge is the maximum age of the last oracle sync before the server is not ready
+	maxSyncAge time.Duration
 }
 
 // NewOracleServer returns a new instance of the OracleServer, given an implementation of the Oracle interface.
-func NewOracleServer(o oracle.Oracle, logger *zap.Logger) *OracleServer {
+func NewOracleServer(o oracle.Oracle, logger *zap.Logger, opts ...Option) *OracleServer {
 	logger = logger.With(zap.String("server", "oracle"))
 
 	os := &OracleServer{
-		o:      o,
-		logger: logger,
+		o:          o,
+		logger:     logger,
+		maxSyncAge: DefaultMaxSyncAge,
+	}
+	for _, opt := range opts {
+		opt(os)
 	}
 	os.Closer = sync.NewCloser().WithCallback(func() {
 		// if the server has been started, close it
@@ -92,6 +103,8 @@ func (os *OracleServer) StartServer(ctx context.Context, host, port string) erro
 	os.grpcSrv = grpc.NewServer()
 	// register oracle server
 	types.RegisterOracleServer(os.grpcSrv, os)
+	// register health server
+	healthpb.RegisterHealthServer(os.grpcSrv, &healthServer{os: os})
 
 	// register the grpc-gateway
 	// it handles the http request and dials the server endpoint with the grpc request
@@ -109,6 +122,9 @@ func (os *OracleServer) StartServer(ctx context.Context, host, port string) erro
 	}
 
 	router := http.NewServeMux()
+	router.HandleFunc(StreamPricesPath, os.ServePricesStream)
+	router.HandleFunc(HealthzPath, os.ServeHealthz)
+	router.HandleFunc(ReadyzPath, os.ServeReadyz)
 	router.HandleFunc("/", os.routeRequest)
 
 	os.httpSrv.Handler = h2c.NewHandler(router, &http2.Server{})
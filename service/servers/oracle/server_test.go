@@ -1,6 +1,7 @@
 package oracle_test
 
 import (
+	"bufio"
 	"context"
 	"fmt"
 	"io"
@@ -15,6 +16,7 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/grpc/status"
 
+	"github.com/skip-mev/slinky/oracle"
 	"github.com/skip-mev/slinky/oracle/mocks"
 	"github.com/skip-mev/slinky/oracle/types"
 	slinkytypes "github.com/skip-mev/slinky/pkg/types"
@@ -170,6 +172,183 @@ func (s *ServerTestSuite) TestOracleServerPrices() {
 	s.Require().Contains(string(respBz), fmt.Sprintf(`{"prices":{"%s":"100","%s":"200"},"timestamp":`, cp1.String(), cp2.String()))
 }
 
+func (s *ServerTestSuite) TestOracleServerProviderPrices() {
+	s.mockOracle.On("IsRunning").Return(true)
+
+	ts := time.Now().UTC()
+	s.mockOracle.On("GetProviderPrices").Return(types.ProviderPriceReports{
+		"BTC/USD": {
+			{
+				Provider:       "okx",
+				OffChainTicker: "BTC-USDT",
+				Price:          big.NewFloat(100),
+				Timestamp:      ts,
+				Normalizations: []types.NormalizationPrice{
+					{Ticker: "USDT/USD", Price: big.NewFloat(0.5)},
+				},
+				ConvertedPrice: big.NewFloat(50),
+				Outlier:        true,
+			},
+			{
+				Provider:       "coinbase",
+				OffChainTicker: "BTC-USD",
+				Price:          big.NewFloat(100.5),
+				Timestamp:      ts,
+				ConvertedPrice: big.NewFloat(100.5),
+				Liquidity:      big.NewFloat(250000),
+			},
+		},
+		"ETH/USD": {
+			{
+				Provider:       "coinbase",
+				OffChainTicker: "ETH-USD",
+				Price:          big.NewFloat(200),
+				Timestamp:      ts.Add(-time.Hour),
+				Stale:          true,
+			},
+		},
+	})
+	s.mockOracle.On("GetLastSyncTime").Return(ts)
+
+	// call from grpc client
+	resp, err := s.client.ProviderPrices(context.Background(), &stypes.QueryProviderPricesRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(ts, resp.Timestamp)
+	s.Require().Len(resp.Markets, 2)
+
+	btc := resp.Markets[0]
+	s.Require().Equal("BTC/USD", btc.Ticker)
+	s.Require().Len(btc.ProviderPrices, 2)
+	s.Require().Equal("coinbase", btc.ProviderPrices[0].Provider)
+	s.Require().Equal("100.5", btc.ProviderPrices[0].Price)
+	s.Require().Equal("100.5", btc.ProviderPrices[0].ConvertedPrice)
+	s.Require().False(btc.ProviderPrices[0].Outlier)
+	s.Require().Equal("250000", btc.ProviderPrices[0].Liquidity)
+	s.Require().Equal("okx", btc.ProviderPrices[1].Provider)
+	s.Require().Equal("BTC-USDT", btc.ProviderPrices[1].OffChainTicker)
+	s.Require().Equal([]stypes.NormalizationPrice{{Ticker: "USDT/USD", Price: "0.5"}}, btc.ProviderPrices[1].Normalizations)
+	s.Require().Equal("50", btc.ProviderPrices[1].ConvertedPrice)
+	s.Require().True(btc.ProviderPrices[1].Outlier)
+	s.Require().Empty(btc.ProviderPrices[1].Liquidity)
+
+	eth := resp.Markets[1]
+	s.Require().Equal("ETH/USD", eth.Ticker)
+	s.Require().Len(eth.ProviderPrices, 1)
+	s.Require().True(eth.ProviderPrices[0].Stale)
+	s.Require().Equal("200", eth.ProviderPrices[0].Price)
+	s.Require().Empty(eth.ProviderPrices[0].ConvertedPrice)
+	s.Require().Equal(ts.Add(-time.Hour), eth.ProviderPrices[0].Timestamp)
+
+	// filter by ticker
+	resp, err = s.client.ProviderPrices(context.Background(), &stypes.QueryProviderPricesRequest{
+		Tickers: []string{"eth/usd"},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(resp.Markets, 1)
+	s.Require().Equal("ETH/USD", resp.Markets[0].Ticker)
+
+	// call from http client
+	respBz := s.getWithStatus("/slinky/oracle/v1/provider_prices?tickers=BTC/USD", http.StatusOK)
+	s.Require().Contains(string(respBz), `"ticker":"BTC/USD"`)
+	s.Require().Contains(string(respBz), `"off_chain_ticker":"BTC-USDT"`)
+	s.Require().NotContains(string(respBz), `"ticker":"ETH/USD"`)
+}
+
+func (s *ServerTestSuite) TestOracleServerStreamPrices() {
+	s.mockOracle.On("IsRunning").Return(true)
+
+	updates := make(chan oracle.PriceUpdate, 2)
+	unsubscribed := make(chan struct{})
+	s.mockOracle.On("Subscribe").Return((<-chan oracle.PriceUpdate)(updates), func() { close(unsubscribed) }).Once()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// subscribe to only the BTC/USD prices
+	stream, err := s.client.StreamPrices(ctx, &stypes.StreamPricesRequest{Tickers: []string{"btc/usd"}})
+	s.Require().NoError(err)
+
+	for i := int64(1); i <= 2; i++ {
+		ts := time.Now().UTC()
+		updates <- oracle.PriceUpdate{
+			Prices: types.Prices{
+				"BTC/USD": big.NewFloat(float64(100 * i)),
+				"ETH/USD": big.NewFloat(float64(10 * i)),
+			},
+			Timestamp: ts,
+		}
+
+		resp, err := stream.Recv()
+		s.Require().NoError(err)
+		s.Require().Equal(map[string]string{"BTC/USD": big.NewInt(100 * i).String()}, resp.Prices)
+		s.Require().Equal(ts, resp.Timestamp)
+	}
+
+	// cancelling the stream unsubscribes from the oracle
+	cancel()
+	select {
+	case <-unsubscribed:
+	case <-time.After(2 * time.Second):
+		s.T().Fatal("stream failed to unsubscribe")
+	}
+}
+
+func (s *ServerTestSuite) TestOracleServerStreamPricesNotRunning() {
+	s.mockOracle.On("IsRunning").Return(false)
+
+	stream, err := s.client.StreamPrices(context.Background(), &stypes.StreamPricesRequest{})
+	s.Require().NoError(err)
+
+	_, err = stream.Recv()
+	s.Require().Equal(err.Error(), grpcErrPrefix+server.ErrOracleNotRunning.Error())
+}
+
+func (s *ServerTestSuite) TestOracleServerStreamPricesHTTP() {
+	s.mockOracle.On("IsRunning").Return(true)
+
+	updates := make(chan oracle.PriceUpdate, 1)
+	s.mockOracle.On("Subscribe").Return((<-chan oracle.PriceUpdate)(updates), func() {}).Once()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodGet,
+		fmt.Sprintf("http://%s:%s%s?tickers=BTC/USD", localhost, port, server.StreamPricesPath),
+		nil,
+	)
+	s.Require().NoError(err)
+
+	// wait for the http server to start listening
+	var httpResp *http.Response
+	s.Require().Eventually(func() bool {
+		httpResp, err = s.httpClient.Do(req)
+		return err == nil
+	}, 2*time.Second, 50*time.Millisecond)
+	defer httpResp.Body.Close()
+
+	s.Require().Equal(http.StatusOK, httpResp.StatusCode)
+	s.Require().Equal("text/event-stream", httpResp.Header.Get("Content-Type"))
+
+	updates <- oracle.PriceUpdate{
+		Prices: types.Prices{
+			"BTC/USD": big.NewFloat(100),
+			"ETH/USD": big.NewFloat(10),
+		},
+		Timestamp: time.Now().UTC(),
+	}
+
+	reader := bufio.NewReader(httpResp.Body)
+	event, err := reader.ReadString('\n')
+	s.Require().NoError(err)
+	s.Require().Equal("event: prices\n", event)
+
+	data, err := reader.ReadString('\n')
+	s.Require().NoError(err)
+	s.Require().Contains(data, `data: {"prices":{"BTC/USD":"100"},"timestamp":`)
+}
+
 // test that the oracle server closes when expected.
 func (s *ServerTestSuite) TestOracleServerClose() {
 	// close the server, and check that no requests are received
@@ -0,0 +1,135 @@
+package oracle
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/skip-mev/slinky/service/servers/oracle/types"
+)
+
+// StreamPricesPath is the HTTP path that serves the price stream as server-sent events.
+const StreamPricesPath = "/slinky/oracle/v1/prices/stream"
+
+// StreamPrices subscribes to the underlying oracle and pushes the aggregated prices to the
+// client after every oracle update. The stream is closed when the client cancels the request,
+// the server is closed, or the oracle stops publishing updates.
+func (os *OracleServer) StreamPrices(req *types.StreamPricesRequest, stream types.Oracle_StreamPricesServer) error {
+	// check that the request is non-nil
+	if req == nil {
+		return ErrNilRequest
+	}
+
+	os.logger.Debug("received request to stream prices", zap.Strings("tickers", req.Tickers))
+
+	return os.streamPrices(stream.Context(), req.Tickers, stream.Send)
+}
+
+// ServePricesStream serves the price stream over HTTP as server-sent events. Tickers can be
+// filtered with a comma separated tickers query parameter i.e. ?tickers=BTC/USD,ETH/USD. Each
+// event contains a JSON encoded QueryPricesResponse.
+func (os *OracleServer) ServePricesStream(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+
+	var tickers []string
+	if param := r.URL.Query().Get("tickers"); len(param) > 0 {
+		tickers = strings.Split(param, ",")
+	}
+
+	os.logger.Debug("received http request to stream prices", zap.Strings("tickers", tickers))
+
+	// check that oracle is running before committing to the stream
+	if !os.o.IsRunning() {
+		http.Error(w, ErrOracleNotRunning.Error(), http.StatusServiceUnavailable)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	err := os.streamPrices(r.Context(), tickers, func(resp *types.QueryPricesResponse) error {
+		bz, err := json.Marshal(resp)
+		if err != nil {
+			return err
+		}
+
+		if _, err := fmt.Fprintf(w, "event: prices\ndata: %s\n\n", bz); err != nil {
+			return err
+		}
+
+		flusher.Flush()
+		return nil
+	})
+	if err != nil {
+		os.logger.Debug("http price stream closed", zap.Error(err))
+	}
+}
+
+// streamPrices subscribes to the oracle and sends every price update - filtered by the given
+// tickers - until the context is cancelled, the server is closed, or send fails.
+func (os *OracleServer) streamPrices(
+	ctx context.Context,
+	tickers []string,
+	send func(*types.QueryPricesResponse) error,
+) error {
+	// check that oracle is running
+	if !os.o.IsRunning() {
+		os.logger.Error("oracle not running")
+		return ErrOracleNotRunning
+	}
+
+	filter := make(map[string]struct{}, len(tickers))
+	for _, ticker := range tickers {
+		filter[strings.ToUpper(strings.TrimSpace(ticker))] = struct{}{}
+	}
+
+	updates, unsubscribe := os.o.Subscribe()
+	defer unsubscribe()
+
+	for {
+		select {
+		case <-ctx.Done():
+			os.logger.Debug("price stream context cancelled")
+			return ctx.Err()
+		case <-os.Done():
+			os.logger.Debug("oracle server closed; closing price stream")
+			return nil
+		case update, ok := <-updates:
+			if !ok {
+				return nil
+			}
+
+			prices := ToReqPrices(update.Prices)
+			if len(filter) > 0 {
+				for ticker := range prices {
+					if _, ok := filter[ticker]; !ok {
+						delete(prices, ticker)
+					}
+				}
+			}
+
+			if err := send(&types.QueryPricesResponse{
+				Prices:    prices,
+				Timestamp: update.Timestamp,
+			}); err != nil {
+				return err
+			}
+		}
+	}
+}
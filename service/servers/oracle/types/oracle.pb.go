@@ -71,6 +71,53 @@ func (m *QueryPricesRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryPricesRequest proto.InternalMessageInfo
 
+// StreamPricesRequest defines the request type for the StreamPrices method.
+type StreamPricesRequest struct {
+	// tickers defines the list of tickers to stream prices for i.e. BTC/USD. If
+	// empty, the prices of all tickers are streamed.
+	Tickers []string `protobuf:"bytes,1,rep,name=tickers,proto3" json:"tickers,omitempty"`
+}
+
+func (m *StreamPricesRequest) Reset()         { *m = StreamPricesRequest{} }
+func (m *StreamPricesRequest) String() string { return proto.CompactTextString(m) }
+func (*StreamPricesRequest) ProtoMessage()    {}
+func (*StreamPricesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e88883d464f0f25b, []int{1}
+}
+func (m *StreamPricesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamPricesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamPricesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StreamPricesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamPricesRequest.Merge(m, src)
+}
+func (m *StreamPricesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamPricesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamPricesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamPricesRequest proto.InternalMessageInfo
+
+func (m *StreamPricesRequest) GetTickers() []string {
+	if m != nil {
+		return m.Tickers
+	}
+	return nil
+}
+
 // QueryPricesResponse defines the response type for the Prices method.
 type QueryPricesResponse struct {
 	// prices defines the list of prices.
@@ -82,7 +129,7 @@ func (m *QueryPricesResponse) Reset()         { *m = QueryPricesResponse{} }
 func (m *QueryPricesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPricesResponse) ProtoMessage()    {}
 func (*QueryPricesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e88883d464f0f25b, []int{1}
+	return fileDescriptor_e88883d464f0f25b, []int{2}
 }
 func (m *QueryPricesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -125,41 +172,416 @@ func (m *QueryPricesResponse) GetTimestamp() time.Time {
 	return time.Time{}
 }
 
+// QueryProviderPricesRequest defines the request type for the ProviderPrices
+// method.
+type QueryProviderPricesRequest struct {
+	// tickers defines the list of tickers to return the breakdown for i.e.
+	// BTC/USD. If empty, the breakdown of all markets is returned.
+	Tickers []string `protobuf:"bytes,1,rep,name=tickers,proto3" json:"tickers,omitempty"`
+}
+
+func (m *QueryProviderPricesRequest) Reset()         { *m = QueryProviderPricesRequest{} }
+func (m *QueryProviderPricesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProviderPricesRequest) ProtoMessage()    {}
+func (*QueryProviderPricesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e88883d464f0f25b, []int{3}
+}
+func (m *QueryProviderPricesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProviderPricesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProviderPricesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProviderPricesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProviderPricesRequest.Merge(m, src)
+}
+func (m *QueryProviderPricesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProviderPricesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProviderPricesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProviderPricesRequest proto.InternalMessageInfo
+
+func (m *QueryProviderPricesRequest) GetTickers() []string {
+	if m != nil {
+		return m.Tickers
+	}
+	return nil
+}
+
+// QueryProviderPricesResponse defines the response type for the
+// ProviderPrices method.
+type QueryProviderPricesResponse struct {
+	// markets defines the per-provider breakdown of each market.
+	Markets   []MarketProviderPrices `protobuf:"bytes,1,rep,name=markets,proto3" json:"markets"`
+	Timestamp time.Time              `protobuf:"bytes,2,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+}
+
+func (m *QueryProviderPricesResponse) Reset()         { *m = QueryProviderPricesResponse{} }
+func (m *QueryProviderPricesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProviderPricesResponse) ProtoMessage()    {}
+func (*QueryProviderPricesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e88883d464f0f25b, []int{4}
+}
+func (m *QueryProviderPricesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProviderPricesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProviderPricesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProviderPricesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProviderPricesResponse.Merge(m, src)
+}
+func (m *QueryProviderPricesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProviderPricesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProviderPricesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProviderPricesResponse proto.InternalMessageInfo
+
+func (m *QueryProviderPricesResponse) GetMarkets() []MarketProviderPrices {
+	if m != nil {
+		return m.Markets
+	}
+	return nil
+}
+
+func (m *QueryProviderPricesResponse) GetTimestamp() time.Time {
+	if m != nil {
+		return m.Timestamp
+	}
+	return time.Time{}
+}
+
+// MarketProviderPrices defines the per-provider breakdown of a single market.
+type MarketProviderPrices struct {
+	// ticker is the ticker of the market i.e. BTC/USD.
+	Ticker string `protobuf:"bytes,1,opt,name=ticker,proto3" json:"ticker,omitempty"`
+	// provider_prices is the breakdown of each provider configured for the
+	// market.
+	ProviderPrices []ProviderPrice `protobuf:"bytes,2,rep,name=provider_prices,json=providerPrices,proto3" json:"provider_prices"`
+}
+
+func (m *MarketProviderPrices) Reset()         { *m = MarketProviderPrices{} }
+func (m *MarketProviderPrices) String() string { return proto.CompactTextString(m) }
+func (*MarketProviderPrices) ProtoMessage()    {}
+func (*MarketProviderPrices) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e88883d464f0f25b, []int{5}
+}
+func (m *MarketProviderPrices) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketProviderPrices) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketProviderPrices.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MarketProviderPrices) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketProviderPrices.Merge(m, src)
+}
+func (m *MarketProviderPrices) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketProviderPrices) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketProviderPrices.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarketProviderPrices proto.InternalMessageInfo
+
+func (m *MarketProviderPrices) GetTicker() string {
+	if m != nil {
+		return m.Ticker
+	}
+	return ""
+}
+
+func (m *MarketProviderPrices) GetProviderPrices() []ProviderPrice {
+	if m != nil {
+		return m.ProviderPrices
+	}
+	return nil
+}
+
+// ProviderPrice defines the breakdown of a single provider price used when
+// calculating the price of a market. Prices are unscaled decimal strings.
+type ProviderPrice struct {
+	// provider is the name of the provider.
+	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	// off_chain_ticker is the ticker of the market on the provider.
+	OffChainTicker string `protobuf:"bytes,2,opt,name=off_chain_ticker,json=offChainTicker,proto3" json:"off_chain_ticker,omitempty"`
+	// price is the raw price reported by the provider. This is empty if the
+	// provider has not reported a price.
+	Price string `protobuf:"bytes,3,opt,name=price,proto3" json:"price,omitempty"`
+	// timestamp is the time at which the provider last updated the price.
+	Timestamp time.Time `protobuf:"bytes,4,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+	// stale is true if the price was dropped because it is older than the
+	// oracle's max price age.
+	Stale bool `protobuf:"varint,5,opt,name=stale,proto3" json:"stale,omitempty"`
+	// invert is true if the raw price is inverted before it is normalized.
+	Invert bool `protobuf:"varint,6,opt,name=invert,proto3" json:"invert,omitempty"`
+	// normalizations is the ordered list of index prices the price is
+	// normalized by.
+	Normalizations []NormalizationPrice `protobuf:"bytes,7,rep,name=normalizations,proto3" json:"normalizations"`
+	// converted_price is the price converted to the market's ticker. This is
+	// empty if the price could not be converted.
+	ConvertedPrice string `protobuf:"bytes,8,opt,name=converted_price,json=convertedPrice,proto3" json:"converted_price,omitempty"`
+	// outlier is true if the converted price was rejected as an outlier.
+	Outlier bool `protobuf:"varint,9,opt,name=outlier,proto3" json:"outlier,omitempty"`
+	// liquidity is the liquidity backing the raw price, e.g. the depth of a DEX
+	// pool, denominated in the quote asset of the provider's market. This is
+	// empty if the provider does not report liquidity.
+	Liquidity string `protobuf:"bytes,10,opt,name=liquidity,proto3" json:"liquidity,omitempty"`
+}
+
+func (m *ProviderPrice) Reset()         { *m = ProviderPrice{} }
+func (m *ProviderPrice) String() string { return proto.CompactTextString(m) }
+func (*ProviderPrice) ProtoMessage()    {}
+func (*ProviderPrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e88883d464f0f25b, []int{6}
+}
+func (m *ProviderPrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProviderPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProviderPrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProviderPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProviderPrice.Merge(m, src)
+}
+func (m *ProviderPrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProviderPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProviderPrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProviderPrice proto.InternalMessageInfo
+
+func (m *ProviderPrice) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *ProviderPrice) GetOffChainTicker() string {
+	if m != nil {
+		return m.OffChainTicker
+	}
+	return ""
+}
+
+func (m *ProviderPrice) GetPrice() string {
+	if m != nil {
+		return m.Price
+	}
+	return ""
+}
+
+func (m *ProviderPrice) GetTimestamp() time.Time {
+	if m != nil {
+		return m.Timestamp
+	}
+	return time.Time{}
+}
+
+func (m *ProviderPrice) GetStale() bool {
+	if m != nil {
+		return m.Stale
+	}
+	return false
+}
+
+func (m *ProviderPrice) GetInvert() bool {
+	if m != nil {
+		return m.Invert
+	}
+	return false
+}
+
+func (m *ProviderPrice) GetNormalizations() []NormalizationPrice {
+	if m != nil {
+		return m.Normalizations
+	}
+	return nil
+}
+
+func (m *ProviderPrice) GetConvertedPrice() string {
+	if m != nil {
+		return m.ConvertedPrice
+	}
+	return ""
+}
+
+func (m *ProviderPrice) GetOutlier() bool {
+	if m != nil {
+		return m.Outlier
+	}
+	return false
+}
+
+func (m *ProviderPrice) GetLiquidity() string {
+	if m != nil {
+		return m.Liquidity
+	}
+	return ""
+}
+
+// NormalizationPrice defines an index price used to normalize a provider
+// price.
+type NormalizationPrice struct {
+	// ticker is the ticker of the index price i.e. USDT/USD.
+	Ticker string `protobuf:"bytes,1,opt,name=ticker,proto3" json:"ticker,omitempty"`
+	// price is the index price. This is empty if the index price is missing.
+	Price string `protobuf:"bytes,2,opt,name=price,proto3" json:"price,omitempty"`
+}
+
+func (m *NormalizationPrice) Reset()         { *m = NormalizationPrice{} }
+func (m *NormalizationPrice) String() string { return proto.CompactTextString(m) }
+func (*NormalizationPrice) ProtoMessage()    {}
+func (*NormalizationPrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e88883d464f0f25b, []int{7}
+}
+func (m *NormalizationPrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NormalizationPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NormalizationPrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NormalizationPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NormalizationPrice.Merge(m, src)
+}
+func (m *NormalizationPrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *NormalizationPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_NormalizationPrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NormalizationPrice proto.InternalMessageInfo
+
+func (m *NormalizationPrice) GetTicker() string {
+	if m != nil {
+		return m.Ticker
+	}
+	return ""
+}
+
+func (m *NormalizationPrice) GetPrice() string {
+	if m != nil {
+		return m.Price
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*QueryPricesRequest)(nil), "slinky.service.v1.QueryPricesRequest")
+	proto.RegisterType((*StreamPricesRequest)(nil), "slinky.service.v1.StreamPricesRequest")
 	proto.RegisterType((*QueryPricesResponse)(nil), "slinky.service.v1.QueryPricesResponse")
 	proto.RegisterMapType((map[string]string)(nil), "slinky.service.v1.QueryPricesResponse.PricesEntry")
+	proto.RegisterType((*QueryProviderPricesRequest)(nil), "slinky.service.v1.QueryProviderPricesRequest")
+	proto.RegisterType((*QueryProviderPricesResponse)(nil), "slinky.service.v1.QueryProviderPricesResponse")
+	proto.RegisterType((*MarketProviderPrices)(nil), "slinky.service.v1.MarketProviderPrices")
+	proto.RegisterType((*ProviderPrice)(nil), "slinky.service.v1.ProviderPrice")
+	proto.RegisterType((*NormalizationPrice)(nil), "slinky.service.v1.NormalizationPrice")
 }
 
 func init() { proto.RegisterFile("slinky/service/v1/oracle.proto", fileDescriptor_e88883d464f0f25b) }
 
 var fileDescriptor_e88883d464f0f25b = []byte{
-	// 394 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0x4d, 0x6f, 0xa3, 0x30,
-	0x14, 0xc4, 0xc9, 0x2e, 0xda, 0x38, 0x97, 0x5d, 0x6f, 0x0e, 0x2c, 0x5a, 0x11, 0x14, 0x69, 0x57,
-	0xb9, 0xac, 0xad, 0xb0, 0x87, 0x7e, 0x1c, 0x91, 0x7a, 0x6e, 0x82, 0x7a, 0xea, 0xa5, 0x22, 0xc8,
-	0xa5, 0x56, 0x00, 0x53, 0x6c, 0x90, 0xb8, 0xb6, 0x7f, 0x20, 0x52, 0xff, 0x54, 0x8e, 0x91, 0x7a,
-	0xe9, 0xa9, 0xad, 0x92, 0xaa, 0xbf, 0xa3, 0x02, 0x93, 0x7e, 0x4b, 0xed, 0x09, 0x8f, 0xe7, 0xbd,
-	0x79, 0xcc, 0xf3, 0x40, 0x4b, 0x44, 0x2c, 0x99, 0x95, 0x44, 0xd0, 0xac, 0x60, 0x01, 0x25, 0xc5,
-	0x88, 0xf0, 0xcc, 0x0f, 0x22, 0x8a, 0xd3, 0x8c, 0x4b, 0x8e, 0x7e, 0x28, 0x1e, 0x37, 0x3c, 0x2e,
-	0x46, 0x66, 0x2f, 0xe4, 0x21, 0xaf, 0x59, 0x52, 0x9d, 0x54, 0xa1, 0xf9, 0x3b, 0xe4, 0x3c, 0x8c,
-	0x28, 0xf1, 0x53, 0x46, 0xfc, 0x24, 0xe1, 0xd2, 0x97, 0x8c, 0x27, 0xa2, 0x61, 0xfb, 0x0d, 0x5b,
-	0xa3, 0x69, 0x7e, 0x4c, 0x24, 0x8b, 0xa9, 0x90, 0x7e, 0x9c, 0x36, 0x05, 0xbf, 0x02, 0x2e, 0x62,
-	0x2e, 0x8e, 0x94, 0xae, 0x02, 0x8a, 0x1a, 0xf4, 0x20, 0x9a, 0xe4, 0x34, 0x2b, 0xc7, 0x19, 0x0b,
-	0xa8, 0xf0, 0xe8, 0x69, 0x4e, 0x85, 0x1c, 0xdc, 0x03, 0xf8, 0xf3, 0xc5, 0xb5, 0x48, 0x79, 0x22,
-	0x28, 0x1a, 0x43, 0x3d, 0xad, 0x6f, 0x0c, 0x60, 0xb7, 0x87, 0x5d, 0xc7, 0xc1, 0x6f, 0x1c, 0xe0,
-	0x77, 0xfa, 0xb0, 0x82, 0x7b, 0x89, 0xcc, 0x4a, 0xf7, 0xcb, 0xe2, 0xba, 0xaf, 0x79, 0x8d, 0x0e,
-	0x72, 0x61, 0xe7, 0xf1, 0x6f, 0x8d, 0x96, 0x0d, 0x86, 0x5d, 0xc7, 0xc4, 0xca, 0x0f, 0xde, 0xf8,
-	0xc1, 0x07, 0x9b, 0x0a, 0xf7, 0x5b, 0xd5, 0x3c, 0xbf, 0xe9, 0x03, 0xef, 0xa9, 0xcd, 0xdc, 0x81,
-	0xdd, 0x67, 0x03, 0xd0, 0x77, 0xd8, 0x9e, 0xd1, 0xd2, 0x00, 0x36, 0x18, 0x76, 0xbc, 0xea, 0x88,
-	0x7a, 0xf0, 0x6b, 0xe1, 0x47, 0x39, 0xad, 0x07, 0x74, 0x3c, 0x05, 0x76, 0x5b, 0xdb, 0xc0, 0x39,
-	0x07, 0x50, 0xdf, 0xaf, 0x9f, 0x04, 0x95, 0x50, 0x57, 0x2a, 0xe8, 0xcf, 0x47, 0xae, 0xea, 0x25,
-	0x99, 0x7f, 0x3f, 0x67, 0x7e, 0x60, 0x9f, 0x5d, 0xde, 0x5d, 0xb4, 0x4c, 0x64, 0x90, 0x26, 0x0e,
-	0x2a, 0x03, 0x55, 0x1a, 0xd4, 0x12, 0xdc, 0xc9, 0x62, 0x65, 0x81, 0xe5, 0xca, 0x02, 0xb7, 0x2b,
-	0x0b, 0xcc, 0xd7, 0x96, 0xb6, 0x5c, 0x5b, 0xda, 0xd5, 0xda, 0xd2, 0x0e, 0xb7, 0x42, 0x26, 0x4f,
-	0xf2, 0x29, 0x0e, 0x78, 0x4c, 0xc4, 0x8c, 0xa5, 0xff, 0x62, 0x5a, 0x90, 0x57, 0xa9, 0xaa, 0xbe,
-	0x34, 0x13, 0x1b, 0x59, 0x59, 0xa6, 0x54, 0x4c, 0xf5, 0x7a, 0x79, 0xff, 0x1f, 0x02, 0x00, 0x00,
-	0xff, 0xff, 0x0e, 0xe7, 0x89, 0x62, 0x83, 0x02, 0x00, 0x00,
+	// 718 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcd, 0x4e, 0xdb, 0x4a,
+	0x14, 0x8e, 0x13, 0xc8, 0xcf, 0xe1, 0xde, 0xc0, 0x1d, 0xa2, 0x2b, 0x5f, 0x5f, 0x14, 0x52, 0x57,
+	0x94, 0x74, 0x81, 0x5d, 0x52, 0xa9, 0x7f, 0xcb, 0x54, 0x55, 0x57, 0x2d, 0x10, 0x58, 0x75, 0x93,
+	0x1a, 0x33, 0x09, 0xa3, 0xd8, 0x1e, 0xe3, 0x99, 0x44, 0x4a, 0x37, 0x95, 0x78, 0x02, 0x24, 0x76,
+	0x7d, 0x84, 0x3e, 0x09, 0x4b, 0xa4, 0x6e, 0xba, 0x6a, 0x2b, 0xa8, 0xfa, 0x1c, 0x95, 0x67, 0xc6,
+	0x21, 0x06, 0xa3, 0x46, 0x55, 0x57, 0xf1, 0x77, 0xfe, 0xe6, 0x9c, 0x6f, 0xe6, 0x7c, 0x81, 0x3a,
+	0xf3, 0x48, 0x30, 0x18, 0xdb, 0x0c, 0x47, 0x23, 0xe2, 0x62, 0x7b, 0xb4, 0x69, 0xd3, 0xc8, 0x71,
+	0x3d, 0x6c, 0x85, 0x11, 0xe5, 0x14, 0xfd, 0x23, 0xfd, 0x96, 0xf2, 0x5b, 0xa3, 0x4d, 0xa3, 0xd6,
+	0xa7, 0x7d, 0x2a, 0xbc, 0x76, 0xfc, 0x25, 0x03, 0x8d, 0x95, 0x3e, 0xa5, 0x7d, 0x0f, 0xdb, 0x4e,
+	0x48, 0x6c, 0x27, 0x08, 0x28, 0x77, 0x38, 0xa1, 0x01, 0x53, 0xde, 0x55, 0xe5, 0x15, 0x68, 0x7f,
+	0xd8, 0xb3, 0x39, 0xf1, 0x31, 0xe3, 0x8e, 0x1f, 0xaa, 0x80, 0xff, 0x5c, 0xca, 0x7c, 0xca, 0xba,
+	0xb2, 0xae, 0x04, 0xd2, 0x65, 0xd6, 0x00, 0xed, 0x0c, 0x71, 0x34, 0xde, 0x8e, 0x88, 0x8b, 0x59,
+	0x07, 0x1f, 0x0d, 0x31, 0xe3, 0xa6, 0x0d, 0xcb, 0xbb, 0x3c, 0xc2, 0x8e, 0x9f, 0x32, 0x23, 0x1d,
+	0x4a, 0x9c, 0xb8, 0x03, 0x1c, 0x31, 0x5d, 0x6b, 0x14, 0x9a, 0x95, 0x4e, 0x02, 0xcd, 0x1f, 0x1a,
+	0x2c, 0xa7, 0xea, 0xb0, 0x90, 0x06, 0x0c, 0xa3, 0x6d, 0x28, 0x86, 0xc2, 0x22, 0x12, 0x16, 0x5a,
+	0x2d, 0xeb, 0xc6, 0xc8, 0x56, 0x46, 0x9e, 0x25, 0xe1, 0x8b, 0x80, 0x47, 0xe3, 0xf6, 0xdc, 0xd9,
+	0x97, 0xd5, 0x5c, 0x47, 0xd5, 0x41, 0x6d, 0xa8, 0x4c, 0xc6, 0xd3, 0xf3, 0x0d, 0xad, 0xb9, 0xd0,
+	0x32, 0x2c, 0x49, 0x80, 0x95, 0x10, 0x60, 0xed, 0x25, 0x11, 0xed, 0x72, 0x9c, 0x7c, 0xf2, 0x75,
+	0x55, 0xeb, 0x5c, 0xa5, 0x19, 0x4f, 0x61, 0x61, 0xea, 0x00, 0xb4, 0x04, 0x85, 0x01, 0x1e, 0xeb,
+	0x5a, 0x43, 0x6b, 0x56, 0x3a, 0xf1, 0x27, 0xaa, 0xc1, 0xfc, 0xc8, 0xf1, 0x86, 0x58, 0x1c, 0x50,
+	0xe9, 0x48, 0xf0, 0x2c, 0xff, 0x44, 0x33, 0x1f, 0x81, 0xa1, 0xfa, 0xa5, 0x23, 0x72, 0x80, 0xa3,
+	0x59, 0x09, 0xfa, 0xa8, 0xc1, 0xff, 0x99, 0x89, 0x8a, 0xa8, 0x97, 0x50, 0xf2, 0x9d, 0x68, 0x80,
+	0x79, 0xc2, 0xd4, 0x7a, 0x06, 0x53, 0xaf, 0x44, 0x44, 0xba, 0x82, 0xa2, 0x27, 0xc9, 0xfe, 0x13,
+	0xfc, 0x98, 0xef, 0xa1, 0x96, 0x75, 0x14, 0xfa, 0x17, 0x8a, 0x72, 0x1e, 0xc5, 0x95, 0x42, 0x68,
+	0x0b, 0x16, 0x43, 0x15, 0xd9, 0x55, 0xd7, 0x9d, 0x17, 0x43, 0x34, 0x32, 0x86, 0x48, 0xd5, 0x54,
+	0xdd, 0x57, 0xc3, 0xd4, 0x41, 0xe6, 0x69, 0x01, 0xfe, 0x4e, 0xc5, 0x21, 0x03, 0xca, 0x49, 0x8c,
+	0x3a, 0x7c, 0x82, 0x51, 0x13, 0x96, 0x68, 0xaf, 0xd7, 0x75, 0x0f, 0x1d, 0x12, 0x74, 0x55, 0x83,
+	0xf2, 0xe2, 0xaa, 0xb4, 0xd7, 0x7b, 0x1e, 0x9b, 0xf7, 0x64, 0xa3, 0x35, 0x98, 0x17, 0xfd, 0xe9,
+	0x05, 0x79, 0xaf, 0x02, 0xa4, 0x29, 0x9b, 0xfb, 0x2d, 0xca, 0xe2, 0xca, 0x8c, 0x3b, 0x1e, 0xd6,
+	0xe7, 0x1b, 0x5a, 0xb3, 0xdc, 0x91, 0x20, 0x26, 0x8c, 0x04, 0x23, 0x1c, 0x71, 0xbd, 0x28, 0xcc,
+	0x0a, 0xa1, 0x5d, 0xa8, 0x06, 0x34, 0xf2, 0x1d, 0x8f, 0xbc, 0x93, 0x9b, 0xac, 0x97, 0x04, 0x5f,
+	0x6b, 0x19, 0x7c, 0xbd, 0x9e, 0x0e, 0x4c, 0x91, 0x96, 0x2e, 0x81, 0xd6, 0x61, 0xd1, 0xa5, 0xa2,
+	0x3e, 0x3e, 0x90, 0xd7, 0xa0, 0x97, 0x25, 0x0b, 0x13, 0xb3, 0xe4, 0x52, 0x87, 0x12, 0x1d, 0x72,
+	0x8f, 0xe0, 0x48, 0xaf, 0x88, 0xb6, 0x12, 0x88, 0x56, 0xa0, 0xe2, 0x91, 0xa3, 0x21, 0x39, 0x20,
+	0x7c, 0xac, 0x83, 0x48, 0xbe, 0x32, 0x98, 0x6d, 0x40, 0x37, 0x9b, 0xb9, 0xf5, 0x51, 0x4c, 0xb8,
+	0xce, 0x4f, 0x71, 0xdd, 0x3a, 0x2e, 0x40, 0x71, 0x4b, 0x68, 0x20, 0x1a, 0x43, 0x51, 0xbd, 0xab,
+	0xb5, 0x5f, 0xa9, 0x82, 0xd8, 0x2e, 0xe3, 0xde, 0x6c, 0xe2, 0x61, 0x36, 0x8e, 0x3f, 0x7d, 0x3f,
+	0xcd, 0x1b, 0x48, 0xb7, 0x95, 0xfe, 0x4a, 0xd1, 0x8d, 0xe5, 0x57, 0x89, 0xc8, 0x5b, 0xf8, 0x6b,
+	0x5a, 0xdf, 0x50, 0x56, 0xe5, 0x0c, 0x01, 0x9c, 0xb5, 0x83, 0x07, 0x1a, 0xfa, 0xa0, 0x41, 0xf5,
+	0xda, 0xf6, 0x6c, 0xdc, 0x9e, 0x9c, 0xa1, 0x25, 0x86, 0x35, 0x6b, 0xb8, 0x9a, 0xfa, 0xbe, 0x98,
+	0xfa, 0x2e, 0xba, 0x93, 0x35, 0x75, 0x6a, 0x39, 0xdb, 0x3b, 0x67, 0x17, 0x75, 0xed, 0xfc, 0xa2,
+	0xae, 0x7d, 0xbb, 0xa8, 0x6b, 0x27, 0x97, 0xf5, 0xdc, 0xf9, 0x65, 0x3d, 0xf7, 0xf9, 0xb2, 0x9e,
+	0x7b, 0xf3, 0xb8, 0x4f, 0xf8, 0xe1, 0x70, 0xdf, 0x72, 0xa9, 0x6f, 0xb3, 0x01, 0x09, 0x37, 0x7c,
+	0x3c, 0xb2, 0xaf, 0xfd, 0x8b, 0xc5, 0xbf, 0x38, 0x62, 0x49, 0x7d, 0x3e, 0x0e, 0x31, 0xdb, 0x2f,
+	0x8a, 0x45, 0x79, 0xf8, 0x73, 0x00, 0x64, 0xd8, 0xab, 0xbe, 0xf3, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -176,6 +598,15 @@ const _ = grpc.SupportPackageIsVersion4
 type OracleClient interface {
 	// Prices defines a method for fetching the latest prices.
 	Prices(ctx context.Context, in *QueryPricesRequest, opts ...grpc.CallOption) (*QueryPricesResponse, error)
+	// StreamPrices defines a method for subscribing to the prices aggregated by
+	// the oracle. A response is pushed after every oracle update. Over HTTP, the
+	// stream is served as server-sent events at /slinky/oracle/v1/prices/stream.
+	StreamPrices(ctx context.Context, in *StreamPricesRequest, opts ...grpc.CallOption) (Oracle_StreamPricesClient, error)
+	// ProviderPrices defines a method for fetching the per-provider breakdown of
+	// the latest prices. This includes the raw price reported by each provider,
+	// the inputs used to convert the price to the market's ticker, and whether
+	// the price was dropped.
+	ProviderPrices(ctx context.Context, in *QueryProviderPricesRequest, opts ...grpc.CallOption) (*QueryProviderPricesResponse, error)
 }
 
 type oracleClient struct {
@@ -195,10 +626,60 @@ func (c *oracleClient) Prices(ctx context.Context, in *QueryPricesRequest, opts
 	return out, nil
 }
 
+func (c *oracleClient) StreamPrices(ctx context.Context, in *StreamPricesRequest, opts ...grpc.CallOption) (Oracle_StreamPricesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Oracle_serviceDesc.Streams[0], "/slinky.service.v1.Oracle/StreamPrices", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &oracleStreamPricesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Oracle_StreamPricesClient interface {
+	Recv() (*QueryPricesResponse, error)
+	grpc.ClientStream
+}
+
+type oracleStreamPricesClient struct {
+	grpc.ClientStream
+}
+
+func (x *oracleStreamPricesClient) Recv() (*QueryPricesResponse, error) {
+	m := new(QueryPricesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *oracleClient) ProviderPrices(ctx context.Context, in *QueryProviderPricesRequest, opts ...grpc.CallOption) (*QueryProviderPricesResponse, error) {
+	out := new(QueryProviderPricesResponse)
+	err := c.cc.Invoke(ctx, "/slinky.service.v1.Oracle/ProviderPrices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OracleServer is the server API for Oracle service.
 type OracleServer interface {
 	// Prices defines a method for fetching the latest prices.
 	Prices(context.Context, *QueryPricesRequest) (*QueryPricesResponse, error)
+	// StreamPrices defines a method for subscribing to the prices aggregated by
+	// the oracle. A response is pushed after every oracle update. Over HTTP, the
+	// stream is served as server-sent events at /slinky/oracle/v1/prices/stream.
+	StreamPrices(*StreamPricesRequest, Oracle_StreamPricesServer) error
+	// ProviderPrices defines a method for fetching the per-provider breakdown of
+	// the latest prices. This includes the raw price reported by each provider,
+	// the inputs used to convert the price to the market's ticker, and whether
+	// the price was dropped.
+	ProviderPrices(context.Context, *QueryProviderPricesRequest) (*QueryProviderPricesResponse, error)
 }
 
 // UnimplementedOracleServer can be embedded to have forward compatible implementations.
@@ -208,6 +689,12 @@ type UnimplementedOracleServer struct {
 func (*UnimplementedOracleServer) Prices(ctx context.Context, req *QueryPricesRequest) (*QueryPricesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Prices not implemented")
 }
+func (*UnimplementedOracleServer) StreamPrices(req *StreamPricesRequest, srv Oracle_StreamPricesServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamPrices not implemented")
+}
+func (*UnimplementedOracleServer) ProviderPrices(ctx context.Context, req *QueryProviderPricesRequest) (*QueryProviderPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderPrices not implemented")
+}
 
 func RegisterOracleServer(s grpc1.Server, srv OracleServer) {
 	s.RegisterService(&_Oracle_serviceDesc, srv)
@@ -231,6 +718,45 @@ func _Oracle_Prices_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Oracle_StreamPrices_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamPricesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OracleServer).StreamPrices(m, &oracleStreamPricesServer{stream})
+}
+
+type Oracle_StreamPricesServer interface {
+	Send(*QueryPricesResponse) error
+	grpc.ServerStream
+}
+
+type oracleStreamPricesServer struct {
+	grpc.ServerStream
+}
+
+func (x *oracleStreamPricesServer) Send(m *QueryPricesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Oracle_ProviderPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProviderPricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OracleServer).ProviderPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/slinky.service.v1.Oracle/ProviderPrices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OracleServer).ProviderPrices(ctx, req.(*QueryProviderPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Oracle_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "slinky.service.v1.Oracle",
 	HandlerType: (*OracleServer)(nil),
@@ -239,8 +765,18 @@ var _Oracle_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Prices",
 			Handler:    _Oracle_Prices_Handler,
 		},
+		{
+			MethodName: "ProviderPrices",
+			Handler:    _Oracle_ProviderPrices_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamPrices",
+			Handler:       _Oracle_StreamPrices_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "slinky/service/v1/oracle.proto",
 }
 
@@ -267,6 +803,38 @@ func (m *QueryPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *StreamPricesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamPricesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tickers) > 0 {
+		for iNdEx := len(m.Tickers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tickers[iNdEx])
+			copy(dAtA[i:], m.Tickers[iNdEx])
+			i = encodeVarintOracle(dAtA, i, uint64(len(m.Tickers[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryPricesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -317,6 +885,274 @@ func (m *QueryPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryProviderPricesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderPricesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tickers) > 0 {
+		for iNdEx := len(m.Tickers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tickers[iNdEx])
+			copy(dAtA[i:], m.Tickers[iNdEx])
+			i = encodeVarintOracle(dAtA, i, uint64(len(m.Tickers[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProviderPricesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderPricesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintOracle(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Markets) > 0 {
+		for iNdEx := len(m.Markets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Markets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketProviderPrices) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketProviderPrices) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketProviderPrices) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProviderPrices) > 0 {
+		for iNdEx := len(m.ProviderPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProviderPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Ticker) > 0 {
+		i -= len(m.Ticker)
+		copy(dAtA[i:], m.Ticker)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Ticker)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProviderPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProviderPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProviderPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Liquidity) > 0 {
+		i -= len(m.Liquidity)
+		copy(dAtA[i:], m.Liquidity)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Liquidity)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Outlier {
+		i--
+		if m.Outlier {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.ConvertedPrice) > 0 {
+		i -= len(m.ConvertedPrice)
+		copy(dAtA[i:], m.ConvertedPrice)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.ConvertedPrice)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Normalizations) > 0 {
+		for iNdEx := len(m.Normalizations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Normalizations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.Invert {
+		i--
+		if m.Invert {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Stale {
+		i--
+		if m.Stale {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintOracle(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Price) > 0 {
+		i -= len(m.Price)
+		copy(dAtA[i:], m.Price)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Price)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OffChainTicker) > 0 {
+		i -= len(m.OffChainTicker)
+		copy(dAtA[i:], m.OffChainTicker)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.OffChainTicker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NormalizationPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NormalizationPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NormalizationPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Price) > 0 {
+		i -= len(m.Price)
+		copy(dAtA[i:], m.Price)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Price)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Ticker) > 0 {
+		i -= len(m.Ticker)
+		copy(dAtA[i:], m.Ticker)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Ticker)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintOracle(dAtA []byte, offset int, v uint64) int {
 	offset -= sovOracle(v)
 	base := offset
@@ -337,6 +1173,21 @@ func (m *QueryPricesRequest) Size() (n int) {
 	return n
 }
 
+func (m *StreamPricesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tickers) > 0 {
+		for _, s := range m.Tickers {
+			l = len(s)
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *QueryPricesResponse) Size() (n int) {
 	if m == nil {
 		return 0
@@ -356,6 +1207,120 @@ func (m *QueryPricesResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryProviderPricesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tickers) > 0 {
+		for _, s := range m.Tickers {
+			l = len(s)
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryProviderPricesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Markets) > 0 {
+		for _, e := range m.Markets {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *MarketProviderPrices) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Ticker)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if len(m.ProviderPrices) > 0 {
+		for _, e := range m.ProviderPrices {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProviderPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.OffChainTicker)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Price)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovOracle(uint64(l))
+	if m.Stale {
+		n += 2
+	}
+	if m.Invert {
+		n += 2
+	}
+	if len(m.Normalizations) > 0 {
+		for _, e := range m.Normalizations {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	l = len(m.ConvertedPrice)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.Outlier {
+		n += 2
+	}
+	l = len(m.Liquidity)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *NormalizationPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Ticker)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Price)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
 func sovOracle(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -412,7 +1377,7 @@ func (m *QueryPricesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPricesResponse) Unmarshal(dAtA []byte) error {
+func (m *StreamPricesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -435,17 +1400,17 @@ func (m *QueryPricesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPricesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: StreamPricesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StreamPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tickers", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -455,27 +1420,109 @@ func (m *QueryPricesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Prices == nil {
-				m.Prices = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
+			m.Tickers = append(m.Tickers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPricesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPricesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Prices == nil {
+				m.Prices = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
 				for shift := uint(0); ; shift += 7 {
@@ -622,6 +1669,772 @@ func (m *QueryPricesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryProviderPricesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProviderPricesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProviderPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tickers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tickers = append(m.Tickers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProviderPricesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProviderPricesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProviderPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Markets = append(m.Markets, MarketProviderPrices{})
+			if err := m.Markets[len(m.Markets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketProviderPrices) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketProviderPrices: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketProviderPrices: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ticker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ticker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderPrices = append(m.ProviderPrices, ProviderPrice{})
+			if err := m.ProviderPrices[len(m.ProviderPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProviderPrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProviderPrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProviderPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OffChainTicker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OffChainTicker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stale", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Stale = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Invert", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Invert = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Normalizations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Normalizations = append(m.Normalizations, NormalizationPrice{})
+			if err := m.Normalizations[len(m.Normalizations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConvertedPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConvertedPrice = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outlier", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Outlier = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Liquidity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NormalizationPrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NormalizationPrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NormalizationPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ticker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ticker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipOracle(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -364,6 +364,11 @@ func NewSimApp(
 			compression.NewDefaultExtendedCommitCodec(),
 			compression.NewZStdCompressor(),
 		),
+		// record the dispersion of validator prices + the voting power that reported them alongside each price
+		aggregator.WithPriceStatistics(voteweighted.StatisticsFromContext(
+			app.Logger(),
+			app.StakingKeeper,
+		)),
 	)
 
 	app.SetPreBlocker(oraclePreBlockHandler.PreBlocker())
@@ -24,6 +24,7 @@ func GetQueryCmd() *cobra.Command {
 		CmdQueryMarketMap(),
 		CmdQueryLastUpdated(),
 		CmdQueryMarket(),
+		CmdQueryPendingUpdates(),
 	)
 
 	return cmd
@@ -133,3 +134,28 @@ func CmdQueryLastUpdated() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+func CmdQueryPendingUpdates() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-updates",
+		Short: "Query the scheduled market map updates that have not yet been applied",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.PendingUpdates(cmd.Context(), &types.PendingUpdatesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
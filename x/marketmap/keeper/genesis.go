@@ -30,6 +30,22 @@ func (k *Keeper) InitGenesis(ctx sdk.Context, gs types.GenesisState) {
 		panic(err)
 	}
 
+	// restore the pending scheduled updates, continuing the id sequence after the largest id
+	var nextID uint64
+	for _, update := range gs.ScheduledUpdates {
+		if err := k.setScheduledUpdate(ctx, update); err != nil {
+			panic(err)
+		}
+
+		if update.Id >= nextID {
+			nextID = update.Id + 1
+		}
+	}
+
+	if err := k.scheduledUpdateID.Set(ctx, nextID); err != nil {
+		panic(err)
+	}
+
 	if err := k.hooks.AfterMarketGenesis(ctx, gs.MarketMap.Markets); err != nil {
 		panic(err)
 	}
@@ -52,11 +68,17 @@ func (k *Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		panic(err)
 	}
 
+	scheduledUpdates, err := k.GetPendingUpdates(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	return &types.GenesisState{
 		MarketMap: types.MarketMap{
 			Markets: markets,
 		},
-		LastUpdated: lastUpdated,
-		Params:      params,
+		LastUpdated:      lastUpdated,
+		Params:           params,
+		ScheduledUpdates: scheduledUpdates,
 	}
 }
@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+
+	"github.com/skip-mev/slinky/x/marketmap/keeper"
+	"github.com/skip-mev/slinky/x/marketmap/types"
+)
+
+// recordingHooks records the markets passed to the lifecycle hooks.
+type recordingHooks struct {
+	types.MultiMarketMapHooks
+
+	disabled []string
+	removed  []string
+}
+
+func (h *recordingHooks) AfterMarketDisabled(_ sdk.Context, market types.Market) error {
+	h.disabled = append(h.disabled, market.Ticker.String())
+	return nil
+}
+
+func (h *recordingHooks) AfterMarketRemoved(_ sdk.Context, market types.Market) error {
+	h.removed = append(h.removed, market.Ticker.String())
+	return nil
+}
+
+func (s *KeeperTestSuite) TestLifecycleHooks() {
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_hooks"))
+	k := keeper.NewKeeper(runtime.NewKVStoreService(key), moduletestutil.MakeTestEncodingConfig().Codec, s.authority)
+	s.Require().NoError(k.SetParams(ctx, types.Params{
+		MarketAuthorities: s.marketAuthorities,
+		Admin:             s.admin,
+	}))
+
+	hooks := &recordingHooks{}
+	k.SetHooks(hooks)
+
+	msgServer := keeper.NewMsgServer(k)
+	update := func(enabled bool) {
+		market := btcusdt
+		market.Ticker.Enabled = enabled
+
+		_, err := msgServer.UpdateMarkets(ctx, &types.MsgUpdateMarkets{
+			Authority:     s.marketAuthorities[0],
+			UpdateMarkets: []types.Market{market},
+		})
+		s.Require().NoError(err)
+	}
+
+	market := btcusdt
+	market.Ticker.Enabled = true
+	_, err := msgServer.CreateMarkets(ctx, &types.MsgCreateMarkets{
+		Authority:     s.marketAuthorities[0],
+		CreateMarkets: []types.Market{market},
+	})
+	s.Require().NoError(err)
+
+	// only updates from the enabled status run the disable hooks
+	update(true)
+	s.Require().Empty(hooks.disabled)
+
+	update(false)
+	s.Require().Equal([]string{btcusdt.Ticker.String()}, hooks.disabled)
+
+	update(false)
+	s.Require().Equal([]string{btcusdt.Ticker.String()}, hooks.disabled)
+
+	_, err = msgServer.RemoveMarkets(ctx, &types.MsgRemoveMarkets{
+		Authority: s.marketAuthorities[0],
+		Markets:   []string{btcusdt.Ticker.String()},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal([]string{btcusdt.Ticker.String()}, hooks.removed)
+}
+
+func (s *KeeperTestSuite) TestRemoveMarketAfterCurrencyPairRemoved() {
+	msgServer := keeper.NewMsgServer(s.keeper)
+
+	_, err := msgServer.CreateMarkets(s.ctx, &types.MsgCreateMarkets{
+		Authority:     s.marketAuthorities[0],
+		CreateMarkets: []types.Market{btcusdt},
+	})
+	s.Require().NoError(err)
+	s.Require().True(s.oracleKeeper.HasCurrencyPair(s.ctx, btcusdt.Ticker.CurrencyPair))
+
+	// the currency pair is removed from x/oracle directly before the market is removed
+	s.Require().NoError(s.oracleKeeper.RemoveCurrencyPair(s.ctx, btcusdt.Ticker.CurrencyPair))
+
+	_, err = msgServer.RemoveMarkets(s.ctx, &types.MsgRemoveMarkets{
+		Authority: s.marketAuthorities[0],
+		Markets:   []string{btcusdt.Ticker.String()},
+	})
+	s.Require().NoError(err)
+
+	_, err = s.keeper.GetMarket(s.ctx, btcusdt.Ticker.String())
+	s.Require().Error(err)
+	s.Require().False(s.oracleKeeper.HasCurrencyPair(s.ctx, btcusdt.Ticker.CurrencyPair))
+}
@@ -1,13 +1,17 @@
 package keeper
 
 import (
+	"errors"
 	"fmt"
+	"sort"
+	"strconv"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/store"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	slinkytypes "github.com/skip-mev/slinky/pkg/types"
 	"github.com/skip-mev/slinky/x/marketmap/types"
 )
 
@@ -30,6 +34,16 @@ type Keeper struct {
 
 	// params is the module's parameters.
 	params collections.Item[types.Params]
+
+	// scheduledUpdates is keyed by (activation height, id) and contains the
+	// pending scheduled updates of the market map.
+	scheduledUpdates collections.Map[collections.Pair[uint64, uint64], types.ScheduledUpdate]
+
+	// scheduledUpdateID is the sequence used to assign ids to scheduled updates.
+	scheduledUpdateID collections.Sequence
+
+	// scheduledUpdateHeights indexes the activation height of each pending scheduled update by its id.
+	scheduledUpdateHeights collections.Map[uint64, uint64]
 }
 
 // NewKeeper initializes the keeper and its backing stores.
@@ -50,6 +64,21 @@ func NewKeeper(ss store.KVStoreService, cdc codec.BinaryCodec, authority sdk.Acc
 		markets:     collections.NewMap(sb, types.MarketsPrefix, "markets", types.TickersCodec, codec.CollValue[types.Market](cdc)),
 		lastUpdated: collections.NewItem[uint64](sb, types.LastUpdatedPrefix, "last_updated", types.LastUpdatedCodec),
 		params:      params,
+		scheduledUpdates: collections.NewMap(
+			sb,
+			types.ScheduledUpdatesPrefix,
+			"scheduled_updates",
+			types.ScheduledUpdatesCodec,
+			codec.CollValue[types.ScheduledUpdate](cdc),
+		),
+		scheduledUpdateID: collections.NewSequence(sb, types.ScheduledUpdateIDPrefix, "scheduled_update_id"),
+		scheduledUpdateHeights: collections.NewMap(
+			sb,
+			types.ScheduledUpdateHeightsPrefix,
+			"scheduled_update_heights",
+			collections.Uint64Key,
+			collections.Uint64Value,
+		),
 	}
 }
 
@@ -118,6 +147,172 @@ func (k *Keeper) UpdateMarket(ctx sdk.Context, market types.Market) error {
 	return k.markets.Set(ctx, types.TickerString(market.Ticker.String()), market)
 }
 
+// DeleteMarket removes a Market from the store by its currency pair string ID.
+func (k *Keeper) DeleteMarket(ctx sdk.Context, tickerStr string) error {
+	// Check if Ticker exists
+	exists, err := k.markets.Has(ctx, types.TickerString(tickerStr))
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return types.NewMarketDoesNotExistsError(types.TickerString(tickerStr))
+	}
+
+	return k.markets.Remove(ctx, types.TickerString(tickerStr))
+}
+
+// createMarkets creates each of the given markets, running the AfterMarketCreated hooks and emitting a
+// create market event for each.
+func (k *Keeper) createMarkets(ctx sdk.Context, markets []types.Market) error {
+	for _, market := range markets {
+		if err := types.ValidateCreateStatus(market.Ticker.LifecycleStatus()); err != nil {
+			return err
+		}
+
+		if err := k.CreateMarket(ctx, market); err != nil {
+			return err
+		}
+
+		if err := k.hooks.AfterMarketCreated(ctx, market); err != nil {
+			return fmt.Errorf("unable to run create market hook: %w", err)
+		}
+
+		ctx.EventManager().EmitEvent(marketEvent(types.EventTypeCreateMarket, market))
+	}
+
+	return nil
+}
+
+// updateMarkets updates each of the given markets, running the AfterMarketUpdated hooks and emitting an
+// update market event for each. Markets that transition out of the enabled status additionally run the
+// AfterMarketDisabled hooks.
+func (k *Keeper) updateMarkets(ctx sdk.Context, markets []types.Market) error {
+	for _, market := range markets {
+		prev, err := k.GetMarket(ctx, market.Ticker.String())
+		if err != nil {
+			return fmt.Errorf("unable to update market: %w", marketNotFound(err, market.Ticker.String()))
+		}
+
+		from, to := prev.Ticker.LifecycleStatus(), market.Ticker.LifecycleStatus()
+		if err := types.ValidateStatusTransition(from, to); err != nil {
+			return fmt.Errorf("unable to update market %s: %w", market.Ticker.String(), err)
+		}
+
+		if err := k.UpdateMarket(ctx, market); err != nil {
+			return fmt.Errorf("unable to update market: %w", err)
+		}
+
+		if err := k.hooks.AfterMarketUpdated(ctx, market); err != nil {
+			return fmt.Errorf("unable to run update market hook: %w", err)
+		}
+
+		if from == types.MarketStatus_MARKET_STATUS_ENABLED && to != types.MarketStatus_MARKET_STATUS_ENABLED {
+			if err := k.hooks.AfterMarketDisabled(ctx, market); err != nil {
+				return fmt.Errorf("unable to run disable market hook: %w", err)
+			}
+		}
+
+		ctx.EventManager().EmitEvent(marketEvent(types.EventTypeUpdateMarket, market))
+	}
+
+	return nil
+}
+
+// removeMarkets removes each of the markets with the given ticker strings, running the AfterMarketRemoved hooks and
+// emitting a remove market event for each. Only proposed, disabled and deprecated markets can be removed, and no
+// remaining market may normalize its prices through a removed market. This applies to remaining markets of any
+// status, as the market map requires every normalization market to exist - including those of disabled markets.
+func (k *Keeper) removeMarkets(ctx sdk.Context, tickers []string) error {
+	var (
+		removed    = make([]types.Market, 0, len(tickers))
+		removedSet = make(map[string]struct{}, len(tickers))
+	)
+	for _, ticker := range tickers {
+		cp, err := slinkytypes.CurrencyPairFromString(ticker)
+		if err != nil {
+			return err
+		}
+
+		market, err := k.GetMarket(ctx, cp.String())
+		if err != nil {
+			return fmt.Errorf("unable to remove market: %w", marketNotFound(err, cp.String()))
+		}
+
+		if err := types.ValidateStatusTransition(market.Ticker.LifecycleStatus(), types.MarketStatus_MARKET_STATUS_REMOVED); err != nil {
+			return fmt.Errorf("unable to remove market %s: %w", cp.String(), err)
+		}
+
+		removed = append(removed, market)
+		removedSet[cp.String()] = struct{}{}
+	}
+
+	// verify that none of the remaining markets depend on the removed markets. The markets are checked in
+	// ticker order so that the returned error is deterministic.
+	all, err := k.GetAllMarkets(ctx)
+	if err != nil {
+		return err
+	}
+
+	remaining := make([]string, 0, len(all))
+	for ticker := range all {
+		if _, found := removedSet[ticker]; !found {
+			remaining = append(remaining, ticker)
+		}
+	}
+	sort.Strings(remaining)
+
+	for _, ticker := range remaining {
+		market := all[ticker]
+
+		for _, providerConfig := range market.ProviderConfigs {
+			for _, pair := range providerConfig.Normalizations() {
+				if _, found := removedSet[pair.String()]; found {
+					return fmt.Errorf("unable to remove market %s: market %s normalizes through it", pair.String(), market.Ticker.String())
+				}
+			}
+		}
+	}
+
+	for _, market := range removed {
+		if err := k.DeleteMarket(ctx, market.Ticker.String()); err != nil {
+			return err
+		}
+
+		if err := k.hooks.AfterMarketRemoved(ctx, market); err != nil {
+			return fmt.Errorf("unable to run remove market hook: %w", err)
+		}
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeRemoveMarket,
+			sdk.NewAttribute(types.AttributeKeyCurrencyPair, market.Ticker.String()),
+		))
+	}
+
+	return nil
+}
+
+// marketNotFound converts a not found error returned when getting the market with the given ticker string into a
+// MarketDoesNotExistsError. Any other error is returned as is.
+func marketNotFound(err error, tickerStr string) error {
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.NewMarketDoesNotExistsError(types.TickerString(tickerStr))
+	}
+
+	return err
+}
+
+// marketEvent returns an event of the given type describing the market.
+func marketEvent(eventType string, market types.Market) sdk.Event {
+	return sdk.NewEvent(
+		eventType,
+		sdk.NewAttribute(types.AttributeKeyCurrencyPair, market.Ticker.String()),
+		sdk.NewAttribute(types.AttributeKeyDecimals, strconv.FormatUint(market.Ticker.Decimals, 10)),
+		sdk.NewAttribute(types.AttributeKeyMinProviderCount, strconv.FormatUint(market.Ticker.MinProviderCount, 10)),
+		sdk.NewAttribute(types.AttributeKeyMetadata, market.Ticker.Metadata_JSON),
+		sdk.NewAttribute(types.AttributeKeyStatus, market.Ticker.LifecycleStatus().String()),
+	)
+}
+
 // SetParams sets the x/marketmap module's parameters.
 func (k *Keeper) SetParams(ctx sdk.Context, params types.Params) error {
 	return k.params.Set(ctx, params)
@@ -145,14 +340,14 @@ func (k *Keeper) ValidateState(ctx sdk.Context, updates []types.Market) error {
 func (k *Keeper) IsMarketValid(ctx sdk.Context, market types.Market) error {
 	// check that all markets already exist in the keeper store:
 	for _, providerConfig := range market.ProviderConfigs {
-		if providerConfig.NormalizeByPair != nil {
-			has, err := k.markets.Has(ctx, types.TickerString(providerConfig.NormalizeByPair.String()))
+		for _, pair := range providerConfig.Normalizations() {
+			has, err := k.markets.Has(ctx, types.TickerString(pair.String()))
 			if err != nil {
 				return err
 			}
 
 			if !has {
-				return fmt.Errorf("currency pair %s in provider config does not exist", providerConfig.NormalizeByPair.String())
+				return fmt.Errorf("currency pair %s in provider config does not exist", pair.String())
 			}
 		}
 	}
@@ -272,3 +272,23 @@ func (s *KeeperTestSuite) TestValidUpdate() {
 	s.Require().NoError(s.keeper.UpdateMarket(s.ctx, validMarket))
 	s.Require().NoError(s.keeper.ValidateState(s.ctx, []types.Market{validMarket}))
 }
+
+func (s *KeeperTestSuite) TestInvalidUpdateNormalizationPath() {
+	// create a valid market
+	s.Require().NoError(s.keeper.CreateMarket(s.ctx, btcusdt))
+	s.Require().NoError(s.keeper.CreateMarket(s.ctx, ethusdt))
+
+	// invalid market with an intermediate normalization pair not in state
+	invalidMarket := btcusdt
+	invalidMarket.ProviderConfigs = append(invalidMarket.ProviderConfigs, types.ProviderConfig{
+		Name:           "huobi",
+		OffChainTicker: "btc-usdt",
+		NormalizationPath: []slinkytypes.CurrencyPair{
+			ethusdt.Ticker.CurrencyPair,
+			{Base: "USDT", Quote: "invalid"},
+		},
+	})
+
+	s.Require().NoError(s.keeper.UpdateMarket(s.ctx, invalidMarket))
+	s.Require().Error(s.keeper.ValidateState(s.ctx, []types.Market{invalidMarket}))
+}
@@ -43,25 +43,8 @@ func (ms msgServer) CreateMarkets(goCtx context.Context, msg *types.MsgCreateMar
 	}
 
 	// create markets
-	for _, market := range msg.CreateMarkets {
-		err = ms.k.CreateMarket(ctx, market)
-		if err != nil {
-			return nil, err
-		}
-
-		err = ms.k.hooks.AfterMarketCreated(ctx, market)
-		if err != nil {
-			return nil, fmt.Errorf("unable to run create market hook: %w", err)
-		}
-
-		event := sdk.NewEvent(
-			types.EventTypeCreateMarket,
-			sdk.NewAttribute(types.AttributeKeyCurrencyPair, market.Ticker.String()),
-			sdk.NewAttribute(types.AttributeKeyDecimals, strconv.FormatUint(market.Ticker.Decimals, 10)),
-			sdk.NewAttribute(types.AttributeKeyMinProviderCount, strconv.FormatUint(market.Ticker.MinProviderCount, 10)),
-			sdk.NewAttribute(types.AttributeKeyMetadata, market.Ticker.Metadata_JSON),
-		)
-		ctx.EventManager().EmitEvent(event)
+	if err := ms.k.createMarkets(ctx, msg.CreateMarkets); err != nil {
+		return nil, err
 	}
 
 	// validate that the new state of the marketmap is valid
@@ -92,26 +75,8 @@ func (ms msgServer) UpdateMarkets(goCtx context.Context, msg *types.MsgUpdateMar
 		return nil, fmt.Errorf("request signer %s does not match module market authorities", msg.Authority)
 	}
 
-	for _, market := range msg.UpdateMarkets {
-		err = ms.k.UpdateMarket(ctx, market)
-		if err != nil {
-			return nil, fmt.Errorf("unable to update market: %w", err)
-		}
-
-		err = ms.k.hooks.AfterMarketUpdated(ctx, market)
-		if err != nil {
-			return nil, fmt.Errorf("unable to run update market hook: %w", err)
-		}
-
-		event := sdk.NewEvent(
-			types.EventTypeUpdateMarket,
-			sdk.NewAttribute(types.AttributeKeyCurrencyPair, market.Ticker.String()),
-			sdk.NewAttribute(types.AttributeKeyDecimals, strconv.FormatUint(market.Ticker.Decimals, 10)),
-			sdk.NewAttribute(types.AttributeKeyMinProviderCount, strconv.FormatUint(market.Ticker.MinProviderCount, 10)),
-			sdk.NewAttribute(types.AttributeKeyMetadata, market.Ticker.Metadata_JSON),
-		)
-		ctx.EventManager().EmitEvent(event)
-
+	if err := ms.k.updateMarkets(ctx, msg.UpdateMarkets); err != nil {
+		return nil, err
 	}
 
 	// validate that the new state of the marketmap is valid
@@ -123,6 +88,32 @@ func (ms msgServer) UpdateMarkets(goCtx context.Context, msg *types.MsgUpdateMar
 	return &types.MsgUpdateMarketsResponse{}, ms.k.SetLastUpdated(ctx, uint64(ctx.BlockHeight()))
 }
 
+// RemoveMarkets removes markets from the marketmap. Markets must be proposed, disabled or deprecated to be removed,
+// and no remaining market may normalize through a removed market.
+func (ms msgServer) RemoveMarkets(goCtx context.Context, msg *types.MsgRemoveMarkets) (*types.MsgRemoveMarketsResponse, error) {
+	if msg == nil {
+		return nil, fmt.Errorf("unable to process nil msg")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params, err := ms.k.GetParams(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get marketmap params: %w", err)
+	}
+
+	found := checkMarketAuthority(msg.Authority, params)
+	if !found {
+		return nil, fmt.Errorf("request signer %s does not match module market authorities", msg.Authority)
+	}
+
+	if err := ms.k.removeMarkets(ctx, msg.Markets); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveMarketsResponse{}, ms.k.SetLastUpdated(ctx, uint64(ctx.BlockHeight()))
+}
+
 // UpdateParams updates the x/marketmap module's Params.
 func (ms msgServer) UpdateParams(goCtx context.Context, msg *types.MsgParams) (*types.MsgParamsResponse, error) {
 	if msg == nil {
@@ -180,6 +171,100 @@ func (ms msgServer) RemoveMarketAuthorities(goCtx context.Context, msg *types.Ms
 	return &types.MsgRemoveMarketAuthoritiesResponse{}, nil
 }
 
+// ScheduleMarketUpdates schedules the markets in the given message to be created and updated at the beginning of
+// the block at the message's activation height. The resulting state is validated when the update is applied.
+func (ms msgServer) ScheduleMarketUpdates(goCtx context.Context, msg *types.MsgScheduleMarketUpdates) (*types.MsgScheduleMarketUpdatesResponse, error) {
+	if msg == nil {
+		return nil, fmt.Errorf("unable to process nil msg")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params, err := ms.k.GetParams(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get marketmap params: %w", err)
+	}
+
+	found := checkMarketAuthority(msg.Authority, params)
+	if !found {
+		return nil, fmt.Errorf("request signer %s does not match module market authorities", msg.Authority)
+	}
+
+	if msg.ActivationHeight <= uint64(ctx.BlockHeight()) {
+		return nil, fmt.Errorf("activation height %d must be greater than the current height %d", msg.ActivationHeight, ctx.BlockHeight())
+	}
+
+	if params.MaxPendingUpdates > 0 {
+		pending, err := ms.k.GetPendingUpdateCount(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("unable to get pending update count: %w", err)
+		}
+
+		if pending >= params.MaxPendingUpdates {
+			return nil, fmt.Errorf("cannot schedule more than %d pending updates", params.MaxPendingUpdates)
+		}
+	}
+
+	update := types.ScheduledUpdate{
+		Authority:        msg.Authority,
+		ActivationHeight: msg.ActivationHeight,
+		CreateMarkets:    msg.CreateMarkets,
+		UpdateMarkets:    msg.UpdateMarkets,
+	}
+	id, err := ms.k.ScheduleUpdate(ctx, update)
+	if err != nil {
+		return nil, fmt.Errorf("unable to schedule update: %w", err)
+	}
+
+	event := sdk.NewEvent(
+		types.EventTypeScheduleMarketUpdates,
+		sdk.NewAttribute(types.AttributeKeyScheduledUpdateID, strconv.FormatUint(id, 10)),
+		sdk.NewAttribute(types.AttributeKeyActivationHeight, strconv.FormatUint(msg.ActivationHeight, 10)),
+		sdk.NewAttribute(types.AttributeKeyAuthority, msg.Authority),
+	)
+	ctx.EventManager().EmitEvent(event)
+
+	return &types.MsgScheduleMarketUpdatesResponse{Id: id}, nil
+}
+
+// CancelScheduledUpdate removes a pending scheduled update. Only the authority that scheduled the update or the
+// module admin may cancel it.
+func (ms msgServer) CancelScheduledUpdate(goCtx context.Context, msg *types.MsgCancelScheduledUpdate) (*types.MsgCancelScheduledUpdateResponse, error) {
+	if msg == nil {
+		return nil, fmt.Errorf("unable to process nil msg")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params, err := ms.k.GetParams(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get marketmap params: %w", err)
+	}
+
+	update, err := ms.k.GetScheduledUpdate(ctx, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Authority != update.Authority && msg.Authority != params.Admin {
+		return nil, fmt.Errorf("request signer %s is not the scheduling authority or the module admin", msg.Authority)
+	}
+
+	if err := ms.k.RemoveScheduledUpdate(ctx, update); err != nil {
+		return nil, err
+	}
+
+	event := sdk.NewEvent(
+		types.EventTypeCancelScheduledUpdate,
+		sdk.NewAttribute(types.AttributeKeyScheduledUpdateID, strconv.FormatUint(update.Id, 10)),
+		sdk.NewAttribute(types.AttributeKeyActivationHeight, strconv.FormatUint(update.ActivationHeight, 10)),
+		sdk.NewAttribute(types.AttributeKeyAuthority, msg.Authority),
+	)
+	ctx.EventManager().EmitEvent(event)
+
+	return &types.MsgCancelScheduledUpdateResponse{}, nil
+}
+
 // checkMarketAuthority checks if the given authority is the x/marketmap's list of MarketAuthorities.
 func checkMarketAuthority(authority string, params types.Params) bool {
 	if len(params.MarketAuthorities) == 0 {
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -329,3 +331,186 @@ func (s *KeeperTestSuite) TestMsgServerRemoveMarketAuthorities() {
 		}))
 	})
 }
+
+func (s *KeeperTestSuite) TestMsgServerRemoveMarkets() {
+	msgServer := keeper.NewMsgServer(s.keeper)
+
+	enabled := func(market types.Market) types.Market {
+		market.Ticker.Enabled = true
+		market.Ticker.Status = types.MarketStatus_MARKET_STATUS_ENABLED
+		return market
+	}
+
+	// usdtusd is used to normalize btcusdt
+	normalizedBtcusdt := btcusdt
+	normalizedBtcusdt.ProviderConfigs = []types.ProviderConfig{
+		{
+			Name:            "kucoin",
+			OffChainTicker:  "btc-usdt",
+			NormalizeByPair: &usdtusd.Ticker.CurrencyPair,
+		},
+	}
+
+	msg := &types.MsgCreateMarkets{
+		Authority:     s.marketAuthorities[0],
+		CreateMarkets: []types.Market{usdtusd, normalizedBtcusdt, enabled(ethusdt)},
+	}
+	_, err := msgServer.CreateMarkets(s.ctx, msg)
+	s.Require().NoError(err)
+
+	s.Run("unable to process nil request", func() {
+		resp, err := msgServer.RemoveMarkets(s.ctx, nil)
+		s.Require().Error(err)
+		s.Require().Nil(resp)
+	})
+
+	s.Run("unable to process for invalid authority", func() {
+		resp, err := msgServer.RemoveMarkets(s.ctx, &types.MsgRemoveMarkets{
+			Authority: sample.Address(r),
+			Markets:   []string{usdtusd.Ticker.String()},
+		})
+		s.Require().Error(err)
+		s.Require().Nil(resp)
+	})
+
+	s.Run("unable to remove a market that does not exist", func() {
+		resp, err := msgServer.RemoveMarkets(s.ctx, &types.MsgRemoveMarkets{
+			Authority: s.marketAuthorities[0],
+			Markets:   []string{usdcusd.Ticker.String()},
+		})
+		s.Require().ErrorIs(err, types.NewMarketDoesNotExistsError(types.TickerString(usdcusd.Ticker.String())))
+		s.Require().Nil(resp)
+	})
+
+	s.Run("unable to remove an enabled market", func() {
+		resp, err := msgServer.RemoveMarkets(s.ctx, &types.MsgRemoveMarkets{
+			Authority: s.marketAuthorities[0],
+			Markets:   []string{ethusdt.Ticker.String()},
+		})
+		s.Require().Error(err)
+		s.Require().Nil(resp)
+	})
+
+	s.Run("unable to remove a market that a disabled market normalizes through", func() {
+		s.Require().False(normalizedBtcusdt.Ticker.Enabled)
+
+		resp, err := msgServer.RemoveMarkets(s.ctx, &types.MsgRemoveMarkets{
+			Authority: s.marketAuthorities[0],
+			Markets:   []string{usdtusd.Ticker.String()},
+		})
+		s.Require().EqualError(err, fmt.Sprintf(
+			"unable to remove market %s: market %s normalizes through it",
+			usdtusd.Ticker.String(),
+			normalizedBtcusdt.Ticker.String(),
+		))
+		s.Require().Nil(resp)
+	})
+
+	s.Run("reports the first dependent market in ticker order", func() {
+		ctx, _ := s.ctx.CacheContext()
+
+		// usdcusd is also normalized by usdtusd and is ordered after btcusdt
+		normalizedUsdcusd := usdcusd
+		normalizedUsdcusd.ProviderConfigs = []types.ProviderConfig{
+			{
+				Name:            "kucoin",
+				OffChainTicker:  "usdc-usdt",
+				NormalizeByPair: &usdtusd.Ticker.CurrencyPair,
+			},
+		}
+		_, err := msgServer.CreateMarkets(ctx, &types.MsgCreateMarkets{
+			Authority:     s.marketAuthorities[0],
+			CreateMarkets: []types.Market{normalizedUsdcusd},
+		})
+		s.Require().NoError(err)
+
+		for i := 0; i < 10; i++ {
+			_, err = msgServer.RemoveMarkets(ctx, &types.MsgRemoveMarkets{
+				Authority: s.marketAuthorities[0],
+				Markets:   []string{usdtusd.Ticker.String()},
+			})
+			s.Require().ErrorContains(err, fmt.Sprintf("market %s normalizes through it", normalizedBtcusdt.Ticker.String()))
+		}
+	})
+
+	s.Run("removes markets and their currency pairs from x/oracle", func() {
+		ctx := s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 1)
+
+		resp, err := msgServer.RemoveMarkets(ctx, &types.MsgRemoveMarkets{
+			Authority: s.marketAuthorities[0],
+			Markets:   []string{usdtusd.Ticker.String(), normalizedBtcusdt.Ticker.String()},
+		})
+		s.Require().NoError(err)
+		s.Require().NotNil(resp)
+
+		got, err := s.keeper.GetAllMarkets(ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(map[string]types.Market{ethusdt.Ticker.String(): enabled(ethusdt)}, got)
+
+		cps := s.oracleKeeper.GetAllCurrencyPairs(ctx)
+		s.Require().Equal([]slinkytypes.CurrencyPair{ethusdt.Ticker.CurrencyPair}, cps)
+
+		lastUpdated, err := s.keeper.GetLastUpdated(ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(ctx.BlockHeight()), lastUpdated)
+	})
+}
+
+func (s *KeeperTestSuite) TestMsgServerMarketLifecycle() {
+	msgServer := keeper.NewMsgServer(s.keeper)
+
+	withStatus := func(market types.Market, status types.MarketStatus) types.Market {
+		market.Ticker.Status = status
+		market.Ticker.Enabled = status == types.MarketStatus_MARKET_STATUS_ENABLED
+		return market
+	}
+
+	s.Run("unable to create a deprecated market", func() {
+		resp, err := msgServer.CreateMarkets(s.ctx, &types.MsgCreateMarkets{
+			Authority:     s.marketAuthorities[0],
+			CreateMarkets: []types.Market{withStatus(btcusdt, types.MarketStatus_MARKET_STATUS_DEPRECATED)},
+		})
+		s.Require().Error(err)
+		s.Require().Nil(resp)
+	})
+
+	s.Run("market moves through its lifecycle", func() {
+		_, err := msgServer.CreateMarkets(s.ctx, &types.MsgCreateMarkets{
+			Authority:     s.marketAuthorities[0],
+			CreateMarkets: []types.Market{withStatus(btcusdt, types.MarketStatus_MARKET_STATUS_PROPOSED)},
+		})
+		s.Require().NoError(err)
+
+		for _, status := range []types.MarketStatus{
+			types.MarketStatus_MARKET_STATUS_ENABLED,
+			types.MarketStatus_MARKET_STATUS_DISABLED,
+			types.MarketStatus_MARKET_STATUS_DEPRECATED,
+		} {
+			_, err = msgServer.UpdateMarkets(s.ctx, &types.MsgUpdateMarkets{
+				Authority:     s.marketAuthorities[0],
+				UpdateMarkets: []types.Market{withStatus(btcusdt, status)},
+			})
+			s.Require().NoError(err)
+
+			got, err := s.keeper.GetMarket(s.ctx, btcusdt.Ticker.String())
+			s.Require().NoError(err)
+			s.Require().Equal(status, got.Ticker.LifecycleStatus())
+		}
+
+		// deprecated markets cannot be enabled again
+		_, err = msgServer.UpdateMarkets(s.ctx, &types.MsgUpdateMarkets{
+			Authority:     s.marketAuthorities[0],
+			UpdateMarkets: []types.Market{withStatus(btcusdt, types.MarketStatus_MARKET_STATUS_ENABLED)},
+		})
+		s.Require().Error(err)
+
+		_, err = msgServer.RemoveMarkets(s.ctx, &types.MsgRemoveMarkets{
+			Authority: s.marketAuthorities[0],
+			Markets:   []string{btcusdt.Ticker.String()},
+		})
+		s.Require().NoError(err)
+
+		_, err = s.keeper.GetMarket(s.ctx, btcusdt.Ticker.String())
+		s.Require().Error(err)
+	})
+}
@@ -98,3 +98,20 @@ func (q queryServerImpl) Params(goCtx context.Context, req *types.ParamsRequest)
 
 	return &types.ParamsResponse{Params: params}, nil
 }
+
+// PendingUpdates returns the scheduled updates stored in the x/marketmap module that have not yet been applied.
+func (q queryServerImpl) PendingUpdates(goCtx context.Context, req *types.PendingUpdatesRequest) (*types.PendingUpdatesResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	// unwrap the context
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	updates, err := q.k.GetPendingUpdates(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.PendingUpdatesResponse{Updates: updates}, nil
+}
@@ -0,0 +1,175 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/slinky/x/marketmap/types"
+)
+
+// ScheduleUpdate assigns the next scheduled update id to the given update and stores it to be applied at its
+// activation height. The assigned id is returned.
+func (k *Keeper) ScheduleUpdate(ctx sdk.Context, update types.ScheduledUpdate) (uint64, error) {
+	id, err := k.scheduledUpdateID.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	update.Id = id
+	return id, k.setScheduledUpdate(ctx, update)
+}
+
+// GetScheduledUpdate returns the pending scheduled update with the given id.
+func (k *Keeper) GetScheduledUpdate(ctx sdk.Context, id uint64) (types.ScheduledUpdate, error) {
+	height, err := k.scheduledUpdateHeights.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.ScheduledUpdate{}, fmt.Errorf("scheduled update %d does not exist", id)
+		}
+		return types.ScheduledUpdate{}, err
+	}
+
+	return k.scheduledUpdates.Get(ctx, collections.Join(height, id))
+}
+
+// GetPendingUpdateCount returns the number of pending scheduled updates.
+func (k *Keeper) GetPendingUpdateCount(ctx sdk.Context) (uint64, error) {
+	iter, err := k.scheduledUpdateHeights.Iterate(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer iter.Close()
+
+	var count uint64
+	for ; iter.Valid(); iter.Next() {
+		count++
+	}
+
+	return count, nil
+}
+
+// GetPendingUpdates returns all pending scheduled updates ordered by activation height, and then by id.
+func (k *Keeper) GetPendingUpdates(ctx sdk.Context) ([]types.ScheduledUpdate, error) {
+	iter, err := k.scheduledUpdates.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return iter.Values()
+}
+
+// RemoveScheduledUpdate removes the given scheduled update from the store.
+func (k *Keeper) RemoveScheduledUpdate(ctx sdk.Context, update types.ScheduledUpdate) error {
+	if err := k.scheduledUpdates.Remove(ctx, collections.Join(update.ActivationHeight, update.Id)); err != nil {
+		return err
+	}
+
+	return k.scheduledUpdateHeights.Remove(ctx, update.Id)
+}
+
+// ApplyScheduledUpdates applies all scheduled updates whose activation height is at or below the current block
+// height, in order of activation height and id. Each update is applied atomically: if any of its markets cannot be
+// created or updated, or the resulting state is invalid, none of its changes are written. Failed updates are
+// discarded and reported via an event rather than halting the chain. Updates whose authority is no longer a market
+// authority are discarded as well.
+func (k *Keeper) ApplyScheduledUpdates(ctx sdk.Context) error {
+	height := uint64(ctx.BlockHeight())
+
+	rng := new(collections.Range[collections.Pair[uint64, uint64]]).EndInclusive(collections.Join(height, uint64(math.MaxUint64)))
+	iter, err := k.scheduledUpdates.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+
+	due, err := iter.Values()
+	if err != nil {
+		return err
+	}
+
+	if len(due) == 0 {
+		return nil
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to get marketmap params: %w", err)
+	}
+
+	applied := false
+	for _, update := range due {
+		if err := k.RemoveScheduledUpdate(ctx, update); err != nil {
+			return err
+		}
+
+		applyErr := k.applyScheduledUpdate(ctx, update, params)
+		if applyErr != nil {
+			ctx.Logger().Error(
+				"failed to apply scheduled market map update",
+				"id", update.Id,
+				"authority", update.Authority,
+				"err", applyErr,
+			)
+		} else {
+			applied = true
+		}
+
+		attributes := []sdk.Attribute{
+			sdk.NewAttribute(types.AttributeKeyScheduledUpdateID, strconv.FormatUint(update.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyActivationHeight, strconv.FormatUint(update.ActivationHeight, 10)),
+			sdk.NewAttribute(types.AttributeKeyAuthority, update.Authority),
+		}
+		if applyErr != nil {
+			attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyError, applyErr.Error()))
+		}
+		ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeApplyScheduledUpdate, attributes...))
+	}
+
+	if !applied {
+		return nil
+	}
+
+	return k.SetLastUpdated(ctx, height)
+}
+
+// applyScheduledUpdate creates and updates the markets of the given scheduled update in a cached context, only
+// writing the changes if they all succeed and the resulting state is valid.
+func (k *Keeper) applyScheduledUpdate(ctx sdk.Context, update types.ScheduledUpdate, params types.Params) error {
+	if !checkMarketAuthority(update.Authority, params) {
+		return fmt.Errorf("authority %s is no longer a market authority", update.Authority)
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+
+	if err := k.createMarkets(cacheCtx, update.CreateMarkets); err != nil {
+		return err
+	}
+
+	if err := k.updateMarkets(cacheCtx, update.UpdateMarkets); err != nil {
+		return err
+	}
+
+	markets := make([]types.Market, 0, len(update.CreateMarkets)+len(update.UpdateMarkets))
+	markets = append(markets, update.CreateMarkets...)
+	markets = append(markets, update.UpdateMarkets...)
+	if err := k.ValidateState(cacheCtx, markets); err != nil {
+		return fmt.Errorf("invalid state resulting from update: %w", err)
+	}
+
+	write()
+	return nil
+}
+
+// setScheduledUpdate stores the given scheduled update keyed by its activation height and id, and indexes its
+// activation height by id.
+func (k *Keeper) setScheduledUpdate(ctx sdk.Context, update types.ScheduledUpdate) error {
+	if err := k.scheduledUpdates.Set(ctx, collections.Join(update.ActivationHeight, update.Id), update); err != nil {
+		return err
+	}
+
+	return k.scheduledUpdateHeights.Set(ctx, update.Id, update.ActivationHeight)
+}
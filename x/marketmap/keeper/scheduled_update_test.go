@@ -0,0 +1,404 @@
+package keeper_test
+
+import (
+	"github.com/skip-mev/chaintestutil/sample"
+
+	slinkytypes "github.com/skip-mev/slinky/pkg/types"
+	"github.com/skip-mev/slinky/x/marketmap/keeper"
+	"github.com/skip-mev/slinky/x/marketmap/types"
+)
+
+func (s *KeeperTestSuite) TestMsgServerScheduleMarketUpdates() {
+	msgServer := keeper.NewMsgServer(s.keeper)
+
+	s.Run("unable to process nil request", func() {
+		resp, err := msgServer.ScheduleMarketUpdates(s.ctx, nil)
+		s.Require().Error(err)
+		s.Require().Nil(resp)
+	})
+
+	s.Run("unable to process for invalid authority", func() {
+		msg := &types.MsgScheduleMarketUpdates{
+			Authority:        sample.Address(r),
+			ActivationHeight: uint64(s.ctx.BlockHeight()) + 1,
+			CreateMarkets:    []types.Market{btcusdt},
+		}
+		resp, err := msgServer.ScheduleMarketUpdates(s.ctx, msg)
+		s.Require().Error(err)
+		s.Require().Nil(resp)
+	})
+
+	s.Run("unable to schedule at the current height", func() {
+		msg := &types.MsgScheduleMarketUpdates{
+			Authority:        s.marketAuthorities[0],
+			ActivationHeight: uint64(s.ctx.BlockHeight()),
+			CreateMarkets:    []types.Market{btcusdt},
+		}
+		resp, err := msgServer.ScheduleMarketUpdates(s.ctx, msg)
+		s.Require().Error(err)
+		s.Require().Nil(resp)
+	})
+
+	s.Run("schedules updates with increasing ids", func() {
+		msg := &types.MsgScheduleMarketUpdates{
+			Authority:        s.marketAuthorities[0],
+			ActivationHeight: uint64(s.ctx.BlockHeight()) + 2,
+			CreateMarkets:    []types.Market{btcusdt},
+		}
+		resp, err := msgServer.ScheduleMarketUpdates(s.ctx, msg)
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(0), resp.Id)
+
+		msg = &types.MsgScheduleMarketUpdates{
+			Authority:        s.marketAuthorities[1],
+			ActivationHeight: uint64(s.ctx.BlockHeight()) + 1,
+			CreateMarkets:    []types.Market{usdtusd},
+		}
+		resp, err = msgServer.ScheduleMarketUpdates(s.ctx, msg)
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(1), resp.Id)
+
+		// the markets are not created until the activation height
+		_, err = s.keeper.GetMarket(s.ctx, btcusdt.Ticker.String())
+		s.Require().Error(err)
+
+		// pending updates are ordered by activation height
+		pending, err := s.keeper.GetPendingUpdates(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Len(pending, 2)
+		s.Require().Equal(uint64(1), pending[0].Id)
+		s.Require().Equal(uint64(0), pending[1].Id)
+	})
+
+	s.Run("unable to schedule more than the max pending updates", func() {
+		params, err := s.keeper.GetParams(s.ctx)
+		s.Require().NoError(err)
+		params.MaxPendingUpdates = 2
+		s.Require().NoError(s.keeper.SetParams(s.ctx, params))
+
+		count, err := s.keeper.GetPendingUpdateCount(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(2), count)
+
+		msg := &types.MsgScheduleMarketUpdates{
+			Authority:        s.marketAuthorities[0],
+			ActivationHeight: uint64(s.ctx.BlockHeight()) + 1,
+			CreateMarkets:    []types.Market{ethusdt},
+		}
+		resp, err := msgServer.ScheduleMarketUpdates(s.ctx, msg)
+		s.Require().Error(err)
+		s.Require().Nil(resp)
+
+		// cancelling an update frees up a slot
+		_, err = msgServer.CancelScheduledUpdate(s.ctx, &types.MsgCancelScheduledUpdate{
+			Authority: s.marketAuthorities[0],
+			Id:        0,
+		})
+		s.Require().NoError(err)
+
+		resp, err = msgServer.ScheduleMarketUpdates(s.ctx, msg)
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(2), resp.Id)
+	})
+}
+
+func (s *KeeperTestSuite) TestMsgServerCancelScheduledUpdate() {
+	msgServer := keeper.NewMsgServer(s.keeper)
+
+	schedule := func(authority string) uint64 {
+		resp, err := msgServer.ScheduleMarketUpdates(s.ctx, &types.MsgScheduleMarketUpdates{
+			Authority:        authority,
+			ActivationHeight: uint64(s.ctx.BlockHeight()) + 1,
+			CreateMarkets:    []types.Market{btcusdt},
+		})
+		s.Require().NoError(err)
+		return resp.Id
+	}
+
+	s.Run("unable to process nil request", func() {
+		resp, err := msgServer.CancelScheduledUpdate(s.ctx, nil)
+		s.Require().Error(err)
+		s.Require().Nil(resp)
+	})
+
+	s.Run("unable to cancel an update that does not exist", func() {
+		resp, err := msgServer.CancelScheduledUpdate(s.ctx, &types.MsgCancelScheduledUpdate{
+			Authority: s.marketAuthorities[0],
+			Id:        100,
+		})
+		s.Require().Error(err)
+		s.Require().Nil(resp)
+	})
+
+	s.Run("unable to cancel an update scheduled by another authority", func() {
+		id := schedule(s.marketAuthorities[0])
+
+		resp, err := msgServer.CancelScheduledUpdate(s.ctx, &types.MsgCancelScheduledUpdate{
+			Authority: s.marketAuthorities[1],
+			Id:        id,
+		})
+		s.Require().Error(err)
+		s.Require().Nil(resp)
+
+		_, err = s.keeper.GetScheduledUpdate(s.ctx, id)
+		s.Require().NoError(err)
+	})
+
+	s.Run("scheduling authority can cancel an update", func() {
+		id := schedule(s.marketAuthorities[0])
+
+		resp, err := msgServer.CancelScheduledUpdate(s.ctx, &types.MsgCancelScheduledUpdate{
+			Authority: s.marketAuthorities[0],
+			Id:        id,
+		})
+		s.Require().NoError(err)
+		s.Require().NotNil(resp)
+
+		_, err = s.keeper.GetScheduledUpdate(s.ctx, id)
+		s.Require().Error(err)
+	})
+
+	s.Run("admin can cancel an update", func() {
+		id := schedule(s.marketAuthorities[1])
+
+		resp, err := msgServer.CancelScheduledUpdate(s.ctx, &types.MsgCancelScheduledUpdate{
+			Authority: s.admin,
+			Id:        id,
+		})
+		s.Require().NoError(err)
+		s.Require().NotNil(resp)
+
+		_, err = s.keeper.GetScheduledUpdate(s.ctx, id)
+		s.Require().Error(err)
+	})
+}
+
+func (s *KeeperTestSuite) TestApplyScheduledUpdates() {
+	height := uint64(s.ctx.BlockHeight())
+
+	updatedBtcusdt := btcusdt
+	updatedBtcusdt.ProviderConfigs = append([]types.ProviderConfig{}, btcusdt.ProviderConfigs...)
+	updatedBtcusdt.ProviderConfigs = append(updatedBtcusdt.ProviderConfigs, types.ProviderConfig{
+		Name:            "huobi",
+		OffChainTicker:  "btc-usdt",
+		NormalizeByPair: &usdtusd.Ticker.CurrencyPair,
+	})
+
+	updates := []types.ScheduledUpdate{
+		{
+			Authority:        s.marketAuthorities[0],
+			ActivationHeight: height + 1,
+			CreateMarkets:    []types.Market{btcusdt, usdtusd},
+		},
+		{
+			Authority:        s.marketAuthorities[1],
+			ActivationHeight: height + 2,
+			UpdateMarkets:    []types.Market{updatedBtcusdt},
+		},
+		{
+			// updates a market that does not exist
+			Authority:        s.marketAuthorities[1],
+			ActivationHeight: height + 2,
+			UpdateMarkets:    []types.Market{ethusdt},
+		},
+		{
+			// creates a market normalized by a market that does not exist
+			Authority:        s.marketAuthorities[2],
+			ActivationHeight: height + 3,
+			CreateMarkets: []types.Market{
+				usdcusd,
+				{
+					Ticker: ethusdt.Ticker,
+					ProviderConfigs: []types.ProviderConfig{
+						{
+							Name:            "kucoin",
+							OffChainTicker:  "eth-usdt",
+							NormalizeByPair: &slinkytypes.CurrencyPair{Base: "invalid", Quote: "pair"},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, update := range updates {
+		_, err := s.keeper.ScheduleUpdate(s.ctx, update)
+		s.Require().NoError(err)
+	}
+
+	s.Run("no updates are applied before the activation height", func() {
+		s.Require().NoError(s.keeper.ApplyScheduledUpdates(s.ctx))
+
+		pending, err := s.keeper.GetPendingUpdates(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Len(pending, len(updates))
+	})
+
+	s.Run("markets are created at the activation height", func() {
+		ctx := s.ctx.WithBlockHeight(int64(height + 1))
+		s.Require().NoError(s.keeper.ApplyScheduledUpdates(ctx))
+
+		got, err := s.keeper.GetAllMarkets(ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(map[string]types.Market{
+			btcusdt.Ticker.String(): btcusdt,
+			usdtusd.Ticker.String(): usdtusd,
+		}, got)
+
+		// the hooks are run for the created markets
+		cps := s.oracleKeeper.GetAllCurrencyPairs(ctx)
+		s.Require().Equal([]slinkytypes.CurrencyPair{btcusdt.Ticker.CurrencyPair, usdtusd.Ticker.CurrencyPair}, cps)
+
+		lastUpdated, err := s.keeper.GetLastUpdated(ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(height+1, lastUpdated)
+
+		pending, err := s.keeper.GetPendingUpdates(ctx)
+		s.Require().NoError(err)
+		s.Require().Len(pending, len(updates)-1)
+	})
+
+	s.Run("failed updates are discarded without affecting other updates", func() {
+		ctx := s.ctx.WithBlockHeight(int64(height + 2))
+		s.Require().NoError(s.keeper.ApplyScheduledUpdates(ctx))
+
+		got, err := s.keeper.GetMarket(ctx, btcusdt.Ticker.String())
+		s.Require().NoError(err)
+		s.Require().Equal(updatedBtcusdt, got)
+
+		_, err = s.keeper.GetMarket(ctx, ethusdt.Ticker.String())
+		s.Require().Error(err)
+
+		pending, err := s.keeper.GetPendingUpdates(ctx)
+		s.Require().NoError(err)
+		s.Require().Len(pending, 1)
+
+		failed := 0
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type != types.EventTypeApplyScheduledUpdate {
+				continue
+			}
+
+			if _, found := event.GetAttribute(types.AttributeKeyError); found {
+				failed++
+			}
+		}
+		s.Require().Equal(1, failed)
+	})
+
+	s.Run("updates resulting in an invalid state are not partially applied", func() {
+		ctx := s.ctx.WithBlockHeight(int64(height + 3))
+		s.Require().NoError(s.keeper.ApplyScheduledUpdates(ctx))
+
+		_, err := s.keeper.GetMarket(ctx, usdcusd.Ticker.String())
+		s.Require().Error(err)
+
+		lastUpdated, err := s.keeper.GetLastUpdated(ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(height+2, lastUpdated)
+
+		pending, err := s.keeper.GetPendingUpdates(ctx)
+		s.Require().NoError(err)
+		s.Require().Empty(pending)
+
+		// applied updates are removed from the id index
+		count, err := s.keeper.GetPendingUpdateCount(ctx)
+		s.Require().NoError(err)
+		s.Require().Zero(count)
+	})
+}
+
+func (s *KeeperTestSuite) TestApplyScheduledUpdatesRemovedAuthority() {
+	height := uint64(s.ctx.BlockHeight())
+
+	_, err := s.keeper.ScheduleUpdate(s.ctx, types.ScheduledUpdate{
+		Authority:        s.marketAuthorities[0],
+		ActivationHeight: height + 1,
+		CreateMarkets:    []types.Market{btcusdt},
+	})
+	s.Require().NoError(err)
+
+	params, err := s.keeper.GetParams(s.ctx)
+	s.Require().NoError(err)
+	params.MarketAuthorities = s.marketAuthorities[1:]
+	s.Require().NoError(s.keeper.SetParams(s.ctx, params))
+
+	ctx := s.ctx.WithBlockHeight(int64(height + 1))
+	s.Require().NoError(s.keeper.ApplyScheduledUpdates(ctx))
+
+	_, err = s.keeper.GetMarket(ctx, btcusdt.Ticker.String())
+	s.Require().Error(err)
+
+	pending, err := s.keeper.GetPendingUpdates(ctx)
+	s.Require().NoError(err)
+	s.Require().Empty(pending)
+}
+
+func (s *KeeperTestSuite) TestPendingUpdates() {
+	qs := keeper.NewQueryServer(s.keeper)
+
+	s.Run("invalid for nil request", func() {
+		_, err := qs.PendingUpdates(s.ctx, nil)
+		s.Require().Error(err)
+	})
+
+	s.Run("run query with no state", func() {
+		resp, err := qs.PendingUpdates(s.ctx, &types.PendingUpdatesRequest{})
+		s.Require().NoError(err)
+		s.Require().Empty(resp.Updates)
+	})
+
+	s.Run("run query with state", func() {
+		update := types.ScheduledUpdate{
+			Authority:        s.marketAuthorities[0],
+			ActivationHeight: uint64(s.ctx.BlockHeight()) + 1,
+			CreateMarkets:    []types.Market{btcusdt},
+		}
+		id, err := s.keeper.ScheduleUpdate(s.ctx, update)
+		s.Require().NoError(err)
+		update.Id = id
+
+		resp, err := qs.PendingUpdates(s.ctx, &types.PendingUpdatesRequest{})
+		s.Require().NoError(err)
+		s.Require().Equal([]types.ScheduledUpdate{update}, resp.Updates)
+	})
+}
+
+func (s *KeeperTestSuite) TestInitExportGenesisScheduledUpdates() {
+	gs := types.DefaultGenesisState()
+	gs.ScheduledUpdates = []types.ScheduledUpdate{
+		{
+			Id:               3,
+			Authority:        s.marketAuthorities[0],
+			ActivationHeight: 20,
+			CreateMarkets:    []types.Market{btcusdt},
+		},
+		{
+			Id:               7,
+			Authority:        s.marketAuthorities[1],
+			ActivationHeight: 15,
+			UpdateMarkets:    []types.Market{usdtusd},
+		},
+	}
+
+	s.Require().NotPanics(func() {
+		s.keeper.InitGenesis(s.ctx, *gs)
+	})
+
+	// the exported updates are ordered by activation height
+	got := s.keeper.ExportGenesis(s.ctx)
+	s.Require().Equal([]types.ScheduledUpdate{gs.ScheduledUpdates[1], gs.ScheduledUpdates[0]}, got.ScheduledUpdates)
+
+	// the imported updates can be looked up by id
+	update, err := s.keeper.GetScheduledUpdate(s.ctx, 7)
+	s.Require().NoError(err)
+	s.Require().Equal(gs.ScheduledUpdates[1], update)
+
+	// newly scheduled updates continue after the largest id
+	id, err := s.keeper.ScheduleUpdate(s.ctx, types.ScheduledUpdate{
+		Authority:        s.marketAuthorities[0],
+		ActivationHeight: 20,
+		CreateMarkets:    []types.Market{ethusdt},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(8), id)
+}
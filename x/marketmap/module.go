@@ -108,9 +108,9 @@ type AppModule struct {
 	k *keeper.Keeper
 }
 
-// BeginBlock is a no-op for x/marketmap.
-func (am AppModule) BeginBlock(_ context.Context) error {
-	return nil
+// BeginBlock applies the scheduled market map updates that are due at the current block height.
+func (am AppModule) BeginBlock(ctx context.Context) error {
+	return am.k.ApplyScheduledUpdates(sdk.UnwrapSDKContext(ctx))
 }
 
 // EndBlock is a no-op for x/marketmap.
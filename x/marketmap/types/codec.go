@@ -14,7 +14,10 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	// register the msg-types
 	legacy.RegisterAminoMsg(cdc, &MsgCreateMarkets{}, "slinky/x/marketmap/MsgCreateMarkets")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateMarkets{}, "slinky/x/marketmap/MsgUpdateMarkets")
+	legacy.RegisterAminoMsg(cdc, &MsgRemoveMarkets{}, "slinky/x/marketmap/MsgRemoveMarkets")
 	legacy.RegisterAminoMsg(cdc, &MsgParams{}, "slinky/x/marketmap/MsgParams")
+	legacy.RegisterAminoMsg(cdc, &MsgScheduleMarketUpdates{}, "slinky/x/marketmap/MsgScheduleUpdates")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelScheduledUpdate{}, "slinky/x/marketmap/MsgCancelUpdate")
 }
 
 // RegisterInterfaces registers the x/marketmap messages + message service w/ the InterfaceRegistry (registry).
@@ -23,7 +26,10 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgCreateMarkets{},
 		&MsgUpdateMarkets{},
+		&MsgRemoveMarkets{},
 		&MsgParams{},
+		&MsgScheduleMarketUpdates{},
+		&MsgCancelScheduledUpdate{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
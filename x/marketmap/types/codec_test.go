@@ -0,0 +1,21 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/x/marketmap/types"
+)
+
+func TestRegisterLegacyAminoCodec(t *testing.T) {
+	cdc := codec.NewLegacyAmino()
+	require.NotPanics(t, func() {
+		types.RegisterLegacyAminoCodec(cdc)
+	})
+
+	bz, err := cdc.MarshalJSON(&types.MsgCancelScheduledUpdate{})
+	require.NoError(t, err)
+	require.Contains(t, string(bz), "slinky/x/marketmap/MsgCancelUpdate")
+}
@@ -5,9 +5,20 @@ package types
 const (
 	EventTypeCreateMarket = "create_market"
 	EventTypeUpdateMarket = "update_market"
+	EventTypeRemoveMarket = "remove_market"
+
+	EventTypeScheduleMarketUpdates = "schedule_market_updates"
+	EventTypeCancelScheduledUpdate = "cancel_scheduled_update"
+	EventTypeApplyScheduledUpdate  = "apply_scheduled_update"
 
 	AttributeKeyCurrencyPair     = "currency_pair"
 	AttributeKeyDecimals         = "decimals"
 	AttributeKeyMinProviderCount = "min_provider_count"
 	AttributeKeyMetadata         = "metadata"
+	AttributeKeyStatus           = "status"
+
+	AttributeKeyScheduledUpdateID = "scheduled_update_id"
+	AttributeKeyActivationHeight  = "activation_height"
+	AttributeKeyAuthority         = "authority"
+	AttributeKeyError             = "error"
 )
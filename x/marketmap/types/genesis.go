@@ -1,15 +1,19 @@
 package types
 
+import "fmt"
+
 // NewGenesisState returns an instance of GenesisState.
 func NewGenesisState(
 	marketMap MarketMap,
 	lastUpdated uint64,
 	params Params,
+	scheduledUpdates ...ScheduledUpdate,
 ) GenesisState {
 	return GenesisState{
-		MarketMap:   marketMap,
-		LastUpdated: lastUpdated,
-		Params:      params,
+		MarketMap:        marketMap,
+		LastUpdated:      lastUpdated,
+		Params:           params,
+		ScheduledUpdates: scheduledUpdates,
 	}
 }
 
@@ -19,6 +23,18 @@ func (gs *GenesisState) ValidateBasic() error {
 		return err
 	}
 
+	seenIDs := make(map[uint64]struct{}, len(gs.ScheduledUpdates))
+	for _, update := range gs.ScheduledUpdates {
+		if err := update.ValidateBasic(); err != nil {
+			return err
+		}
+
+		if _, seen := seenIDs[update.Id]; seen {
+			return fmt.Errorf("duplicate scheduled update id %d found", update.Id)
+		}
+		seenIDs[update.Id] = struct{}{}
+	}
+
 	return gs.Params.ValidateBasic()
 }
 
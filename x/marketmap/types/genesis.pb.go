@@ -34,6 +34,8 @@ type GenesisState struct {
 	LastUpdated uint64 `protobuf:"varint,2,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
 	// Params are the parameters for the x/marketmap module.
 	Params Params `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+	// ScheduledUpdates are the pending scheduled updates of the market map.
+	ScheduledUpdates []ScheduledUpdate `protobuf:"bytes,4,rep,name=scheduled_updates,json=scheduledUpdates,proto3" json:"scheduled_updates"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -90,6 +92,13 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetScheduledUpdates() []ScheduledUpdate {
+	if m != nil {
+		return m.ScheduledUpdates
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "slinky.marketmap.v1.GenesisState")
 }
@@ -97,24 +106,26 @@ func init() {
 func init() { proto.RegisterFile("slinky/marketmap/v1/genesis.proto", fileDescriptor_a621f29fb8bf99f4) }
 
 var fileDescriptor_a621f29fb8bf99f4 = []byte{
-	// 265 bytes of a gzipped FileDescriptorProto
+	// 301 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2c, 0xce, 0xc9, 0xcc,
 	0xcb, 0xae, 0xd4, 0xcf, 0x4d, 0x2c, 0xca, 0x4e, 0x2d, 0xc9, 0x4d, 0x2c, 0xd0, 0x2f, 0x33, 0xd4,
 	0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86,
 	0x28, 0xd1, 0x83, 0x2b, 0xd1, 0x2b, 0x33, 0x94, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xcb, 0xeb,
 	0x83, 0x58, 0x10, 0xa5, 0x52, 0x0a, 0xd8, 0x4c, 0x83, 0x70, 0xf0, 0xa9, 0x28, 0x48, 0x2c, 0x4a,
-	0xcc, 0x85, 0x5a, 0xa7, 0xb4, 0x91, 0x91, 0x8b, 0xc7, 0x1d, 0xe2, 0x80, 0xe0, 0x92, 0xc4, 0x92,
-	0x54, 0x21, 0x67, 0x2e, 0x2e, 0x88, 0xea, 0xf8, 0xdc, 0xc4, 0x02, 0x09, 0x46, 0x05, 0x46, 0x0d,
-	0x6e, 0x23, 0x39, 0x3d, 0x2c, 0x8e, 0xd2, 0xf3, 0x05, 0x73, 0x7c, 0x13, 0x0b, 0x9c, 0x58, 0x4e,
-	0xdc, 0x93, 0x67, 0x08, 0xe2, 0xcc, 0x85, 0x09, 0x08, 0x29, 0x72, 0xf1, 0xe4, 0x24, 0x16, 0x97,
-	0xc4, 0x97, 0x16, 0xa4, 0x24, 0x96, 0xa4, 0xa6, 0x48, 0x30, 0x29, 0x30, 0x6a, 0xb0, 0x04, 0x71,
-	0x83, 0xc4, 0x42, 0x21, 0x42, 0x42, 0x96, 0x5c, 0x6c, 0x10, 0x87, 0x48, 0x30, 0x83, 0xed, 0x90,
-	0xc6, 0x6a, 0x47, 0x00, 0x58, 0x09, 0xd4, 0x02, 0xa8, 0x06, 0x27, 0xb7, 0x13, 0x8f, 0xe4, 0x18,
-	0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5,
-	0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0xd2, 0x49, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce,
-	0xcf, 0xd5, 0x2f, 0xce, 0xce, 0x2c, 0xd0, 0xcd, 0x4d, 0x2d, 0xd3, 0x87, 0x86, 0x41, 0x05, 0x52,
-	0x28, 0x94, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x83, 0xc0, 0x18, 0x10, 0x00, 0x00, 0xff,
-	0xff, 0xc8, 0xaf, 0xda, 0x95, 0x96, 0x01, 0x00, 0x00,
+	0xcc, 0x85, 0x5a, 0xa7, 0xd4, 0xc5, 0xc4, 0xc5, 0xe3, 0x0e, 0x71, 0x40, 0x70, 0x49, 0x62, 0x49,
+	0xaa, 0x90, 0x33, 0x17, 0x17, 0x44, 0x75, 0x7c, 0x6e, 0x62, 0x81, 0x04, 0xa3, 0x02, 0xa3, 0x06,
+	0xb7, 0x91, 0x9c, 0x1e, 0x16, 0x47, 0xe9, 0xf9, 0x82, 0x39, 0xbe, 0x89, 0x05, 0x4e, 0x2c, 0x27,
+	0xee, 0xc9, 0x33, 0x04, 0x71, 0xe6, 0xc2, 0x04, 0x84, 0x14, 0xb9, 0x78, 0x72, 0x12, 0x8b, 0x4b,
+	0xe2, 0x4b, 0x0b, 0x52, 0x12, 0x4b, 0x52, 0x53, 0x24, 0x98, 0x14, 0x18, 0x35, 0x58, 0x82, 0xb8,
+	0x41, 0x62, 0xa1, 0x10, 0x21, 0x21, 0x4b, 0x2e, 0x36, 0x88, 0x43, 0x24, 0x98, 0xc1, 0x76, 0x48,
+	0x63, 0xb5, 0x23, 0x00, 0xac, 0x04, 0x6a, 0x01, 0x54, 0x83, 0x50, 0x38, 0x97, 0x60, 0x71, 0x72,
+	0x46, 0x6a, 0x4a, 0x69, 0x4e, 0x6a, 0x0a, 0xd4, 0x8a, 0x62, 0x09, 0x16, 0x05, 0x66, 0x0d, 0x6e,
+	0x23, 0x15, 0xac, 0xa6, 0x04, 0xc3, 0x54, 0x43, 0x2c, 0x87, 0x1a, 0x27, 0x50, 0x8c, 0x2a, 0x5c,
+	0xec, 0xe4, 0x76, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e,
+	0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x3a, 0xe9, 0x99,
+	0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0xc5, 0xd9, 0x99, 0x05, 0xba, 0xb9, 0xa9,
+	0x65, 0xfa, 0xd0, 0xc0, 0xad, 0x40, 0x0a, 0xde, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0x70,
+	0xd8, 0x1a, 0x03, 0x06, 0x00, 0xf3, 0xf6, 0x3d, 0xd2, 0xef, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -137,6 +148,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ScheduledUpdates) > 0 {
+		for iNdEx := len(m.ScheduledUpdates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -189,6 +214,12 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ScheduledUpdates) > 0 {
+		for _, e := range m.ScheduledUpdates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -312,6 +343,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledUpdates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledUpdates = append(m.ScheduledUpdates, ScheduledUpdate{})
+			if err := m.ScheduledUpdates[len(m.ScheduledUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	"github.com/skip-mev/chaintestutil/sample"
 	"github.com/stretchr/testify/require"
 
 	"github.com/skip-mev/slinky/x/marketmap/types"
@@ -30,4 +31,38 @@ func TestGenesisState(t *testing.T) {
 		}
 		require.NoError(t, gs.ValidateBasic())
 	})
+
+	t.Run("invalid scheduled update - fail", func(t *testing.T) {
+		gs := types.DefaultGenesisState()
+
+		gs.ScheduledUpdates = []types.ScheduledUpdate{
+			{
+				Authority:        "invalid",
+				ActivationHeight: 10,
+				CreateMarkets:    []types.Market{btcusdt},
+			},
+		}
+		require.Error(t, gs.ValidateBasic())
+	})
+
+	t.Run("duplicate scheduled update ids - fail", func(t *testing.T) {
+		gs := types.DefaultGenesisState()
+
+		authority := sample.Address(sample.Rand())
+		gs.ScheduledUpdates = []types.ScheduledUpdate{
+			{
+				Id:               1,
+				Authority:        authority,
+				ActivationHeight: 10,
+				CreateMarkets:    []types.Market{btcusdt},
+			},
+			{
+				Id:               1,
+				Authority:        authority,
+				ActivationHeight: 11,
+				CreateMarkets:    []types.Market{usdtusd},
+			},
+		}
+		require.Error(t, gs.ValidateBasic())
+	})
 }
@@ -10,6 +10,12 @@ type MarketMapHooks interface {
 	// AfterMarketUpdated is called after UpdateMarket is called.
 	AfterMarketUpdated(ctx sdk.Context, market Market) error
 
+	// AfterMarketDisabled is called after an enabled market is updated to any other status.
+	AfterMarketDisabled(ctx sdk.Context, market Market) error
+
+	// AfterMarketRemoved is called after a market is removed from the market map.
+	AfterMarketRemoved(ctx sdk.Context, market Market) error
+
 	// AfterMarketGenesis is called after x/marketmap init genesis.
 	AfterMarketGenesis(ctx sdk.Context, tickers map[string]Market) error
 }
@@ -41,6 +47,28 @@ func (mh MultiMarketMapHooks) AfterMarketUpdated(ctx sdk.Context, market Market)
 	return nil
 }
 
+// AfterMarketDisabled calls all AfterMarketDisabled hooks registered to the MultiMarketMapHooks.
+func (mh MultiMarketMapHooks) AfterMarketDisabled(ctx sdk.Context, market Market) error {
+	for i := range mh {
+		if err := mh[i].AfterMarketDisabled(ctx, market); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AfterMarketRemoved calls all AfterMarketRemoved hooks registered to the MultiMarketMapHooks.
+func (mh MultiMarketMapHooks) AfterMarketRemoved(ctx sdk.Context, market Market) error {
+	for i := range mh {
+		if err := mh[i].AfterMarketRemoved(ctx, market); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // AfterMarketGenesis calls all AfterMarketGenesis hooks registered to the MultiMarketMapHooks.
 func (mh MultiMarketMapHooks) AfterMarketGenesis(ctx sdk.Context, markets map[string]Market) error {
 	for i := range mh {
@@ -22,11 +22,24 @@ var (
 	// ParamsPrefix is the key prefix of the module Params.
 	ParamsPrefix = collections.NewPrefix(3)
 
+	// ScheduledUpdatesPrefix is the key prefix for scheduled updates.
+	ScheduledUpdatesPrefix = collections.NewPrefix(4)
+
+	// ScheduledUpdateIDPrefix is the key prefix for the scheduled update id sequence.
+	ScheduledUpdateIDPrefix = collections.NewPrefix(5)
+
+	// ScheduledUpdateHeightsPrefix is the key prefix for the index of scheduled update ids to activation heights.
+	ScheduledUpdateHeightsPrefix = collections.NewPrefix(6)
+
 	// TickersCodec is the collections.KeyCodec value used for the markets map.
 	TickersCodec = codec.NewStringKeyCodec[TickerString]()
 
 	// LastUpdatedCodec is the collections.KeyCodec value used for the lastUpdated value.
 	LastUpdatedCodec = codec.KeyToValueCodec[uint64](codec.NewUint64Key[uint64]())
+
+	// ScheduledUpdatesCodec is the collections.KeyCodec value used for the scheduled updates map. Scheduled
+	// updates are keyed by (activation height, id) so that they can be iterated in the order they are applied.
+	ScheduledUpdatesCodec = collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key)
 )
 
 // TickerString is the key used to identify unique pairs of Base/Quote with corresponding PathsConfig objects--or in other words AggregationConfigs.
@@ -2,6 +2,8 @@ package types
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 )
 
 // ValidateBasic validates the market map configuration and its expected configuration.
@@ -11,7 +13,9 @@ import (
 //		1. Ensure that the market map is valid (ValidateBasic). This ensures that each of the provider's
 //		   markets are supported by the market map.
 //		2. Ensure that each provider config has a valid corresponding ticker.
-//	 	3. Ensure that all normalization markets are enabled.
+//	 	3. Ensure that all normalization markets (including intermediate markets of a normalization
+//		   path) are enabled.
+//		4. Ensure that every market can be resolved without a cycle through its normalization markets.
 func (mm *MarketMap) ValidateBasic() error {
 	for _, market := range mm.Markets {
 		if err := market.ValidateBasic(); err != nil {
@@ -19,10 +23,10 @@ func (mm *MarketMap) ValidateBasic() error {
 		}
 
 		for _, providerConfig := range market.ProviderConfigs {
-			if providerConfig.NormalizeByPair != nil {
-				normalizeMarket, found := mm.Markets[providerConfig.NormalizeByPair.String()]
+			for _, pair := range providerConfig.Normalizations() {
+				normalizeMarket, found := mm.Markets[pair.String()]
 				if !found {
-					return fmt.Errorf("provider's (%s) pair for normalization (%s) was not found in the marketmap", providerConfig.Name, providerConfig.NormalizeByPair.String())
+					return fmt.Errorf("provider's (%s) pair for normalization (%s) was not found in the marketmap", providerConfig.Name, pair.String())
 				}
 
 				if !normalizeMarket.Ticker.Enabled && market.Ticker.Enabled {
@@ -32,6 +36,56 @@ func (mm *MarketMap) ValidateBasic() error {
 		}
 	}
 
+	return mm.validateNormalizationCycles()
+}
+
+// validateNormalizationCycles ensures that every market can be resolved without depending on
+// itself through the markets used to normalize its provider prices. Cycles between markets are
+// permitted (i.e. USDT/USD normalized by BTC/USD which is normalized by USDT/USD) as long as
+// each market in the cycle has at least one provider config that breaks the cycle. A market is
+// resolvable if it has a provider config whose normalization markets are all resolvable.
+func (mm *MarketMap) validateNormalizationCycles() error {
+	resolved := make(map[string]bool, len(mm.Markets))
+	for progress := true; progress; {
+		progress = false
+		for ticker, market := range mm.Markets {
+			if resolved[ticker] {
+				continue
+			}
+
+			for _, providerConfig := range market.ProviderConfigs {
+				resolvable := true
+				for _, pair := range providerConfig.Normalizations() {
+					if !resolved[pair.String()] {
+						resolvable = false
+						break
+					}
+				}
+
+				if resolvable {
+					resolved[ticker] = true
+					progress = true
+					break
+				}
+			}
+		}
+	}
+
+	unresolved := make([]string, 0)
+	for ticker := range mm.Markets {
+		if !resolved[ticker] {
+			unresolved = append(unresolved, ticker)
+		}
+	}
+
+	if len(unresolved) > 0 {
+		sort.Strings(unresolved)
+		return fmt.Errorf(
+			"normalization cycle detected: markets %s cannot be resolved without depending on themselves",
+			strings.Join(unresolved, ", "),
+		)
+	}
+
 	return nil
 }
 
@@ -24,6 +24,56 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// MarketStatus is the lifecycle state of a market in the market map.
+type MarketStatus int32
+
+const (
+	// MARKET_STATUS_UNSPECIFIED denotes a market whose status is derived from
+	// its Enabled flag.
+	MarketStatus_MARKET_STATUS_UNSPECIFIED MarketStatus = 0
+	// MARKET_STATUS_PROPOSED denotes a market that has been added to the market
+	// map but is not yet fetched by oracles.
+	MarketStatus_MARKET_STATUS_PROPOSED MarketStatus = 1
+	// MARKET_STATUS_ENABLED denotes a market that is fetched by oracles.
+	MarketStatus_MARKET_STATUS_ENABLED MarketStatus = 2
+	// MARKET_STATUS_DISABLED denotes a market that is temporarily not fetched by
+	// oracles.
+	MarketStatus_MARKET_STATUS_DISABLED MarketStatus = 3
+	// MARKET_STATUS_DEPRECATED denotes a disabled market that is scheduled for
+	// removal and can no longer be enabled.
+	MarketStatus_MARKET_STATUS_DEPRECATED MarketStatus = 4
+	// MARKET_STATUS_REMOVED denotes a market that has been removed from the
+	// market map. Markets are deleted from state when removed, so this status is
+	// never stored.
+	MarketStatus_MARKET_STATUS_REMOVED MarketStatus = 5
+)
+
+var MarketStatus_name = map[int32]string{
+	0: "MARKET_STATUS_UNSPECIFIED",
+	1: "MARKET_STATUS_PROPOSED",
+	2: "MARKET_STATUS_ENABLED",
+	3: "MARKET_STATUS_DISABLED",
+	4: "MARKET_STATUS_DEPRECATED",
+	5: "MARKET_STATUS_REMOVED",
+}
+
+var MarketStatus_value = map[string]int32{
+	"MARKET_STATUS_UNSPECIFIED": 0,
+	"MARKET_STATUS_PROPOSED":    1,
+	"MARKET_STATUS_ENABLED":     2,
+	"MARKET_STATUS_DISABLED":    3,
+	"MARKET_STATUS_DEPRECATED":  4,
+	"MARKET_STATUS_REMOVED":     5,
+}
+
+func (x MarketStatus) String() string {
+	return proto.EnumName(MarketStatus_name, int32(x))
+}
+
+func (MarketStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_fefe265720fc8a78, []int{0}
+}
+
 // Market encapsulates a Ticker and its provider-specific configuration.
 type Market struct {
 	// Ticker represents a price feed for a given asset pair i.e. BTC/USD. The
@@ -92,6 +142,10 @@ type Ticker struct {
 	// MinProviderCount is the minimum number of providers required to consider
 	// the ticker valid.
 	MinProviderCount uint64 `protobuf:"varint,3,opt,name=min_provider_count,json=minProviderCount,proto3" json:"min_provider_count,omitempty"`
+	// Status is the lifecycle state of the market. If unspecified, the status
+	// is derived from Enabled. If specified, Enabled must be true if and only if
+	// the status is MARKET_STATUS_ENABLED.
+	Status MarketStatus `protobuf:"varint,4,opt,name=status,proto3,enum=slinky.marketmap.v1.MarketStatus" json:"status,omitempty"`
 	// Enabled is the flag that denotes if the Ticker is enabled for price
 	// fetching by an oracle.
 	Enabled bool `protobuf:"varint,14,opt,name=enabled,proto3" json:"enabled,omitempty"`
@@ -153,6 +207,13 @@ func (m *Ticker) GetMinProviderCount() uint64 {
 	return 0
 }
 
+func (m *Ticker) GetStatus() MarketStatus {
+	if m != nil {
+		return m.Status
+	}
+	return MarketStatus_MARKET_STATUS_UNSPECIFIED
+}
+
 func (m *Ticker) GetEnabled() bool {
 	if m != nil {
 		return m.Enabled
@@ -183,6 +244,13 @@ type ProviderConfig struct {
 	// Invert is a boolean indicating if the BASE and QUOTE of the market should
 	// be inverted. i.e. BASE -> QUOTE, QUOTE -> BASE
 	Invert bool `protobuf:"varint,4,opt,name=invert,proto3" json:"invert,omitempty"`
+	// NormalizationPath is an ordered list of currency pairs for this ticker to
+	// be normalized by. The price is multiplied by the index price of each pair
+	// in order. For example, if the desired Ticker is TOKEN/USD, this market
+	// could be reached using: OffChainTicker = TOKEN/ETH NormalizationPath =
+	// [ETH/USDT, USDT/USD]. This field is optional and cannot be set alongside
+	// NormalizeByPair.
+	NormalizationPath []types.CurrencyPair `protobuf:"bytes,5,rep,name=normalization_path,json=normalizationPath,proto3" json:"normalization_path"`
 	// MetadataJSON is a string of JSON that encodes any extra configuration
 	// for the given provider config.
 	Metadata_JSON string `protobuf:"bytes,15,opt,name=metadata_JSON,json=metadataJSON,proto3" json:"metadata_JSON,omitempty"`
@@ -249,6 +317,13 @@ func (m *ProviderConfig) GetInvert() bool {
 	return false
 }
 
+func (m *ProviderConfig) GetNormalizationPath() []types.CurrencyPair {
+	if m != nil {
+		return m.NormalizationPath
+	}
+	return nil
+}
+
 func (m *ProviderConfig) GetMetadata_JSON() string {
 	if m != nil {
 		return m.Metadata_JSON
@@ -302,53 +377,156 @@ func (m *MarketMap) GetMarkets() map[string]Market {
 	return nil
 }
 
+// ScheduledUpdate is a set of market map changes submitted by a market
+// authority that is applied at the beginning of the block at the given
+// activation height.
+type ScheduledUpdate struct {
+	// Id is the unique identifier of the scheduled update.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Authority is the market authority that scheduled the update.
+	Authority string `protobuf:"bytes,2,opt,name=authority,proto3" json:"authority,omitempty"`
+	// ActivationHeight is the block height at which the update is applied.
+	ActivationHeight uint64 `protobuf:"varint,3,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	// CreateMarkets is the list of markets to be created when the update is
+	// applied.
+	CreateMarkets []Market `protobuf:"bytes,4,rep,name=create_markets,json=createMarkets,proto3" json:"create_markets"`
+	// UpdateMarkets is the list of markets to be updated when the update is
+	// applied.
+	UpdateMarkets []Market `protobuf:"bytes,5,rep,name=update_markets,json=updateMarkets,proto3" json:"update_markets"`
+}
+
+func (m *ScheduledUpdate) Reset()         { *m = ScheduledUpdate{} }
+func (m *ScheduledUpdate) String() string { return proto.CompactTextString(m) }
+func (*ScheduledUpdate) ProtoMessage()    {}
+func (*ScheduledUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fefe265720fc8a78, []int{4}
+}
+func (m *ScheduledUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledUpdate.Merge(m, src)
+}
+func (m *ScheduledUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledUpdate proto.InternalMessageInfo
+
+func (m *ScheduledUpdate) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ScheduledUpdate) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *ScheduledUpdate) GetActivationHeight() uint64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
+}
+
+func (m *ScheduledUpdate) GetCreateMarkets() []Market {
+	if m != nil {
+		return m.CreateMarkets
+	}
+	return nil
+}
+
+func (m *ScheduledUpdate) GetUpdateMarkets() []Market {
+	if m != nil {
+		return m.UpdateMarkets
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("slinky.marketmap.v1.MarketStatus", MarketStatus_name, MarketStatus_value)
 	proto.RegisterType((*Market)(nil), "slinky.marketmap.v1.Market")
 	proto.RegisterType((*Ticker)(nil), "slinky.marketmap.v1.Ticker")
 	proto.RegisterType((*ProviderConfig)(nil), "slinky.marketmap.v1.ProviderConfig")
 	proto.RegisterType((*MarketMap)(nil), "slinky.marketmap.v1.MarketMap")
 	proto.RegisterMapType((map[string]Market)(nil), "slinky.marketmap.v1.MarketMap.MarketsEntry")
+	proto.RegisterType((*ScheduledUpdate)(nil), "slinky.marketmap.v1.ScheduledUpdate")
 }
 
 func init() { proto.RegisterFile("slinky/marketmap/v1/market.proto", fileDescriptor_fefe265720fc8a78) }
 
 var fileDescriptor_fefe265720fc8a78 = []byte{
-	// 550 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xcd, 0x8a, 0x13, 0x4d,
-	0x14, 0xed, 0x4a, 0xf2, 0x65, 0x92, 0x9a, 0x4c, 0x92, 0xaf, 0x14, 0x69, 0x22, 0xf6, 0x34, 0xc9,
-	0xa6, 0xc1, 0xb1, 0x43, 0xc6, 0x8d, 0xce, 0x32, 0x41, 0xf1, 0x87, 0xd1, 0xa1, 0x1d, 0x10, 0xdc,
-	0x34, 0x95, 0x4e, 0x25, 0x53, 0x24, 0x5d, 0xd5, 0x54, 0x57, 0x1a, 0xe3, 0xca, 0x47, 0x70, 0xe9,
-	0x52, 0xf0, 0x31, 0x7c, 0x81, 0x59, 0xce, 0x4a, 0x5c, 0x88, 0x48, 0x82, 0xef, 0x21, 0x5d, 0x5d,
-	0xc9, 0x74, 0x20, 0xc2, 0xec, 0xee, 0xbd, 0x75, 0xea, 0xdc, 0x3a, 0xa7, 0xee, 0x85, 0x76, 0x3c,
-	0xa3, 0x6c, 0xba, 0xe8, 0x86, 0x58, 0x4c, 0x89, 0x0c, 0x71, 0xd4, 0x4d, 0x7a, 0x3a, 0x71, 0x23,
-	0xc1, 0x25, 0x47, 0xb7, 0x32, 0x84, 0xbb, 0x41, 0xb8, 0x49, 0xaf, 0x75, 0x7b, 0xc2, 0x27, 0x5c,
-	0x9d, 0x77, 0xd3, 0x28, 0x83, 0xb6, 0x3a, 0x9a, 0x4c, 0x2e, 0x22, 0x12, 0xa7, 0x44, 0xc1, 0x5c,
-	0x08, 0xc2, 0x82, 0x85, 0x1f, 0x61, 0x2a, 0x32, 0x50, 0xfb, 0x2b, 0x80, 0xe5, 0x53, 0xc5, 0x85,
-	0x1e, 0xc3, 0xb2, 0xa4, 0xc1, 0x94, 0x08, 0x13, 0xd8, 0xc0, 0xd9, 0x3f, 0xbe, 0xeb, 0xee, 0xe8,
-	0xe5, 0x9e, 0x2b, 0x48, 0xbf, 0x74, 0xf9, 0xeb, 0xd0, 0xf0, 0xf4, 0x05, 0x74, 0x0e, 0x9b, 0x91,
-	0xe0, 0x09, 0x1d, 0x11, 0xe1, 0x07, 0x9c, 0x8d, 0xe9, 0x24, 0x36, 0x0b, 0x76, 0xd1, 0xd9, 0x3f,
-	0xee, 0xec, 0x24, 0x39, 0xd3, 0xe0, 0x81, 0xc2, 0x6a, 0xb2, 0x46, 0xb4, 0x55, 0x8d, 0x4f, 0x2a,
-	0x9f, 0xbf, 0x1c, 0x1a, 0x1f, 0x7f, 0xda, 0x46, 0xfb, 0x0f, 0x80, 0xe5, 0xac, 0x31, 0x7a, 0x06,
-	0x0f, 0xb6, 0x74, 0xe8, 0xc7, 0xde, 0x5b, 0xf7, 0x51, 0x6a, 0xd3, 0x1e, 0x03, 0x8d, 0x3a, 0xc3,
-	0x74, 0xfd, 0xdc, 0x5a, 0x90, 0xab, 0xa1, 0x16, 0xac, 0x8c, 0x48, 0x40, 0x43, 0x3c, 0x4b, 0x1f,
-	0x0b, 0x9c, 0x92, 0xb7, 0xc9, 0xd1, 0x11, 0x44, 0x21, 0x65, 0x7e, 0x4e, 0xd4, 0x9c, 0x49, 0xb3,
-	0xa8, 0x50, 0xcd, 0x90, 0xb2, 0x6b, 0x01, 0x73, 0x26, 0x91, 0x09, 0xf7, 0x08, 0xc3, 0xc3, 0x19,
-	0x19, 0x99, 0x75, 0x1b, 0x38, 0x15, 0x6f, 0x9d, 0xa2, 0x0e, 0x3c, 0x08, 0x89, 0xc4, 0x23, 0x2c,
-	0xb1, 0xff, 0xe2, 0xcd, 0xeb, 0x57, 0x66, 0xc3, 0x06, 0x4e, 0xd5, 0xab, 0xad, 0x8b, 0x69, 0x2d,
-	0xa7, 0xf3, 0x3b, 0x80, 0xf5, 0x6d, 0x6f, 0x10, 0x82, 0x25, 0x86, 0x43, 0xa2, 0x64, 0x56, 0x3d,
-	0x15, 0x23, 0x07, 0x36, 0xf9, 0x78, 0xec, 0x07, 0x17, 0x98, 0x32, 0x5f, 0xff, 0x59, 0x41, 0x9d,
-	0xd7, 0xf9, 0x78, 0x3c, 0x48, 0xcb, 0xda, 0xad, 0xe7, 0xf0, 0x7f, 0xc6, 0x45, 0x88, 0x67, 0xf4,
-	0x03, 0xf1, 0x87, 0xda, 0xb1, 0xe2, 0x0d, 0x1c, 0xf3, 0x1a, 0x9b, 0x7b, 0xfd, 0xcc, 0xae, 0x3b,
-	0xb0, 0x4c, 0x59, 0x42, 0x84, 0x34, 0x4b, 0x4a, 0xa3, 0xce, 0x6e, 0x24, 0xb1, 0xfd, 0x0d, 0xc0,
-	0x6a, 0x36, 0x66, 0xa7, 0x38, 0x42, 0x2f, 0xe1, 0x5e, 0x36, 0x0e, 0xb1, 0x09, 0xd4, 0x94, 0xdc,
-	0xdf, 0x39, 0x25, 0x9b, 0x0b, 0x3a, 0x8a, 0x9f, 0x30, 0x29, 0x16, 0xfa, 0x2f, 0xd7, 0x0c, 0xad,
-	0xb7, 0xb0, 0x96, 0x3f, 0x46, 0x4d, 0x58, 0x9c, 0x92, 0x85, 0xf6, 0x2b, 0x0d, 0x51, 0x0f, 0xfe,
-	0x97, 0xe0, 0xd9, 0x9c, 0x28, 0x8f, 0xfe, 0x35, 0xd7, 0x19, 0x87, 0x97, 0x21, 0x4f, 0x0a, 0x8f,
-	0xc0, 0xf5, 0xb7, 0xf4, 0x9f, 0x5e, 0x2e, 0x2d, 0x70, 0xb5, 0xb4, 0xc0, 0xef, 0xa5, 0x05, 0x3e,
-	0xad, 0x2c, 0xe3, 0x6a, 0x65, 0x19, 0x3f, 0x56, 0x96, 0xf1, 0xee, 0x68, 0x42, 0xe5, 0xc5, 0x7c,
-	0xe8, 0x06, 0x3c, 0xec, 0xc6, 0x53, 0x1a, 0x3d, 0x08, 0x49, 0xd2, 0xd5, 0x7b, 0xf7, 0x3e, 0xb7,
-	0xc6, 0xca, 0xe3, 0x61, 0x59, 0xed, 0xdc, 0xc3, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x09, 0xf7,
-	0x02, 0xad, 0xe7, 0x03, 0x00, 0x00,
+	// 789 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0x4d, 0x6f, 0xe2, 0x46,
+	0x18, 0xc6, 0xe6, 0x63, 0x61, 0x96, 0x80, 0x33, 0x6d, 0x57, 0x5e, 0xba, 0xcb, 0xba, 0xc9, 0x05,
+	0x75, 0xb7, 0xa0, 0xa4, 0x97, 0x36, 0x37, 0x3e, 0x1c, 0x85, 0xa6, 0x04, 0x64, 0x93, 0x56, 0xea,
+	0xc5, 0x1a, 0xec, 0x01, 0x8f, 0xc0, 0x1f, 0xb2, 0xc7, 0xa8, 0xf4, 0x94, 0x9f, 0xd0, 0x63, 0x8f,
+	0x91, 0x7a, 0xeb, 0x3f, 0xa8, 0xfa, 0x07, 0x72, 0xcc, 0xb1, 0x87, 0xaa, 0xaa, 0x92, 0x3f, 0x52,
+	0x79, 0x3c, 0x10, 0xb3, 0x89, 0x92, 0xdc, 0x66, 0xde, 0xf7, 0x79, 0x9f, 0xf7, 0x7d, 0x9f, 0x87,
+	0x31, 0x40, 0x09, 0x17, 0xc4, 0x9d, 0xaf, 0x5a, 0x0e, 0x0a, 0xe6, 0x98, 0x3a, 0xc8, 0x6f, 0x2d,
+	0x0f, 0xf8, 0xa5, 0xe9, 0x07, 0x1e, 0xf5, 0xe0, 0x27, 0x09, 0xa2, 0xb9, 0x41, 0x34, 0x97, 0x07,
+	0xb5, 0x4f, 0x67, 0xde, 0xcc, 0x63, 0xf9, 0x56, 0x7c, 0x4a, 0xa0, 0xb5, 0x7d, 0x4e, 0x46, 0x57,
+	0x3e, 0x0e, 0x63, 0x22, 0x33, 0x0a, 0x02, 0xec, 0x9a, 0x2b, 0xc3, 0x47, 0x24, 0x48, 0x40, 0x7b,
+	0xbf, 0x0b, 0xa0, 0x30, 0x60, 0x5c, 0xf0, 0x5b, 0x50, 0xa0, 0xc4, 0x9c, 0xe3, 0x40, 0x16, 0x14,
+	0xa1, 0xf1, 0xf2, 0xf0, 0xf3, 0xe6, 0x03, 0xbd, 0x9a, 0x63, 0x06, 0xe9, 0xe4, 0xae, 0xfe, 0x7d,
+	0x97, 0xd1, 0x78, 0x01, 0x1c, 0x03, 0xc9, 0x0f, 0xbc, 0x25, 0xb1, 0x70, 0x60, 0x98, 0x9e, 0x3b,
+	0x25, 0xb3, 0x50, 0x16, 0x95, 0x6c, 0xe3, 0xe5, 0xe1, 0xfe, 0x83, 0x24, 0x23, 0x0e, 0xee, 0x32,
+	0x2c, 0x27, 0xab, 0xfa, 0x5b, 0xd1, 0xf0, 0xa8, 0xf8, 0xdb, 0xe5, 0xbb, 0xcc, 0xc5, 0x3f, 0x4a,
+	0x66, 0xef, 0x52, 0x04, 0x85, 0xa4, 0x31, 0x3c, 0x01, 0x3b, 0x5b, 0x7b, 0xf0, 0x61, 0xdf, 0xae,
+	0xfb, 0xb0, 0x6d, 0xe3, 0x1e, 0x5d, 0x8e, 0x1a, 0x21, 0xb2, 0x1e, 0xb7, 0x6c, 0xa6, 0x62, 0xb0,
+	0x06, 0x8a, 0x16, 0x36, 0x89, 0x83, 0x16, 0xf1, 0xb0, 0x42, 0x23, 0xa7, 0x6d, 0xee, 0xf0, 0x03,
+	0x80, 0x0e, 0x71, 0x8d, 0xd4, 0x52, 0x91, 0x4b, 0xe5, 0x2c, 0x43, 0x49, 0x0e, 0x71, 0xef, 0x16,
+	0x88, 0x5c, 0xa6, 0x5c, 0x48, 0x11, 0x8d, 0x42, 0x39, 0xa7, 0x08, 0x8d, 0xca, 0xe1, 0x17, 0x0f,
+	0x2e, 0x9d, 0xc8, 0xac, 0x33, 0xa0, 0xc6, 0x0b, 0xa0, 0x0c, 0x5e, 0x60, 0x17, 0x4d, 0x16, 0xd8,
+	0x92, 0x2b, 0x8a, 0xd0, 0x28, 0x6a, 0xeb, 0x2b, 0xdc, 0x07, 0x3b, 0x0e, 0xa6, 0xc8, 0x42, 0x14,
+	0x19, 0xdf, 0xe9, 0xc3, 0x33, 0xb9, 0xaa, 0x08, 0x8d, 0x92, 0x56, 0x5e, 0x07, 0xe3, 0x58, 0x4a,
+	0xa2, 0x3f, 0x44, 0x50, 0xd9, 0x96, 0x15, 0x42, 0x90, 0x73, 0x91, 0x83, 0x99, 0x42, 0x25, 0x8d,
+	0x9d, 0x61, 0x03, 0x48, 0xde, 0x74, 0x6a, 0x98, 0x36, 0x22, 0xae, 0xc1, 0xed, 0x16, 0x59, 0xbe,
+	0xe2, 0x4d, 0xa7, 0xdd, 0x38, 0xcc, 0x85, 0xee, 0x83, 0x5d, 0xd7, 0x0b, 0x1c, 0xb4, 0x20, 0xbf,
+	0x60, 0x63, 0xc2, 0xc5, 0xce, 0x3e, 0x43, 0x6c, 0xad, 0xba, 0xa9, 0xeb, 0x24, 0x4a, 0xbf, 0x02,
+	0x05, 0xe2, 0x2e, 0x71, 0x40, 0x99, 0x3e, 0x45, 0x8d, 0xdf, 0xa0, 0x06, 0xe0, 0x1a, 0x8a, 0x28,
+	0xf1, 0x5c, 0xc3, 0x47, 0xd4, 0x96, 0xf3, 0x4a, 0xf6, 0xc9, 0x1e, 0xdc, 0xd0, 0xdd, 0xad, 0xf2,
+	0x11, 0xa2, 0xf6, 0xb3, 0x64, 0xdb, 0xfb, 0x4b, 0x00, 0xa5, 0xc4, 0x8e, 0x01, 0xf2, 0xe1, 0x29,
+	0x78, 0x91, 0x18, 0x15, 0xca, 0x02, 0xeb, 0xfd, 0xfe, 0x11, 0xff, 0x06, 0xc8, 0xe7, 0xa7, 0x50,
+	0x75, 0x69, 0xb0, 0xe2, 0x93, 0xac, 0x19, 0x6a, 0x3f, 0x82, 0x72, 0x3a, 0x0d, 0x25, 0x90, 0x9d,
+	0xe3, 0x15, 0xf7, 0x20, 0x3e, 0xc2, 0x03, 0x90, 0x5f, 0xa2, 0x45, 0x84, 0x65, 0xf1, 0x91, 0x67,
+	0x96, 0x70, 0x68, 0x09, 0xf2, 0x48, 0xfc, 0x46, 0x48, 0x59, 0x7d, 0x21, 0x82, 0xaa, 0x6e, 0xda,
+	0xd8, 0x8a, 0x16, 0xd8, 0x3a, 0xf7, 0x2d, 0x44, 0x31, 0xac, 0x00, 0x91, 0x58, 0xac, 0x4b, 0x4e,
+	0x13, 0x89, 0x05, 0xdf, 0x80, 0x12, 0x8a, 0xa8, 0xed, 0x05, 0x84, 0xae, 0xb8, 0xc1, 0x77, 0x01,
+	0xf8, 0x1e, 0xec, 0x22, 0x93, 0x92, 0x65, 0xa2, 0xba, 0x8d, 0xc9, 0xcc, 0xde, 0xfc, 0xba, 0xef,
+	0x12, 0x27, 0x2c, 0x0e, 0x4f, 0x40, 0xc5, 0x0c, 0x30, 0xa2, 0xd8, 0x58, 0xab, 0x94, 0x53, 0xb2,
+	0x4f, 0x0c, 0xce, 0x55, 0xd9, 0x49, 0x0a, 0xb9, 0x20, 0x31, 0x53, 0xe4, 0x5b, 0x69, 0xa6, 0xfc,
+	0xb3, 0x99, 0x92, 0x42, 0xce, 0xf4, 0xe5, 0x9f, 0x02, 0x28, 0xa7, 0xdf, 0x13, 0x7c, 0x0b, 0x5e,
+	0x0f, 0xda, 0xda, 0xa9, 0x3a, 0x36, 0xf4, 0x71, 0x7b, 0x7c, 0xae, 0x1b, 0xe7, 0x67, 0xfa, 0x48,
+	0xed, 0xf6, 0x8f, 0xfb, 0x6a, 0x4f, 0xca, 0xc0, 0x1a, 0x78, 0xb5, 0x9d, 0x1e, 0x69, 0xc3, 0xd1,
+	0x50, 0x57, 0x7b, 0x92, 0x00, 0x5f, 0x83, 0xcf, 0xb6, 0x73, 0xea, 0x59, 0xbb, 0xf3, 0xbd, 0xda,
+	0x93, 0xc4, 0xfb, 0x65, 0xbd, 0xbe, 0x9e, 0xe4, 0xb2, 0xf0, 0x0d, 0x90, 0x3f, 0xca, 0xa9, 0x23,
+	0x4d, 0xed, 0xb6, 0xc7, 0x6a, 0x4f, 0xca, 0xdd, 0x27, 0xd5, 0xd4, 0xc1, 0xf0, 0x07, 0xb5, 0x27,
+	0xe5, 0x3b, 0xc7, 0x57, 0x37, 0x75, 0xe1, 0xfa, 0xa6, 0x2e, 0xfc, 0x77, 0x53, 0x17, 0x7e, 0xbd,
+	0xad, 0x67, 0xae, 0x6f, 0xeb, 0x99, 0xbf, 0x6f, 0xeb, 0x99, 0x9f, 0x3e, 0xcc, 0x08, 0xb5, 0xa3,
+	0x49, 0xd3, 0xf4, 0x9c, 0x56, 0x38, 0x27, 0xfe, 0x57, 0x0e, 0x5e, 0xb6, 0xf8, 0x57, 0xfc, 0xe7,
+	0xd4, 0x9f, 0x02, 0x7b, 0x12, 0x93, 0x02, 0xfb, 0x82, 0x7f, 0xfd, 0xff, 0x00, 0x56, 0x03, 0x81,
+	0x37, 0x35, 0x06, 0x00, 0x00,
 }
 
 func (m *Market) Marshal() (dAtA []byte, err error) {
@@ -435,6 +613,11 @@ func (m *Ticker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x70
 	}
+	if m.Status != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.MinProviderCount != 0 {
 		i = encodeVarintMarket(dAtA, i, uint64(m.MinProviderCount))
 		i--
@@ -485,6 +668,20 @@ func (m *ProviderConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x7a
 	}
+	if len(m.NormalizationPath) > 0 {
+		for iNdEx := len(m.NormalizationPath) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NormalizationPath[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMarket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if m.Invert {
 		i--
 		if m.Invert {
@@ -571,6 +768,74 @@ func (m *MarketMap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ScheduledUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UpdateMarkets) > 0 {
+		for iNdEx := len(m.UpdateMarkets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UpdateMarkets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMarket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.CreateMarkets) > 0 {
+		for iNdEx := len(m.CreateMarkets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CreateMarkets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMarket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.ActivationHeight != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMarket(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMarket(v)
 	base := offset
@@ -613,6 +878,9 @@ func (m *Ticker) Size() (n int) {
 	if m.MinProviderCount != 0 {
 		n += 1 + sovMarket(uint64(m.MinProviderCount))
 	}
+	if m.Status != 0 {
+		n += 1 + sovMarket(uint64(m.Status))
+	}
 	if m.Enabled {
 		n += 2
 	}
@@ -644,6 +912,12 @@ func (m *ProviderConfig) Size() (n int) {
 	if m.Invert {
 		n += 2
 	}
+	if len(m.NormalizationPath) > 0 {
+		for _, e := range m.NormalizationPath {
+			l = e.Size()
+			n += 1 + l + sovMarket(uint64(l))
+		}
+	}
 	l = len(m.Metadata_JSON)
 	if l > 0 {
 		n += 1 + l + sovMarket(uint64(l))
@@ -669,6 +943,37 @@ func (m *MarketMap) Size() (n int) {
 	return n
 }
 
+func (m *ScheduledUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMarket(uint64(m.Id))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	if m.ActivationHeight != 0 {
+		n += 1 + sovMarket(uint64(m.ActivationHeight))
+	}
+	if len(m.CreateMarkets) > 0 {
+		for _, e := range m.CreateMarkets {
+			l = e.Size()
+			n += 1 + l + sovMarket(uint64(l))
+		}
+	}
+	if len(m.UpdateMarkets) > 0 {
+		for _, e := range m.UpdateMarkets {
+			l = e.Size()
+			n += 1 + l + sovMarket(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovMarket(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -892,6 +1197,25 @@ func (m *Ticker) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= MarketStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 14:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
@@ -1114,6 +1438,40 @@ func (m *ProviderConfig) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Invert = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NormalizationPath", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NormalizationPath = append(m.NormalizationPath, types.CurrencyPair{})
+			if err := m.NormalizationPath[len(m.NormalizationPath)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 15:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Metadata_JSON", wireType)
@@ -1346,6 +1704,194 @@ func (m *MarketMap) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ScheduledUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+			}
+			m.ActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateMarkets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreateMarkets = append(m.CreateMarkets, Market{})
+			if err := m.CreateMarkets[len(m.CreateMarkets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateMarkets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdateMarkets = append(m.UpdateMarkets, Market{})
+			if err := m.UpdateMarkets[len(m.UpdateMarkets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMarket(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
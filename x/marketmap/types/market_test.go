@@ -166,6 +166,44 @@ var (
 		},
 	}
 
+	pepeusd = types.Market{
+		Ticker: types.Ticker{
+			CurrencyPair: slinkytypes.CurrencyPair{
+				Base:  "PEPE",
+				Quote: "USD",
+			},
+			Decimals:         18,
+			MinProviderCount: 1,
+			Enabled:          true,
+		},
+		ProviderConfigs: []types.ProviderConfig{
+			{
+				Name:           "kucoin",
+				OffChainTicker: "pepe-eth",
+				NormalizationPath: []slinkytypes.CurrencyPair{
+					ethusdt.Ticker.CurrencyPair,
+					usdtusd.Ticker.CurrencyPair,
+				},
+			},
+		},
+	}
+
+	usdtusdCyclic = types.Market{
+		Ticker: usdtusd.Ticker,
+		ProviderConfigs: []types.ProviderConfig{
+			{
+				Name:           "kucoin",
+				OffChainTicker: "usdt-usd",
+			},
+			{
+				Name:            "okx",
+				OffChainTicker:  "btc-usdt",
+				Invert:          true,
+				NormalizeByPair: &btcusd.Ticker.CurrencyPair,
+			},
+		},
+	}
+
 	markets = map[string]types.Market{
 		btcusdt.Ticker.String(): btcusdt,
 		btcusd.Ticker.String():  btcusd,
@@ -206,6 +244,61 @@ func TestMarketMapValidateBasic(t *testing.T) {
 			},
 			expectErr: true,
 		},
+		{
+			name: "valid normalization path",
+			marketMap: types.MarketMap{
+				Markets: map[string]types.Market{
+					ethusdt.Ticker.String(): ethusdt,
+					usdtusd.Ticker.String(): usdtusd,
+					pepeusd.Ticker.String(): pepeusd,
+				},
+			},
+			expectErr: false,
+		},
+		{
+			name: "invalid disabled intermediate market in normalization path",
+			marketMap: types.MarketMap{
+				Markets: map[string]types.Market{
+					ethusdt.Ticker.String():         ethusdt,
+					usdtusdDisabled.Ticker.String(): usdtusdDisabled,
+					pepeusd.Ticker.String():         pepeusd,
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "invalid missing intermediate market in normalization path",
+			marketMap: types.MarketMap{
+				Markets: map[string]types.Market{
+					usdtusd.Ticker.String(): usdtusd,
+					pepeusd.Ticker.String(): pepeusd,
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "valid normalization cycle that can be resolved by a direct provider",
+			marketMap: types.MarketMap{
+				Markets: map[string]types.Market{
+					usdtusdCyclic.Ticker.String(): usdtusdCyclic,
+					btcusd.Ticker.String():        btcusd,
+				},
+			},
+			expectErr: false,
+		},
+		{
+			name: "invalid normalization cycle that cannot be resolved",
+			marketMap: types.MarketMap{
+				Markets: map[string]types.Market{
+					usdtusdCyclic.Ticker.String(): {
+						Ticker:          usdtusdCyclic.Ticker,
+						ProviderConfigs: usdtusdCyclic.ProviderConfigs[1:],
+					},
+					btcusd.Ticker.String(): btcusd,
+				},
+			},
+			expectErr: true,
+		},
 		{
 			name: "market with no ticker",
 			marketMap: types.MarketMap{
@@ -149,6 +149,39 @@ func (_m *QueryClient) Params(ctx context.Context, in *types.ParamsRequest, opts
 	return r0, r1
 }
 
+// PendingUpdates provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) PendingUpdates(ctx context.Context, in *types.PendingUpdatesRequest, opts ...grpc.CallOption) (*types.PendingUpdatesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.PendingUpdatesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.PendingUpdatesRequest, ...grpc.CallOption) (*types.PendingUpdatesResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.PendingUpdatesRequest, ...grpc.CallOption) *types.PendingUpdatesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.PendingUpdatesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.PendingUpdatesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewQueryClient creates a new instance of QueryClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewQueryClient(t interface {
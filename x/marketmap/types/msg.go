@@ -4,6 +4,8 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	slinkytypes "github.com/skip-mev/slinky/pkg/types"
 )
 
 var (
@@ -11,6 +13,9 @@ var (
 	_ sdk.Msg = &MsgUpdateMarkets{}
 	_ sdk.Msg = &MsgParams{}
 	_ sdk.Msg = &MsgRemoveMarketAuthorities{}
+	_ sdk.Msg = &MsgRemoveMarkets{}
+	_ sdk.Msg = &MsgScheduleMarketUpdates{}
+	_ sdk.Msg = &MsgCancelScheduledUpdate{}
 )
 
 // ValidateBasic determines whether the information in the message is formatted correctly, specifically
@@ -55,6 +60,34 @@ func (m *MsgUpdateMarkets) ValidateBasic() error {
 	return nil
 }
 
+// ValidateBasic determines whether the information in the message is formatted correctly, specifically
+// whether the signer is a valid acc-address and the markets are unique, valid ticker strings.
+func (m *MsgRemoveMarkets) ValidateBasic() error {
+	// validate signer address
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return err
+	}
+
+	if len(m.Markets) == 0 {
+		return fmt.Errorf("no markets to remove")
+	}
+
+	seenMarkets := make(map[string]struct{}, len(m.Markets))
+	for _, market := range m.Markets {
+		if _, seen := seenMarkets[market]; seen {
+			return fmt.Errorf("duplicate market %s found", market)
+		}
+
+		if _, err := slinkytypes.CurrencyPairFromString(market); err != nil {
+			return fmt.Errorf("invalid market %s: %w", market, err)
+		}
+
+		seenMarkets[market] = struct{}{}
+	}
+
+	return nil
+}
+
 // ValidateBasic determines whether the information in the message is formatted correctly, specifically
 // whether the signer is a valid acc-address.
 func (m *MsgParams) ValidateBasic() error {
@@ -93,3 +126,24 @@ func (m *MsgRemoveMarketAuthorities) ValidateBasic() error {
 
 	return nil
 }
+
+// ValidateBasic determines whether the information in the message is formatted correctly, specifically
+// whether the signer is a valid acc-address and the scheduled markets are valid.
+func (m *MsgScheduleMarketUpdates) ValidateBasic() error {
+	update := ScheduledUpdate{
+		Authority:        m.Authority,
+		ActivationHeight: m.ActivationHeight,
+		CreateMarkets:    m.CreateMarkets,
+		UpdateMarkets:    m.UpdateMarkets,
+	}
+
+	return update.ValidateBasic()
+}
+
+// ValidateBasic determines whether the information in the message is formatted correctly, specifically
+// whether the signer is a valid acc-address.
+func (m *MsgCancelScheduledUpdate) ValidateBasic() error {
+	// validate signer address
+	_, err := sdk.AccAddressFromBech32(m.Authority)
+	return err
+}
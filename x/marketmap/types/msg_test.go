@@ -380,3 +380,174 @@ func TestValidateBasicMsgRemoveMarketAuthorities(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateBasicMsgScheduleMarketUpdates(t *testing.T) {
+	rng := sample.Rand()
+
+	tcs := []struct {
+		name       string
+		msg        types.MsgScheduleMarketUpdates
+		expectPass bool
+	}{
+		{
+			"if the authority is not an acc-address - fail",
+			types.MsgScheduleMarketUpdates{
+				Authority:        "invalid",
+				ActivationHeight: 10,
+				CreateMarkets:    []types.Market{btcusdt},
+			},
+			false,
+		},
+		{
+			"zero activation height - fail",
+			types.MsgScheduleMarketUpdates{
+				Authority:     sample.Address(rng),
+				CreateMarkets: []types.Market{btcusdt},
+			},
+			false,
+		},
+		{
+			"no creates or updates - fail",
+			types.MsgScheduleMarketUpdates{
+				Authority:        sample.Address(rng),
+				ActivationHeight: 10,
+			},
+			false,
+		},
+		{
+			"invalid market - fail",
+			types.MsgScheduleMarketUpdates{
+				Authority:        sample.Address(rng),
+				ActivationHeight: 10,
+				UpdateMarkets:    []types.Market{{Ticker: btcusdt.Ticker}},
+			},
+			false,
+		},
+		{
+			"duplicate creates - fail",
+			types.MsgScheduleMarketUpdates{
+				Authority:        sample.Address(rng),
+				ActivationHeight: 10,
+				CreateMarkets:    []types.Market{btcusdt, btcusdt},
+			},
+			false,
+		},
+		{
+			"valid creates and updates",
+			types.MsgScheduleMarketUpdates{
+				Authority:        sample.Address(rng),
+				ActivationHeight: 10,
+				CreateMarkets:    []types.Market{btcusdt},
+				UpdateMarkets:    []types.Market{usdtusd},
+			},
+			true,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if !tc.expectPass {
+				require.NotNil(t, err)
+			} else {
+				require.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateBasicMsgCancelScheduledUpdate(t *testing.T) {
+	tcs := []struct {
+		name       string
+		msg        types.MsgCancelScheduledUpdate
+		expectPass bool
+	}{
+		{
+			"if the authority is not an acc-address - fail",
+			types.MsgCancelScheduledUpdate{
+				Authority: "invalid",
+			},
+			false,
+		},
+		{
+			"valid message",
+			types.MsgCancelScheduledUpdate{
+				Authority: sample.Address(sample.Rand()),
+				Id:        1,
+			},
+			true,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if !tc.expectPass {
+				require.NotNil(t, err)
+			} else {
+				require.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateBasicMsgRemoveMarkets(t *testing.T) {
+	rng := sample.Rand()
+
+	tcs := []struct {
+		name       string
+		msg        types.MsgRemoveMarkets
+		expectPass bool
+	}{
+		{
+			"if the authority is not an acc-address - fail",
+			types.MsgRemoveMarkets{
+				Authority: "invalid",
+				Markets:   []string{"BTC/USD"},
+			},
+			false,
+		},
+		{
+			"no markets - fail",
+			types.MsgRemoveMarkets{
+				Authority: sample.Address(rng),
+			},
+			false,
+		},
+		{
+			"invalid ticker string - fail",
+			types.MsgRemoveMarkets{
+				Authority: sample.Address(rng),
+				Markets:   []string{"BTCUSD"},
+			},
+			false,
+		},
+		{
+			"duplicate markets - fail",
+			types.MsgRemoveMarkets{
+				Authority: sample.Address(rng),
+				Markets:   []string{"BTC/USD", "BTC/USD"},
+			},
+			false,
+		},
+		{
+			"valid message",
+			types.MsgRemoveMarkets{
+				Authority: sample.Address(rng),
+				Markets:   []string{"BTC/USD", "ETH/USD"},
+			},
+			true,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if !tc.expectPass {
+				require.NotNil(t, err)
+			} else {
+				require.Nil(t, err)
+			}
+		})
+	}
+}
@@ -8,11 +8,15 @@ import (
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
+// DefaultMaxPendingUpdates is the default maximum number of pending scheduled updates.
+const DefaultMaxPendingUpdates = 100
+
 // DefaultParams returns default marketmap parameters.
 func DefaultParams() Params {
 	return Params{
 		MarketAuthorities: []string{authtypes.NewModuleAddress(govtypes.ModuleName).String()},
 		Admin:             authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		MaxPendingUpdates: DefaultMaxPendingUpdates,
 	}
 }
 
@@ -25,6 +29,7 @@ func NewParams(authorities []string, admin string) (Params, error) {
 	return Params{
 		MarketAuthorities: authorities,
 		Admin:             admin,
+		MaxPendingUpdates: DefaultMaxPendingUpdates,
 	}, nil
 }
 
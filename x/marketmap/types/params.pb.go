@@ -30,6 +30,9 @@ type Params struct {
 	// Admin is an address that can remove addresses from the MarketAuthorities
 	// list. Only governance can add to the MarketAuthorities or change the Admin.
 	Admin string `protobuf:"bytes,2,opt,name=admin,proto3" json:"admin,omitempty"`
+	// MaxPendingUpdates is the maximum number of scheduled updates that can be
+	// pending at any given time. A value of zero disables the limit.
+	MaxPendingUpdates uint64 `protobuf:"varint,3,opt,name=max_pending_updates,json=maxPendingUpdates,proto3" json:"max_pending_updates,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -79,6 +82,13 @@ func (m *Params) GetAdmin() string {
 	return ""
 }
 
+func (m *Params) GetMaxPendingUpdates() uint64 {
+	if m != nil {
+		return m.MaxPendingUpdates
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "slinky.marketmap.v1.Params")
 }
@@ -86,19 +96,21 @@ func init() {
 func init() { proto.RegisterFile("slinky/marketmap/v1/params.proto", fileDescriptor_ee4934564ff92a6f) }
 
 var fileDescriptor_ee4934564ff92a6f = []byte{
-	// 189 bytes of a gzipped FileDescriptorProto
+	// 224 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x28, 0xce, 0xc9, 0xcc,
 	0xcb, 0xae, 0xd4, 0xcf, 0x4d, 0x2c, 0xca, 0x4e, 0x2d, 0xc9, 0x4d, 0x2c, 0xd0, 0x2f, 0x33, 0xd4,
 	0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86, 0xa8,
-	0xd0, 0x83, 0xab, 0xd0, 0x2b, 0x33, 0x54, 0xf2, 0xe5, 0x62, 0x0b, 0x00, 0x2b, 0x12, 0xd2, 0xe5,
+	0xd0, 0x83, 0xab, 0xd0, 0x2b, 0x33, 0x54, 0xaa, 0xe5, 0x62, 0x0b, 0x00, 0x2b, 0x12, 0xd2, 0xe5,
 	0x12, 0x82, 0xc8, 0xc4, 0x27, 0x96, 0x96, 0x64, 0xe4, 0x17, 0x65, 0x96, 0x64, 0xa6, 0x16, 0x4b,
 	0x30, 0x2a, 0x30, 0x6b, 0x70, 0x06, 0x09, 0x42, 0x64, 0x1c, 0x11, 0x12, 0x42, 0x22, 0x5c, 0xac,
-	0x89, 0x29, 0xb9, 0x99, 0x79, 0x12, 0x4c, 0x0a, 0x8c, 0x1a, 0x9c, 0x41, 0x10, 0x8e, 0x93, 0xdb,
-	0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c,
-	0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0xe9, 0xa4, 0x67, 0x96, 0x64, 0x94,
-	0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x17, 0x67, 0x67, 0x16, 0xe8, 0xe6, 0xa6, 0x96, 0xe9, 0x43,
-	0xdd, 0x5c, 0x81, 0xe4, 0xea, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0xb0, 0x93, 0x8d, 0x01,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0x4a, 0xfb, 0xbf, 0x2c, 0xd6, 0x00, 0x00, 0x00,
+	0x89, 0x29, 0xb9, 0x99, 0x79, 0x12, 0x4c, 0x0a, 0x8c, 0x1a, 0x9c, 0x41, 0x10, 0x8e, 0x90, 0x1e,
+	0x97, 0x70, 0x6e, 0x62, 0x45, 0x7c, 0x41, 0x6a, 0x5e, 0x4a, 0x66, 0x5e, 0x7a, 0x7c, 0x69, 0x41,
+	0x4a, 0x62, 0x49, 0x6a, 0xb1, 0x04, 0xb3, 0x02, 0xa3, 0x06, 0x0b, 0xc8, 0x94, 0x8a, 0x00, 0x88,
+	0x4c, 0x28, 0x44, 0xc2, 0xc9, 0xed, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c,
+	0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2,
+	0x74, 0xd2, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0x8b, 0xb3, 0x33, 0x0b,
+	0x74, 0x73, 0x53, 0xcb, 0xf4, 0xa1, 0x7e, 0xac, 0x40, 0xf2, 0x65, 0x49, 0x65, 0x41, 0x6a, 0x71,
+	0x12, 0x1b, 0xd8, 0x8b, 0xc6, 0x80, 0x01, 0x00, 0x4c, 0xb0, 0xbc, 0x51, 0x06, 0x01, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -121,6 +133,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxPendingUpdates != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxPendingUpdates))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Admin) > 0 {
 		i -= len(m.Admin)
 		copy(dAtA[i:], m.Admin)
@@ -167,6 +184,9 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovParams(uint64(l))
 	}
+	if m.MaxPendingUpdates != 0 {
+		n += 1 + sovParams(uint64(m.MaxPendingUpdates))
+	}
 	return n
 }
 
@@ -269,6 +289,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPendingUpdates", wireType)
+			}
+			m.MaxPendingUpdates = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPendingUpdates |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -4,8 +4,13 @@ import (
 	"fmt"
 
 	"github.com/skip-mev/slinky/pkg/json"
+	slinkytypes "github.com/skip-mev/slinky/pkg/types"
 )
 
+// MaxNormalizationPathLength is the maximum number of currency pairs that can be included
+// in a provider config's normalization path.
+const MaxNormalizationPathLength = 4
+
 // ValidateBasic performs basic validation on a ProviderConfig.
 func (pc *ProviderConfig) ValidateBasic() error {
 	if len(pc.Name) == 0 {
@@ -23,6 +28,35 @@ func (pc *ProviderConfig) ValidateBasic() error {
 		}
 	}
 
+	if len(pc.NormalizationPath) > 0 {
+		if pc.NormalizeByPair != nil {
+			return fmt.Errorf("provider config cannot set both a normalize by pair and a normalization path")
+		}
+
+		if len(pc.NormalizationPath) > MaxNormalizationPathLength {
+			return fmt.Errorf(
+				"normalization path must have at most %d pairs; got %d",
+				MaxNormalizationPathLength,
+				len(pc.NormalizationPath),
+			)
+		}
+
+		for i, pair := range pc.NormalizationPath {
+			if err := pair.ValidateBasic(); err != nil {
+				return err
+			}
+
+			// Each hop must convert the quote of the previous hop i.e. ETH/USDT -> USDT/USD.
+			if i > 0 && pc.NormalizationPath[i-1].Quote != pair.Base {
+				return fmt.Errorf(
+					"normalization path is not contiguous: %s cannot be followed by %s",
+					pc.NormalizationPath[i-1].String(),
+					pair.String(),
+				)
+			}
+		}
+	}
+
 	if len(pc.Metadata_JSON) > MaxMetadataJSONFieldLength {
 		return fmt.Errorf("metadata json field is longer than maximum length of %d", MaxMetadataJSONFieldLength)
 	}
@@ -62,5 +96,26 @@ func (pc *ProviderConfig) Equal(other ProviderConfig) bool {
 		}
 	}
 
+	if len(pc.NormalizationPath) != len(other.NormalizationPath) {
+		return false
+	}
+
+	for i, pair := range pc.NormalizationPath {
+		if !pair.Equal(other.NormalizationPath[i]) {
+			return false
+		}
+	}
+
 	return pc.Metadata_JSON == other.Metadata_JSON
 }
+
+// Normalizations returns the ordered list of currency pairs that the provider's price must
+// be normalized by to resolve the price of the market's ticker. This is either the
+// normalization path or the normalize by pair (if set).
+func (pc *ProviderConfig) Normalizations() []slinkytypes.CurrencyPair {
+	if pc.NormalizeByPair != nil {
+		return []slinkytypes.CurrencyPair{*pc.NormalizeByPair}
+	}
+
+	return pc.NormalizationPath
+}
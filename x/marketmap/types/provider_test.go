@@ -52,6 +52,53 @@ func TestProviderConfigValidateBasic(t *testing.T) {
 		}
 		require.Error(t, pc.ValidateBasic())
 	})
+	t.Run("valid config with normalization path - pass", func(t *testing.T) {
+		pc := types.ProviderConfig{
+			Name:           "mexc",
+			OffChainTicker: "ticker",
+			NormalizationPath: []slinkytypes.CurrencyPair{
+				slinkytypes.NewCurrencyPair("ETH", "USDT"),
+				slinkytypes.NewCurrencyPair("USDT", "USD"),
+			},
+		}
+		require.NoError(t, pc.ValidateBasic())
+		require.Equal(t, pc.NormalizationPath, pc.Normalizations())
+	})
+	t.Run("invalid config with normalize by and normalization path - fail", func(t *testing.T) {
+		pc := types.ProviderConfig{
+			Name:            "mexc",
+			OffChainTicker:  "ticker",
+			NormalizeByPair: &slinkytypes.CurrencyPair{Base: "USDT", Quote: "USD"},
+			NormalizationPath: []slinkytypes.CurrencyPair{
+				slinkytypes.NewCurrencyPair("USDT", "USD"),
+			},
+		}
+		require.Error(t, pc.ValidateBasic())
+	})
+	t.Run("invalid config with non-contiguous normalization path - fail", func(t *testing.T) {
+		pc := types.ProviderConfig{
+			Name:           "mexc",
+			OffChainTicker: "ticker",
+			NormalizationPath: []slinkytypes.CurrencyPair{
+				slinkytypes.NewCurrencyPair("ETH", "USDC"),
+				slinkytypes.NewCurrencyPair("USDT", "USD"),
+			},
+		}
+		require.Error(t, pc.ValidateBasic())
+	})
+	t.Run("invalid config with normalization path that is too long - fail", func(t *testing.T) {
+		path := make([]slinkytypes.CurrencyPair, types.MaxNormalizationPathLength+1)
+		for i := range path {
+			path[i] = slinkytypes.NewCurrencyPair("USD", "USD")
+		}
+
+		pc := types.ProviderConfig{
+			Name:              "mexc",
+			OffChainTicker:    "ticker",
+			NormalizationPath: path,
+		}
+		require.Error(t, pc.ValidateBasic())
+	})
 	t.Run("invalid name - fail", func(t *testing.T) {
 		pc := types.ProviderConfig{
 			Name:           "",
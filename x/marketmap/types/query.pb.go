@@ -395,6 +395,92 @@ func (m *LastUpdatedResponse) GetLastUpdated() uint64 {
 	return 0
 }
 
+// PendingUpdatesRequest is the request type for the Query/PendingUpdates RPC
+// method.
+type PendingUpdatesRequest struct {
+}
+
+func (m *PendingUpdatesRequest) Reset()         { *m = PendingUpdatesRequest{} }
+func (m *PendingUpdatesRequest) String() string { return proto.CompactTextString(m) }
+func (*PendingUpdatesRequest) ProtoMessage()    {}
+func (*PendingUpdatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b5d6ff68f3c474a0, []int{8}
+}
+func (m *PendingUpdatesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingUpdatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingUpdatesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingUpdatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingUpdatesRequest.Merge(m, src)
+}
+func (m *PendingUpdatesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingUpdatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingUpdatesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingUpdatesRequest proto.InternalMessageInfo
+
+// PendingUpdatesResponse is the response type for the Query/PendingUpdates
+// RPC method.
+type PendingUpdatesResponse struct {
+	// Updates is the list of pending scheduled updates ordered by activation
+	// height.
+	Updates []ScheduledUpdate `protobuf:"bytes,1,rep,name=updates,proto3" json:"updates"`
+}
+
+func (m *PendingUpdatesResponse) Reset()         { *m = PendingUpdatesResponse{} }
+func (m *PendingUpdatesResponse) String() string { return proto.CompactTextString(m) }
+func (*PendingUpdatesResponse) ProtoMessage()    {}
+func (*PendingUpdatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b5d6ff68f3c474a0, []int{9}
+}
+func (m *PendingUpdatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingUpdatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingUpdatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingUpdatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingUpdatesResponse.Merge(m, src)
+}
+func (m *PendingUpdatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingUpdatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingUpdatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingUpdatesResponse proto.InternalMessageInfo
+
+func (m *PendingUpdatesResponse) GetUpdates() []ScheduledUpdate {
+	if m != nil {
+		return m.Updates
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MarketMapRequest)(nil), "slinky.marketmap.v1.MarketMapRequest")
 	proto.RegisterType((*MarketMapResponse)(nil), "slinky.marketmap.v1.MarketMapResponse")
@@ -404,46 +490,53 @@ func init() {
 	proto.RegisterType((*ParamsResponse)(nil), "slinky.marketmap.v1.ParamsResponse")
 	proto.RegisterType((*LastUpdatedRequest)(nil), "slinky.marketmap.v1.LastUpdatedRequest")
 	proto.RegisterType((*LastUpdatedResponse)(nil), "slinky.marketmap.v1.LastUpdatedResponse")
+	proto.RegisterType((*PendingUpdatesRequest)(nil), "slinky.marketmap.v1.PendingUpdatesRequest")
+	proto.RegisterType((*PendingUpdatesResponse)(nil), "slinky.marketmap.v1.PendingUpdatesResponse")
 }
 
 func init() { proto.RegisterFile("slinky/marketmap/v1/query.proto", fileDescriptor_b5d6ff68f3c474a0) }
 
 var fileDescriptor_b5d6ff68f3c474a0 = []byte{
-	// 543 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0xcd, 0x96, 0x10, 0xc8, 0xa4, 0x29, 0xb0, 0xed, 0x21, 0xa4, 0xd4, 0x75, 0x1d, 0x51, 0x82,
-	0x04, 0xb6, 0x5a, 0x2e, 0x70, 0x6d, 0x25, 0x04, 0x2a, 0x95, 0x4a, 0x24, 0x0e, 0x70, 0x89, 0xb6,
-	0xce, 0xca, 0xb5, 0x12, 0x7b, 0xb7, 0xfe, 0x88, 0xc8, 0xb5, 0x47, 0xb8, 0x20, 0x21, 0xf1, 0x9b,
-	0x7a, 0xac, 0xc4, 0x85, 0x13, 0x42, 0x09, 0x3f, 0x04, 0x79, 0x3f, 0x52, 0x87, 0x26, 0xce, 0x2d,
-	0x3b, 0xfb, 0xe6, 0xbd, 0xb7, 0x6f, 0x26, 0x86, 0xed, 0x78, 0xe0, 0x87, 0xfd, 0x91, 0x13, 0x90,
-	0xa8, 0x4f, 0x93, 0x80, 0x70, 0x67, 0xb8, 0xe7, 0x9c, 0xa7, 0x34, 0x1a, 0xd9, 0x3c, 0x62, 0x09,
-	0xc3, 0xeb, 0x12, 0x60, 0x4f, 0x01, 0xf6, 0x70, 0xaf, 0xb9, 0xe1, 0x31, 0x8f, 0x89, 0x7b, 0x27,
-	0xfb, 0x25, 0xa1, 0xcd, 0x47, 0x1e, 0x63, 0xde, 0x80, 0x3a, 0x84, 0xfb, 0x0e, 0x09, 0x43, 0x96,
-	0x90, 0xc4, 0x67, 0x61, 0xac, 0x6e, 0x5b, 0x4a, 0x29, 0x19, 0x71, 0x1a, 0x67, 0x2a, 0x6e, 0x1a,
-	0x45, 0x34, 0x74, 0x47, 0x5d, 0x4e, 0xfc, 0x48, 0x81, 0xcc, 0x79, 0x76, 0xe4, 0xa1, 0x08, 0xc1,
-	0x49, 0x44, 0x02, 0x25, 0x64, 0x61, 0xb8, 0x7f, 0x2c, 0x2e, 0x8f, 0x09, 0xef, 0xd0, 0xf3, 0x94,
-	0xc6, 0x89, 0xf5, 0x03, 0xc1, 0x83, 0x5c, 0x31, 0xe6, 0x2c, 0x8c, 0x29, 0x3e, 0x04, 0x90, 0x34,
-	0xdd, 0x80, 0xf0, 0x06, 0x32, 0x51, 0xbb, 0xb6, 0x6f, 0xd8, 0x73, 0x1e, 0x6c, 0x4f, 0x7b, 0x0f,
-	0xca, 0x97, 0xbf, 0xb7, 0x4b, 0x9d, 0x6a, 0xa0, 0x0b, 0x78, 0x07, 0x56, 0x07, 0x24, 0x4e, 0xba,
-	0x29, 0xef, 0x91, 0x84, 0xf6, 0x1a, 0x2b, 0x26, 0x6a, 0x97, 0x3b, 0xb5, 0xac, 0xf6, 0x41, 0x96,
-	0xf0, 0x43, 0xb8, 0xeb, 0x9e, 0x11, 0x3f, 0xec, 0xfa, 0xbd, 0xc6, 0x2d, 0x13, 0xb5, 0xab, 0x9d,
-	0x3b, 0xe2, 0xfc, 0xb6, 0x67, 0x7d, 0x84, 0xba, 0xe4, 0x56, 0x4e, 0xf1, 0x1b, 0xa8, 0xcf, 0x04,
-	0xa3, 0x6c, 0x6d, 0x69, 0x5b, 0x22, 0xbe, 0xcc, 0xd2, 0xa1, 0x42, 0x9d, 0x10, 0x3f, 0x52, 0xae,
-	0x56, 0xdd, 0x5c, 0xcd, 0x3a, 0x82, 0x35, 0x4d, 0xad, 0xde, 0xfb, 0x0a, 0x2a, 0xd2, 0xb7, 0x22,
-	0xdd, 0x2c, 0x78, 0xab, 0xa2, 0x54, 0x0d, 0xd6, 0x3d, 0xa8, 0x9f, 0x88, 0x90, 0x75, 0xa2, 0x47,
-	0xb0, 0xa6, 0x0b, 0xd7, 0xec, 0x72, 0x0e, 0x85, 0xec, 0xb2, 0x49, 0xb3, 0xcb, 0x06, 0x6b, 0x03,
-	0xf0, 0xbb, 0xeb, 0xbc, 0xb4, 0xc4, 0x4b, 0x58, 0x9f, 0xa9, 0x2a, 0x9d, 0xff, 0x03, 0x47, 0x37,
-	0x02, 0xdf, 0xff, 0x52, 0x86, 0xdb, 0xef, 0xb3, 0x25, 0xc6, 0x17, 0x08, 0xaa, 0xd3, 0xe1, 0xe1,
-	0xc7, 0xc5, 0xc3, 0x55, 0xc2, 0xcd, 0xdd, 0x65, 0x30, 0xe9, 0xc4, 0xda, 0xbd, 0xf8, 0xf9, 0xf7,
-	0xfb, 0x8a, 0x89, 0x0d, 0x67, 0xf1, 0xda, 0x06, 0x84, 0xe3, 0x21, 0x54, 0x64, 0x33, 0xb6, 0x0a,
-	0x98, 0xb5, 0x7a, 0xab, 0x10, 0xa3, 0xa4, 0x5b, 0x42, 0x7a, 0x0b, 0x6f, 0x16, 0x48, 0xe3, 0xaf,
-	0x08, 0x6a, 0xb9, 0x04, 0xf1, 0x93, 0xb9, 0xcc, 0x37, 0x93, 0x6f, 0xb6, 0x97, 0x03, 0x95, 0x8f,
-	0xa7, 0xc2, 0x47, 0x0b, 0xef, 0xcc, 0xf5, 0x91, 0x9f, 0x53, 0x96, 0x82, 0x1c, 0xfe, 0x82, 0x14,
-	0x66, 0xf6, 0x6b, 0x41, 0x0a, 0xb3, 0x2b, 0xb7, 0x24, 0x05, 0xb9, 0x5c, 0x07, 0xaf, 0x2f, 0xc7,
-	0x06, 0xba, 0x1a, 0x1b, 0xe8, 0xcf, 0xd8, 0x40, 0xdf, 0x26, 0x46, 0xe9, 0x6a, 0x62, 0x94, 0x7e,
-	0x4d, 0x8c, 0xd2, 0xa7, 0x67, 0x9e, 0x9f, 0x9c, 0xa5, 0xa7, 0xb6, 0xcb, 0x02, 0x27, 0xee, 0xfb,
-	0xfc, 0x79, 0x40, 0x87, 0x9a, 0xe9, 0x73, 0x8e, 0x4b, 0xfc, 0xe7, 0x4e, 0x2b, 0xe2, 0xf3, 0xf2,
-	0xe2, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x7e, 0x7b, 0x92, 0x92, 0x33, 0x05, 0x00, 0x00,
+	// 618 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0xcd, 0xf6, 0x93, 0x4c, 0x9a, 0x00, 0xdb, 0x02, 0xc1, 0xa5, 0x6e, 0xea, 0x94, 0x10, 0xa0,
+	0xd8, 0x6a, 0xb8, 0xc0, 0xb5, 0x45, 0x08, 0x54, 0x2a, 0x85, 0x20, 0x0e, 0x70, 0x20, 0xda, 0xda,
+	0x2b, 0xc7, 0x4a, 0x6c, 0x6f, 0xfd, 0x11, 0x91, 0x6b, 0xaf, 0x5c, 0x90, 0x90, 0xe0, 0x0f, 0xf0,
+	0x63, 0x7a, 0xac, 0xc4, 0x85, 0x13, 0x42, 0x09, 0x3f, 0x04, 0xd9, 0xbb, 0x4e, 0x93, 0xd6, 0x75,
+	0x7b, 0xb3, 0x67, 0xdf, 0xbc, 0xf7, 0xe6, 0xed, 0xd8, 0xb0, 0xee, 0xf7, 0x2c, 0xa7, 0x3b, 0xd0,
+	0x6c, 0xe2, 0x75, 0x69, 0x60, 0x13, 0xa6, 0xf5, 0xb7, 0xb5, 0xc3, 0x90, 0x7a, 0x03, 0x95, 0x79,
+	0x6e, 0xe0, 0xe2, 0x65, 0x0e, 0x50, 0xc7, 0x00, 0xb5, 0xbf, 0x2d, 0xad, 0x98, 0xae, 0xe9, 0xc6,
+	0xe7, 0x5a, 0xf4, 0xc4, 0xa1, 0xd2, 0x3d, 0xd3, 0x75, 0xcd, 0x1e, 0xd5, 0x08, 0xb3, 0x34, 0xe2,
+	0x38, 0x6e, 0x40, 0x02, 0xcb, 0x75, 0x7c, 0x71, 0x5a, 0x15, 0x4a, 0xc1, 0x80, 0x51, 0x3f, 0x52,
+	0xd1, 0x43, 0xcf, 0xa3, 0x8e, 0x3e, 0x68, 0x33, 0x62, 0x79, 0x02, 0x54, 0x49, 0xb3, 0xc3, 0x5f,
+	0xb2, 0x10, 0x8c, 0x78, 0xc4, 0x16, 0x42, 0x0a, 0x86, 0x1b, 0xfb, 0xf1, 0xe1, 0x3e, 0x61, 0x2d,
+	0x7a, 0x18, 0x52, 0x3f, 0x50, 0xbe, 0x23, 0xb8, 0x39, 0x51, 0xf4, 0x99, 0xeb, 0xf8, 0x14, 0xef,
+	0x02, 0x70, 0x9a, 0xb6, 0x4d, 0x58, 0x19, 0x55, 0x50, 0xbd, 0xd0, 0x90, 0xd5, 0x94, 0x81, 0xd5,
+	0x71, 0xef, 0xce, 0xdc, 0xf1, 0x9f, 0xf5, 0x5c, 0x2b, 0x6f, 0x27, 0x05, 0xbc, 0x01, 0x4b, 0x3d,
+	0xe2, 0x07, 0xed, 0x90, 0x19, 0x24, 0xa0, 0x46, 0x79, 0xa6, 0x82, 0xea, 0x73, 0xad, 0x42, 0x54,
+	0x7b, 0xcf, 0x4b, 0xf8, 0x2e, 0x5c, 0xd3, 0x3b, 0xc4, 0x72, 0xda, 0x96, 0x51, 0x9e, 0xad, 0xa0,
+	0x7a, 0xbe, 0xb5, 0x18, 0xbf, 0xbf, 0x36, 0x94, 0x0f, 0x50, 0xe4, 0xdc, 0xc2, 0x29, 0x7e, 0x05,
+	0xc5, 0xa9, 0x60, 0x84, 0xad, 0xb5, 0xc4, 0x56, 0x1c, 0x5f, 0x64, 0x69, 0x57, 0xa0, 0x9a, 0xc4,
+	0xf2, 0x84, 0xab, 0x25, 0x7d, 0xa2, 0xa6, 0xec, 0x41, 0x29, 0xa1, 0x16, 0xf3, 0x3e, 0x87, 0x05,
+	0xee, 0x5b, 0x90, 0xae, 0x66, 0xcc, 0x2a, 0x28, 0x45, 0x83, 0x72, 0x1d, 0x8a, 0xcd, 0x38, 0xe4,
+	0x24, 0xd1, 0x3d, 0x28, 0x25, 0x85, 0x53, 0x76, 0x7e, 0x0f, 0x99, 0xec, 0xbc, 0x29, 0x61, 0xe7,
+	0x0d, 0xca, 0x0a, 0xe0, 0x37, 0xa7, 0x79, 0x25, 0x12, 0xcf, 0x60, 0x79, 0xaa, 0x2a, 0x74, 0xce,
+	0x06, 0x8e, 0xce, 0x05, 0xae, 0xdc, 0x81, 0x5b, 0x4d, 0xea, 0x18, 0x96, 0x63, 0xf2, 0xca, 0xd8,
+	0xf5, 0x27, 0xb8, 0x7d, 0xf6, 0x40, 0xb0, 0xbe, 0x80, 0x45, 0x4e, 0x18, 0xd9, 0x9f, 0xad, 0x17,
+	0x1a, 0x9b, 0xa9, 0xf6, 0xdf, 0xe9, 0x1d, 0x6a, 0x84, 0x3d, 0x6a, 0xf0, 0x7e, 0x31, 0x47, 0xd2,
+	0xda, 0xf8, 0x39, 0x0f, 0xf3, 0x6f, 0xa3, 0xaf, 0x07, 0x1f, 0x21, 0xc8, 0x8f, 0xb7, 0x06, 0xdf,
+	0xcf, 0xde, 0x2a, 0x61, 0x4f, 0xaa, 0x5d, 0x06, 0xe3, 0x66, 0x95, 0xda, 0xd1, 0xaf, 0x7f, 0xdf,
+	0x66, 0x2a, 0x58, 0xd6, 0x2e, 0xfe, 0x5e, 0x6c, 0xc2, 0x70, 0x1f, 0x16, 0x78, 0x33, 0x56, 0x32,
+	0x98, 0x13, 0xf5, 0x6a, 0x26, 0x46, 0x48, 0x57, 0x63, 0xe9, 0x35, 0xbc, 0x9a, 0x21, 0x8d, 0xbf,
+	0x20, 0x28, 0x4c, 0x5c, 0x1d, 0x7e, 0x90, 0xca, 0x7c, 0xfe, 0xca, 0xa5, 0xfa, 0xe5, 0x40, 0xe1,
+	0xe3, 0x61, 0xec, 0xa3, 0x8a, 0x37, 0x52, 0x7d, 0x4c, 0x2e, 0x48, 0x94, 0x02, 0xdf, 0xba, 0x0b,
+	0x52, 0x98, 0x5a, 0x6c, 0xa9, 0x9a, 0x89, 0xb9, 0x52, 0x0a, 0x7c, 0xab, 0xf1, 0x0f, 0x04, 0xa5,
+	0xe9, 0x6d, 0xc3, 0x8f, 0xd2, 0xc9, 0xd3, 0x76, 0x55, 0x7a, 0x7c, 0x25, 0xac, 0x30, 0xb4, 0x15,
+	0x1b, 0xaa, 0xe1, 0xcd, 0x74, 0x43, 0xbc, 0x49, 0x24, 0xe2, 0xef, 0xbc, 0x3c, 0x1e, 0xca, 0xe8,
+	0x64, 0x28, 0xa3, 0xbf, 0x43, 0x19, 0x7d, 0x1d, 0xc9, 0xb9, 0x93, 0x91, 0x9c, 0xfb, 0x3d, 0x92,
+	0x73, 0x1f, 0xb7, 0x4c, 0x2b, 0xe8, 0x84, 0x07, 0xaa, 0xee, 0xda, 0x9a, 0xdf, 0xb5, 0xd8, 0x13,
+	0x9b, 0xf6, 0x13, 0xca, 0xcf, 0x13, 0xa4, 0xf1, 0x6f, 0xe8, 0x60, 0x21, 0xfe, 0xe3, 0x3e, 0xfd,
+	0x3f, 0x00, 0x4f, 0x28, 0x3a, 0xf5, 0x46, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -468,6 +561,9 @@ type QueryClient interface {
 	LastUpdated(ctx context.Context, in *LastUpdatedRequest, opts ...grpc.CallOption) (*LastUpdatedResponse, error)
 	// Params returns the current x/marketmap module parameters.
 	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
+	// PendingUpdates returns the scheduled market map updates that have not yet
+	// been applied, ordered by activation height.
+	PendingUpdates(ctx context.Context, in *PendingUpdatesRequest, opts ...grpc.CallOption) (*PendingUpdatesResponse, error)
 }
 
 type queryClient struct {
@@ -514,6 +610,15 @@ func (c *queryClient) Params(ctx context.Context, in *ParamsRequest, opts ...grp
 	return out, nil
 }
 
+func (c *queryClient) PendingUpdates(ctx context.Context, in *PendingUpdatesRequest, opts ...grpc.CallOption) (*PendingUpdatesResponse, error) {
+	out := new(PendingUpdatesResponse)
+	err := c.cc.Invoke(ctx, "/slinky.marketmap.v1.Query/PendingUpdates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// MarketMap returns the full market map stored in the x/marketmap
@@ -526,6 +631,9 @@ type QueryServer interface {
 	LastUpdated(context.Context, *LastUpdatedRequest) (*LastUpdatedResponse, error)
 	// Params returns the current x/marketmap module parameters.
 	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
+	// PendingUpdates returns the scheduled market map updates that have not yet
+	// been applied, ordered by activation height.
+	PendingUpdates(context.Context, *PendingUpdatesRequest) (*PendingUpdatesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -544,6 +652,9 @@ func (*UnimplementedQueryServer) LastUpdated(ctx context.Context, req *LastUpdat
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *ParamsRequest) (*ParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) PendingUpdates(ctx context.Context, req *PendingUpdatesRequest) (*PendingUpdatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingUpdates not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -621,6 +732,24 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PendingUpdates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PendingUpdatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingUpdates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/slinky.marketmap.v1.Query/PendingUpdates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingUpdates(ctx, req.(*PendingUpdatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "slinky.marketmap.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -641,6 +770,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "PendingUpdates",
+			Handler:    _Query_PendingUpdates_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "slinky/marketmap/v1/query.proto",
@@ -887,6 +1020,66 @@ func (m *LastUpdatedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PendingUpdatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingUpdatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingUpdatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingUpdatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingUpdatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingUpdatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Updates) > 0 {
+		for iNdEx := len(m.Updates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Updates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -988,6 +1181,30 @@ func (m *LastUpdatedResponse) Size() (n int) {
 	return n
 }
 
+func (m *PendingUpdatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *PendingUpdatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Updates) > 0 {
+		for _, e := range m.Updates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1596,6 +1813,140 @@ func (m *LastUpdatedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PendingUpdatesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingUpdatesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingUpdatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingUpdatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingUpdatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingUpdatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Updates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Updates = append(m.Updates, ScheduledUpdate{})
+			if err := m.Updates[len(m.Updates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
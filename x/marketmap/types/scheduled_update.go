@@ -0,0 +1,39 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidateBasic performs stateless validation of a ScheduledUpdate.
+func (u *ScheduledUpdate) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(u.Authority); err != nil {
+		return err
+	}
+
+	if u.ActivationHeight == 0 {
+		return fmt.Errorf("activation height must be positive")
+	}
+
+	if len(u.CreateMarkets) == 0 && len(u.UpdateMarkets) == 0 {
+		return fmt.Errorf("no markets to create or update")
+	}
+
+	for _, markets := range [][]Market{u.CreateMarkets, u.UpdateMarkets} {
+		seen := make(map[string]struct{}, len(markets))
+		for _, market := range markets {
+			if err := market.ValidateBasic(); err != nil {
+				return err
+			}
+
+			ticker := market.Ticker.String()
+			if _, found := seen[ticker]; found {
+				return fmt.Errorf("duplicate market %s found", ticker)
+			}
+			seen[ticker] = struct{}{}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package types
+
+import "fmt"
+
+// statusTransitions maps each stored market status to the statuses a market may transition to. A market may
+// always remain in its current status.
+var statusTransitions = map[MarketStatus][]MarketStatus{
+	MarketStatus_MARKET_STATUS_PROPOSED: {
+		MarketStatus_MARKET_STATUS_ENABLED,
+		MarketStatus_MARKET_STATUS_DISABLED,
+		MarketStatus_MARKET_STATUS_DEPRECATED,
+		MarketStatus_MARKET_STATUS_REMOVED,
+	},
+	MarketStatus_MARKET_STATUS_ENABLED: {
+		MarketStatus_MARKET_STATUS_DISABLED,
+		MarketStatus_MARKET_STATUS_DEPRECATED,
+	},
+	MarketStatus_MARKET_STATUS_DISABLED: {
+		MarketStatus_MARKET_STATUS_ENABLED,
+		MarketStatus_MARKET_STATUS_DEPRECATED,
+		MarketStatus_MARKET_STATUS_REMOVED,
+	},
+	MarketStatus_MARKET_STATUS_DEPRECATED: {
+		MarketStatus_MARKET_STATUS_REMOVED,
+	},
+}
+
+// ValidateStatusTransition returns an error if a market cannot transition from one status to another.
+func ValidateStatusTransition(from, to MarketStatus) error {
+	if from == to {
+		return nil
+	}
+
+	for _, status := range statusTransitions[from] {
+		if status == to {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("invalid market status transition from %s to %s", from, to)
+}
+
+// ValidateCreateStatus returns an error if a market cannot be created with the given status.
+func ValidateCreateStatus(status MarketStatus) error {
+	switch status {
+	case MarketStatus_MARKET_STATUS_PROPOSED, MarketStatus_MARKET_STATUS_ENABLED, MarketStatus_MARKET_STATUS_DISABLED:
+		return nil
+	default:
+		return fmt.Errorf("markets cannot be created with status %s", status)
+	}
+}
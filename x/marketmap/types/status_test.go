@@ -0,0 +1,128 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/x/marketmap/types"
+)
+
+func TestLifecycleStatus(t *testing.T) {
+	testCases := []struct {
+		name     string
+		ticker   types.Ticker
+		expected types.MarketStatus
+	}{
+		{
+			name:     "unspecified and enabled",
+			ticker:   types.Ticker{Enabled: true},
+			expected: types.MarketStatus_MARKET_STATUS_ENABLED,
+		},
+		{
+			name:     "unspecified and disabled",
+			ticker:   types.Ticker{},
+			expected: types.MarketStatus_MARKET_STATUS_DISABLED,
+		},
+		{
+			name:     "specified",
+			ticker:   types.Ticker{Status: types.MarketStatus_MARKET_STATUS_PROPOSED},
+			expected: types.MarketStatus_MARKET_STATUS_PROPOSED,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, tc.ticker.LifecycleStatus())
+		})
+	}
+}
+
+func TestValidateStatusTransition(t *testing.T) {
+	testCases := []struct {
+		name   string
+		from   types.MarketStatus
+		to     types.MarketStatus
+		expErr bool
+	}{
+		{
+			name:   "same status",
+			from:   types.MarketStatus_MARKET_STATUS_DEPRECATED,
+			to:     types.MarketStatus_MARKET_STATUS_DEPRECATED,
+			expErr: false,
+		},
+		{
+			name:   "proposed to enabled",
+			from:   types.MarketStatus_MARKET_STATUS_PROPOSED,
+			to:     types.MarketStatus_MARKET_STATUS_ENABLED,
+			expErr: false,
+		},
+		{
+			name:   "proposed to removed",
+			from:   types.MarketStatus_MARKET_STATUS_PROPOSED,
+			to:     types.MarketStatus_MARKET_STATUS_REMOVED,
+			expErr: false,
+		},
+		{
+			name:   "enabled to disabled",
+			from:   types.MarketStatus_MARKET_STATUS_ENABLED,
+			to:     types.MarketStatus_MARKET_STATUS_DISABLED,
+			expErr: false,
+		},
+		{
+			name:   "enabled to removed",
+			from:   types.MarketStatus_MARKET_STATUS_ENABLED,
+			to:     types.MarketStatus_MARKET_STATUS_REMOVED,
+			expErr: true,
+		},
+		{
+			name:   "enabled to proposed",
+			from:   types.MarketStatus_MARKET_STATUS_ENABLED,
+			to:     types.MarketStatus_MARKET_STATUS_PROPOSED,
+			expErr: true,
+		},
+		{
+			name:   "disabled to enabled",
+			from:   types.MarketStatus_MARKET_STATUS_DISABLED,
+			to:     types.MarketStatus_MARKET_STATUS_ENABLED,
+			expErr: false,
+		},
+		{
+			name:   "disabled to removed",
+			from:   types.MarketStatus_MARKET_STATUS_DISABLED,
+			to:     types.MarketStatus_MARKET_STATUS_REMOVED,
+			expErr: false,
+		},
+		{
+			name:   "deprecated to enabled",
+			from:   types.MarketStatus_MARKET_STATUS_DEPRECATED,
+			to:     types.MarketStatus_MARKET_STATUS_ENABLED,
+			expErr: true,
+		},
+		{
+			name:   "deprecated to removed",
+			from:   types.MarketStatus_MARKET_STATUS_DEPRECATED,
+			to:     types.MarketStatus_MARKET_STATUS_REMOVED,
+			expErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.ValidateStatusTransition(tc.from, tc.to)
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateCreateStatus(t *testing.T) {
+	require.NoError(t, types.ValidateCreateStatus(types.MarketStatus_MARKET_STATUS_PROPOSED))
+	require.NoError(t, types.ValidateCreateStatus(types.MarketStatus_MARKET_STATUS_ENABLED))
+	require.NoError(t, types.ValidateCreateStatus(types.MarketStatus_MARKET_STATUS_DISABLED))
+	require.Error(t, types.ValidateCreateStatus(types.MarketStatus_MARKET_STATUS_DEPRECATED))
+	require.Error(t, types.ValidateCreateStatus(types.MarketStatus_MARKET_STATUS_REMOVED))
+}
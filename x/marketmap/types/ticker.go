@@ -60,6 +60,40 @@ func (t *Ticker) ValidateBasic() error {
 		return fmt.Errorf("invalid ticker metadata json: %w", err)
 	}
 
+	return t.validateStatus()
+}
+
+// LifecycleStatus returns the lifecycle status of the Ticker. If the status is unspecified, it is derived from
+// the Enabled flag.
+func (t Ticker) LifecycleStatus() MarketStatus {
+	if t.Status != MarketStatus_MARKET_STATUS_UNSPECIFIED {
+		return t.Status
+	}
+
+	if t.Enabled {
+		return MarketStatus_MARKET_STATUS_ENABLED
+	}
+
+	return MarketStatus_MARKET_STATUS_DISABLED
+}
+
+// validateStatus checks that the status of the Ticker is a stored status and is consistent with the Enabled flag.
+func (t *Ticker) validateStatus() error {
+	switch t.Status {
+	case MarketStatus_MARKET_STATUS_UNSPECIFIED:
+		return nil
+	case MarketStatus_MARKET_STATUS_REMOVED:
+		return fmt.Errorf("status of %s cannot be %s; markets must be removed with MsgRemoveMarkets", t.CurrencyPair.String(), t.Status)
+	}
+
+	if _, ok := MarketStatus_name[int32(t.Status)]; !ok {
+		return fmt.Errorf("unknown market status %d for %s", t.Status, t.CurrencyPair.String())
+	}
+
+	if t.Enabled != (t.Status == MarketStatus_MARKET_STATUS_ENABLED) {
+		return fmt.Errorf("enabled flag (%t) of %s is inconsistent with status %s", t.Enabled, t.CurrencyPair.String(), t.Status)
+	}
+
 	return nil
 }
 
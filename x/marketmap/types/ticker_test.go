@@ -30,6 +30,60 @@ func TestTicker(t *testing.T) {
 			},
 			expErr: false,
 		},
+		{
+			name: "valid ticker with status",
+			ticker: types.Ticker{
+				CurrencyPair: slinkytypes.CurrencyPair{
+					Base:  "BITCOIN",
+					Quote: "USDT",
+				},
+				Decimals:         8,
+				MinProviderCount: 1,
+				Status:           types.MarketStatus_MARKET_STATUS_ENABLED,
+				Enabled:          true,
+			},
+			expErr: false,
+		},
+		{
+			name: "status inconsistent with enabled flag",
+			ticker: types.Ticker{
+				CurrencyPair: slinkytypes.CurrencyPair{
+					Base:  "BITCOIN",
+					Quote: "USDT",
+				},
+				Decimals:         8,
+				MinProviderCount: 1,
+				Status:           types.MarketStatus_MARKET_STATUS_DEPRECATED,
+				Enabled:          true,
+			},
+			expErr: true,
+		},
+		{
+			name: "removed status",
+			ticker: types.Ticker{
+				CurrencyPair: slinkytypes.CurrencyPair{
+					Base:  "BITCOIN",
+					Quote: "USDT",
+				},
+				Decimals:         8,
+				MinProviderCount: 1,
+				Status:           types.MarketStatus_MARKET_STATUS_REMOVED,
+			},
+			expErr: true,
+		},
+		{
+			name: "unknown status",
+			ticker: types.Ticker{
+				CurrencyPair: slinkytypes.CurrencyPair{
+					Base:  "BITCOIN",
+					Quote: "USDT",
+				},
+				Decimals:         8,
+				MinProviderCount: 1,
+				Status:           types.MarketStatus(100),
+			},
+			expErr: true,
+		},
 		{
 			name: "empty base",
 			ticker: types.Ticker{
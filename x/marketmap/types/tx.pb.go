@@ -221,6 +221,101 @@ func (m *MsgUpdateMarketsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateMarketsResponse proto.InternalMessageInfo
 
+// MsgRemoveMarkets defines a message for removing markets from the
+// x/marketmap module.
+type MsgRemoveMarkets struct {
+	// Authority is the signer of this transaction.  This authority must be
+	// authorized by the module to execute the message.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Markets is the list of ticker strings (BASE/QUOTE) of the markets to be
+	// removed.
+	Markets []string `protobuf:"bytes,2,rep,name=markets,proto3" json:"markets,omitempty"`
+}
+
+func (m *MsgRemoveMarkets) Reset()         { *m = MsgRemoveMarkets{} }
+func (m *MsgRemoveMarkets) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveMarkets) ProtoMessage()    {}
+func (*MsgRemoveMarkets) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9adadfc18297083, []int{4}
+}
+func (m *MsgRemoveMarkets) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveMarkets) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveMarkets.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveMarkets) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveMarkets.Merge(m, src)
+}
+func (m *MsgRemoveMarkets) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveMarkets) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveMarkets.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveMarkets proto.InternalMessageInfo
+
+func (m *MsgRemoveMarkets) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgRemoveMarkets) GetMarkets() []string {
+	if m != nil {
+		return m.Markets
+	}
+	return nil
+}
+
+// MsgRemoveMarketsResponse is the response message for MsgRemoveMarkets.
+type MsgRemoveMarketsResponse struct {
+}
+
+func (m *MsgRemoveMarketsResponse) Reset()         { *m = MsgRemoveMarketsResponse{} }
+func (m *MsgRemoveMarketsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveMarketsResponse) ProtoMessage()    {}
+func (*MsgRemoveMarketsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9adadfc18297083, []int{5}
+}
+func (m *MsgRemoveMarketsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveMarketsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveMarketsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveMarketsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveMarketsResponse.Merge(m, src)
+}
+func (m *MsgRemoveMarketsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveMarketsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveMarketsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveMarketsResponse proto.InternalMessageInfo
+
 // MsgParams defines the Msg/Params request type. It contains the
 // new parameters for the x/marketmap module.
 type MsgParams struct {
@@ -235,7 +330,7 @@ func (m *MsgParams) Reset()         { *m = MsgParams{} }
 func (m *MsgParams) String() string { return proto.CompactTextString(m) }
 func (*MsgParams) ProtoMessage()    {}
 func (*MsgParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e9adadfc18297083, []int{4}
+	return fileDescriptor_e9adadfc18297083, []int{6}
 }
 func (m *MsgParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -286,7 +381,7 @@ func (m *MsgParamsResponse) Reset()         { *m = MsgParamsResponse{} }
 func (m *MsgParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgParamsResponse) ProtoMessage()    {}
 func (*MsgParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e9adadfc18297083, []int{5}
+	return fileDescriptor_e9adadfc18297083, []int{7}
 }
 func (m *MsgParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -330,7 +425,7 @@ func (m *MsgRemoveMarketAuthorities) Reset()         { *m = MsgRemoveMarketAutho
 func (m *MsgRemoveMarketAuthorities) String() string { return proto.CompactTextString(m) }
 func (*MsgRemoveMarketAuthorities) ProtoMessage()    {}
 func (*MsgRemoveMarketAuthorities) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e9adadfc18297083, []int{6}
+	return fileDescriptor_e9adadfc18297083, []int{8}
 }
 func (m *MsgRemoveMarketAuthorities) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -382,7 +477,7 @@ func (m *MsgRemoveMarketAuthoritiesResponse) Reset()         { *m = MsgRemoveMar
 func (m *MsgRemoveMarketAuthoritiesResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRemoveMarketAuthoritiesResponse) ProtoMessage()    {}
 func (*MsgRemoveMarketAuthoritiesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e9adadfc18297083, []int{7}
+	return fileDescriptor_e9adadfc18297083, []int{9}
 }
 func (m *MsgRemoveMarketAuthoritiesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -411,57 +506,294 @@ func (m *MsgRemoveMarketAuthoritiesResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRemoveMarketAuthoritiesResponse proto.InternalMessageInfo
 
+// MsgScheduleMarketUpdates defines a message carrying a payload for creating
+// and updating markets in the x/marketmap module at a future block height.
+type MsgScheduleMarketUpdates struct {
+	// Authority is the signer of this transaction.  This authority must be
+	// authorized by the module to execute the message.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// ActivationHeight is the block height at which the updates are applied.
+	// This must be greater than the height the message is executed at.
+	ActivationHeight uint64 `protobuf:"varint,2,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	// CreateMarkets is the list of markets to be created at the activation
+	// height.
+	CreateMarkets []Market `protobuf:"bytes,3,rep,name=create_markets,json=createMarkets,proto3" json:"create_markets"`
+	// UpdateMarkets is the list of markets to be updated at the activation
+	// height.
+	UpdateMarkets []Market `protobuf:"bytes,4,rep,name=update_markets,json=updateMarkets,proto3" json:"update_markets"`
+}
+
+func (m *MsgScheduleMarketUpdates) Reset()         { *m = MsgScheduleMarketUpdates{} }
+func (m *MsgScheduleMarketUpdates) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleMarketUpdates) ProtoMessage()    {}
+func (*MsgScheduleMarketUpdates) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9adadfc18297083, []int{10}
+}
+func (m *MsgScheduleMarketUpdates) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleMarketUpdates) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleMarketUpdates.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleMarketUpdates) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleMarketUpdates.Merge(m, src)
+}
+func (m *MsgScheduleMarketUpdates) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleMarketUpdates) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleMarketUpdates.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleMarketUpdates proto.InternalMessageInfo
+
+func (m *MsgScheduleMarketUpdates) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgScheduleMarketUpdates) GetActivationHeight() uint64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
+}
+
+func (m *MsgScheduleMarketUpdates) GetCreateMarkets() []Market {
+	if m != nil {
+		return m.CreateMarkets
+	}
+	return nil
+}
+
+func (m *MsgScheduleMarketUpdates) GetUpdateMarkets() []Market {
+	if m != nil {
+		return m.UpdateMarkets
+	}
+	return nil
+}
+
+// MsgScheduleMarketUpdatesResponse is the response message for
+// MsgScheduleMarketUpdates.
+type MsgScheduleMarketUpdatesResponse struct {
+	// Id is the identifier of the scheduled update.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgScheduleMarketUpdatesResponse) Reset()         { *m = MsgScheduleMarketUpdatesResponse{} }
+func (m *MsgScheduleMarketUpdatesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleMarketUpdatesResponse) ProtoMessage()    {}
+func (*MsgScheduleMarketUpdatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9adadfc18297083, []int{11}
+}
+func (m *MsgScheduleMarketUpdatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleMarketUpdatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleMarketUpdatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleMarketUpdatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleMarketUpdatesResponse.Merge(m, src)
+}
+func (m *MsgScheduleMarketUpdatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleMarketUpdatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleMarketUpdatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleMarketUpdatesResponse proto.InternalMessageInfo
+
+func (m *MsgScheduleMarketUpdatesResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// MsgCancelScheduledUpdate defines a message for cancelling a pending scheduled
+// update in the x/marketmap module.
+type MsgCancelScheduledUpdate struct {
+	// Authority is the signer of this transaction.  This must be the authority
+	// that scheduled the update or the module admin.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Id is the identifier of the scheduled update to cancel.
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgCancelScheduledUpdate) Reset()         { *m = MsgCancelScheduledUpdate{} }
+func (m *MsgCancelScheduledUpdate) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelScheduledUpdate) ProtoMessage()    {}
+func (*MsgCancelScheduledUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9adadfc18297083, []int{12}
+}
+func (m *MsgCancelScheduledUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelScheduledUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelScheduledUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelScheduledUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelScheduledUpdate.Merge(m, src)
+}
+func (m *MsgCancelScheduledUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelScheduledUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelScheduledUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelScheduledUpdate proto.InternalMessageInfo
+
+func (m *MsgCancelScheduledUpdate) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgCancelScheduledUpdate) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// MsgCancelScheduledUpdateResponse is the response message for
+// MsgCancelScheduledUpdate.
+type MsgCancelScheduledUpdateResponse struct {
+}
+
+func (m *MsgCancelScheduledUpdateResponse) Reset()         { *m = MsgCancelScheduledUpdateResponse{} }
+func (m *MsgCancelScheduledUpdateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelScheduledUpdateResponse) ProtoMessage()    {}
+func (*MsgCancelScheduledUpdateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9adadfc18297083, []int{13}
+}
+func (m *MsgCancelScheduledUpdateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelScheduledUpdateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelScheduledUpdateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelScheduledUpdateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelScheduledUpdateResponse.Merge(m, src)
+}
+func (m *MsgCancelScheduledUpdateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelScheduledUpdateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelScheduledUpdateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelScheduledUpdateResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateMarkets)(nil), "slinky.marketmap.v1.MsgCreateMarkets")
 	proto.RegisterType((*MsgCreateMarketsResponse)(nil), "slinky.marketmap.v1.MsgCreateMarketsResponse")
 	proto.RegisterType((*MsgUpdateMarkets)(nil), "slinky.marketmap.v1.MsgUpdateMarkets")
 	proto.RegisterType((*MsgUpdateMarketsResponse)(nil), "slinky.marketmap.v1.MsgUpdateMarketsResponse")
+	proto.RegisterType((*MsgRemoveMarkets)(nil), "slinky.marketmap.v1.MsgRemoveMarkets")
+	proto.RegisterType((*MsgRemoveMarketsResponse)(nil), "slinky.marketmap.v1.MsgRemoveMarketsResponse")
 	proto.RegisterType((*MsgParams)(nil), "slinky.marketmap.v1.MsgParams")
 	proto.RegisterType((*MsgParamsResponse)(nil), "slinky.marketmap.v1.MsgParamsResponse")
 	proto.RegisterType((*MsgRemoveMarketAuthorities)(nil), "slinky.marketmap.v1.MsgRemoveMarketAuthorities")
 	proto.RegisterType((*MsgRemoveMarketAuthoritiesResponse)(nil), "slinky.marketmap.v1.MsgRemoveMarketAuthoritiesResponse")
+	proto.RegisterType((*MsgScheduleMarketUpdates)(nil), "slinky.marketmap.v1.MsgScheduleMarketUpdates")
+	proto.RegisterType((*MsgScheduleMarketUpdatesResponse)(nil), "slinky.marketmap.v1.MsgScheduleMarketUpdatesResponse")
+	proto.RegisterType((*MsgCancelScheduledUpdate)(nil), "slinky.marketmap.v1.MsgCancelScheduledUpdate")
+	proto.RegisterType((*MsgCancelScheduledUpdateResponse)(nil), "slinky.marketmap.v1.MsgCancelScheduledUpdateResponse")
 }
 
 func init() { proto.RegisterFile("slinky/marketmap/v1/tx.proto", fileDescriptor_e9adadfc18297083) }
 
 var fileDescriptor_e9adadfc18297083 = []byte{
-	// 562 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xf6, 0x36, 0xb4, 0x52, 0x16, 0x5a, 0x5a, 0xb7, 0x52, 0x83, 0x41, 0x6e, 0x64, 0x7e, 0x14,
-	0x22, 0x62, 0xab, 0x45, 0x02, 0x91, 0x5b, 0x82, 0x84, 0xb8, 0x58, 0x42, 0x46, 0x70, 0xe0, 0x12,
-	0xb9, 0xc9, 0x6a, 0x6b, 0x85, 0xf5, 0x5a, 0x5e, 0x27, 0x6a, 0x6e, 0x88, 0xde, 0x38, 0x20, 0x1e,
-	0x81, 0x47, 0xc8, 0x81, 0x03, 0x8f, 0xd0, 0x63, 0xc4, 0x89, 0x13, 0x42, 0xc9, 0x21, 0x3c, 0x06,
-	0xf2, 0xee, 0xda, 0x8d, 0x23, 0xbb, 0xb4, 0xa8, 0x97, 0x68, 0x3d, 0xdf, 0x37, 0x33, 0xdf, 0xb7,
-	0x33, 0x1b, 0x78, 0x87, 0xbd, 0xf7, 0xfc, 0xfe, 0xc8, 0x22, 0x6e, 0xd8, 0x47, 0x11, 0x71, 0x03,
-	0x6b, 0xb8, 0x6f, 0x45, 0xc7, 0x66, 0x10, 0xd2, 0x88, 0xaa, 0xdb, 0x02, 0x35, 0x53, 0xd4, 0x1c,
-	0xee, 0x6b, 0xbb, 0x5d, 0xca, 0x08, 0x65, 0x16, 0x61, 0x38, 0x26, 0x13, 0x86, 0x05, 0x5b, 0xdb,
-	0xc1, 0x14, 0x53, 0x7e, 0xb4, 0xe2, 0x93, 0x8c, 0xde, 0x12, 0xf4, 0x8e, 0x00, 0xc4, 0x87, 0x84,
-	0xb6, 0x5c, 0xe2, 0xf9, 0xd4, 0xe2, 0xbf, 0x32, 0x54, 0xcd, 0xd3, 0x23, 0x3e, 0xce, 0x63, 0x04,
-	0x6e, 0xe8, 0x12, 0x59, 0xd6, 0x98, 0x00, 0xb8, 0x69, 0x33, 0xfc, 0x3c, 0x44, 0x6e, 0x84, 0x6c,
-	0x4e, 0x63, 0xea, 0x13, 0x58, 0x76, 0x07, 0xd1, 0x11, 0x0d, 0xbd, 0x68, 0x54, 0x01, 0x55, 0x50,
-	0x2b, 0xb7, 0x2b, 0x3f, 0xbe, 0x35, 0x76, 0xa4, 0xa0, 0x56, 0xaf, 0x17, 0x22, 0xc6, 0x5e, 0x47,
-	0xa1, 0xe7, 0x63, 0xe7, 0x8c, 0xaa, 0xbe, 0x84, 0x1b, 0x5d, 0x5e, 0xa8, 0x23, 0x1a, 0xb2, 0xca,
-	0x4a, 0xb5, 0x54, 0xbb, 0x7e, 0x70, 0xdb, 0xcc, 0xb9, 0x1b, 0x53, 0x74, 0x6b, 0x5f, 0x3b, 0xfd,
-	0xb5, 0xa7, 0x38, 0xeb, 0xdd, 0x45, 0x05, 0xcd, 0xe6, 0x9f, 0xaf, 0x7b, 0xca, 0xc7, 0xf9, 0xb8,
-	0x7e, 0x56, 0xfd, 0xd3, 0x7c, 0x5c, 0xbf, 0x2b, 0xfd, 0x1c, 0x2f, 0x38, 0x5a, 0x56, 0x6f, 0x68,
-	0xb0, 0xb2, 0x1c, 0x73, 0x10, 0x0b, 0xa8, 0xcf, 0x50, 0x62, 0xf7, 0x4d, 0xd0, 0xbb, 0x1a, 0xbb,
-	0x03, 0x5e, 0xe8, 0x3f, 0xec, 0x0e, 0x16, 0x15, 0x5c, 0xd2, 0x6e, 0x46, 0xbd, 0xb4, 0x9b, 0x89,
-	0xa5, 0x76, 0x3f, 0x03, 0x58, 0xb6, 0x19, 0x7e, 0xc5, 0x27, 0xae, 0x3e, 0x83, 0x6b, 0x62, 0xf6,
-	0xdc, 0x64, 0x91, 0x4e, 0x41, 0x96, 0x3a, 0x65, 0x42, 0xf6, 0x8a, 0x56, 0x2e, 0x7c, 0x45, 0xcd,
-	0x8d, 0xac, 0x29, 0x63, 0x1b, 0x6e, 0xa5, 0x7a, 0x52, 0x95, 0x27, 0x00, 0x6a, 0x36, 0xc3, 0x0e,
-	0x22, 0x74, 0x28, 0x2d, 0xb4, 0x64, 0x86, 0x87, 0x98, 0xfa, 0x10, 0x6e, 0x86, 0x1c, 0xea, 0xb8,
-	0xa2, 0x0d, 0x8a, 0x0d, 0x94, 0x6a, 0x65, 0xe7, 0xa6, 0x88, 0xb7, 0x92, 0xb0, 0x6a, 0xc2, 0x55,
-	0xb7, 0x47, 0x3c, 0xff, 0x9f, 0x12, 0x05, 0xad, 0x09, 0x63, 0x79, 0xe2, 0x6c, 0xdc, 0x83, 0x46,
-	0xb1, 0x88, 0x44, 0xeb, 0xc1, 0xf7, 0x12, 0x2c, 0xd9, 0x0c, 0xab, 0x08, 0xae, 0x67, 0xdf, 0xcc,
-	0xfd, 0xfc, 0xa1, 0x2f, 0x2d, 0xa2, 0xd6, 0xb8, 0x10, 0x2d, 0x69, 0x17, 0xb7, 0xc9, 0xee, 0x6a,
-	0x61, 0x9b, 0x0c, 0xad, 0xb8, 0x4d, 0xee, 0x9e, 0xa8, 0x6f, 0xe1, 0x0d, 0x01, 0xc8, 0x4d, 0xd1,
-	0x8b, 0xd2, 0x05, 0xae, 0x3d, 0x38, 0x1f, 0x4f, 0xeb, 0x9e, 0x00, 0xb8, 0x5b, 0x34, 0x56, 0xab,
-	0xa8, 0x46, 0x41, 0x82, 0xf6, 0xf4, 0x92, 0x09, 0x89, 0x0a, 0x6d, 0xf5, 0xc3, 0x7c, 0x5c, 0x07,
-	0xed, 0x17, 0xa7, 0x53, 0x1d, 0x4c, 0xa6, 0x3a, 0xf8, 0x3d, 0xd5, 0xc1, 0x97, 0x99, 0xae, 0x4c,
-	0x66, 0xba, 0xf2, 0x73, 0xa6, 0x2b, 0xef, 0x1e, 0x61, 0x2f, 0x3a, 0x1a, 0x1c, 0x9a, 0x5d, 0x4a,
-	0x2c, 0xd6, 0xf7, 0x82, 0x06, 0x41, 0x43, 0x2b, 0xe7, 0xed, 0x45, 0xa3, 0x00, 0xb1, 0xc3, 0x35,
-	0xfe, 0xcf, 0xf9, 0xf8, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x8f, 0x7b, 0x41, 0xdd, 0x0f, 0x06,
-	0x00, 0x00,
+	// 751 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x4f, 0xd4, 0x40,
+	0x14, 0xde, 0x2e, 0x0b, 0x66, 0x9f, 0x82, 0x50, 0x30, 0xac, 0xd5, 0x94, 0x4d, 0x15, 0x83, 0xe8,
+	0xb6, 0x01, 0x03, 0xc6, 0x8d, 0x17, 0x30, 0x31, 0x5c, 0x36, 0x31, 0x25, 0x7a, 0xf0, 0xb2, 0x29,
+	0xed, 0xa4, 0x3b, 0x81, 0xfe, 0x48, 0xa7, 0xbb, 0x81, 0x83, 0x89, 0x91, 0x9b, 0x07, 0xe3, 0xd5,
+	0x44, 0x13, 0xff, 0x04, 0x0e, 0xfe, 0x11, 0x1c, 0xd1, 0x93, 0x27, 0x63, 0xe0, 0x80, 0x7f, 0x86,
+	0xd9, 0x99, 0x69, 0xd9, 0xae, 0x53, 0x5c, 0x56, 0x2f, 0xa4, 0x7d, 0xef, 0x7b, 0xef, 0x7d, 0xdf,
+	0xc7, 0xcc, 0xeb, 0xc2, 0x4d, 0xb2, 0x83, 0xfd, 0xed, 0x3d, 0xc3, 0xb3, 0xa2, 0x6d, 0x14, 0x7b,
+	0x56, 0x68, 0x74, 0x96, 0x8c, 0x78, 0x57, 0x0f, 0xa3, 0x20, 0x0e, 0xe4, 0x69, 0x96, 0xd5, 0xd3,
+	0xac, 0xde, 0x59, 0x52, 0x66, 0xed, 0x80, 0x78, 0x01, 0x31, 0x3c, 0xe2, 0x76, 0xc1, 0x1e, 0x71,
+	0x19, 0x5a, 0x99, 0x71, 0x03, 0x37, 0xa0, 0x8f, 0x46, 0xf7, 0x89, 0x47, 0xaf, 0x33, 0x78, 0x93,
+	0x25, 0xd8, 0x0b, 0x4f, 0x4d, 0x59, 0x1e, 0xf6, 0x03, 0x83, 0xfe, 0xe5, 0xa1, 0xaa, 0x88, 0x0f,
+	0x7b, 0x39, 0x0f, 0x11, 0x5a, 0x91, 0xe5, 0xf1, 0xb6, 0xda, 0x91, 0x04, 0x93, 0x0d, 0xe2, 0x3e,
+	0x89, 0x90, 0x15, 0xa3, 0x06, 0x85, 0x11, 0x79, 0x15, 0xca, 0x56, 0x3b, 0x6e, 0x05, 0x11, 0x8e,
+	0xf7, 0x2a, 0x52, 0x55, 0x5a, 0x28, 0xaf, 0x57, 0xbe, 0x7d, 0xa9, 0xcd, 0x70, 0x42, 0x6b, 0x8e,
+	0x13, 0x21, 0x42, 0x36, 0xe3, 0x08, 0xfb, 0xae, 0x79, 0x06, 0x95, 0x37, 0x60, 0xc2, 0xa6, 0x8d,
+	0x9a, 0x6c, 0x20, 0xa9, 0x14, 0xab, 0x23, 0x0b, 0x97, 0x97, 0x6f, 0xe8, 0x02, 0x6f, 0x74, 0x36,
+	0x6d, 0xbd, 0x74, 0xf8, 0x63, 0xae, 0x60, 0x8e, 0xdb, 0xbd, 0x0c, 0xea, 0xf5, 0x5f, 0x9f, 0xe7,
+	0x0a, 0x6f, 0x4e, 0x0f, 0x16, 0xcf, 0xba, 0xbf, 0x3d, 0x3d, 0x58, 0xbc, 0xc5, 0xf5, 0xec, 0xf6,
+	0x28, 0xea, 0x67, 0xaf, 0x29, 0x50, 0xe9, 0x8f, 0x99, 0x88, 0x84, 0x81, 0x4f, 0x50, 0x22, 0xf7,
+	0x79, 0xe8, 0xfc, 0x1f, 0xb9, 0xed, 0xd0, 0x19, 0x4e, 0x6e, 0x3b, 0x74, 0x86, 0x96, 0x9b, 0x61,
+	0xcf, 0xe5, 0x66, 0x62, 0xa9, 0xdc, 0x8f, 0x4c, 0xae, 0x89, 0xbc, 0xa0, 0xf3, 0xcf, 0x72, 0x2b,
+	0x70, 0xa9, 0x57, 0x67, 0xd9, 0x4c, 0x5e, 0xeb, 0xab, 0x03, 0x53, 0xcf, 0x30, 0xe1, 0xd4, 0x33,
+	0xb1, 0x94, 0xfa, 0x3b, 0x09, 0xca, 0x0d, 0xe2, 0x3e, 0xa3, 0x87, 0x55, 0x7e, 0x04, 0x63, 0xec,
+	0xd8, 0x52, 0xc2, 0x79, 0x16, 0x33, 0x30, 0xb7, 0x98, 0x17, 0x64, 0xe5, 0x16, 0x07, 0x96, 0x5b,
+	0x9f, 0xc8, 0x8a, 0xd2, 0xa6, 0x61, 0x2a, 0xe5, 0x93, 0xb2, 0xdc, 0x97, 0x40, 0xe9, 0x93, 0xb0,
+	0xc6, 0x2b, 0x30, 0x22, 0xf2, 0x5d, 0x98, 0x8c, 0x68, 0xaa, 0x69, 0xb1, 0x31, 0xa8, 0x2b, 0xa0,
+	0xeb, 0xdd, 0x55, 0x16, 0x5f, 0x4b, 0xc2, 0xb2, 0x0e, 0xa3, 0x96, 0xe3, 0x61, 0xff, 0xaf, 0x14,
+	0x19, 0xac, 0x0e, 0x5d, 0x7a, 0xec, 0x59, 0xbb, 0x0d, 0x5a, 0x3e, 0x89, 0x94, 0xeb, 0xd7, 0x22,
+	0xb5, 0x7b, 0xd3, 0x6e, 0x21, 0xa7, 0xbd, 0xc3, 0x81, 0xec, 0xdc, 0x0c, 0x7f, 0x28, 0xee, 0xc1,
+	0x94, 0x65, 0xc7, 0xb8, 0x63, 0xc5, 0x38, 0xf0, 0x9b, 0x2d, 0x84, 0xdd, 0x56, 0x4c, 0x25, 0x94,
+	0xcc, 0xc9, 0xb3, 0xc4, 0x06, 0x8d, 0x0b, 0xf6, 0xc3, 0xc8, 0x70, 0xfb, 0x41, 0x70, 0xf5, 0x4a,
+	0x43, 0x5e, 0xbd, 0xc7, 0xe2, 0xab, 0x37, 0x2f, 0x3e, 0xbf, 0x89, 0x79, 0xdc, 0x36, 0x6d, 0x19,
+	0xaa, 0x79, 0x96, 0x26, 0xbe, 0xcb, 0x13, 0x50, 0xc4, 0x0e, 0xf5, 0xb4, 0x64, 0x16, 0xb1, 0xa3,
+	0x7d, 0x90, 0xd8, 0x82, 0xb2, 0x7c, 0x1b, 0xed, 0x24, 0xa5, 0x0e, 0xab, 0x1a, 0xfa, 0xff, 0xc0,
+	0x86, 0x14, 0x93, 0x21, 0xf5, 0x95, 0x3f, 0x25, 0x69, 0x39, 0xcb, 0x93, 0xf2, 0x60, 0xe3, 0x35,
+	0x0d, 0xaa, 0x69, 0xa8, 0x8f, 0x5a, 0xa2, 0x67, 0xf9, 0xd3, 0x18, 0x8c, 0x34, 0x88, 0x2b, 0x23,
+	0x18, 0xcf, 0x7e, 0x36, 0xe6, 0xc5, 0xe6, 0xf7, 0xed, 0x62, 0xa5, 0x36, 0x10, 0x2c, 0xb5, 0x0f,
+	0xc1, 0x78, 0x76, 0x5d, 0xe7, 0x8e, 0xc9, 0xc0, 0x94, 0xda, 0x40, 0xb0, 0x74, 0xcc, 0x0b, 0xb8,
+	0xc2, 0x12, 0x7c, 0xe3, 0xa8, 0x79, 0xe5, 0x2c, 0xaf, 0xdc, 0x39, 0x3f, 0x9f, 0xf6, 0xdd, 0x97,
+	0x60, 0x36, 0x6f, 0x3d, 0x18, 0x79, 0x3d, 0x72, 0x0a, 0x94, 0x87, 0x17, 0x2c, 0xe8, 0x35, 0x31,
+	0xfb, 0x11, 0x98, 0x1f, 0xa4, 0xd3, 0x39, 0x26, 0x0a, 0x97, 0xb6, 0xfc, 0x0a, 0xae, 0x89, 0xd7,
+	0x4b, 0x6e, 0x1f, 0x21, 0x5c, 0x59, 0xb9, 0x10, 0xbc, 0x77, 0xbc, 0xf8, 0x56, 0xe5, 0x1f, 0x39,
+	0x11, 0x5c, 0x59, 0xb9, 0x10, 0x3c, 0x19, 0xaf, 0x8c, 0xbe, 0x3e, 0x3d, 0x58, 0x94, 0xd6, 0x9f,
+	0x1e, 0x1e, 0xab, 0xd2, 0xd1, 0xb1, 0x2a, 0xfd, 0x3c, 0x56, 0xa5, 0xf7, 0x27, 0x6a, 0xe1, 0xe8,
+	0x44, 0x2d, 0x7c, 0x3f, 0x51, 0x0b, 0x2f, 0xef, 0xbb, 0x38, 0x6e, 0xb5, 0xb7, 0x74, 0x3b, 0xf0,
+	0x0c, 0xb2, 0x8d, 0xc3, 0x9a, 0x87, 0x3a, 0x86, 0xe0, 0x56, 0xc6, 0x7b, 0x21, 0x22, 0x5b, 0x63,
+	0xf4, 0x17, 0xda, 0x83, 0xdf, 0x03, 0x00, 0x6a, 0x01, 0xde, 0xd4, 0x77, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -486,6 +818,15 @@ type MsgClient interface {
 	// RemoveMarketAuthorities defines a method for removing market authorities
 	// from the x/marketmap module. the signer must be the admin.
 	RemoveMarketAuthorities(ctx context.Context, in *MsgRemoveMarketAuthorities, opts ...grpc.CallOption) (*MsgRemoveMarketAuthoritiesResponse, error)
+	// RemoveMarkets removes markets from the market map. Markets must be
+	// proposed, disabled or deprecated to be removed.
+	RemoveMarkets(ctx context.Context, in *MsgRemoveMarkets, opts ...grpc.CallOption) (*MsgRemoveMarketsResponse, error)
+	// ScheduleMarketUpdates schedules markets to be created and updated at a
+	// future block height.
+	ScheduleMarketUpdates(ctx context.Context, in *MsgScheduleMarketUpdates, opts ...grpc.CallOption) (*MsgScheduleMarketUpdatesResponse, error)
+	// CancelScheduledUpdate cancels a pending scheduled update. The signer must
+	// be the authority that scheduled the update or the admin.
+	CancelScheduledUpdate(ctx context.Context, in *MsgCancelScheduledUpdate, opts ...grpc.CallOption) (*MsgCancelScheduledUpdateResponse, error)
 }
 
 type msgClient struct {
@@ -532,6 +873,33 @@ func (c *msgClient) RemoveMarketAuthorities(ctx context.Context, in *MsgRemoveMa
 	return out, nil
 }
 
+func (c *msgClient) RemoveMarkets(ctx context.Context, in *MsgRemoveMarkets, opts ...grpc.CallOption) (*MsgRemoveMarketsResponse, error) {
+	out := new(MsgRemoveMarketsResponse)
+	err := c.cc.Invoke(ctx, "/slinky.marketmap.v1.Msg/RemoveMarkets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ScheduleMarketUpdates(ctx context.Context, in *MsgScheduleMarketUpdates, opts ...grpc.CallOption) (*MsgScheduleMarketUpdatesResponse, error) {
+	out := new(MsgScheduleMarketUpdatesResponse)
+	err := c.cc.Invoke(ctx, "/slinky.marketmap.v1.Msg/ScheduleMarketUpdates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelScheduledUpdate(ctx context.Context, in *MsgCancelScheduledUpdate, opts ...grpc.CallOption) (*MsgCancelScheduledUpdateResponse, error) {
+	out := new(MsgCancelScheduledUpdateResponse)
+	err := c.cc.Invoke(ctx, "/slinky.marketmap.v1.Msg/CancelScheduledUpdate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateMarkets creates markets from the given message.
@@ -544,6 +912,15 @@ type MsgServer interface {
 	// RemoveMarketAuthorities defines a method for removing market authorities
 	// from the x/marketmap module. the signer must be the admin.
 	RemoveMarketAuthorities(context.Context, *MsgRemoveMarketAuthorities) (*MsgRemoveMarketAuthoritiesResponse, error)
+	// RemoveMarkets removes markets from the market map. Markets must be
+	// proposed, disabled or deprecated to be removed.
+	RemoveMarkets(context.Context, *MsgRemoveMarkets) (*MsgRemoveMarketsResponse, error)
+	// ScheduleMarketUpdates schedules markets to be created and updated at a
+	// future block height.
+	ScheduleMarketUpdates(context.Context, *MsgScheduleMarketUpdates) (*MsgScheduleMarketUpdatesResponse, error)
+	// CancelScheduledUpdate cancels a pending scheduled update. The signer must
+	// be the authority that scheduled the update or the admin.
+	CancelScheduledUpdate(context.Context, *MsgCancelScheduledUpdate) (*MsgCancelScheduledUpdateResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -562,6 +939,15 @@ func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgParams)
 func (*UnimplementedMsgServer) RemoveMarketAuthorities(ctx context.Context, req *MsgRemoveMarketAuthorities) (*MsgRemoveMarketAuthoritiesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveMarketAuthorities not implemented")
 }
+func (*UnimplementedMsgServer) RemoveMarkets(ctx context.Context, req *MsgRemoveMarkets) (*MsgRemoveMarketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMarkets not implemented")
+}
+func (*UnimplementedMsgServer) ScheduleMarketUpdates(ctx context.Context, req *MsgScheduleMarketUpdates) (*MsgScheduleMarketUpdatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleMarketUpdates not implemented")
+}
+func (*UnimplementedMsgServer) CancelScheduledUpdate(ctx context.Context, req *MsgCancelScheduledUpdate) (*MsgCancelScheduledUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledUpdate not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -639,6 +1025,60 @@ func _Msg_RemoveMarketAuthorities_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RemoveMarkets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveMarkets)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveMarkets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/slinky.marketmap.v1.Msg/RemoveMarkets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveMarkets(ctx, req.(*MsgRemoveMarkets))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ScheduleMarketUpdates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleMarketUpdates)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleMarketUpdates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/slinky.marketmap.v1.Msg/ScheduleMarketUpdates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleMarketUpdates(ctx, req.(*MsgScheduleMarketUpdates))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelScheduledUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelScheduledUpdate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelScheduledUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/slinky.marketmap.v1.Msg/CancelScheduledUpdate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelScheduledUpdate(ctx, req.(*MsgCancelScheduledUpdate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "slinky.marketmap.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -659,6 +1099,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveMarketAuthorities",
 			Handler:    _Msg_RemoveMarketAuthorities_Handler,
 		},
+		{
+			MethodName: "RemoveMarkets",
+			Handler:    _Msg_RemoveMarkets_Handler,
+		},
+		{
+			MethodName: "ScheduleMarketUpdates",
+			Handler:    _Msg_ScheduleMarketUpdates_Handler,
+		},
+		{
+			MethodName: "CancelScheduledUpdate",
+			Handler:    _Msg_CancelScheduledUpdate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "slinky/marketmap/v1/tx.proto",
@@ -798,7 +1250,7 @@ func (m *MsgUpdateMarketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgParams) Marshal() (dAtA []byte, err error) {
+func (m *MsgRemoveMarkets) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -808,17 +1260,79 @@ func (m *MsgParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRemoveMarkets) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRemoveMarkets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Authority) > 0 {
+	if len(m.Markets) > 0 {
+		for iNdEx := len(m.Markets) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Markets[iNdEx])
+			copy(dAtA[i:], m.Markets[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Markets[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveMarketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveMarketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveMarketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
 		i -= len(m.Authority)
 		copy(dAtA[i:], m.Authority)
 		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
@@ -923,6 +1437,155 @@ func (m *MsgRemoveMarketAuthoritiesResponse) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgScheduleMarketUpdates) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleMarketUpdates) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleMarketUpdates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UpdateMarkets) > 0 {
+		for iNdEx := len(m.UpdateMarkets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UpdateMarkets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.CreateMarkets) > 0 {
+		for iNdEx := len(m.CreateMarkets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CreateMarkets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.ActivationHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleMarketUpdatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleMarketUpdatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleMarketUpdatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelScheduledUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelScheduledUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelScheduledUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelScheduledUpdateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelScheduledUpdateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelScheduledUpdateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -990,6 +1653,34 @@ func (m *MsgUpdateMarketsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgRemoveMarkets) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Markets) > 0 {
+		for _, s := range m.Markets {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRemoveMarketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgParams) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1030,25 +1721,586 @@ func (m *MsgRemoveMarketAuthorities) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *MsgRemoveMarketAuthoritiesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgScheduleMarketUpdates) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ActivationHeight != 0 {
+		n += 1 + sovTx(uint64(m.ActivationHeight))
+	}
+	if len(m.CreateMarkets) > 0 {
+		for _, e := range m.CreateMarkets {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.UpdateMarkets) > 0 {
+		for _, e := range m.UpdateMarkets {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgScheduleMarketUpdatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelScheduledUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelScheduledUpdateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateMarkets) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateMarkets: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateMarkets: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateMarkets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreateMarkets = append(m.CreateMarkets, Market{})
+			if err := m.CreateMarkets[len(m.CreateMarkets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateMarketsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateMarketsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateMarketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateMarkets) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateMarkets: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateMarkets: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateMarkets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdateMarkets = append(m.UpdateMarkets, Market{})
+			if err := m.UpdateMarkets[len(m.UpdateMarkets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateMarketsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateMarketsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateMarketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveMarkets) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveMarkets: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveMarkets: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Markets = append(m.Markets, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgRemoveMarketAuthoritiesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
+func (m *MsgRemoveMarketsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveMarketsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveMarketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgCreateMarkets) Unmarshal(dAtA []byte) error {
+func (m *MsgParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1071,17 +2323,17 @@ func (m *MsgCreateMarkets) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateMarkets: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateMarkets: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1091,29 +2343,30 @@ func (m *MsgCreateMarkets) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateMarkets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1123,25 +2376,23 @@ func (m *MsgCreateMarkets) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CreateMarkets = append(m.CreateMarkets, Market{})
-			if err := m.CreateMarkets[len(m.CreateMarkets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1164,7 +2415,7 @@ func (m *MsgCreateMarkets) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateMarketsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1187,10 +2438,10 @@ func (m *MsgCreateMarketsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateMarketsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateMarketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1214,7 +2465,7 @@ func (m *MsgCreateMarketsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateMarkets) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveMarketAuthorities) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1237,15 +2488,15 @@ func (m *MsgUpdateMarkets) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateMarkets: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveMarketAuthorities: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateMarkets: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveMarketAuthorities: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveAddresses", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1273,13 +2524,13 @@ func (m *MsgUpdateMarkets) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.RemoveAddresses = append(m.RemoveAddresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateMarkets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1289,25 +2540,23 @@ func (m *MsgUpdateMarkets) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpdateMarkets = append(m.UpdateMarkets, Market{})
-			if err := m.UpdateMarkets[len(m.UpdateMarkets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1330,7 +2579,7 @@ func (m *MsgUpdateMarkets) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateMarketsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveMarketAuthoritiesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1353,10 +2602,10 @@ func (m *MsgUpdateMarketsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateMarketsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveMarketAuthoritiesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateMarketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveMarketAuthoritiesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1380,7 +2629,7 @@ func (m *MsgUpdateMarketsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgParams) Unmarshal(dAtA []byte) error {
+func (m *MsgScheduleMarketUpdates) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1403,15 +2652,66 @@ func (m *MsgParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgScheduleMarketUpdates: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgScheduleMarketUpdates: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+			}
+			m.ActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateMarkets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1438,15 +2738,16 @@ func (m *MsgParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CreateMarkets = append(m.CreateMarkets, Market{})
+			if err := m.CreateMarkets[len(m.CreateMarkets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateMarkets", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1456,23 +2757,25 @@ func (m *MsgParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.UpdateMarkets = append(m.UpdateMarkets, Market{})
+			if err := m.UpdateMarkets[len(m.UpdateMarkets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1495,7 +2798,7 @@ func (m *MsgParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgScheduleMarketUpdatesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1518,12 +2821,31 @@ func (m *MsgParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgScheduleMarketUpdatesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgScheduleMarketUpdatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1545,7 +2867,7 @@ func (m *MsgParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveMarketAuthorities) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelScheduledUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1568,15 +2890,15 @@ func (m *MsgRemoveMarketAuthorities) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveMarketAuthorities: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelScheduledUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveMarketAuthorities: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelScheduledUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoveAddresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1604,13 +2926,13 @@ func (m *MsgRemoveMarketAuthorities) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RemoveAddresses = append(m.RemoveAddresses, string(dAtA[iNdEx:postIndex]))
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1620,24 +2942,11 @@ func (m *MsgRemoveMarketAuthorities) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Admin = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1659,7 +2968,7 @@ func (m *MsgRemoveMarketAuthorities) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveMarketAuthoritiesResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelScheduledUpdateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1682,10 +2991,10 @@ func (m *MsgRemoveMarketAuthoritiesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveMarketAuthoritiesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelScheduledUpdateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveMarketAuthoritiesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelScheduledUpdateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
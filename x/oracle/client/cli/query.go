@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -26,6 +27,9 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		GetPriceCmd(),
 		GetAllCurrencyPairsCmd(),
+		GetPriceHistoryCmd(),
+		GetTWAPCmd(),
+		GetCircuitBreakerCmd(),
 	)
 
 	return cmd
@@ -95,3 +99,111 @@ func GetAllCurrencyPairsCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetPriceHistoryCmd returns the cli-command that queries the retained price history for a given CurrencyPair.
+func GetPriceHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "price-history [base] [quote]",
+		Short: "Query for the retained price history of a specified currency-pair",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// get context
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// retrieve CurrencyPair from arguments
+			cp := slinkytypes.NewCurrencyPair(args[0], args[1])
+
+			// create client
+			qc := types.NewQueryClient(clientCtx)
+
+			// query for the price history
+			res, err := qc.GetPriceHistory(cmd.Context(), &types.GetPriceHistoryRequest{
+				CurrencyPair: cp,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetTWAPCmd returns the cli-command that queries the time-weighted average price of a given CurrencyPair over a
+// window, i.e. 1h, ending at the latest block time.
+func GetTWAPCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "twap [base] [quote] [window]",
+		Short: "Query for the time-weighted average price of a specified currency-pair over a window (i.e. 1h)",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// get context
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// retrieve CurrencyPair and window from arguments
+			cp := slinkytypes.NewCurrencyPair(args[0], args[1])
+			window, err := time.ParseDuration(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid window: %w", err)
+			}
+
+			// create client
+			qc := types.NewQueryClient(clientCtx)
+
+			// query for the twap
+			res, err := qc.GetTWAP(cmd.Context(), &types.GetTWAPRequest{
+				CurrencyPair: cp,
+				Window:       window,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCircuitBreakerCmd returns the cli-command that queries the circuit breaker configured for a given CurrencyPair.
+func GetCircuitBreakerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "circuit-breaker [base] [quote]",
+		Short: "Query for the circuit breaker configured for a specified currency-pair",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// get context
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// retrieve CurrencyPair from arguments
+			cp := slinkytypes.NewCurrencyPair(args[0], args[1])
+
+			// create client
+			qc := types.NewQueryClient(clientCtx)
+
+			// query for the circuit breaker
+			res, err := qc.GetCircuitBreaker(cmd.Context(), &types.GetCircuitBreakerRequest{
+				CurrencyPair: cp,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,180 @@
+package keeper
+
+import (
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	slinkytypes "github.com/skip-mev/slinky/pkg/types"
+	"github.com/skip-mev/slinky/x/oracle/types"
+)
+
+const (
+	// limitBlock and limitWindow identify the limit of a CircuitBreaker that was tripped in events.
+	limitBlock  = "block"
+	limitWindow = "window"
+)
+
+// SetCircuitBreaker sets the CircuitBreaker of the given CurrencyPair, leaving whether the CurrencyPair is halted
+// unchanged. If the CircuitBreaker has no limits, the CurrencyPair's CircuitBreaker is removed, resuming price updates
+// if it was halted. This method fails if the CurrencyPair does not exist.
+func (k *Keeper) SetCircuitBreaker(ctx sdk.Context, cp slinkytypes.CurrencyPair, cb types.CircuitBreaker) error {
+	if !k.HasCurrencyPair(ctx, cp) {
+		return types.NewCurrencyPairNotExistError(cp)
+	}
+
+	if !cb.HasLimits() {
+		return k.circuitBreakers.Remove(ctx, cp.String())
+	}
+
+	cpcb, err := k.GetCircuitBreaker(ctx, cp)
+	if err != nil && !errors.As(err, &types.CircuitBreakerNotExistError{}) {
+		return err
+	}
+
+	cpcb.CurrencyPair = cp
+	cpcb.CircuitBreaker = cb
+	return k.circuitBreakers.Set(ctx, cp.String(), cpcb)
+}
+
+// GetCircuitBreaker returns the CircuitBreaker configured for the given CurrencyPair.
+func (k *Keeper) GetCircuitBreaker(ctx sdk.Context, cp slinkytypes.CurrencyPair) (types.CurrencyPairCircuitBreaker, error) {
+	cpcb, err := k.circuitBreakers.Get(ctx, cp.String())
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.CurrencyPairCircuitBreaker{}, types.NewCircuitBreakerNotExistError(cp)
+		}
+
+		return types.CurrencyPairCircuitBreaker{}, err
+	}
+
+	return cpcb, nil
+}
+
+// GetAllCircuitBreakers returns the CircuitBreakers configured for all CurrencyPairs.
+func (k *Keeper) GetAllCircuitBreakers(ctx sdk.Context) ([]types.CurrencyPairCircuitBreaker, error) {
+	it, err := k.circuitBreakers.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return it.Values()
+}
+
+// ResetCircuitBreaker resumes price updates for the given CurrencyPair if they were halted by its CircuitBreaker.
+func (k *Keeper) ResetCircuitBreaker(ctx sdk.Context, cp slinkytypes.CurrencyPair) error {
+	cpcb, err := k.GetCircuitBreaker(ctx, cp)
+	if err != nil {
+		return err
+	}
+
+	cpcb.Halted = false
+	if err := k.circuitBreakers.Set(ctx, cp.String(), cpcb); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCircuitBreakerReset,
+		sdk.NewAttribute(types.AttributeKeyCurrencyPair, cp.String()),
+	))
+
+	return nil
+}
+
+// applyCircuitBreaker checks the given price update against the CircuitBreaker of the CurrencyPair, if any. It returns
+// the price to write to state, which is clamped to the limits if the CircuitBreaker is tripped with the CLAMP action,
+// and whether the price should be written at all. Price updates are dropped for halted CurrencyPairs, and if the
+// CircuitBreaker is tripped with the SKIP or HALT actions.
+func (k *Keeper) applyCircuitBreaker(ctx sdk.Context, cp slinkytypes.CurrencyPair, qp types.QuotePrice) (types.QuotePrice, bool, error) {
+	cpcb, err := k.GetCircuitBreaker(ctx, cp)
+	if err != nil {
+		if errors.As(err, &types.CircuitBreakerNotExistError{}) {
+			return qp, true, nil
+		}
+
+		return qp, false, err
+	}
+
+	if cpcb.Halted {
+		return qp, false, nil
+	}
+
+	cb := cpcb.CircuitBreaker
+
+	// check the change relative to the previous price
+	if cb.MaxBlockChangeBps > 0 {
+		prev, err := k.GetPriceForCurrencyPair(ctx, cp)
+		switch {
+		case err == nil:
+			var ok bool
+			if qp, ok, err = k.checkMaxChange(ctx, cpcb, qp, prev.Price, cb.MaxBlockChangeBps, limitBlock); !ok || err != nil {
+				return qp, ok, err
+			}
+		case !errors.As(err, &types.QuotePriceNotExistError{}):
+			return qp, false, err
+		}
+	}
+
+	// check the change relative to the oldest price within the window
+	if cb.MaxWindowChangeBps > 0 {
+		prices, err := k.GetPriceHistory(ctx, cp)
+		if err != nil {
+			return qp, false, err
+		}
+
+		for _, ref := range prices {
+			if ref.BlockHeight+cb.WindowBlocks < uint64(ctx.BlockHeight()) {
+				continue
+			}
+
+			var ok bool
+			if qp, ok, err = k.checkMaxChange(ctx, cpcb, qp, ref.Price, cb.MaxWindowChangeBps, limitWindow); !ok || err != nil {
+				return qp, ok, err
+			}
+			break
+		}
+	}
+
+	return qp, true, nil
+}
+
+// checkMaxChange checks the change of the price update relative to the reference price against the given limit. If
+// the limit is exceeded, an event is emitted and the CircuitBreaker's action is applied.
+func (k *Keeper) checkMaxChange(
+	ctx sdk.Context,
+	cpcb types.CurrencyPairCircuitBreaker,
+	qp types.QuotePrice,
+	ref math.Int,
+	maxBps uint64,
+	limit string,
+) (types.QuotePrice, bool, error) {
+	if !types.ExceedsMaxChange(ref, qp.Price, maxBps) {
+		return qp, true, nil
+	}
+
+	action := cpcb.CircuitBreaker.Action
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCircuitBreakerTripped,
+		sdk.NewAttribute(types.AttributeKeyCurrencyPair, cpcb.CurrencyPair.String()),
+		sdk.NewAttribute(types.AttributeKeyPrice, qp.Price.String()),
+		sdk.NewAttribute(types.AttributeKeyReferencePrice, ref.String()),
+		sdk.NewAttribute(types.AttributeKeyMaxChangeBps, strconv.FormatUint(maxBps, 10)),
+		sdk.NewAttribute(types.AttributeKeyLimit, limit),
+		sdk.NewAttribute(types.AttributeKeyAction, action.String()),
+		sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+	))
+
+	switch action {
+	case types.CircuitBreakerAction_CIRCUIT_BREAKER_ACTION_CLAMP:
+		qp.Price = types.ClampChange(ref, qp.Price, maxBps)
+		return qp, true, nil
+	case types.CircuitBreakerAction_CIRCUIT_BREAKER_ACTION_HALT:
+		cpcb.Halted = true
+		return qp, false, k.circuitBreakers.Set(ctx, cpcb.CurrencyPair.String(), cpcb)
+	default:
+		return qp, false, nil
+	}
+}
@@ -0,0 +1,287 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	slinkytypes "github.com/skip-mev/slinky/pkg/types"
+	"github.com/skip-mev/slinky/x/oracle/keeper"
+	"github.com/skip-mev/slinky/x/oracle/types"
+)
+
+// applyPriceAtHeight applies a price update for the given currency pair at the given height, returning the events
+// emitted.
+func (s *KeeperTestSuite) applyPriceAtHeight(cp slinkytypes.CurrencyPair, height int64, price int64) sdk.Events {
+	s.ctx = s.ctx.WithBlockHeight(height).WithBlockTime(time.Unix(height, 0).UTC()).WithEventManager(sdk.NewEventManager())
+	s.Require().NoError(s.oracleKeeper.ApplyPriceUpdate(s.ctx, cp, types.QuotePrice{
+		Price:          sdkmath.NewInt(price),
+		BlockTimestamp: s.ctx.BlockTime(),
+		BlockHeight:    uint64(height),
+	}, 1))
+
+	return s.ctx.EventManager().Events()
+}
+
+func (s *KeeperTestSuite) requirePrice(cp slinkytypes.CurrencyPair, price int64, nonce uint64) {
+	qpn, err := s.oracleKeeper.GetPriceWithNonceForCurrencyPair(s.ctx, cp)
+	s.Require().NoError(err)
+	s.Require().Equal(sdkmath.NewInt(price), qpn.Price)
+	s.Require().Equal(nonce, qpn.Nonce())
+}
+
+func (s *KeeperTestSuite) requireTripped(events sdk.Events, limit string, action types.CircuitBreakerAction) {
+	s.Require().Equal(types.EventTypeCircuitBreakerTripped, events[0].Type)
+
+	attr, ok := events[0].GetAttribute(types.AttributeKeyLimit)
+	s.Require().True(ok)
+	s.Require().Equal(limit, attr.Value)
+
+	attr, ok = events[0].GetAttribute(types.AttributeKeyAction)
+	s.Require().True(ok)
+	s.Require().Equal(action.String(), attr.Value)
+}
+
+func (s *KeeperTestSuite) TestCircuitBreakerActions() {
+	cp := slinkytypes.NewCurrencyPair("BTC", "USD")
+
+	setup := func(action types.CircuitBreakerAction) {
+		s.SetupWithPriceHistory(10)
+		s.setPriceAtHeight(cp, 1, 1000)
+		s.Require().NoError(s.oracleKeeper.SetCircuitBreaker(s.ctx, cp, types.CircuitBreaker{
+			MaxBlockChangeBps: 100,
+			Action:            action,
+		}))
+	}
+
+	s.Run("updates within the limit are applied", func() {
+		setup(types.CircuitBreakerAction_CIRCUIT_BREAKER_ACTION_SKIP)
+
+		events := s.applyPriceAtHeight(cp, 2, 1010)
+		s.Require().Len(events, 1)
+		s.Require().Equal(types.EventTypePriceUpdate, events[0].Type)
+		s.requirePrice(cp, 1010, 1)
+	})
+
+	s.Run("clamp writes the clamped price", func() {
+		setup(types.CircuitBreakerAction_CIRCUIT_BREAKER_ACTION_CLAMP)
+
+		events := s.applyPriceAtHeight(cp, 2, 2000)
+		s.Require().Len(events, 2)
+		s.requireTripped(events, "block", types.CircuitBreakerAction_CIRCUIT_BREAKER_ACTION_CLAMP)
+		s.Require().Equal(types.EventTypePriceUpdate, events[1].Type)
+		s.requirePrice(cp, 1010, 1)
+	})
+
+	s.Run("skip drops the update", func() {
+		setup(types.CircuitBreakerAction_CIRCUIT_BREAKER_ACTION_SKIP)
+
+		events := s.applyPriceAtHeight(cp, 2, 500)
+		s.Require().Len(events, 2)
+		s.requireTripped(events, "block", types.CircuitBreakerAction_CIRCUIT_BREAKER_ACTION_SKIP)
+		s.Require().Equal(types.EventTypePriceMissed, events[1].Type)
+		s.requirePrice(cp, 1000, 0)
+
+		// the circuit breaker is not halted, so the next update within the limit is applied
+		s.applyPriceAtHeight(cp, 3, 1005)
+		s.requirePrice(cp, 1005, 1)
+	})
+
+	s.Run("halt drops all updates until reset", func() {
+		setup(types.CircuitBreakerAction_CIRCUIT_BREAKER_ACTION_HALT)
+
+		events := s.applyPriceAtHeight(cp, 2, 2000)
+		s.requireTripped(events, "block", types.CircuitBreakerAction_CIRCUIT_BREAKER_ACTION_HALT)
+
+		cpcb, err := s.oracleKeeper.GetCircuitBreaker(s.ctx, cp)
+		s.Require().NoError(err)
+		s.Require().True(cpcb.Halted)
+
+		// updates within the limit are dropped while halted
+		events = s.applyPriceAtHeight(cp, 3, 1005)
+		s.Require().Len(events, 1)
+		s.Require().Equal(types.EventTypePriceMissed, events[0].Type)
+		s.requirePrice(cp, 1000, 0)
+
+		// updating the circuit breaker does not resume updates
+		s.Require().NoError(s.oracleKeeper.SetCircuitBreaker(s.ctx, cp, types.CircuitBreaker{
+			MaxBlockChangeBps: 200,
+			Action:            types.CircuitBreakerAction_CIRCUIT_BREAKER_ACTION_HALT,
+		}))
+		s.applyPriceAtHeight(cp, 4, 1005)
+		s.requirePrice(cp, 1000, 0)
+
+		s.Require().NoError(s.oracleKeeper.ResetCircuitBreaker(s.ctx, cp))
+		s.applyPriceAtHeight(cp, 5, 1005)
+		s.requirePrice(cp, 1005, 1)
+	})
+
+	s.Run("removing the circuit breaker resumes updates", func() {
+		setup(types.CircuitBreakerAction_CIRCUIT_BREAKER_ACTION_HALT)
+
+		s.applyPriceAtHeight(cp, 2, 2000)
+		s.Require().NoError(s.oracleKeeper.SetCircuitBreaker(s.ctx, cp, types.CircuitBreaker{}))
+
+		_, err := s.oracleKeeper.GetCircuitBreaker(s.ctx, cp)
+		s.Require().Error(err)
+
+		s.applyPriceAtHeight(cp, 3, 2000)
+		s.requirePrice(cp, 2000, 1)
+	})
+
+	s.Run("removing the currency pair removes the circuit breaker", func() {
+		setup(types.CircuitBreakerAction_CIRCUIT_BREAKER_ACTION_HALT)
+
+		s.Require().NoError(s.oracleKeeper.RemoveCurrencyPair(s.ctx, cp))
+		_, err := s.oracleKeeper.GetCircuitBreaker(s.ctx, cp)
+		s.Require().Error(err)
+	})
+}
+
+func (s *KeeperTestSuite) TestCircuitBreakerWindow() {
+	cp := slinkytypes.NewCurrencyPair("BTC", "USD")
+
+	s.SetupWithPriceHistory(10)
+	s.setPriceAtHeight(cp, 1, 1000)
+	s.setPriceAtHeight(cp, 2, 1040)
+	s.setPriceAtHeight(cp, 3, 1080)
+	s.Require().NoError(s.oracleKeeper.SetCircuitBreaker(s.ctx, cp, types.CircuitBreaker{
+		MaxBlockChangeBps:  500,
+		MaxWindowChangeBps: 1000,
+		WindowBlocks:       3,
+		Action:             types.CircuitBreakerAction_CIRCUIT_BREAKER_ACTION_CLAMP,
+	}))
+
+	// within 5% of the previous price, but more than 10% from the price 3 blocks ago
+	events := s.applyPriceAtHeight(cp, 4, 1120)
+	s.requireTripped(events, "window", types.CircuitBreakerAction_CIRCUIT_BREAKER_ACTION_CLAMP)
+	s.requirePrice(cp, 1100, 3)
+
+	// the price 3 blocks ago is now 1040
+	events = s.applyPriceAtHeight(cp, 5, 1144)
+	s.Require().Len(events, 1)
+	s.requirePrice(cp, 1144, 4)
+}
+
+func (s *KeeperTestSuite) TestMsgSetCircuitBreaker() {
+	s.SetupWithPriceHistory(10)
+	ms := keeper.NewMsgServer(s.oracleKeeper)
+
+	cp := slinkytypes.NewCurrencyPair("BTC", "USD")
+	s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, cp))
+
+	cb := types.CircuitBreaker{
+		MaxWindowChangeBps: 1000,
+		WindowBlocks:       10,
+		Action:             types.CircuitBreakerAction_CIRCUIT_BREAKER_ACTION_HALT,
+	}
+
+	tcs := []struct {
+		name       string
+		req        *types.MsgSetCircuitBreaker
+		expectPass bool
+	}{
+		{
+			"nil request - fail",
+			nil,
+			false,
+		},
+		{
+			"invalid authority - fail",
+			&types.MsgSetCircuitBreaker{
+				Authority:      sdk.AccAddress("invalid").String(),
+				CurrencyPair:   cp,
+				CircuitBreaker: cb,
+			},
+			false,
+		},
+		{
+			"window longer than the retained price history - fail",
+			&types.MsgSetCircuitBreaker{
+				Authority:    moduleAuthAddr.String(),
+				CurrencyPair: cp,
+				CircuitBreaker: types.CircuitBreaker{
+					MaxWindowChangeBps: 1000,
+					WindowBlocks:       11,
+					Action:             types.CircuitBreakerAction_CIRCUIT_BREAKER_ACTION_HALT,
+				},
+			},
+			false,
+		},
+		{
+			"currency pair does not exist - fail",
+			&types.MsgSetCircuitBreaker{
+				Authority:      moduleAuthAddr.String(),
+				CurrencyPair:   slinkytypes.NewCurrencyPair("ETH", "USD"),
+				CircuitBreaker: cb,
+			},
+			false,
+		},
+		{
+			"valid request - pass",
+			&types.MsgSetCircuitBreaker{
+				Authority:      moduleAuthAddr.String(),
+				CurrencyPair:   cp,
+				CircuitBreaker: cb,
+			},
+			true,
+		},
+	}
+
+	for _, tc := range tcs {
+		s.Run(tc.name, func() {
+			_, err := ms.SetCircuitBreaker(s.ctx, tc.req)
+			if !tc.expectPass {
+				s.Require().Error(err)
+				return
+			}
+
+			s.Require().NoError(err)
+
+			res, err := keeper.NewQueryServer(s.oracleKeeper).GetCircuitBreaker(s.ctx, &types.GetCircuitBreakerRequest{CurrencyPair: cp})
+			s.Require().NoError(err)
+			s.Require().Equal(types.CurrencyPairCircuitBreaker{CurrencyPair: cp, CircuitBreaker: cb}, res.CircuitBreaker)
+		})
+	}
+
+	s.Run("reset", func() {
+		_, err := ms.ResetCircuitBreaker(s.ctx, &types.MsgResetCircuitBreaker{
+			Authority:    sdk.AccAddress("invalid").String(),
+			CurrencyPair: cp,
+		})
+		s.Require().Error(err)
+
+		_, err = ms.ResetCircuitBreaker(s.ctx, &types.MsgResetCircuitBreaker{
+			Authority:    moduleAuthAddr.String(),
+			CurrencyPair: slinkytypes.NewCurrencyPair("ETH", "USD"),
+		})
+		s.Require().Error(err)
+
+		_, err = ms.ResetCircuitBreaker(s.ctx, &types.MsgResetCircuitBreaker{
+			Authority:    moduleAuthAddr.String(),
+			CurrencyPair: cp,
+		})
+		s.Require().NoError(err)
+	})
+}
+
+func (s *KeeperTestSuite) TestCircuitBreakerGenesis() {
+	cp := slinkytypes.NewCurrencyPair("BTC", "USD")
+	cpcb := types.CurrencyPairCircuitBreaker{
+		CurrencyPair: cp,
+		CircuitBreaker: types.CircuitBreaker{
+			MaxBlockChangeBps: 100,
+			Action:            types.CircuitBreakerAction_CIRCUIT_BREAKER_ACTION_HALT,
+		},
+		Halted: true,
+	}
+
+	s.SetupWithPriceHistory(0)
+	s.oracleKeeper.InitGenesis(s.ctx, *types.NewGenesisState([]types.CurrencyPairGenesis{
+		{CurrencyPair: cp, Id: 0},
+	}, 1, cpcb))
+
+	gs := s.oracleKeeper.ExportGenesis(s.ctx)
+	s.Require().Equal([]types.CurrencyPairCircuitBreaker{cpcb}, gs.CircuitBreakers)
+}
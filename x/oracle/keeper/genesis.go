@@ -38,9 +38,24 @@ func (k *Keeper) InitGenesis(ctx sdk.Context, gs types.GenesisState) {
 	if err := k.numRemoves.Set(ctx, 0); err != nil {
 		panic(fmt.Errorf("error in genesis: %w", err))
 	}
+
+	// set the circuit breakers, including whether they are halted
+	for _, cb := range gs.CircuitBreakers {
+		if err := k.circuitBreakers.Set(ctx, cb.CurrencyPair.String(), cb); err != nil {
+			panic(fmt.Errorf("error in genesis: %w", err))
+		}
+	}
+
+	// set the max price ages
+	for _, ma := range gs.MaxPriceAges {
+		if err := k.maxPriceAges.Set(ctx, ma.CurrencyPair.String(), ma); err != nil {
+			panic(fmt.Errorf("error in genesis: %w", err))
+		}
+	}
 }
 
 // ExportGenesis retrieve all CurrencyPairs + QuotePrices set for the module, and return them as a genesis state.
+// The price history is not exported, and is therefore reset when a chain is restarted from an exported genesis.
 // This module panics on any errors encountered in execution.
 func (k *Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	// get the current next ID
@@ -69,5 +84,15 @@ func (k *Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		panic(err)
 	}
 
+	gs.CircuitBreakers, err = k.GetAllCircuitBreakers(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	gs.MaxPriceAges, err = k.GetAllMaxPriceAges(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	return gs
 }
@@ -74,12 +74,19 @@ func (q queryServer) GetPrice(goCtx context.Context, req *types.GetPriceRequest)
 	}
 
 	// return the QuotePrice + Nonce
-	return &types.GetPriceResponse{
+	res := &types.GetPriceResponse{
 		Price:    &qpn.QuotePrice,
 		Nonce:    qpn.Nonce(),
 		Decimals: decimals,
 		Id:       id,
-	}, nil
+	}
+
+	// report the staleness of the QuotePrice
+	if err := q.k.setPriceStaleness(ctx, cp, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
 }
 
 // GetPrices gets the array of the QuotePrice and the nonce for the QuotePrice for a given CurrencyPairs.
@@ -117,15 +124,110 @@ func (q queryServer) GetPrices(goCtx context.Context, req *types.GetPricesReques
 			return nil, err
 		}
 
-		prices = append(prices, types.GetPriceResponse{
+		res := types.GetPriceResponse{
 			Price:    &qpn.QuotePrice,
 			Nonce:    qpn.Nonce(),
 			Decimals: decimals,
 			Id:       id,
-		})
+		}
+
+		// report the staleness of the QuotePrice
+		if err := q.k.setPriceStaleness(ctx, cp, &res); err != nil {
+			return nil, err
+		}
+
+		prices = append(prices, res)
 	}
 
 	return &types.GetPricesResponse{
 		Prices: prices,
 	}, nil
 }
+
+// GetPriceHistory gets the retained QuotePrices for a given CurrencyPair ordered by block height. If the request is nil
+// or the CurrencyPair is invalid or not tracked by the module, this method fails.
+func (q queryServer) GetPriceHistory(goCtx context.Context, req *types.GetPriceHistoryRequest) (*types.GetPriceHistoryResponse, error) {
+	// fail on nil requests
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	cp := req.CurrencyPair
+	if err := cp.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid currency pair: %w", err)
+	}
+
+	// unwrap ctx
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	prices, err := q.k.GetPriceHistory(ctx, cp)
+	if err != nil {
+		return nil, err
+	}
+
+	decimals, err := q.k.GetDecimalsForCurrencyPair(ctx, cp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GetPriceHistoryResponse{
+		Prices:   prices,
+		Decimals: decimals,
+	}, nil
+}
+
+// GetTWAP gets the time-weighted average price of a given CurrencyPair over the requested window ending at the current
+// block time. If the request is nil, the CurrencyPair is invalid or has no price history, this method fails.
+func (q queryServer) GetTWAP(goCtx context.Context, req *types.GetTWAPRequest) (*types.GetTWAPResponse, error) {
+	// fail on nil requests
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	cp := req.CurrencyPair
+	if err := cp.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid currency pair: %w", err)
+	}
+
+	// unwrap ctx
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	price, start, err := q.k.GetTWAP(ctx, cp, req.Window)
+	if err != nil {
+		return nil, err
+	}
+
+	decimals, err := q.k.GetDecimalsForCurrencyPair(ctx, cp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GetTWAPResponse{
+		Price:          price,
+		StartTimestamp: start,
+		Decimals:       decimals,
+	}, nil
+}
+
+// GetCircuitBreaker gets the CircuitBreaker configured for a given CurrencyPair, and whether its price updates are halted. If
+// the request is nil, the CurrencyPair is invalid or no CircuitBreaker is configured for it, this method fails.
+func (q queryServer) GetCircuitBreaker(goCtx context.Context, req *types.GetCircuitBreakerRequest) (*types.GetCircuitBreakerResponse, error) {
+	// fail on nil requests
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	cp := req.CurrencyPair
+	if err := cp.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid currency pair: %w", err)
+	}
+
+	cb, err := q.k.GetCircuitBreaker(sdk.UnwrapSDKContext(goCtx), cp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GetCircuitBreakerResponse{
+		CircuitBreaker: cb,
+	}, nil
+}
@@ -6,8 +6,29 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	marketmaptypes "github.com/skip-mev/slinky/x/marketmap/types"
+	"github.com/skip-mev/slinky/x/oracle/types"
 )
 
+// OracleHooks gets the x/oracle hooks registered by other modules.
+func (k *Keeper) OracleHooks() types.OracleHooks {
+	if k.hooks == nil {
+		// return a no-op implementation if no hooks are set
+		return types.MultiOracleHooks{}
+	}
+
+	return k.hooks
+}
+
+// SetHooks sets the x/oracle hooks.  In contrast to other receivers, this method must take a pointer due to nature
+// of the hooks interface and SDK start up sequence.
+func (k *Keeper) SetHooks(oh types.OracleHooks) {
+	if k.hooks != nil {
+		panic("cannot set oracle hooks twice")
+	}
+
+	k.hooks = oh
+}
+
 // Hooks is a wrapper struct around Keeper.
 type Hooks struct {
 	k *Keeper
@@ -34,6 +55,24 @@ func (h Hooks) AfterMarketUpdated(_ sdk.Context, _ marketmaptypes.Market) error
 	return nil
 }
 
+// AfterMarketDisabled is the marketmap hook for x/oracle that is run after an enabled market is
+// disabled in the marketmap. The currency pair and its state are retained so that the market can be
+// re-enabled.
+func (h Hooks) AfterMarketDisabled(_ sdk.Context, _ marketmaptypes.Market) error {
+	return nil
+}
+
+// AfterMarketRemoved is the marketmap hook for x/oracle that is run after a market is removed from
+// the marketmap. The currency pair and its state are removed from the oracle module. This is a no-op
+// if the currency pair was already removed, e.g. through MsgRemoveCurrencyPairs.
+func (h Hooks) AfterMarketRemoved(ctx sdk.Context, market marketmaptypes.Market) error {
+	if !h.k.HasCurrencyPair(ctx, market.Ticker.CurrencyPair) {
+		return nil
+	}
+
+	return h.k.RemoveCurrencyPair(ctx, market.Ticker.CurrencyPair)
+}
+
 // AfterMarketGenesis verifies that all markets set in the x/marketmap genesis are registered in
 // the x/oracle module.
 func (h Hooks) AfterMarketGenesis(ctx sdk.Context, markets map[string]marketmaptypes.Market) error {
@@ -67,6 +67,22 @@ type Keeper struct {
 	// numCPs is the number of CPs.
 	numCPs collections.Item[uint64]
 
+	// priceHistory is a ring buffer of the QuotePrices of each CP, keyed by (CP, block height % priceHistoryBlocks).
+	priceHistory collections.Map[collections.Pair[string, uint64], types.QuotePrice]
+
+	// priceHistoryBlocks is the number of blocks of price history retained for each CP. If zero, no history is
+	// retained.
+	priceHistoryBlocks uint64
+
+	// circuitBreakers are the circuit breakers configured for CPs, keyed by CP.
+	circuitBreakers collections.Map[string, types.CurrencyPairCircuitBreaker]
+
+	// maxPriceAges are the max price ages configured for CPs, keyed by CP.
+	maxPriceAges collections.Map[string, types.CurrencyPairMaxPriceAge]
+
+	// registered hooks
+	hooks types.OracleHooks
+
 	// module authority
 	authority sdk.AccAddress
 }
@@ -77,6 +93,7 @@ func NewKeeper(
 	cdc codec.BinaryCodec,
 	mmKeeper types.MarketMapKeeper,
 	authority sdk.AccAddress,
+	opts ...Option,
 ) Keeper {
 	// create a new schema builder
 	sb := collections.NewSchemaBuilder(ss)
@@ -98,6 +115,13 @@ func NewKeeper(
 		nextCurrencyPairID: collections.NewSequence(sb, types.CurrencyPairIDKeyPrefix, "currency_pair_id"),
 		currencyPairs:      collections.NewIndexedMap(sb, types.CurrencyPairKeyPrefix, "currency_pair", collections.StringKey, codec.CollValue[types.CurrencyPairState](cdc), indices),
 		idIndex:            idMulti,
+		priceHistory:       collections.NewMap(sb, types.PriceHistoryKeyPrefix, "price_history", types.PriceHistoryCodec, codec.CollValue[types.QuotePrice](cdc)),
+		circuitBreakers:    collections.NewMap(sb, types.CircuitBreakerKeyPrefix, "circuit_breakers", collections.StringKey, codec.CollValue[types.CurrencyPairCircuitBreaker](cdc)),
+		maxPriceAges:       collections.NewMap(sb, types.MaxPriceAgeKeyPrefix, "max_price_ages", collections.StringKey, codec.CollValue[types.CurrencyPairMaxPriceAge](cdc)),
+	}
+
+	for _, opt := range opts {
+		opt(&k)
 	}
 
 	// create the schema
@@ -120,6 +144,15 @@ func (k *Keeper) RemoveCurrencyPair(ctx sdk.Context, cp slinkytypes.CurrencyPair
 	if err := k.currencyPairs.Remove(ctx, cp.String()); err != nil {
 		return err
 	}
+	if err := k.priceHistory.Clear(ctx, collections.NewPrefixedPairRange[string, uint64](cp.String())); err != nil {
+		return err
+	}
+	if err := k.circuitBreakers.Remove(ctx, cp.String()); err != nil {
+		return err
+	}
+	if err := k.maxPriceAges.Remove(ctx, cp.String()); err != nil {
+		return err
+	}
 	if err := k.incrementRemovedCPCounter(ctx); err != nil {
 		return err
 	}
@@ -211,7 +244,11 @@ func (k *Keeper) SetPriceForCurrencyPair(ctx sdk.Context, cp slinkytypes.Currenc
 	}
 
 	// set the updated state
-	return k.currencyPairs.Set(ctx, cp.String(), cps)
+	if err := k.currencyPairs.Set(ctx, cp.String(), cps); err != nil {
+		return err
+	}
+
+	return k.addPriceToHistory(ctx, cp, qp)
 }
 
 // CreateCurrencyPair creates a CurrencyPair in state, and sets its ID to the next available ID. If the CurrencyPair already exists, return an error.
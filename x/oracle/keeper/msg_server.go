@@ -92,3 +92,76 @@ func (m *msgServer) RemoveCurrencyPairs(goCtx context.Context, req *types.MsgRem
 
 	return nil, nil
 }
+
+// SetCircuitBreaker sets the CircuitBreaker of a CurrencyPair. This method fails if the message is invalid, if the signer is not
+// the authority account of the module, if the CurrencyPair does not exist, or if the CircuitBreaker's window is longer than the
+// price history retained by the module.
+func (m *msgServer) SetCircuitBreaker(goCtx context.Context, req *types.MsgSetCircuitBreaker) (*types.MsgSetCircuitBreakerResponse, error) {
+	// check validity of message
+	if req == nil {
+		return nil, fmt.Errorf("message cannot be empty")
+	}
+
+	// check that the authority of the message is the authority of the module
+	if req.Authority != m.k.authority.String() {
+		return nil, fmt.Errorf("message validation failed: authority %s is not module authority %s", req.Authority, m.k.authority)
+	}
+
+	// the window limit is enforced against the retained price history
+	if req.CircuitBreaker.WindowBlocks > m.k.priceHistoryBlocks {
+		return nil, fmt.Errorf(
+			"circuit breaker window of %d blocks exceeds the %d blocks of retained price history",
+			req.CircuitBreaker.WindowBlocks,
+			m.k.priceHistoryBlocks,
+		)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.k.SetCircuitBreaker(ctx, req.CurrencyPair, req.CircuitBreaker); err != nil {
+		return nil, fmt.Errorf("error setting circuit breaker: %w", err)
+	}
+
+	return &types.MsgSetCircuitBreakerResponse{}, nil
+}
+
+// ResetCircuitBreaker resumes price updates for a CurrencyPair halted by its CircuitBreaker. This method fails if the message is
+// invalid, if the signer is not the authority account of the module, or if no CircuitBreaker is configured for the CurrencyPair.
+func (m *msgServer) ResetCircuitBreaker(goCtx context.Context, req *types.MsgResetCircuitBreaker) (*types.MsgResetCircuitBreakerResponse, error) {
+	// check validity of message
+	if req == nil {
+		return nil, fmt.Errorf("message cannot be empty")
+	}
+
+	// check that the authority of the message is the authority of the module
+	if req.Authority != m.k.authority.String() {
+		return nil, fmt.Errorf("message validation failed: authority %s is not module authority %s", req.Authority, m.k.authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.k.ResetCircuitBreaker(ctx, req.CurrencyPair); err != nil {
+		return nil, fmt.Errorf("error resetting circuit breaker: %w", err)
+	}
+
+	return &types.MsgResetCircuitBreakerResponse{}, nil
+}
+
+// SetMaxPriceAge sets the MaxPriceAge of a CurrencyPair. This method fails if the message is invalid, if the signer is not the
+// authority account of the module, or if the CurrencyPair does not exist.
+func (m *msgServer) SetMaxPriceAge(goCtx context.Context, req *types.MsgSetMaxPriceAge) (*types.MsgSetMaxPriceAgeResponse, error) {
+	// check validity of message
+	if req == nil {
+		return nil, fmt.Errorf("message cannot be empty")
+	}
+
+	// check that the authority of the message is the authority of the module
+	if req.Authority != m.k.authority.String() {
+		return nil, fmt.Errorf("message validation failed: authority %s is not module authority %s", req.Authority, m.k.authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.k.SetMaxPriceAge(ctx, req.CurrencyPair, req.MaxPriceAge); err != nil {
+		return nil, fmt.Errorf("error setting max price age: %w", err)
+	}
+
+	return &types.MsgSetMaxPriceAgeResponse{}, nil
+}
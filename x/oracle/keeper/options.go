@@ -0,0 +1,12 @@
+package keeper
+
+// Option is a function that configures the Keeper.
+type Option func(*Keeper)
+
+// WithPriceHistoryBlocks sets the number of blocks of price history retained for each currency pair. If zero, no
+// price history is retained.
+func WithPriceHistoryBlocks(blocks uint64) Option {
+	return func(k *Keeper) {
+		k.priceHistoryBlocks = blocks
+	}
+}
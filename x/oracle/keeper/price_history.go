@@ -0,0 +1,137 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	slinkytypes "github.com/skip-mev/slinky/pkg/types"
+	"github.com/skip-mev/slinky/x/oracle/types"
+)
+
+// GetPriceHistory returns the QuotePrices retained for the given CurrencyPair ordered by block height. Only prices
+// from the last priceHistoryBlocks blocks (inclusive of the current block) are returned. If the CurrencyPair does not
+// exist, an error is returned.
+func (k *Keeper) GetPriceHistory(ctx sdk.Context, cp slinkytypes.CurrencyPair) ([]types.QuotePrice, error) {
+	if !k.HasCurrencyPair(ctx, cp) {
+		return nil, types.NewCurrencyPairNotExistError(cp)
+	}
+
+	if k.priceHistoryBlocks == 0 {
+		return []types.QuotePrice{}, nil
+	}
+
+	it, err := k.priceHistory.Iterate(ctx, collections.NewPrefixedPairRange[string, uint64](cp.String()))
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := it.Values()
+	if err != nil {
+		return nil, err
+	}
+
+	// slots may contain prices that have fallen out of the retention window if the CP has not been updated recently
+	height := uint64(ctx.BlockHeight())
+	prices := make([]types.QuotePrice, 0, len(values))
+	for _, qp := range values {
+		if qp.BlockHeight+k.priceHistoryBlocks > height {
+			prices = append(prices, qp)
+		}
+	}
+
+	sort.Slice(prices, func(i, j int) bool {
+		return prices[i].BlockHeight < prices[j].BlockHeight
+	})
+
+	return prices, nil
+}
+
+// GetTWAP returns the time-weighted average price of the given CurrencyPair over the window ending at the current
+// block time. Each price in the history is weighted by the time until the next price (or the current block time for
+// the latest price). If the retained history does not cover the full window, the average is computed over the
+// retained history only. The start of the interval covered by the average is returned alongside the price.
+func (k *Keeper) GetTWAP(ctx sdk.Context, cp slinkytypes.CurrencyPair, window time.Duration) (math.Int, time.Time, error) {
+	if window <= 0 {
+		return math.Int{}, time.Time{}, fmt.Errorf("window must be positive")
+	}
+
+	prices, err := k.GetPriceHistory(ctx, cp)
+	if err != nil {
+		return math.Int{}, time.Time{}, err
+	}
+
+	if len(prices) == 0 {
+		return math.Int{}, time.Time{}, types.NewPriceHistoryNotExistError(cp)
+	}
+
+	var (
+		end   = ctx.BlockTime()
+		start = end.Add(-window)
+		first = 0
+	)
+
+	// find the latest price that was set at or before the start of the window
+	for i, qp := range prices {
+		if qp.BlockTimestamp.After(start) {
+			break
+		}
+		first = i
+	}
+
+	if prices[first].BlockTimestamp.After(start) {
+		start = prices[first].BlockTimestamp
+	}
+
+	var (
+		sum   = math.ZeroInt()
+		total int64
+	)
+	for i := first; i < len(prices); i++ {
+		from := prices[i].BlockTimestamp
+		if from.Before(start) {
+			from = start
+		}
+
+		to := end
+		if i+1 < len(prices) {
+			to = prices[i+1].BlockTimestamp
+		}
+
+		weight := to.Sub(from)
+		if weight <= 0 {
+			continue
+		}
+
+		sum = sum.Add(prices[i].Price.MulRaw(int64(weight)))
+		total += int64(weight)
+	}
+
+	// all prices were set at the current block time
+	if total == 0 {
+		return prices[len(prices)-1].Price, start, nil
+	}
+
+	return sum.QuoRaw(total), start, nil
+}
+
+// addPriceToHistory writes the given QuotePrice to the slot of the CurrencyPair's price history that corresponds to
+// the price's block height, overwriting the price from priceHistoryBlocks blocks earlier. Slots at or above
+// priceHistoryBlocks - left behind if the number of retained blocks was lowered - are deleted so that they do not
+// linger in state.
+func (k *Keeper) addPriceToHistory(ctx sdk.Context, cp slinkytypes.CurrencyPair, qp types.QuotePrice) error {
+	stale := collections.NewPrefixedPairRange[string, uint64](cp.String()).StartInclusive(k.priceHistoryBlocks)
+	if err := k.priceHistory.Clear(ctx, stale); err != nil {
+		return err
+	}
+
+	if k.priceHistoryBlocks == 0 {
+		return nil
+	}
+
+	return k.priceHistory.Set(ctx, collections.Join(cp.String(), qp.BlockHeight%k.priceHistoryBlocks), qp)
+}
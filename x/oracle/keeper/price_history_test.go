@@ -0,0 +1,262 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+
+	slinkytypes "github.com/skip-mev/slinky/pkg/types"
+	"github.com/skip-mev/slinky/x/oracle/keeper"
+	"github.com/skip-mev/slinky/x/oracle/types"
+)
+
+// SetupWithPriceHistory sets up a keeper without a market map keeper that retains prices for the given number of blocks.
+func (s *KeeperTestSuite) SetupWithPriceHistory(blocks uint64) {
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ss := runtime.NewKVStoreService(key)
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	s.oracleKeeper = keeper.NewKeeper(ss, encCfg.Codec, nil, moduleAuthAddr, keeper.WithPriceHistoryBlocks(blocks))
+	s.ctx = testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_key"))
+	s.oracleKeeper.InitGenesis(s.ctx, *types.DefaultGenesisState())
+}
+
+// setPriceAtHeight sets the price for the given currency pair at the given height, with the block time being height
+// seconds after genesis.
+func (s *KeeperTestSuite) setPriceAtHeight(cp slinkytypes.CurrencyPair, height int64, price int64) {
+	s.ctx = s.ctx.WithBlockHeight(height).WithBlockTime(time.Unix(height, 0).UTC())
+	s.Require().NoError(s.oracleKeeper.SetPriceForCurrencyPair(s.ctx, cp, types.QuotePrice{
+		Price:          sdkmath.NewInt(price),
+		BlockTimestamp: s.ctx.BlockTime(),
+		BlockHeight:    uint64(height),
+	}))
+}
+
+func (s *KeeperTestSuite) TestGetPriceHistory() {
+	cp := slinkytypes.NewCurrencyPair("BTC", "USD")
+
+	s.Run("history is empty when disabled", func() {
+		s.SetupWithPriceHistory(0)
+		s.setPriceAtHeight(cp, 1, 100)
+
+		prices, err := s.oracleKeeper.GetPriceHistory(s.ctx, cp)
+		s.Require().NoError(err)
+		s.Require().Empty(prices)
+	})
+
+	s.Run("unknown currency pair fails", func() {
+		s.SetupWithPriceHistory(4)
+
+		_, err := s.oracleKeeper.GetPriceHistory(s.ctx, cp)
+		s.Require().Error(err)
+	})
+
+	s.Run("history is ordered by height and older prices are overwritten", func() {
+		s.SetupWithPriceHistory(4)
+		for height := int64(1); height <= 6; height++ {
+			s.setPriceAtHeight(cp, height, 100*height)
+		}
+
+		prices, err := s.oracleKeeper.GetPriceHistory(s.ctx, cp)
+		s.Require().NoError(err)
+		s.Require().Len(prices, 4)
+		for i, qp := range prices {
+			s.Require().Equal(uint64(i+3), qp.BlockHeight)
+			s.Require().Equal(sdkmath.NewInt(int64(100*(i+3))), qp.Price)
+		}
+	})
+
+	s.Run("prices outside of the retention window are not returned", func() {
+		s.SetupWithPriceHistory(4)
+		s.setPriceAtHeight(cp, 1, 100)
+		s.setPriceAtHeight(cp, 3, 300)
+
+		s.ctx = s.ctx.WithBlockHeight(5)
+		prices, err := s.oracleKeeper.GetPriceHistory(s.ctx, cp)
+		s.Require().NoError(err)
+		s.Require().Len(prices, 1)
+		s.Require().Equal(uint64(3), prices[0].BlockHeight)
+	})
+
+	s.Run("removing the currency pair clears its history", func() {
+		s.SetupWithPriceHistory(4)
+		s.setPriceAtHeight(cp, 1, 100)
+		s.setPriceAtHeight(cp, 2, 200)
+
+		s.Require().NoError(s.oracleKeeper.RemoveCurrencyPair(s.ctx, cp))
+		s.setPriceAtHeight(cp, 3, 300)
+
+		prices, err := s.oracleKeeper.GetPriceHistory(s.ctx, cp)
+		s.Require().NoError(err)
+		s.Require().Len(prices, 1)
+		s.Require().Equal(uint64(3), prices[0].BlockHeight)
+	})
+
+	s.Run("slots outside of a lowered retention window are deleted", func() {
+		key := storetypes.NewKVStoreKey(types.StoreKey)
+		ss := runtime.NewKVStoreService(key)
+		encCfg := moduletestutil.MakeTestEncodingConfig()
+		s.ctx = testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_key"))
+
+		s.oracleKeeper = keeper.NewKeeper(ss, encCfg.Codec, nil, moduleAuthAddr, keeper.WithPriceHistoryBlocks(8))
+		s.oracleKeeper.InitGenesis(s.ctx, *types.DefaultGenesisState())
+		for height := int64(1); height <= 8; height++ {
+			s.setPriceAtHeight(cp, height, 100*height)
+		}
+
+		slots := func() int {
+			it := storetypes.KVStorePrefixIterator(s.ctx.KVStore(key), types.PriceHistoryKeyPrefix)
+			defer it.Close()
+
+			count := 0
+			for ; it.Valid(); it.Next() {
+				count++
+			}
+			return count
+		}
+		s.Require().Equal(8, slots())
+
+		// the number of retained blocks is lowered, e.g. on restart with an updated module config
+		s.oracleKeeper = keeper.NewKeeper(ss, encCfg.Codec, nil, moduleAuthAddr, keeper.WithPriceHistoryBlocks(4))
+		s.setPriceAtHeight(cp, 9, 900)
+		s.Require().Equal(4, slots())
+
+		prices, err := s.oracleKeeper.GetPriceHistory(s.ctx, cp)
+		s.Require().NoError(err)
+		s.Require().Len(prices, 2)
+		s.Require().Equal(uint64(8), prices[0].BlockHeight)
+		s.Require().Equal(uint64(9), prices[1].BlockHeight)
+	})
+}
+
+func (s *KeeperTestSuite) TestGetTWAP() {
+	cp := slinkytypes.NewCurrencyPair("BTC", "USD")
+
+	tcs := []struct {
+		name          string
+		prices        []int64
+		height        int64
+		window        time.Duration
+		expectedPrice sdkmath.Int
+		expectedStart time.Time
+		expectPass    bool
+	}{
+		{
+			"non-positive window - fail",
+			[]int64{100},
+			1,
+			0,
+			sdkmath.Int{},
+			time.Time{},
+			false,
+		},
+		{
+			"no price history - fail",
+			nil,
+			1,
+			time.Second,
+			sdkmath.Int{},
+			time.Time{},
+			false,
+		},
+		{
+			"all prices at the current block time - return the latest price",
+			[]int64{100},
+			1,
+			time.Minute,
+			sdkmath.NewInt(100),
+			time.Unix(1, 0).UTC(),
+			true,
+		},
+		{
+			"window covered by the history - weight each price by its duration",
+			// prices at heights 1..4, the window [2, 5] covers 200, 300 and 400 for a second each and ends at height 5
+			[]int64{100, 200, 300, 400},
+			5,
+			3 * time.Second,
+			sdkmath.NewInt(300),
+			time.Unix(2, 0).UTC(),
+			true,
+		},
+		{
+			"window starting between prices - clamp the first price to the start of the window",
+			// the window [1.5, 4] covers 100 for 0.5s, 200 for 1s and 300 for 1s
+			[]int64{100, 200, 300},
+			4,
+			2500 * time.Millisecond,
+			sdkmath.NewInt(220),
+			time.Unix(1, int64(500*time.Millisecond)).UTC(),
+			true,
+		},
+		{
+			"window longer than the history - average over the retained history",
+			[]int64{100, 300},
+			3,
+			time.Hour,
+			sdkmath.NewInt(200),
+			time.Unix(1, 0).UTC(),
+			true,
+		},
+	}
+
+	for _, tc := range tcs {
+		s.Run(tc.name, func() {
+			s.SetupWithPriceHistory(10)
+			s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, cp))
+			for i, price := range tc.prices {
+				s.setPriceAtHeight(cp, int64(i+1), price)
+			}
+			s.ctx = s.ctx.WithBlockHeight(tc.height).WithBlockTime(time.Unix(tc.height, 0).UTC())
+
+			price, start, err := s.oracleKeeper.GetTWAP(s.ctx, cp, tc.window)
+			if !tc.expectPass {
+				s.Require().Error(err)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedPrice, price)
+			s.Require().Equal(tc.expectedStart, start)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestQueryPriceHistoryAndTWAP() {
+	s.SetupWithPriceHistory(4)
+	qs := keeper.NewQueryServer(s.oracleKeeper)
+
+	cp := slinkytypes.NewCurrencyPair("BTC", "USD")
+	s.setPriceAtHeight(cp, 1, 100)
+	s.setPriceAtHeight(cp, 2, 300)
+	s.ctx = s.ctx.WithBlockHeight(3).WithBlockTime(time.Unix(3, 0).UTC())
+
+	s.Run("price history query", func() {
+		_, err := qs.GetPriceHistory(s.ctx, nil)
+		s.Require().Error(err)
+
+		_, err = qs.GetPriceHistory(s.ctx, &types.GetPriceHistoryRequest{})
+		s.Require().Error(err)
+
+		res, err := qs.GetPriceHistory(s.ctx, &types.GetPriceHistoryRequest{CurrencyPair: cp})
+		s.Require().NoError(err)
+		s.Require().Len(res.Prices, 2)
+		s.Require().Equal(uint64(cp.LegacyDecimals()), res.Decimals)
+	})
+
+	s.Run("twap query", func() {
+		_, err := qs.GetTWAP(s.ctx, nil)
+		s.Require().Error(err)
+
+		_, err = qs.GetTWAP(s.ctx, &types.GetTWAPRequest{CurrencyPair: slinkytypes.NewCurrencyPair("ETH", "USD"), Window: time.Second})
+		s.Require().Error(err)
+
+		res, err := qs.GetTWAP(s.ctx, &types.GetTWAPRequest{CurrencyPair: cp, Window: 2 * time.Second})
+		s.Require().NoError(err)
+		s.Require().Equal(sdkmath.NewInt(200), res.Price)
+		s.Require().Equal(time.Unix(1, 0).UTC(), res.StartTimestamp)
+		s.Require().Equal(uint64(cp.LegacyDecimals()), res.Decimals)
+	})
+}
@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	slinkytypes "github.com/skip-mev/slinky/pkg/types"
+	"github.com/skip-mev/slinky/x/oracle/types"
+)
+
+// ApplyPriceUpdate sets the given QuotePrice aggregated from numValidators validators' vote extensions for the given
+// CurrencyPair, emits a price update event, and runs the AfterPriceUpdated hooks. Errors returned by the hooks are
+// logged and their state changes discarded, so that a failing consumer cannot halt the chain. The price is first
+// checked against the CurrencyPair's CircuitBreaker, if the update is dropped it is applied as a missed price.
+func (k *Keeper) ApplyPriceUpdate(ctx sdk.Context, cp slinkytypes.CurrencyPair, qp types.QuotePrice, numValidators uint64) error {
+	qp, ok, err := k.applyCircuitBreaker(ctx, cp, qp)
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		k.ApplyMissedPrice(ctx, cp)
+		return nil
+	}
+
+	if err := k.SetPriceForCurrencyPair(ctx, cp, qp); err != nil {
+		return err
+	}
+
+	qpn, err := k.GetPriceWithNonceForCurrencyPair(ctx, cp)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypePriceUpdate,
+		sdk.NewAttribute(types.AttributeKeyCurrencyPair, cp.String()),
+		sdk.NewAttribute(types.AttributeKeyPrice, qp.Price.String()),
+		sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatUint(qp.BlockHeight, 10)),
+		sdk.NewAttribute(types.AttributeKeyNonce, strconv.FormatUint(qpn.Nonce(), 10)),
+		sdk.NewAttribute(types.AttributeKeyNumValidators, strconv.FormatUint(numValidators, 10)),
+	))
+
+	k.runHook(ctx, "AfterPriceUpdated", cp, func(ctx sdk.Context) error {
+		return k.OracleHooks().AfterPriceUpdated(ctx, cp, qpn)
+	})
+
+	return nil
+}
+
+// ApplyMissedPrice emits a missed price event and runs the AfterPriceMissed hooks for a CurrencyPair for which no
+// valid price was aggregated in the current block. Errors returned by the hooks are logged and their state changes
+// discarded.
+func (k *Keeper) ApplyMissedPrice(ctx sdk.Context, cp slinkytypes.CurrencyPair) {
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypePriceMissed,
+		sdk.NewAttribute(types.AttributeKeyCurrencyPair, cp.String()),
+		sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+	))
+
+	k.runHook(ctx, "AfterPriceMissed", cp, func(ctx sdk.Context) error {
+		return k.OracleHooks().AfterPriceMissed(ctx, cp)
+	})
+}
+
+// runHook runs the given hook in a cached context, only writing its state changes if it succeeds.
+func (k *Keeper) runHook(ctx sdk.Context, name string, cp slinkytypes.CurrencyPair, hook func(sdk.Context) error) {
+	cacheCtx, write := ctx.CacheContext()
+	if err := hook(cacheCtx); err != nil {
+		ctx.Logger().Error(
+			"oracle hook failed",
+			"hook", name,
+			"currency_pair", cp.String(),
+			"err", err,
+		)
+
+		return
+	}
+
+	write()
+}
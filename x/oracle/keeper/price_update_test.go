@@ -0,0 +1,133 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	slinkytypes "github.com/skip-mev/slinky/pkg/types"
+	"github.com/skip-mev/slinky/x/oracle/types"
+)
+
+// recordingHooks records the calls to the oracle hooks, optionally failing after writing to state.
+type recordingHooks struct {
+	updated []types.QuotePriceWithNonce
+	missed  []slinkytypes.CurrencyPair
+	fail    bool
+
+	// onUpdate is run within the hook, allowing the test to write to state
+	onUpdate func(ctx sdk.Context)
+}
+
+func (h *recordingHooks) AfterPriceUpdated(ctx sdk.Context, _ slinkytypes.CurrencyPair, qp types.QuotePriceWithNonce) error {
+	h.updated = append(h.updated, qp)
+	if h.onUpdate != nil {
+		h.onUpdate(ctx)
+	}
+
+	if h.fail {
+		return fmt.Errorf("hook failed")
+	}
+	return nil
+}
+
+func (h *recordingHooks) AfterPriceMissed(_ sdk.Context, cp slinkytypes.CurrencyPair) error {
+	h.missed = append(h.missed, cp)
+	if h.fail {
+		return fmt.Errorf("hook failed")
+	}
+	return nil
+}
+
+func (s *KeeperTestSuite) TestApplyPriceUpdate() {
+	cp := slinkytypes.NewCurrencyPair("BTC", "USD")
+	qp := types.QuotePrice{
+		Price:          sdkmath.NewInt(100),
+		BlockTimestamp: time.Unix(10, 0).UTC(),
+		BlockHeight:    10,
+	}
+
+	s.Run("price is written, event emitted and hooks run", func() {
+		s.SetupWithPriceHistory(0)
+		hooks := &recordingHooks{}
+		s.oracleKeeper.SetHooks(types.MultiOracleHooks{hooks})
+		s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, cp))
+
+		ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+		s.Require().NoError(s.oracleKeeper.ApplyPriceUpdate(ctx, cp, qp, 3))
+
+		qpn, err := s.oracleKeeper.GetPriceWithNonceForCurrencyPair(ctx, cp)
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(1), qpn.Nonce())
+		s.Require().Equal([]types.QuotePriceWithNonce{qpn}, hooks.updated)
+
+		events := ctx.EventManager().Events()
+		s.Require().Len(events, 1)
+		s.Require().Equal(types.EventTypePriceUpdate, events[0].Type)
+		for key, value := range map[string]string{
+			types.AttributeKeyCurrencyPair:  "BTC/USD",
+			types.AttributeKeyPrice:         "100",
+			types.AttributeKeyHeight:        "10",
+			types.AttributeKeyNonce:         "1",
+			types.AttributeKeyNumValidators: "3",
+		} {
+			attr, ok := events[0].GetAttribute(key)
+			s.Require().True(ok)
+			s.Require().Equal(value, attr.Value)
+		}
+	})
+
+	s.Run("failing hooks do not fail the update and their state is discarded", func() {
+		s.SetupWithPriceHistory(0)
+		other := slinkytypes.NewCurrencyPair("ETH", "USD")
+		hooks := &recordingHooks{
+			fail: true,
+			onUpdate: func(ctx sdk.Context) {
+				s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(ctx, other))
+			},
+		}
+		s.oracleKeeper.SetHooks(hooks)
+
+		s.Require().NoError(s.oracleKeeper.ApplyPriceUpdate(s.ctx, cp, qp, 1))
+		s.Require().Len(hooks.updated, 1)
+
+		_, err := s.oracleKeeper.GetPriceForCurrencyPair(s.ctx, cp)
+		s.Require().NoError(err)
+		s.Require().False(s.oracleKeeper.HasCurrencyPair(s.ctx, other))
+	})
+
+	s.Run("hooks cannot be set twice", func() {
+		s.SetupWithPriceHistory(0)
+		s.oracleKeeper.SetHooks(&recordingHooks{})
+		s.Require().Panics(func() {
+			s.oracleKeeper.SetHooks(&recordingHooks{})
+		})
+	})
+}
+
+func (s *KeeperTestSuite) TestApplyMissedPrice() {
+	cp := slinkytypes.NewCurrencyPair("BTC", "USD")
+
+	s.SetupWithPriceHistory(0)
+	hooks := &recordingHooks{}
+	s.oracleKeeper.SetHooks(types.MultiOracleHooks{hooks, hooks})
+
+	ctx := s.ctx.WithEventManager(sdk.NewEventManager()).WithBlockHeight(7)
+	s.oracleKeeper.ApplyMissedPrice(ctx, cp)
+
+	s.Require().Equal([]slinkytypes.CurrencyPair{cp, cp}, hooks.missed)
+
+	events := ctx.EventManager().Events()
+	s.Require().Len(events, 1)
+	s.Require().Equal(types.EventTypePriceMissed, events[0].Type)
+
+	attr, ok := events[0].GetAttribute(types.AttributeKeyCurrencyPair)
+	s.Require().True(ok)
+	s.Require().Equal("BTC/USD", attr.Value)
+
+	attr, ok = events[0].GetAttribute(types.AttributeKeyHeight)
+	s.Require().True(ok)
+	s.Require().Equal("7", attr.Value)
+}
@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	slinkytypes "github.com/skip-mev/slinky/pkg/types"
+	"github.com/skip-mev/slinky/x/oracle/types"
+)
+
+// SetMaxPriceAge sets the MaxPriceAge of the given CurrencyPair. If the MaxPriceAge has no limits, the CurrencyPair's
+// MaxPriceAge is removed. This method fails if the CurrencyPair does not exist.
+func (k *Keeper) SetMaxPriceAge(ctx sdk.Context, cp slinkytypes.CurrencyPair, maxAge types.MaxPriceAge) error {
+	if !k.HasCurrencyPair(ctx, cp) {
+		return types.NewCurrencyPairNotExistError(cp)
+	}
+
+	if !maxAge.HasLimits() {
+		return k.maxPriceAges.Remove(ctx, cp.String())
+	}
+
+	return k.maxPriceAges.Set(ctx, cp.String(), types.CurrencyPairMaxPriceAge{
+		CurrencyPair: cp,
+		MaxPriceAge:  maxAge,
+	})
+}
+
+// GetMaxPriceAge returns the MaxPriceAge configured for the given CurrencyPair. If none is configured, a MaxPriceAge
+// without limits is returned.
+func (k *Keeper) GetMaxPriceAge(ctx sdk.Context, cp slinkytypes.CurrencyPair) (types.MaxPriceAge, error) {
+	cpma, err := k.maxPriceAges.Get(ctx, cp.String())
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.MaxPriceAge{}, nil
+		}
+
+		return types.MaxPriceAge{}, err
+	}
+
+	return cpma.MaxPriceAge, nil
+}
+
+// GetAllMaxPriceAges returns the MaxPriceAges configured for all CurrencyPairs.
+func (k *Keeper) GetAllMaxPriceAges(ctx sdk.Context) ([]types.CurrencyPairMaxPriceAge, error) {
+	it, err := k.maxPriceAges.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return it.Values()
+}
+
+// GetFreshPrice returns the QuotePrice of the given CurrencyPair if it is not older than the MaxPriceAge configured for
+// the CurrencyPair. If the price is stale, a StalePriceError is returned, and if no price has been set, a
+// QuotePriceNotExistError is returned. Consumers of x/oracle prices should prefer this method over
+// GetPriceForCurrencyPair.
+func (k *Keeper) GetFreshPrice(ctx sdk.Context, cp slinkytypes.CurrencyPair) (types.QuotePrice, error) {
+	qp, err := k.GetPriceForCurrencyPair(ctx, cp)
+	if err != nil {
+		return types.QuotePrice{}, err
+	}
+
+	stale, ageBlocks, age, err := k.priceStaleness(ctx, cp, qp)
+	if err != nil {
+		return types.QuotePrice{}, err
+	}
+
+	if stale {
+		return types.QuotePrice{}, types.NewStalePriceError(cp, ageBlocks, age)
+	}
+
+	return qp, nil
+}
+
+// setPriceStaleness sets the staleness of the CurrencyPair's price on the given response. If no price has been set for
+// the CurrencyPair, it is stale if a MaxPriceAge is configured.
+func (k *Keeper) setPriceStaleness(ctx sdk.Context, cp slinkytypes.CurrencyPair, res *types.GetPriceResponse) error {
+	qp, err := k.GetPriceForCurrencyPair(ctx, cp)
+	if err != nil {
+		if !errors.As(err, &types.QuotePriceNotExistError{}) {
+			return err
+		}
+
+		maxAge, err := k.GetMaxPriceAge(ctx, cp)
+		if err != nil {
+			return err
+		}
+
+		res.Stale = maxAge.HasLimits()
+		return nil
+	}
+
+	res.Stale, res.AgeBlocks, res.Age, err = k.priceStaleness(ctx, cp, qp)
+	return err
+}
+
+// priceStaleness returns whether the given QuotePrice of the CurrencyPair is stale as of the current block, alongside
+// its age in blocks and in time.
+func (k *Keeper) priceStaleness(ctx sdk.Context, cp slinkytypes.CurrencyPair, qp types.QuotePrice) (bool, uint64, time.Duration, error) {
+	maxAge, err := k.GetMaxPriceAge(ctx, cp)
+	if err != nil {
+		return false, 0, 0, err
+	}
+
+	ageBlocks, age := qp.Age(uint64(ctx.BlockHeight()), ctx.BlockTime())
+	return maxAge.IsStale(ageBlocks, age), ageBlocks, age, nil
+}
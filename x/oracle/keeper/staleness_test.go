@@ -0,0 +1,190 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	slinkytypes "github.com/skip-mev/slinky/pkg/types"
+	"github.com/skip-mev/slinky/x/oracle/keeper"
+	"github.com/skip-mev/slinky/x/oracle/types"
+)
+
+func (s *KeeperTestSuite) TestGetFreshPrice() {
+	cp := slinkytypes.NewCurrencyPair("BTC", "USD")
+
+	tcs := []struct {
+		name      string
+		maxAge    types.MaxPriceAge
+		setPrice  bool
+		height    int64
+		expectErr error
+	}{
+		{
+			"no price - fail",
+			types.MaxPriceAge{},
+			false,
+			1,
+			types.QuotePriceNotExistError{},
+		},
+		{
+			"no max price age - pass",
+			types.MaxPriceAge{},
+			true,
+			1000,
+			nil,
+		},
+		{
+			"within the max price age - pass",
+			types.MaxPriceAge{MaxBlocks: 5, MaxDuration: 5 * time.Second},
+			true,
+			6,
+			nil,
+		},
+		{
+			"beyond the max age in blocks - fail",
+			types.MaxPriceAge{MaxBlocks: 5},
+			true,
+			7,
+			types.StalePriceError{},
+		},
+		{
+			"beyond the max age in time - fail",
+			types.MaxPriceAge{MaxDuration: 5 * time.Second},
+			true,
+			7,
+			types.StalePriceError{},
+		},
+	}
+
+	for _, tc := range tcs {
+		s.Run(tc.name, func() {
+			s.SetupWithPriceHistory(0)
+			s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, cp))
+			if tc.setPrice {
+				s.setPriceAtHeight(cp, 1, 100)
+			}
+			s.Require().NoError(s.oracleKeeper.SetMaxPriceAge(s.ctx, cp, tc.maxAge))
+
+			s.ctx = s.ctx.WithBlockHeight(tc.height).WithBlockTime(time.Unix(tc.height, 0).UTC())
+			qp, err := s.oracleKeeper.GetFreshPrice(s.ctx, cp)
+			if tc.expectErr != nil {
+				s.Require().IsType(tc.expectErr, err)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Equal(uint64(1), qp.BlockHeight)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestQueryPriceStaleness() {
+	s.SetupWithPriceHistory(0)
+	qs := keeper.NewQueryServer(s.oracleKeeper)
+
+	btc := slinkytypes.NewCurrencyPair("BTC", "USD")
+	eth := slinkytypes.NewCurrencyPair("ETH", "USD")
+	sol := slinkytypes.NewCurrencyPair("SOL", "USD")
+	s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, sol))
+	s.setPriceAtHeight(btc, 1, 100)
+	s.setPriceAtHeight(eth, 3, 100)
+
+	maxAge := types.MaxPriceAge{MaxBlocks: 2}
+	s.Require().NoError(s.oracleKeeper.SetMaxPriceAge(s.ctx, btc, maxAge))
+	s.Require().NoError(s.oracleKeeper.SetMaxPriceAge(s.ctx, eth, maxAge))
+	s.Require().NoError(s.oracleKeeper.SetMaxPriceAge(s.ctx, sol, maxAge))
+
+	s.ctx = s.ctx.WithBlockHeight(4).WithBlockTime(time.Unix(4, 0).UTC())
+
+	s.Run("get price", func() {
+		res, err := qs.GetPrice(s.ctx, &types.GetPriceRequest{CurrencyPair: btc})
+		s.Require().NoError(err)
+		s.Require().True(res.Stale)
+		s.Require().Equal(uint64(3), res.AgeBlocks)
+		s.Require().Equal(3*time.Second, res.Age)
+	})
+
+	s.Run("get prices", func() {
+		res, err := qs.GetPrices(s.ctx, &types.GetPricesRequest{CurrencyPairIds: []string{"BTC/USD", "ETH/USD", "SOL/USD"}})
+		s.Require().NoError(err)
+		s.Require().Len(res.Prices, 3)
+
+		s.Require().True(res.Prices[0].Stale)
+
+		s.Require().False(res.Prices[1].Stale)
+		s.Require().Equal(uint64(1), res.Prices[1].AgeBlocks)
+		s.Require().Equal(time.Second, res.Prices[1].Age)
+
+		// a currency pair with a max price age but no price is stale
+		s.Require().True(res.Prices[2].Stale)
+		s.Require().Zero(res.Prices[2].AgeBlocks)
+	})
+}
+
+func (s *KeeperTestSuite) TestMsgSetMaxPriceAge() {
+	s.SetupWithPriceHistory(0)
+	ms := keeper.NewMsgServer(s.oracleKeeper)
+
+	cp := slinkytypes.NewCurrencyPair("BTC", "USD")
+	s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, cp))
+	maxAge := types.MaxPriceAge{MaxBlocks: 10, MaxDuration: time.Minute}
+
+	s.Run("nil request - fail", func() {
+		_, err := ms.SetMaxPriceAge(s.ctx, nil)
+		s.Require().Error(err)
+	})
+
+	s.Run("invalid authority - fail", func() {
+		_, err := ms.SetMaxPriceAge(s.ctx, &types.MsgSetMaxPriceAge{
+			Authority:    sdk.AccAddress("invalid").String(),
+			CurrencyPair: cp,
+			MaxPriceAge:  maxAge,
+		})
+		s.Require().Error(err)
+	})
+
+	s.Run("currency pair does not exist - fail", func() {
+		_, err := ms.SetMaxPriceAge(s.ctx, &types.MsgSetMaxPriceAge{
+			Authority:    moduleAuthAddr.String(),
+			CurrencyPair: slinkytypes.NewCurrencyPair("ETH", "USD"),
+			MaxPriceAge:  maxAge,
+		})
+		s.Require().Error(err)
+	})
+
+	s.Run("valid request - pass", func() {
+		_, err := ms.SetMaxPriceAge(s.ctx, &types.MsgSetMaxPriceAge{
+			Authority:    moduleAuthAddr.String(),
+			CurrencyPair: cp,
+			MaxPriceAge:  maxAge,
+		})
+		s.Require().NoError(err)
+
+		got, err := s.oracleKeeper.GetMaxPriceAge(s.ctx, cp)
+		s.Require().NoError(err)
+		s.Require().Equal(maxAge, got)
+
+		gs := s.oracleKeeper.ExportGenesis(s.ctx)
+		s.Require().Equal([]types.CurrencyPairMaxPriceAge{{CurrencyPair: cp, MaxPriceAge: maxAge}}, gs.MaxPriceAges)
+	})
+
+	s.Run("max price age without limits removes it", func() {
+		_, err := ms.SetMaxPriceAge(s.ctx, &types.MsgSetMaxPriceAge{
+			Authority:    moduleAuthAddr.String(),
+			CurrencyPair: cp,
+		})
+		s.Require().NoError(err)
+
+		got, err := s.oracleKeeper.GetMaxPriceAge(s.ctx, cp)
+		s.Require().NoError(err)
+		s.Require().False(got.HasLimits())
+	})
+
+	s.Run("removing the currency pair removes the max price age", func() {
+		s.Require().NoError(s.oracleKeeper.SetMaxPriceAge(s.ctx, cp, maxAge))
+		s.Require().NoError(s.oracleKeeper.RemoveCurrencyPair(s.ctx, cp))
+
+		s.Require().Empty(s.oracleKeeper.ExportGenesis(s.ctx).MaxPriceAges)
+	})
+}
@@ -3,6 +3,8 @@ package oracle
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"sort"
 
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/store"
@@ -16,6 +18,7 @@ import (
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/cobra"
+	"golang.org/x/exp/maps"
 
 	oraclemodulev1 "github.com/skip-mev/slinky/api/slinky/oracle/module/v1"
 	marketmaptypes "github.com/skip-mev/slinky/x/marketmap/types"
@@ -167,6 +170,7 @@ func init() {
 	appmodule.Register(
 		&oraclemodulev1.Module{},
 		appmodule.Provide(ProvideModule),
+		appmodule.Invoke(InvokeSetOracleHooks),
 	)
 }
 
@@ -202,6 +206,7 @@ func ProvideModule(in Inputs) Outputs {
 		in.Cdc,
 		in.MarketMapKeeper,
 		authority,
+		keeper.WithPriceHistoryBlocks(in.Config.PriceHistoryBlocks),
 	)
 
 	m := NewAppModule(in.Cdc, oracleKeeper)
@@ -212,3 +217,43 @@ func ProvideModule(in Inputs) Outputs {
 		Hooks:        marketmaptypes.MarketMapHooksWrapper{MarketMapHooks: oracleKeeper.Hooks()},
 	}
 }
+
+// InvokeSetOracleHooks uses the module config to set the hooks on the module.
+func InvokeSetOracleHooks(
+	config *oraclemodulev1.Module,
+	keeper *keeper.Keeper,
+	hooks map[string]types.OracleHooksWrapper,
+) error {
+	// all arguments to invokers are optional
+	if keeper == nil || config == nil {
+		return nil
+	}
+
+	modNames := maps.Keys(hooks)
+	order := config.HooksOrder
+	if len(order) == 0 {
+		order = modNames
+		sort.Strings(order)
+	}
+
+	if len(order) != len(modNames) {
+		return fmt.Errorf("len(hooks_order: %v) != len(hooks modules: %v)", order, modNames)
+	}
+
+	if len(modNames) == 0 {
+		return nil
+	}
+
+	var multiHooks types.MultiOracleHooks
+	for _, modName := range order {
+		hook, ok := hooks[modName]
+		if !ok {
+			return fmt.Errorf("can't find oracle hooks for module %s", modName)
+		}
+
+		multiHooks = append(multiHooks, hook)
+	}
+
+	keeper.SetHooks(multiHooks)
+	return nil
+}
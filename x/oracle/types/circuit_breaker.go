@@ -0,0 +1,95 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+// BpsDenominator is the number of basis points in one.
+const BpsDenominator = 10000
+
+// HasLimits returns true if the CircuitBreaker limits the change of a price between blocks or over a window of blocks.
+func (cb *CircuitBreaker) HasLimits() bool {
+	return cb.MaxBlockChangeBps > 0 || cb.MaxWindowChangeBps > 0
+}
+
+// ValidateBasic validates that a CircuitBreaker with limits has a valid action, and that the window limit is only set
+// alongside a non-zero window. A CircuitBreaker without limits is valid.
+func (cb *CircuitBreaker) ValidateBasic() error {
+	if (cb.MaxWindowChangeBps > 0) != (cb.WindowBlocks > 0) {
+		return fmt.Errorf("max window change and window blocks must either both be set or both be zero")
+	}
+
+	if !cb.HasLimits() {
+		return nil
+	}
+
+	if _, ok := CircuitBreakerAction_name[int32(cb.Action)]; !ok || cb.Action == CircuitBreakerAction_CIRCUIT_BREAKER_ACTION_UNSPECIFIED {
+		return fmt.Errorf("invalid circuit breaker action: %s", cb.Action)
+	}
+
+	return nil
+}
+
+// ValidateBasic validates the CurrencyPair and the CircuitBreaker, and that the CircuitBreaker has limits.
+func (cpcb *CurrencyPairCircuitBreaker) ValidateBasic() error {
+	if err := cpcb.CurrencyPair.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if err := cpcb.CircuitBreaker.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid circuit breaker for %s: %w", cpcb.CurrencyPair, err)
+	}
+
+	if !cpcb.CircuitBreaker.HasLimits() {
+		return fmt.Errorf("circuit breaker for %s has no limits", cpcb.CurrencyPair)
+	}
+
+	return nil
+}
+
+// ExceedsMaxChange returns true if the change of price relative to the (positive) reference price is larger than
+// maxBps basis points.
+func ExceedsMaxChange(ref, price math.Int, maxBps uint64) bool {
+	if !ref.IsPositive() {
+		return false
+	}
+
+	lower, upper := changeBounds(ref, maxBps)
+	return price.LT(lower) || price.GT(upper)
+}
+
+// ClampChange clamps the price to within maxBps basis points of the (positive) reference price.
+func ClampChange(ref, price math.Int, maxBps uint64) math.Int {
+	if !ref.IsPositive() {
+		return price
+	}
+
+	lower, upper := changeBounds(ref, maxBps)
+	switch {
+	case price.LT(lower):
+		return lower
+	case price.GT(upper):
+		return upper
+	default:
+		return price
+	}
+}
+
+// changeBounds returns the lowest and highest prices within maxBps basis points of the reference price. The lower
+// bound is rounded up and the upper bound down so that both are within the limit, and the lower bound is never
+// negative.
+func changeBounds(ref math.Int, maxBps uint64) (math.Int, math.Int) {
+	delta := ref.Mul(math.NewIntFromUint64(maxBps))
+	denom := math.NewInt(BpsDenominator)
+
+	upper := ref.Mul(denom).Add(delta).Quo(denom)
+
+	lower := math.ZeroInt()
+	if scaled := ref.Mul(denom).Sub(delta); scaled.IsPositive() {
+		lower = scaled.Add(denom).SubRaw(1).Quo(denom)
+	}
+
+	return lower, upper
+}
@@ -0,0 +1,750 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: slinky/oracle/v1/circuit_breaker.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	types "github.com/skip-mev/slinky/pkg/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// CircuitBreakerAction is the action taken when a price update exceeds the
+// limits of a CurrencyPair's CircuitBreaker.
+type CircuitBreakerAction int32
+
+const (
+	// CIRCUIT_BREAKER_ACTION_UNSPECIFIED is an invalid action.
+	CircuitBreakerAction_CIRCUIT_BREAKER_ACTION_UNSPECIFIED CircuitBreakerAction = 0
+	// CIRCUIT_BREAKER_ACTION_CLAMP writes the price clamped to the limits.
+	CircuitBreakerAction_CIRCUIT_BREAKER_ACTION_CLAMP CircuitBreakerAction = 1
+	// CIRCUIT_BREAKER_ACTION_SKIP drops the price update.
+	CircuitBreakerAction_CIRCUIT_BREAKER_ACTION_SKIP CircuitBreakerAction = 2
+	// CIRCUIT_BREAKER_ACTION_HALT drops the price update and halts price updates
+	// for the CurrencyPair until the circuit breaker is reset.
+	CircuitBreakerAction_CIRCUIT_BREAKER_ACTION_HALT CircuitBreakerAction = 3
+)
+
+var CircuitBreakerAction_name = map[int32]string{
+	0: "CIRCUIT_BREAKER_ACTION_UNSPECIFIED",
+	1: "CIRCUIT_BREAKER_ACTION_CLAMP",
+	2: "CIRCUIT_BREAKER_ACTION_SKIP",
+	3: "CIRCUIT_BREAKER_ACTION_HALT",
+}
+
+var CircuitBreakerAction_value = map[string]int32{
+	"CIRCUIT_BREAKER_ACTION_UNSPECIFIED": 0,
+	"CIRCUIT_BREAKER_ACTION_CLAMP":       1,
+	"CIRCUIT_BREAKER_ACTION_SKIP":        2,
+	"CIRCUIT_BREAKER_ACTION_HALT":        3,
+}
+
+func (x CircuitBreakerAction) String() string {
+	return proto.EnumName(CircuitBreakerAction_name, int32(x))
+}
+
+func (CircuitBreakerAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f8e1f031ba40dda5, []int{0}
+}
+
+// CircuitBreaker defines the maximum change of a CurrencyPair's price
+// between blocks and over a window of blocks, and the action taken when a
+// price update exceeds either limit.
+type CircuitBreaker struct {
+	// MaxBlockChangeBps is the maximum change, in basis points, of the price
+	// relative to the previous price. If zero, the change is not limited.
+	MaxBlockChangeBps uint64 `protobuf:"varint,1,opt,name=max_block_change_bps,json=maxBlockChangeBps,proto3" json:"max_block_change_bps,omitempty"`
+	// MaxWindowChangeBps is the maximum change, in basis points, of the price
+	// relative to the oldest price within the last WindowBlocks blocks. If
+	// zero, the change is not limited.
+	MaxWindowChangeBps uint64 `protobuf:"varint,2,opt,name=max_window_change_bps,json=maxWindowChangeBps,proto3" json:"max_window_change_bps,omitempty"`
+	// WindowBlocks is the number of blocks over which MaxWindowChangeBps is
+	// enforced. The window is limited by the price history retained by the
+	// module.
+	WindowBlocks uint64 `protobuf:"varint,3,opt,name=window_blocks,json=windowBlocks,proto3" json:"window_blocks,omitempty"`
+	// Action is the action taken when a price update exceeds either limit.
+	Action CircuitBreakerAction `protobuf:"varint,4,opt,name=action,proto3,enum=slinky.oracle.v1.CircuitBreakerAction" json:"action,omitempty"`
+}
+
+func (m *CircuitBreaker) Reset()         { *m = CircuitBreaker{} }
+func (m *CircuitBreaker) String() string { return proto.CompactTextString(m) }
+func (*CircuitBreaker) ProtoMessage()    {}
+func (*CircuitBreaker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8e1f031ba40dda5, []int{0}
+}
+func (m *CircuitBreaker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CircuitBreaker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CircuitBreaker.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CircuitBreaker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitBreaker.Merge(m, src)
+}
+func (m *CircuitBreaker) XXX_Size() int {
+	return m.Size()
+}
+func (m *CircuitBreaker) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitBreaker.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CircuitBreaker proto.InternalMessageInfo
+
+func (m *CircuitBreaker) GetMaxBlockChangeBps() uint64 {
+	if m != nil {
+		return m.MaxBlockChangeBps
+	}
+	return 0
+}
+
+func (m *CircuitBreaker) GetMaxWindowChangeBps() uint64 {
+	if m != nil {
+		return m.MaxWindowChangeBps
+	}
+	return 0
+}
+
+func (m *CircuitBreaker) GetWindowBlocks() uint64 {
+	if m != nil {
+		return m.WindowBlocks
+	}
+	return 0
+}
+
+func (m *CircuitBreaker) GetAction() CircuitBreakerAction {
+	if m != nil {
+		return m.Action
+	}
+	return CircuitBreakerAction_CIRCUIT_BREAKER_ACTION_UNSPECIFIED
+}
+
+// CurrencyPairCircuitBreaker is the CircuitBreaker configured for a
+// CurrencyPair, alongside whether price updates for the CurrencyPair are
+// halted.
+type CurrencyPairCircuitBreaker struct {
+	// CurrencyPair is the CurrencyPair the CircuitBreaker is configured for.
+	CurrencyPair types.CurrencyPair `protobuf:"bytes,1,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair"`
+	// CircuitBreaker is the configured CircuitBreaker.
+	CircuitBreaker CircuitBreaker `protobuf:"bytes,2,opt,name=circuit_breaker,json=circuitBreaker,proto3" json:"circuit_breaker"`
+	// Halted is true if the CircuitBreaker was tripped with the HALT action and
+	// has not been reset.
+	Halted bool `protobuf:"varint,3,opt,name=halted,proto3" json:"halted,omitempty"`
+}
+
+func (m *CurrencyPairCircuitBreaker) Reset()         { *m = CurrencyPairCircuitBreaker{} }
+func (m *CurrencyPairCircuitBreaker) String() string { return proto.CompactTextString(m) }
+func (*CurrencyPairCircuitBreaker) ProtoMessage()    {}
+func (*CurrencyPairCircuitBreaker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8e1f031ba40dda5, []int{1}
+}
+func (m *CurrencyPairCircuitBreaker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CurrencyPairCircuitBreaker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CurrencyPairCircuitBreaker.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CurrencyPairCircuitBreaker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CurrencyPairCircuitBreaker.Merge(m, src)
+}
+func (m *CurrencyPairCircuitBreaker) XXX_Size() int {
+	return m.Size()
+}
+func (m *CurrencyPairCircuitBreaker) XXX_DiscardUnknown() {
+	xxx_messageInfo_CurrencyPairCircuitBreaker.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CurrencyPairCircuitBreaker proto.InternalMessageInfo
+
+func (m *CurrencyPairCircuitBreaker) GetCurrencyPair() types.CurrencyPair {
+	if m != nil {
+		return m.CurrencyPair
+	}
+	return types.CurrencyPair{}
+}
+
+func (m *CurrencyPairCircuitBreaker) GetCircuitBreaker() CircuitBreaker {
+	if m != nil {
+		return m.CircuitBreaker
+	}
+	return CircuitBreaker{}
+}
+
+func (m *CurrencyPairCircuitBreaker) GetHalted() bool {
+	if m != nil {
+		return m.Halted
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterEnum("slinky.oracle.v1.CircuitBreakerAction", CircuitBreakerAction_name, CircuitBreakerAction_value)
+	proto.RegisterType((*CircuitBreaker)(nil), "slinky.oracle.v1.CircuitBreaker")
+	proto.RegisterType((*CurrencyPairCircuitBreaker)(nil), "slinky.oracle.v1.CurrencyPairCircuitBreaker")
+}
+
+func init() {
+	proto.RegisterFile("slinky/oracle/v1/circuit_breaker.proto", fileDescriptor_f8e1f031ba40dda5)
+}
+
+var fileDescriptor_f8e1f031ba40dda5 = []byte{
+	// 455 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x52, 0x5f, 0x6b, 0xd3, 0x50,
+	0x14, 0xcf, 0xdd, 0x4a, 0x91, 0xbb, 0xad, 0xc6, 0x4b, 0x95, 0x52, 0x35, 0x2b, 0x1d, 0x94, 0x29,
+	0x98, 0xd0, 0xfa, 0x2e, 0x24, 0xd7, 0xca, 0xc2, 0xe6, 0x56, 0xb2, 0x0e, 0xc1, 0x97, 0x70, 0x73,
+	0x17, 0xda, 0x4b, 0x9b, 0xdc, 0x90, 0xa4, 0x5d, 0xfb, 0x2d, 0xfc, 0x0c, 0x7e, 0x9a, 0xbd, 0xb9,
+	0x47, 0x7d, 0x11, 0x69, 0xbf, 0x88, 0xf4, 0xe4, 0x0e, 0xdb, 0xe2, 0xf4, 0x2d, 0x39, 0xe7, 0xf7,
+	0xe7, 0xfc, 0xce, 0x3d, 0xb8, 0x95, 0x8d, 0x45, 0x3c, 0x9a, 0x5b, 0x32, 0x65, 0x7c, 0x1c, 0x5a,
+	0xd3, 0xb6, 0xc5, 0x45, 0xca, 0x27, 0x22, 0xf7, 0x83, 0x34, 0x64, 0xa3, 0x30, 0x35, 0x93, 0x54,
+	0xe6, 0x92, 0xe8, 0x05, 0xce, 0x2c, 0x70, 0xe6, 0xb4, 0x5d, 0xaf, 0x0e, 0xe4, 0x40, 0x42, 0xd3,
+	0x5a, 0x7d, 0x15, 0xb8, 0xfa, 0x91, 0xd2, 0xcb, 0xe7, 0x49, 0x98, 0x81, 0xdc, 0x24, 0x4d, 0xc3,
+	0x98, 0xcf, 0xfd, 0x84, 0x09, 0x25, 0xd6, 0xfc, 0x81, 0x70, 0x85, 0x16, 0x36, 0x4e, 0xe1, 0x42,
+	0x2c, 0x5c, 0x8d, 0xd8, 0xcc, 0x0f, 0xc6, 0x92, 0x8f, 0x7c, 0x3e, 0x64, 0xf1, 0x20, 0xf4, 0x83,
+	0x24, 0xab, 0xa1, 0x06, 0x3a, 0x2e, 0x79, 0x4f, 0x22, 0x36, 0x73, 0x56, 0x2d, 0x0a, 0x1d, 0x27,
+	0xc9, 0x48, 0x1b, 0x3f, 0x5d, 0x11, 0x6e, 0x44, 0x7c, 0x2d, 0x6f, 0xd6, 0x19, 0x3b, 0xc0, 0x20,
+	0x11, 0x9b, 0x7d, 0x82, 0xde, 0x1f, 0xca, 0x11, 0x3e, 0x50, 0x70, 0xb0, 0xc9, 0x6a, 0xbb, 0x00,
+	0xdd, 0x2f, 0x8a, 0xa0, 0x9f, 0x91, 0x77, 0xb8, 0xcc, 0x78, 0x2e, 0x64, 0x5c, 0x2b, 0x35, 0xd0,
+	0x71, 0xa5, 0xd3, 0x32, 0xb7, 0x93, 0x9b, 0x9b, 0xa3, 0xdb, 0x80, 0xf6, 0x14, 0xab, 0xf9, 0x0d,
+	0xe1, 0x3a, 0x55, 0x99, 0x7b, 0x4c, 0xa4, 0x5b, 0x39, 0x4f, 0xf0, 0xc1, 0xc6, 0x46, 0x20, 0xe0,
+	0x5e, 0xe7, 0xe5, 0xbd, 0x0b, 0xec, 0x0d, 0x4c, 0xd6, 0x34, 0x9c, 0xd2, 0xed, 0xcf, 0x43, 0xcd,
+	0xdb, 0xe7, 0x6b, 0x35, 0x72, 0x81, 0x1f, 0x6f, 0x3d, 0x15, 0x44, 0xdf, 0xeb, 0x34, 0xfe, 0x37,
+	0xb1, 0x92, 0xab, 0xf0, 0xcd, 0xd1, 0x9e, 0xe1, 0xf2, 0x90, 0x8d, 0xf3, 0xf0, 0x1a, 0xf6, 0xf2,
+	0xc8, 0x53, 0x7f, 0xaf, 0xbf, 0x22, 0x5c, 0xfd, 0x5b, 0x64, 0xd2, 0xc2, 0x4d, 0xea, 0x7a, 0xf4,
+	0xca, 0xed, 0xfb, 0x8e, 0xd7, 0xb5, 0x4f, 0xbb, 0x9e, 0x6f, 0xd3, 0xbe, 0x7b, 0x71, 0xee, 0x5f,
+	0x9d, 0x5f, 0xf6, 0xba, 0xd4, 0xfd, 0xe0, 0x76, 0xdf, 0xeb, 0x1a, 0x69, 0xe0, 0x17, 0x0f, 0xe0,
+	0xe8, 0x99, 0xfd, 0xb1, 0xa7, 0x23, 0x72, 0x88, 0x9f, 0x3f, 0x80, 0xb8, 0x3c, 0x75, 0x7b, 0xfa,
+	0xce, 0x3f, 0x00, 0x27, 0xf6, 0x59, 0x5f, 0xdf, 0x75, 0xe8, 0xed, 0xc2, 0x40, 0x77, 0x0b, 0x03,
+	0xfd, 0x5a, 0x18, 0xe8, 0xcb, 0xd2, 0xd0, 0xee, 0x96, 0x86, 0xf6, 0x7d, 0x69, 0x68, 0x9f, 0x5f,
+	0x0d, 0x44, 0x3e, 0x9c, 0x04, 0x26, 0x97, 0x91, 0x95, 0x8d, 0x44, 0xf2, 0x26, 0x0a, 0xa7, 0x96,
+	0xba, 0xd2, 0xd9, 0xfd, 0xdd, 0xc3, 0xda, 0x83, 0x32, 0x9c, 0xe7, 0xdb, 0xdf, 0x03, 0x00, 0x46,
+	0x22, 0x3e, 0x41, 0x15, 0x03, 0x00, 0x00,
+}
+
+func (m *CircuitBreaker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CircuitBreaker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CircuitBreaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Action != 0 {
+		i = encodeVarintCircuitBreaker(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.WindowBlocks != 0 {
+		i = encodeVarintCircuitBreaker(dAtA, i, uint64(m.WindowBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxWindowChangeBps != 0 {
+		i = encodeVarintCircuitBreaker(dAtA, i, uint64(m.MaxWindowChangeBps))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxBlockChangeBps != 0 {
+		i = encodeVarintCircuitBreaker(dAtA, i, uint64(m.MaxBlockChangeBps))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CurrencyPairCircuitBreaker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CurrencyPairCircuitBreaker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CurrencyPairCircuitBreaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Halted {
+		i--
+		if m.Halted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.CircuitBreaker.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCircuitBreaker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.CurrencyPair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCircuitBreaker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCircuitBreaker(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCircuitBreaker(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CircuitBreaker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxBlockChangeBps != 0 {
+		n += 1 + sovCircuitBreaker(uint64(m.MaxBlockChangeBps))
+	}
+	if m.MaxWindowChangeBps != 0 {
+		n += 1 + sovCircuitBreaker(uint64(m.MaxWindowChangeBps))
+	}
+	if m.WindowBlocks != 0 {
+		n += 1 + sovCircuitBreaker(uint64(m.WindowBlocks))
+	}
+	if m.Action != 0 {
+		n += 1 + sovCircuitBreaker(uint64(m.Action))
+	}
+	return n
+}
+
+func (m *CurrencyPairCircuitBreaker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CurrencyPair.Size()
+	n += 1 + l + sovCircuitBreaker(uint64(l))
+	l = m.CircuitBreaker.Size()
+	n += 1 + l + sovCircuitBreaker(uint64(l))
+	if m.Halted {
+		n += 2
+	}
+	return n
+}
+
+func sovCircuitBreaker(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCircuitBreaker(x uint64) (n int) {
+	return sovCircuitBreaker(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CircuitBreaker) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCircuitBreaker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CircuitBreaker: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CircuitBreaker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlockChangeBps", wireType)
+			}
+			m.MaxBlockChangeBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBlockChangeBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxWindowChangeBps", wireType)
+			}
+			m.MaxWindowChangeBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxWindowChangeBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowBlocks", wireType)
+			}
+			m.WindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= CircuitBreakerAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCircuitBreaker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CurrencyPairCircuitBreaker) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCircuitBreaker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CurrencyPairCircuitBreaker: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CurrencyPairCircuitBreaker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrencyPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreaker", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CircuitBreaker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Halted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Halted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCircuitBreaker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCircuitBreaker(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCircuitBreaker
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCircuitBreaker
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCircuitBreaker
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCircuitBreaker
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCircuitBreaker        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCircuitBreaker          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCircuitBreaker = fmt.Errorf("proto: unexpected end of group")
+)
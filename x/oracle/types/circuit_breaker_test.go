@@ -0,0 +1,168 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	slinkytypes "github.com/skip-mev/slinky/pkg/types"
+	"github.com/skip-mev/slinky/x/oracle/types"
+)
+
+func TestCircuitBreakerValidateBasic(t *testing.T) {
+	tcs := []struct {
+		name       string
+		cb         types.CircuitBreaker
+		expectPass bool
+	}{
+		{
+			"no limits - pass",
+			types.CircuitBreaker{},
+			true,
+		},
+		{
+			"block limit with an action - pass",
+			types.CircuitBreaker{
+				MaxBlockChangeBps: 100,
+				Action:            types.CircuitBreakerAction_CIRCUIT_BREAKER_ACTION_CLAMP,
+			},
+			true,
+		},
+		{
+			"window limit with a window and an action - pass",
+			types.CircuitBreaker{
+				MaxWindowChangeBps: 500,
+				WindowBlocks:       10,
+				Action:             types.CircuitBreakerAction_CIRCUIT_BREAKER_ACTION_HALT,
+			},
+			true,
+		},
+		{
+			"limit without an action - fail",
+			types.CircuitBreaker{
+				MaxBlockChangeBps: 100,
+			},
+			false,
+		},
+		{
+			"limit with an unknown action - fail",
+			types.CircuitBreaker{
+				MaxBlockChangeBps: 100,
+				Action:            types.CircuitBreakerAction(10),
+			},
+			false,
+		},
+		{
+			"window limit without a window - fail",
+			types.CircuitBreaker{
+				MaxWindowChangeBps: 500,
+				Action:             types.CircuitBreakerAction_CIRCUIT_BREAKER_ACTION_SKIP,
+			},
+			false,
+		},
+		{
+			"window without a window limit - fail",
+			types.CircuitBreaker{
+				MaxBlockChangeBps: 100,
+				WindowBlocks:      10,
+				Action:            types.CircuitBreakerAction_CIRCUIT_BREAKER_ACTION_SKIP,
+			},
+			false,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.cb.ValidateBasic()
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+
+	t.Run("currency pair circuit breaker without limits - fail", func(t *testing.T) {
+		cpcb := types.CurrencyPairCircuitBreaker{
+			CurrencyPair: slinkytypes.NewCurrencyPair("BTC", "USD"),
+		}
+		require.Error(t, cpcb.ValidateBasic())
+	})
+}
+
+func TestMaxChange(t *testing.T) {
+	tcs := []struct {
+		name    string
+		ref     int64
+		price   int64
+		maxBps  uint64
+		exceeds bool
+		clamped int64
+	}{
+		{
+			"within the limit",
+			1000,
+			995,
+			100,
+			false,
+			995,
+		},
+		{
+			"at the upper limit",
+			1000,
+			1010,
+			100,
+			false,
+			1010,
+		},
+		{
+			"above the upper limit",
+			1000,
+			1011,
+			100,
+			true,
+			1010,
+		},
+		{
+			"below the lower limit",
+			1000,
+			989,
+			100,
+			true,
+			990,
+		},
+		{
+			"bounds are rounded towards the reference price",
+			999,
+			1,
+			100,
+			true,
+			990,
+		},
+		{
+			"lower bound is never negative",
+			1000,
+			0,
+			20000,
+			false,
+			0,
+		},
+		{
+			"zero reference price is not limited",
+			0,
+			1000,
+			100,
+			false,
+			1000,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			ref, price := math.NewInt(tc.ref), math.NewInt(tc.price)
+			require.Equal(t, tc.exceeds, types.ExceedsMaxChange(ref, price, tc.maxBps))
+			require.Equal(t, math.NewInt(tc.clamped), types.ClampChange(ref, price, tc.maxBps))
+		})
+	}
+}
@@ -16,6 +16,15 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 
 	// register the MsgRemoveCurrencyPairs for amino serialization
 	legacy.RegisterAminoMsg(cdc, &MsgRemoveCurrencyPairs{}, "slinky/x/oracle/MsgRemoveCurrencyPairs")
+
+	// register the MsgSetCircuitBreaker for amino serialization
+	legacy.RegisterAminoMsg(cdc, &MsgSetCircuitBreaker{}, "slinky/x/oracle/MsgSetCircuitBreaker")
+
+	// register the MsgResetCircuitBreaker for amino serialization
+	legacy.RegisterAminoMsg(cdc, &MsgResetCircuitBreaker{}, "slinky/x/oracle/MsgResetCircuitBreaker")
+
+	// register the MsgSetMaxPriceAge for amino serialization
+	legacy.RegisterAminoMsg(cdc, &MsgSetMaxPriceAge{}, "slinky/x/oracle/MsgSetMaxPriceAge")
 }
 
 // RegisterInterfaces registers the x/oracle messages + message service w/ the InterfaceRegistry (registry).
@@ -24,6 +33,9 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgAddCurrencyPairs{},
 		&MsgRemoveCurrencyPairs{},
+		&MsgSetCircuitBreaker{},
+		&MsgResetCircuitBreaker{},
+		&MsgSetMaxPriceAge{},
 	)
 
 	// register the x/oracle message-service
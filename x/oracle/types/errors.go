@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	slinkytypes "github.com/skip-mev/slinky/pkg/types"
 )
@@ -41,3 +42,41 @@ func NewCurrencyPairAlreadyExistsError(cp slinkytypes.CurrencyPair) CurrencyPair
 func (e CurrencyPairAlreadyExistsError) Error() string {
 	return fmt.Sprintf("currency pair already exists: %s", e.cp)
 }
+
+func NewPriceHistoryNotExistError(cp slinkytypes.CurrencyPair) PriceHistoryNotExistError {
+	return PriceHistoryNotExistError{cp.String()}
+}
+
+type PriceHistoryNotExistError struct {
+	cp string
+}
+
+func (e PriceHistoryNotExistError) Error() string {
+	return fmt.Sprintf("no price history for CurrencyPair: %s", e.cp)
+}
+
+func NewCircuitBreakerNotExistError(cp slinkytypes.CurrencyPair) CircuitBreakerNotExistError {
+	return CircuitBreakerNotExistError{cp.String()}
+}
+
+type CircuitBreakerNotExistError struct {
+	cp string
+}
+
+func (e CircuitBreakerNotExistError) Error() string {
+	return fmt.Sprintf("no circuit breaker for CurrencyPair: %s", e.cp)
+}
+
+func NewStalePriceError(cp slinkytypes.CurrencyPair, ageBlocks uint64, age time.Duration) StalePriceError {
+	return StalePriceError{cp.String(), ageBlocks, age}
+}
+
+type StalePriceError struct {
+	cp        string
+	ageBlocks uint64
+	age       time.Duration
+}
+
+func (e StalePriceError) Error() string {
+	return fmt.Sprintf("stale price for CurrencyPair: %s, last updated %d blocks (%s) ago", e.cp, e.ageBlocks, e.age)
+}
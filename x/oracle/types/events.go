@@ -0,0 +1,22 @@
+package types
+
+// oracle module event types
+
+const (
+	EventTypePriceUpdate = "price_update"
+	EventTypePriceMissed = "price_missed"
+
+	EventTypeCircuitBreakerTripped = "circuit_breaker_tripped"
+	EventTypeCircuitBreakerReset   = "circuit_breaker_reset"
+
+	AttributeKeyCurrencyPair  = "currency_pair"
+	AttributeKeyPrice         = "price"
+	AttributeKeyHeight        = "height"
+	AttributeKeyNonce         = "nonce"
+	AttributeKeyNumValidators = "num_validators"
+
+	AttributeKeyReferencePrice = "reference_price"
+	AttributeKeyMaxChangeBps   = "max_change_bps"
+	AttributeKeyLimit          = "limit"
+	AttributeKeyAction         = "action"
+)
@@ -24,11 +24,13 @@ func (cpg *CurrencyPairGenesis) ValidateBasic() error {
 	return nil
 }
 
-// NewGenesisState returns a new genesis-state from a set of CurrencyPairGeneses.
-func NewGenesisState(cpgs []CurrencyPairGenesis, nextID uint64) *GenesisState {
+// NewGenesisState returns a new genesis-state from a set of CurrencyPairGeneses and any CircuitBreakers configured
+// for them.
+func NewGenesisState(cpgs []CurrencyPairGenesis, nextID uint64, circuitBreakers ...CurrencyPairCircuitBreaker) *GenesisState {
 	return &GenesisState{
 		CurrencyPairGenesis: cpgs,
 		NextId:              nextID,
+		CircuitBreakers:     circuitBreakers,
 	}
 }
 
@@ -70,6 +72,44 @@ func (gs *GenesisState) Validate() error {
 		cps[cpg.CurrencyPair.String()] = struct{}{}
 	}
 
+	// check that each circuit breaker is valid, and configured once for a currency-pair in genesis
+	breakers := make(map[string]struct{})
+	for _, cb := range gs.CircuitBreakers {
+		if err := cb.ValidateBasic(); err != nil {
+			return err
+		}
+
+		cp := cb.CurrencyPair.String()
+		if _, ok := cps[cp]; !ok {
+			return fmt.Errorf("circuit breaker for currency-pair not in genesis: %v", cp)
+		}
+
+		if _, ok := breakers[cp]; ok {
+			return fmt.Errorf("repeated circuit breaker for currency-pair: %v", cp)
+		}
+
+		breakers[cp] = struct{}{}
+	}
+
+	// check that each max price age is valid, and configured once for a currency-pair in genesis
+	maxAges := make(map[string]struct{})
+	for _, ma := range gs.MaxPriceAges {
+		if err := ma.ValidateBasic(); err != nil {
+			return err
+		}
+
+		cp := ma.CurrencyPair.String()
+		if _, ok := cps[cp]; !ok {
+			return fmt.Errorf("max price age for currency-pair not in genesis: %v", cp)
+		}
+
+		if _, ok := maxAges[cp]; ok {
+			return fmt.Errorf("repeated max price age for currency-pair: %v", cp)
+		}
+
+		maxAges[cp] = struct{}{}
+	}
+
 	return nil
 }
 
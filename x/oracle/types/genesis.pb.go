@@ -40,6 +40,16 @@ type QuotePrice struct {
 	BlockTimestamp time.Time `protobuf:"bytes,2,opt,name=block_timestamp,json=blockTimestamp,proto3,stdtime" json:"block_timestamp"`
 	// BlockHeight is height of block mentioned above
 	BlockHeight uint64 `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// Dispersion is the stake-weighted interquartile range of the prices
+	// reported by validators for this update, i.e. the difference between the
+	// stake-weighted 75th and 25th percentile prices. This is nil if the price
+	// was not derived from validator votes or the application does not compute
+	// price statistics.
+	Dispersion *cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=dispersion,proto3,customtype=cosmossdk.io/math.Int" json:"dispersion,omitempty"`
+	// VotingPowerFraction is the fraction of the total voting power that
+	// reported a price for this update. This is nil in the same cases as
+	// Dispersion.
+	VotingPowerFraction *cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=voting_power_fraction,json=votingPowerFraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"voting_power_fraction,omitempty"`
 }
 
 func (m *QuotePrice) Reset()         { *m = QuotePrice{} }
@@ -240,6 +250,12 @@ type GenesisState struct {
 	CurrencyPairGenesis []CurrencyPairGenesis `protobuf:"bytes,1,rep,name=currency_pair_genesis,json=currencyPairGenesis,proto3" json:"currency_pair_genesis"`
 	// NextID is the next ID to be used for a CurrencyPair
 	NextId uint64 `protobuf:"varint,2,opt,name=next_id,json=nextId,proto3" json:"next_id,omitempty"`
+	// CircuitBreakers is the set of CircuitBreakers configured for
+	// CurrencyPairs in the module.
+	CircuitBreakers []CurrencyPairCircuitBreaker `protobuf:"bytes,3,rep,name=circuit_breakers,json=circuitBreakers,proto3" json:"circuit_breakers"`
+	// MaxPriceAges is the set of MaxPriceAges configured for CurrencyPairs in
+	// the module.
+	MaxPriceAges []CurrencyPairMaxPriceAge `protobuf:"bytes,4,rep,name=max_price_ages,json=maxPriceAges,proto3" json:"max_price_ages"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -289,6 +305,20 @@ func (m *GenesisState) GetNextId() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetCircuitBreakers() []CurrencyPairCircuitBreaker {
+	if m != nil {
+		return m.CircuitBreakers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMaxPriceAges() []CurrencyPairMaxPriceAge {
+	if m != nil {
+		return m.MaxPriceAges
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QuotePrice)(nil), "slinky.oracle.v1.QuotePrice")
 	proto.RegisterType((*CurrencyPairState)(nil), "slinky.oracle.v1.CurrencyPairState")
@@ -299,39 +329,48 @@ func init() {
 func init() { proto.RegisterFile("slinky/oracle/v1/genesis.proto", fileDescriptor_de36a97821ccc13b) }
 
 var fileDescriptor_de36a97821ccc13b = []byte{
-	// 504 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xcf, 0x6e, 0xd3, 0x30,
-	0x1c, 0xc7, 0xeb, 0xb6, 0x1b, 0xe0, 0x96, 0xc1, 0xd2, 0x4d, 0x94, 0x0a, 0x92, 0x52, 0x84, 0x54,
-	0x84, 0x66, 0x6b, 0xe5, 0xc2, 0x95, 0xee, 0xc0, 0x7a, 0x40, 0x2a, 0x81, 0x13, 0x97, 0x28, 0x75,
-	0x4c, 0x6a, 0xb5, 0x89, 0xa3, 0xd8, 0xad, 0xd6, 0x37, 0xe0, 0xb8, 0x87, 0xe1, 0x05, 0xb8, 0xf5,
-	0x38, 0x71, 0x02, 0x0e, 0x05, 0xb5, 0x2f, 0x82, 0x62, 0x3b, 0x25, 0x65, 0x3b, 0x70, 0xcb, 0xcf,
-	0xbf, 0x7f, 0xdf, 0xcf, 0xd7, 0x0e, 0xb4, 0xc5, 0x94, 0xc5, 0x93, 0x05, 0xe6, 0xa9, 0x4f, 0xa6,
-	0x14, 0xcf, 0x4f, 0x71, 0x48, 0x63, 0x2a, 0x98, 0x40, 0x49, 0xca, 0x25, 0xb7, 0xee, 0xeb, 0x3c,
-	0xd2, 0x79, 0x34, 0x3f, 0x6d, 0x1d, 0x85, 0x3c, 0xe4, 0x2a, 0x89, 0xb3, 0x2f, 0x5d, 0xd7, 0x72,
-	0x42, 0xce, 0xc3, 0x29, 0xc5, 0x2a, 0x1a, 0xcd, 0x3e, 0x61, 0xc9, 0x22, 0x2a, 0xa4, 0x1f, 0x25,
-	0xa6, 0xe0, 0x21, 0xe1, 0x22, 0xe2, 0xc2, 0xd3, 0x9d, 0x3a, 0x30, 0xa9, 0xa7, 0x46, 0x83, 0x5c,
-	0x24, 0x54, 0x64, 0x12, 0xc8, 0x2c, 0x4d, 0x69, 0x4c, 0x16, 0x5e, 0xe2, 0xb3, 0x54, 0x17, 0x75,
-	0xbe, 0x02, 0x08, 0xdf, 0xcd, 0xb8, 0xa4, 0xc3, 0x94, 0x11, 0x6a, 0xbd, 0x86, 0x7b, 0x49, 0xf6,
-	0xd1, 0x04, 0x6d, 0xd0, 0xbd, 0xd3, 0x7f, 0xb1, 0x5c, 0x39, 0xa5, 0x9f, 0x2b, 0xe7, 0x58, 0x0f,
-	0x16, 0xc1, 0x04, 0x31, 0x8e, 0x23, 0x5f, 0x8e, 0xd1, 0x20, 0x96, 0xdf, 0xbe, 0x9c, 0x40, 0xb3,
-	0x71, 0x10, 0x4b, 0x57, 0x77, 0x5a, 0x6f, 0xe1, 0xbd, 0xd1, 0x94, 0x93, 0x89, 0xb7, 0x95, 0xda,
-	0x2c, 0xb7, 0x41, 0xb7, 0xd6, 0x6b, 0x21, 0x0d, 0x83, 0x72, 0x18, 0xf4, 0x21, 0xaf, 0xe8, 0xdf,
-	0xce, 0x16, 0x5d, 0xfe, 0x72, 0x80, 0x7b, 0xa0, 0x9a, 0xb7, 0x19, 0xeb, 0x09, 0xac, 0xeb, 0x71,
-	0x63, 0xca, 0xc2, 0xb1, 0x6c, 0x56, 0xda, 0xa0, 0x5b, 0x75, 0x6b, 0xea, 0xec, 0x5c, 0x1d, 0x75,
-	0x04, 0x3c, 0x3c, 0x33, 0x68, 0x43, 0x9f, 0xa5, 0xef, 0xa5, 0x2f, 0xa9, 0xf5, 0xaa, 0x48, 0x52,
-	0xeb, 0x3d, 0x42, 0xff, 0x3a, 0x8e, 0xfe, 0x62, 0xf7, 0xab, 0xcb, 0x95, 0x03, 0x72, 0x80, 0x23,
-	0xb8, 0x17, 0xf3, 0x98, 0x50, 0x25, 0xbb, 0xea, 0xea, 0xc0, 0x3a, 0x80, 0x65, 0x16, 0x98, 0xed,
-	0x65, 0x16, 0x74, 0x7e, 0x00, 0xd8, 0x28, 0x6e, 0x7d, 0xa3, 0xef, 0xd7, 0x3a, 0x87, 0x77, 0x77,
-	0x7c, 0x36, 0xfb, 0x1f, 0xe7, 0xfb, 0xd5, 0x6d, 0x64, 0xeb, 0x8b, 0xcd, 0x4a, 0x40, 0xc9, 0xad,
-	0x93, 0xc2, 0x99, 0xe5, 0xc2, 0xc6, 0xce, 0x24, 0x4f, 0xf3, 0x94, 0xff, 0x9b, 0xe7, 0xb0, 0x38,
-	0x6e, 0xb8, 0xcb, 0x56, 0xb9, 0xce, 0x56, 0xdd, 0xb2, 0x7d, 0x06, 0xb0, 0x6e, 0x78, 0xb4, 0x99,
-	0x1e, 0x3c, 0xde, 0x95, 0x62, 0x5e, 0x73, 0x13, 0xb4, 0x2b, 0xdd, 0x5a, 0xef, 0xd9, 0x75, 0x31,
-	0x37, 0x58, 0x63, 0x20, 0x1b, 0xe4, 0x06, 0xd7, 0x1e, 0xc0, 0x5b, 0x31, 0xbd, 0x90, 0x1e, 0x0b,
-	0x8c, 0xeb, 0xfb, 0x59, 0x38, 0x08, 0xfa, 0x67, 0xcb, 0xb5, 0x0d, 0xae, 0xd6, 0x36, 0xf8, 0xbd,
-	0xb6, 0xc1, 0xe5, 0xc6, 0x2e, 0x5d, 0x6d, 0xec, 0xd2, 0xf7, 0x8d, 0x5d, 0xfa, 0xf8, 0x3c, 0x64,
-	0x72, 0x3c, 0x1b, 0x21, 0xc2, 0x23, 0x2c, 0x26, 0x2c, 0x39, 0x89, 0xe8, 0x1c, 0x9b, 0x27, 0x7f,
-	0x91, 0xff, 0x78, 0xca, 0xed, 0xd1, 0xbe, 0x7a, 0x71, 0x2f, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff,
-	0xe8, 0xaa, 0xe3, 0xa1, 0x96, 0x03, 0x00, 0x00,
+	// 652 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x4d, 0x6f, 0xd3, 0x30,
+	0x18, 0x6e, 0xda, 0x6e, 0x80, 0x3b, 0xf6, 0x91, 0x6e, 0x22, 0x0c, 0x48, 0xcb, 0x10, 0xa8, 0x13,
+	0x2c, 0xd1, 0xc6, 0x85, 0xeb, 0xba, 0x09, 0x36, 0xc1, 0xa4, 0x12, 0xe0, 0x82, 0x84, 0x22, 0xd7,
+	0xf1, 0x52, 0xab, 0x4d, 0x1c, 0xd9, 0x6e, 0x69, 0xff, 0xc5, 0x7e, 0x0c, 0x67, 0xce, 0x3b, 0x4e,
+	0x9c, 0x80, 0xc3, 0x40, 0xdb, 0x6f, 0xe0, 0x8e, 0x62, 0x3b, 0x5d, 0xba, 0x4e, 0xd3, 0x6e, 0xf1,
+	0xfb, 0xf1, 0xbc, 0xcf, 0xf3, 0xf8, 0x75, 0x80, 0xcd, 0x7b, 0x24, 0xee, 0x8e, 0x5c, 0xca, 0x20,
+	0xea, 0x61, 0x77, 0xb0, 0xe9, 0x86, 0x38, 0xc6, 0x9c, 0x70, 0x27, 0x61, 0x54, 0x50, 0x73, 0x51,
+	0xe5, 0x1d, 0x95, 0x77, 0x06, 0x9b, 0xab, 0xcb, 0x21, 0x0d, 0xa9, 0x4c, 0xba, 0xe9, 0x97, 0xaa,
+	0x5b, 0xad, 0x85, 0x94, 0x86, 0x3d, 0xec, 0xca, 0x53, 0xbb, 0x7f, 0xe8, 0x0a, 0x12, 0x61, 0x2e,
+	0x60, 0x94, 0xe8, 0x82, 0xfb, 0x88, 0xf2, 0x88, 0x72, 0x5f, 0x75, 0xaa, 0x83, 0x4e, 0x3d, 0xd1,
+	0x1c, 0xc4, 0x28, 0xc1, 0x3c, 0xa5, 0x80, 0xfa, 0x8c, 0xe1, 0x18, 0x8d, 0xfc, 0x04, 0x12, 0xa6,
+	0x8b, 0x9e, 0x4d, 0x11, 0x45, 0x84, 0xa1, 0x3e, 0x11, 0x7e, 0x9b, 0x61, 0xd8, 0xc5, 0x59, 0x5d,
+	0x7d, 0xaa, 0x8e, 0x0b, 0xd8, 0x4b, 0x35, 0xe9, 0x71, 0x6b, 0xff, 0x8a, 0x00, 0xbc, 0xef, 0x53,
+	0x81, 0x5b, 0x8c, 0x20, 0x6c, 0x6e, 0x83, 0x99, 0x24, 0xfd, 0xb0, 0x8c, 0xba, 0xd1, 0xb8, 0xd3,
+	0x7c, 0x7e, 0x7c, 0x5a, 0x2b, 0xfc, 0x3e, 0xad, 0xad, 0x28, 0x8a, 0x3c, 0xe8, 0x3a, 0x84, 0xba,
+	0x11, 0x14, 0x1d, 0x67, 0x3f, 0x16, 0x3f, 0xbe, 0x6d, 0x00, 0xcd, 0x7d, 0x3f, 0x16, 0x9e, 0xea,
+	0x34, 0x0f, 0xc0, 0x42, 0xbb, 0x47, 0x51, 0xd7, 0x1f, 0x8b, 0xb6, 0x8a, 0x75, 0xa3, 0x51, 0xd9,
+	0x5a, 0x75, 0x94, 0x2d, 0x4e, 0x66, 0x8b, 0xf3, 0x31, 0xab, 0x68, 0xde, 0x4e, 0x07, 0x1d, 0xfd,
+	0xa9, 0x19, 0xde, 0xbc, 0x6c, 0x1e, 0x67, 0xcc, 0xc7, 0x60, 0x4e, 0xc1, 0x75, 0x30, 0x09, 0x3b,
+	0xc2, 0x2a, 0xd5, 0x8d, 0x46, 0xd9, 0xab, 0xc8, 0xd8, 0x9e, 0x0c, 0x99, 0x6f, 0x01, 0x08, 0x08,
+	0x4f, 0x30, 0xe3, 0x84, 0xc6, 0x56, 0x79, 0xcc, 0xdc, 0xb8, 0x29, 0xf3, 0x5c, 0xbb, 0x89, 0xc1,
+	0xca, 0x80, 0x0a, 0x12, 0x87, 0x7e, 0x42, 0xbf, 0x62, 0xe6, 0x1f, 0x32, 0x88, 0x44, 0x8a, 0x3b,
+	0x23, 0x71, 0x37, 0x35, 0xee, 0x83, 0x69, 0xdc, 0x77, 0x38, 0x84, 0x68, 0xb4, 0x8b, 0x51, 0x0e,
+	0x7d, 0x17, 0x23, 0xaf, 0xaa, 0xf0, 0x5a, 0x29, 0xdc, 0x6b, 0x8d, 0xb6, 0xc6, 0xc1, 0xd2, 0x8e,
+	0xbe, 0xd8, 0x16, 0x24, 0xec, 0x83, 0x80, 0x02, 0x9b, 0xaf, 0xf2, 0xee, 0x57, 0xb6, 0x1e, 0x3a,
+	0x97, 0xf7, 0xcd, 0xb9, 0xb8, 0xaa, 0x66, 0x39, 0x65, 0x92, 0x99, 0xbe, 0x0c, 0x66, 0x62, 0x1a,
+	0x23, 0x2c, 0xad, 0x2e, 0x7b, 0xea, 0x60, 0xce, 0x83, 0x22, 0x09, 0xb4, 0x63, 0x45, 0x12, 0xac,
+	0xfd, 0x32, 0x40, 0x35, 0x3f, 0xf5, 0x8d, 0xda, 0x6e, 0x73, 0x0f, 0xdc, 0x9d, 0xd8, 0x32, 0x3d,
+	0xff, 0x51, 0x36, 0x5f, 0xee, 0x62, 0x3a, 0x3e, 0xdf, 0x2c, 0x09, 0x14, 0xbc, 0x39, 0x94, 0x8b,
+	0x99, 0x1e, 0xa8, 0x4e, 0x20, 0xf9, 0x4a, 0x4f, 0xf1, 0xc6, 0x7a, 0x96, 0xf2, 0x70, 0xad, 0x49,
+	0x6d, 0xa5, 0x69, 0x6d, 0xe5, 0xb1, 0xb6, 0xef, 0x45, 0x30, 0xa7, 0xf5, 0x28, 0x33, 0x7d, 0xb0,
+	0x32, 0x49, 0x45, 0xbf, 0x65, 0xcb, 0xa8, 0x97, 0x1a, 0x95, 0xad, 0xa7, 0xd3, 0x64, 0xae, 0xb0,
+	0x46, 0x8b, 0xac, 0xa2, 0x2b, 0x5c, 0xbb, 0x07, 0x6e, 0xc5, 0x78, 0x28, 0x7c, 0x12, 0x68, 0xd7,
+	0x67, 0xd3, 0xe3, 0x7e, 0x60, 0x7e, 0x01, 0x8b, 0x97, 0x9e, 0x23, 0xb7, 0x4a, 0x72, 0xe8, 0x8b,
+	0xeb, 0x87, 0xee, 0xa8, 0xae, 0xa6, 0x6a, 0xd2, 0xb3, 0x17, 0xd0, 0x44, 0x94, 0x9b, 0x9f, 0xc0,
+	0x7c, 0x04, 0x87, 0xca, 0x59, 0x1f, 0x86, 0x98, 0x5b, 0x65, 0x09, 0xbe, 0x7e, 0x3d, 0xf8, 0x01,
+	0x1c, 0x4a, 0x3f, 0xb7, 0x43, 0x9c, 0x5d, 0x5d, 0x74, 0x11, 0xe2, 0xcd, 0x9d, 0xe3, 0x33, 0xdb,
+	0x38, 0x39, 0xb3, 0x8d, 0xbf, 0x67, 0xb6, 0x71, 0x74, 0x6e, 0x17, 0x4e, 0xce, 0xed, 0xc2, 0xcf,
+	0x73, 0xbb, 0xf0, 0x79, 0x3d, 0x24, 0xa2, 0xd3, 0x6f, 0x3b, 0x88, 0x46, 0x2e, 0xef, 0x92, 0x64,
+	0x23, 0xc2, 0x03, 0x57, 0xff, 0x59, 0x86, 0xd9, 0xbf, 0x45, 0xee, 0x48, 0x7b, 0x56, 0xbe, 0xed,
+	0x97, 0xff, 0x07, 0x00, 0xf4, 0x19, 0x06, 0x27, 0x4a, 0x05, 0x00, 0x00,
 }
 
 func (m *QuotePrice) Marshal() (dAtA []byte, err error) {
@@ -354,6 +393,30 @@ func (m *QuotePrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.VotingPowerFraction != nil {
+		{
+			size := m.VotingPowerFraction.Size()
+			i -= size
+			if _, err := m.VotingPowerFraction.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Dispersion != nil {
+		{
+			size := m.Dispersion.Size()
+			i -= size
+			if _, err := m.Dispersion.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.BlockHeight != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
 		i--
@@ -500,6 +563,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MaxPriceAges) > 0 {
+		for iNdEx := len(m.MaxPriceAges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxPriceAges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.CircuitBreakers) > 0 {
+		for iNdEx := len(m.CircuitBreakers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CircuitBreakers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.NextId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.NextId))
 		i--
@@ -546,6 +637,14 @@ func (m *QuotePrice) Size() (n int) {
 	if m.BlockHeight != 0 {
 		n += 1 + sovGenesis(uint64(m.BlockHeight))
 	}
+	if m.Dispersion != nil {
+		l = m.Dispersion.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.VotingPowerFraction != nil {
+		l = m.VotingPowerFraction.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -604,6 +703,18 @@ func (m *GenesisState) Size() (n int) {
 	if m.NextId != 0 {
 		n += 1 + sovGenesis(uint64(m.NextId))
 	}
+	if len(m.CircuitBreakers) > 0 {
+		for _, e := range m.CircuitBreakers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MaxPriceAges) > 0 {
+		for _, e := range m.MaxPriceAges {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -728,6 +839,78 @@ func (m *QuotePrice) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dispersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.Dispersion = &v
+			if err := m.Dispersion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPowerFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.VotingPowerFraction = &v
+			if err := m.VotingPowerFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1112,6 +1295,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CircuitBreakers = append(m.CircuitBreakers, CurrencyPairCircuitBreaker{})
+			if err := m.CircuitBreakers[len(m.CircuitBreakers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceAges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxPriceAges = append(m.MaxPriceAges, CurrencyPairMaxPriceAge{})
+			if err := m.MaxPriceAges[len(m.MaxPriceAges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -129,3 +129,127 @@ func TestGenesisValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestGenesisValidationCircuitBreakers(t *testing.T) {
+	cpgs := []types.CurrencyPairGenesis{
+		{
+			CurrencyPair: slinkytypes.NewCurrencyPair("AA", "BB"),
+			Id:           0,
+		},
+	}
+
+	cb := types.CircuitBreaker{
+		MaxBlockChangeBps: 100,
+		Action:            types.CircuitBreakerAction_CIRCUIT_BREAKER_ACTION_CLAMP,
+	}
+
+	tcs := []struct {
+		name       string
+		cbs        []types.CurrencyPairCircuitBreaker
+		expectPass bool
+	}{
+		{
+			"valid circuit breaker - pass",
+			[]types.CurrencyPairCircuitBreaker{
+				{CurrencyPair: slinkytypes.NewCurrencyPair("AA", "BB"), CircuitBreaker: cb, Halted: true},
+			},
+			true,
+		},
+		{
+			"circuit breaker without limits - fail",
+			[]types.CurrencyPairCircuitBreaker{
+				{CurrencyPair: slinkytypes.NewCurrencyPair("AA", "BB")},
+			},
+			false,
+		},
+		{
+			"circuit breaker for a currency-pair not in genesis - fail",
+			[]types.CurrencyPairCircuitBreaker{
+				{CurrencyPair: slinkytypes.NewCurrencyPair("CC", "DD"), CircuitBreaker: cb},
+			},
+			false,
+		},
+		{
+			"repeated circuit breaker - fail",
+			[]types.CurrencyPairCircuitBreaker{
+				{CurrencyPair: slinkytypes.NewCurrencyPair("AA", "BB"), CircuitBreaker: cb},
+				{CurrencyPair: slinkytypes.NewCurrencyPair("AA", "BB"), CircuitBreaker: cb},
+			},
+			false,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			gs := types.NewGenesisState(cpgs, 1, tc.cbs...)
+			err := gs.Validate()
+
+			if tc.expectPass {
+				require.Nil(t, err)
+			} else {
+				require.NotNil(t, err)
+			}
+		})
+	}
+}
+
+func TestGenesisValidationMaxPriceAges(t *testing.T) {
+	cpgs := []types.CurrencyPairGenesis{
+		{
+			CurrencyPair: slinkytypes.NewCurrencyPair("AA", "BB"),
+			Id:           0,
+		},
+	}
+
+	maxAge := types.MaxPriceAge{MaxBlocks: 10}
+
+	tcs := []struct {
+		name       string
+		maxAges    []types.CurrencyPairMaxPriceAge
+		expectPass bool
+	}{
+		{
+			"valid max price age - pass",
+			[]types.CurrencyPairMaxPriceAge{
+				{CurrencyPair: slinkytypes.NewCurrencyPair("AA", "BB"), MaxPriceAge: maxAge},
+			},
+			true,
+		},
+		{
+			"max price age without limits - fail",
+			[]types.CurrencyPairMaxPriceAge{
+				{CurrencyPair: slinkytypes.NewCurrencyPair("AA", "BB")},
+			},
+			false,
+		},
+		{
+			"max price age for a currency-pair not in genesis - fail",
+			[]types.CurrencyPairMaxPriceAge{
+				{CurrencyPair: slinkytypes.NewCurrencyPair("CC", "DD"), MaxPriceAge: maxAge},
+			},
+			false,
+		},
+		{
+			"repeated max price age - fail",
+			[]types.CurrencyPairMaxPriceAge{
+				{CurrencyPair: slinkytypes.NewCurrencyPair("AA", "BB"), MaxPriceAge: maxAge},
+				{CurrencyPair: slinkytypes.NewCurrencyPair("AA", "BB"), MaxPriceAge: maxAge},
+			},
+			false,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			gs := types.NewGenesisState(cpgs, 1)
+			gs.MaxPriceAges = tc.maxAges
+			err := gs.Validate()
+
+			if tc.expectPass {
+				require.Nil(t, err)
+			} else {
+				require.NotNil(t, err)
+			}
+		})
+	}
+}
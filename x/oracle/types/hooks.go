@@ -0,0 +1,48 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	slinkytypes "github.com/skip-mev/slinky/pkg/types"
+)
+
+// OracleHooks is the interface that defines the hooks that can be integrated by other modules.
+type OracleHooks interface {
+	// AfterPriceUpdated is called after a price aggregated from vote extensions is written to state for a
+	// CurrencyPair. The QuotePriceWithNonce is the updated state of the CurrencyPair.
+	AfterPriceUpdated(ctx sdk.Context, cp slinkytypes.CurrencyPair, qp QuotePriceWithNonce) error
+
+	// AfterPriceMissed is called after the prices aggregated from vote extensions are applied if no valid
+	// price was aggregated for a CurrencyPair.
+	AfterPriceMissed(ctx sdk.Context, cp slinkytypes.CurrencyPair) error
+}
+
+var _ OracleHooks = &MultiOracleHooks{}
+
+// MultiOracleHooks defines an array of OracleHooks which can be executed in sequence.
+type MultiOracleHooks []OracleHooks
+
+// AfterPriceUpdated calls all AfterPriceUpdated hooks registered to the MultiOracleHooks.
+func (oh MultiOracleHooks) AfterPriceUpdated(ctx sdk.Context, cp slinkytypes.CurrencyPair, qp QuotePriceWithNonce) error {
+	for i := range oh {
+		if err := oh[i].AfterPriceUpdated(ctx, cp, qp); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AfterPriceMissed calls all AfterPriceMissed hooks registered to the MultiOracleHooks.
+func (oh MultiOracleHooks) AfterPriceMissed(ctx sdk.Context, cp slinkytypes.CurrencyPair) error {
+	for i := range oh {
+		if err := oh[i].AfterPriceMissed(ctx, cp); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// OracleHooksWrapper is a wrapper for modules to inject OracleHooks using depinject.
+type OracleHooksWrapper struct{ OracleHooks }
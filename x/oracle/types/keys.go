@@ -33,6 +33,20 @@ var (
 	// NumCPsKeyPrefix is the key-prefix under which the number CPs is stored.
 	NumCPsKeyPrefix = collections.NewPrefix(5)
 
+	// PriceHistoryKeyPrefix is the key-prefix under which the price history of each CP is stored.
+	PriceHistoryKeyPrefix = collections.NewPrefix(6)
+
+	// CircuitBreakerKeyPrefix is the key-prefix under which the circuit breaker of each CP is stored.
+	CircuitBreakerKeyPrefix = collections.NewPrefix(7)
+
+	// MaxPriceAgeKeyPrefix is the key-prefix under which the max price age of each CP is stored.
+	MaxPriceAgeKeyPrefix = collections.NewPrefix(8)
+
 	// CounterCodec is the collections.KeyCodec value used for the counter values.
 	CounterCodec = codec.KeyToValueCodec[uint64](codec.NewUint64Key[uint64]())
+
+	// PriceHistoryCodec is the collections.KeyCodec value used for the price history. Prices are keyed by
+	// (CurrencyPair.String(), slot), where the slot is the block height of the price modulo the number of
+	// blocks of history retained.
+	PriceHistoryCodec = collections.PairKeyCodec(collections.StringKey, collections.Uint64Key)
 )
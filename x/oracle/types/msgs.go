@@ -9,6 +9,9 @@ import (
 var (
 	_ sdk.Msg = &MsgAddCurrencyPairs{}
 	_ sdk.Msg = &MsgRemoveCurrencyPairs{}
+	_ sdk.Msg = &MsgSetCircuitBreaker{}
+	_ sdk.Msg = &MsgResetCircuitBreaker{}
+	_ sdk.Msg = &MsgSetMaxPriceAge{}
 )
 
 // NewMsgAddCurrencyPairs returns a new message from a set of currency-pairs and an authority.
@@ -65,3 +68,74 @@ func (m *MsgRemoveCurrencyPairs) ValidateBasic() error {
 
 	return nil
 }
+
+// NewMsgSetCircuitBreaker returns a new message to set the circuit breaker of a currency-pair.
+func NewMsgSetCircuitBreaker(authority string, cp slinkytypes.CurrencyPair, cb CircuitBreaker) MsgSetCircuitBreaker {
+	return MsgSetCircuitBreaker{
+		Authority:      authority,
+		CurrencyPair:   cp,
+		CircuitBreaker: cb,
+	}
+}
+
+// ValidateBasic determines whether the information in the message is formatted correctly, specifically
+// whether the authority is a valid acc-address, and that the CurrencyPair and CircuitBreaker are valid.
+func (m *MsgSetCircuitBreaker) ValidateBasic() error {
+	// validate authority address
+	_, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		return err
+	}
+
+	if err := m.CurrencyPair.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return m.CircuitBreaker.ValidateBasic()
+}
+
+// NewMsgResetCircuitBreaker returns a new message to resume price updates for a currency-pair halted by its
+// circuit breaker.
+func NewMsgResetCircuitBreaker(authority string, cp slinkytypes.CurrencyPair) MsgResetCircuitBreaker {
+	return MsgResetCircuitBreaker{
+		Authority:    authority,
+		CurrencyPair: cp,
+	}
+}
+
+// ValidateBasic determines whether the information in the message is formatted correctly, specifically
+// whether the authority is a valid acc-address, and that the CurrencyPair is valid.
+func (m *MsgResetCircuitBreaker) ValidateBasic() error {
+	// validate authority address
+	_, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		return err
+	}
+
+	return m.CurrencyPair.ValidateBasic()
+}
+
+// NewMsgSetMaxPriceAge returns a new message to set the max price age of a currency-pair.
+func NewMsgSetMaxPriceAge(authority string, cp slinkytypes.CurrencyPair, maxAge MaxPriceAge) MsgSetMaxPriceAge {
+	return MsgSetMaxPriceAge{
+		Authority:    authority,
+		CurrencyPair: cp,
+		MaxPriceAge:  maxAge,
+	}
+}
+
+// ValidateBasic determines whether the information in the message is formatted correctly, specifically
+// whether the authority is a valid acc-address, and that the CurrencyPair and MaxPriceAge are valid.
+func (m *MsgSetMaxPriceAge) ValidateBasic() error {
+	// validate authority address
+	_, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		return err
+	}
+
+	if err := m.CurrencyPair.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return m.MaxPriceAge.ValidateBasic()
+}
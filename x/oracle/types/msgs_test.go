@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
@@ -105,3 +106,133 @@ func TestValidateBasicMsgRemoveCurrencyPairs(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateBasicMsgSetCircuitBreaker(t *testing.T) {
+	tcs := []struct {
+		name       string
+		msg        types.MsgSetCircuitBreaker
+		expectPass bool
+	}{
+		{
+			"if the authority is not an acc-address - fail",
+			types.NewMsgSetCircuitBreaker("abc", slinkytypes.NewCurrencyPair("A", "B"), types.CircuitBreaker{}),
+			false,
+		},
+		{
+			"if the currency pair is invalid - fail",
+			types.NewMsgSetCircuitBreaker(sdk.AccAddress("abc").String(), slinkytypes.CurrencyPair{Base: "A"}, types.CircuitBreaker{}),
+			false,
+		},
+		{
+			"if the circuit breaker is invalid - fail",
+			types.NewMsgSetCircuitBreaker(sdk.AccAddress("abc").String(), slinkytypes.NewCurrencyPair("A", "B"), types.CircuitBreaker{
+				MaxBlockChangeBps: 100,
+			}),
+			false,
+		},
+		{
+			"if the circuit breaker has no limits - pass",
+			types.NewMsgSetCircuitBreaker(sdk.AccAddress("abc").String(), slinkytypes.NewCurrencyPair("A", "B"), types.CircuitBreaker{}),
+			true,
+		},
+		{
+			"if the circuit breaker is valid - pass",
+			types.NewMsgSetCircuitBreaker(sdk.AccAddress("abc").String(), slinkytypes.NewCurrencyPair("A", "B"), types.CircuitBreaker{
+				MaxBlockChangeBps: 100,
+				Action:            types.CircuitBreakerAction_CIRCUIT_BREAKER_ACTION_SKIP,
+			}),
+			true,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if !tc.expectPass {
+				require.NotNil(t, err)
+			} else {
+				require.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateBasicMsgResetCircuitBreaker(t *testing.T) {
+	tcs := []struct {
+		name       string
+		msg        types.MsgResetCircuitBreaker
+		expectPass bool
+	}{
+		{
+			"if the authority is not an acc-address - fail",
+			types.NewMsgResetCircuitBreaker("abc", slinkytypes.NewCurrencyPair("A", "B")),
+			false,
+		},
+		{
+			"if the currency pair is invalid - fail",
+			types.NewMsgResetCircuitBreaker(sdk.AccAddress("abc").String(), slinkytypes.CurrencyPair{Base: "A"}),
+			false,
+		},
+		{
+			"if the authority and currency pair are valid - pass",
+			types.NewMsgResetCircuitBreaker(sdk.AccAddress("abc").String(), slinkytypes.NewCurrencyPair("A", "B")),
+			true,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if !tc.expectPass {
+				require.NotNil(t, err)
+			} else {
+				require.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateBasicMsgSetMaxPriceAge(t *testing.T) {
+	tcs := []struct {
+		name       string
+		msg        types.MsgSetMaxPriceAge
+		expectPass bool
+	}{
+		{
+			"if the authority is not an acc-address - fail",
+			types.NewMsgSetMaxPriceAge("abc", slinkytypes.NewCurrencyPair("A", "B"), types.MaxPriceAge{}),
+			false,
+		},
+		{
+			"if the currency pair is invalid - fail",
+			types.NewMsgSetMaxPriceAge(sdk.AccAddress("abc").String(), slinkytypes.CurrencyPair{Base: "A"}, types.MaxPriceAge{}),
+			false,
+		},
+		{
+			"if the max duration is negative - fail",
+			types.NewMsgSetMaxPriceAge(sdk.AccAddress("abc").String(), slinkytypes.NewCurrencyPair("A", "B"), types.MaxPriceAge{
+				MaxDuration: -time.Second,
+			}),
+			false,
+		},
+		{
+			"if the max price age is valid - pass",
+			types.NewMsgSetMaxPriceAge(sdk.AccAddress("abc").String(), slinkytypes.NewCurrencyPair("A", "B"), types.MaxPriceAge{
+				MaxBlocks:   10,
+				MaxDuration: time.Minute,
+			}),
+			true,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if !tc.expectPass {
+				require.NotNil(t, err)
+			} else {
+				require.Nil(t, err)
+			}
+		})
+	}
+}
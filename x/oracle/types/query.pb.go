@@ -5,24 +5,31 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	types "github.com/skip-mev/slinky/pkg/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -173,6 +180,15 @@ type GetPriceResponse struct {
 	Decimals uint64 `protobuf:"varint,3,opt,name=decimals,proto3" json:"decimals,omitempty"`
 	// ID represents the identifier for the CurrencyPair.
 	Id uint64 `protobuf:"varint,4,opt,name=id,proto3" json:"id,omitempty"`
+	// Stale is true if the price is older than the MaxPriceAge configured for
+	// the CurrencyPair, or if no price has been set for a CurrencyPair with a
+	// MaxPriceAge. Prices of CurrencyPairs without a MaxPriceAge are never
+	// stale.
+	Stale bool `protobuf:"varint,5,opt,name=stale,proto3" json:"stale,omitempty"`
+	// AgeBlocks is the number of blocks since the price was last updated.
+	AgeBlocks uint64 `protobuf:"varint,6,opt,name=age_blocks,json=ageBlocks,proto3" json:"age_blocks,omitempty"`
+	// Age is the time elapsed since the price was last updated.
+	Age time.Duration `protobuf:"bytes,7,opt,name=age,proto3,stdduration" json:"age"`
 }
 
 func (m *GetPriceResponse) Reset()         { *m = GetPriceResponse{} }
@@ -236,6 +252,27 @@ func (m *GetPriceResponse) GetId() uint64 {
 	return 0
 }
 
+func (m *GetPriceResponse) GetStale() bool {
+	if m != nil {
+		return m.Stale
+	}
+	return false
+}
+
+func (m *GetPriceResponse) GetAgeBlocks() uint64 {
+	if m != nil {
+		return m.AgeBlocks
+	}
+	return 0
+}
+
+func (m *GetPriceResponse) GetAge() time.Duration {
+	if m != nil {
+		return m.Age
+	}
+	return 0
+}
+
 // GetPricesRequest takes an identifier for the CurrencyPair
 // in the format base/quote.
 type GetPricesRequest struct {
@@ -328,6 +365,323 @@ func (m *GetPricesResponse) GetPrices() []GetPriceResponse {
 	return nil
 }
 
+// GetPriceHistoryRequest takes the CurrencyPair whose price history is
+// requested.
+type GetPriceHistoryRequest struct {
+	// CurrencyPair represents the pair that the user wishes to query.
+	CurrencyPair types.CurrencyPair `protobuf:"bytes,1,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair"`
+}
+
+func (m *GetPriceHistoryRequest) Reset()         { *m = GetPriceHistoryRequest{} }
+func (m *GetPriceHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPriceHistoryRequest) ProtoMessage()    {}
+func (*GetPriceHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ba8e832073f3a7b0, []int{6}
+}
+func (m *GetPriceHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetPriceHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetPriceHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetPriceHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPriceHistoryRequest.Merge(m, src)
+}
+func (m *GetPriceHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetPriceHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPriceHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPriceHistoryRequest proto.InternalMessageInfo
+
+func (m *GetPriceHistoryRequest) GetCurrencyPair() types.CurrencyPair {
+	if m != nil {
+		return m.CurrencyPair
+	}
+	return types.CurrencyPair{}
+}
+
+// GetPriceHistoryResponse is the response from the GetPriceHistory grpc method
+// exposed from the x/oracle query service.
+type GetPriceHistoryResponse struct {
+	// Prices are the retained QuotePrices for the CurrencyPair ordered by block
+	// height.
+	Prices []QuotePrice `protobuf:"bytes,1,rep,name=prices,proto3" json:"prices"`
+	// decimals represents the number of decimals that the quote-prices are
+	// represented in.
+	Decimals uint64 `protobuf:"varint,2,opt,name=decimals,proto3" json:"decimals,omitempty"`
+}
+
+func (m *GetPriceHistoryResponse) Reset()         { *m = GetPriceHistoryResponse{} }
+func (m *GetPriceHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPriceHistoryResponse) ProtoMessage()    {}
+func (*GetPriceHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ba8e832073f3a7b0, []int{7}
+}
+func (m *GetPriceHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetPriceHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetPriceHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetPriceHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPriceHistoryResponse.Merge(m, src)
+}
+func (m *GetPriceHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetPriceHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPriceHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPriceHistoryResponse proto.InternalMessageInfo
+
+func (m *GetPriceHistoryResponse) GetPrices() []QuotePrice {
+	if m != nil {
+		return m.Prices
+	}
+	return nil
+}
+
+func (m *GetPriceHistoryResponse) GetDecimals() uint64 {
+	if m != nil {
+		return m.Decimals
+	}
+	return 0
+}
+
+// GetTWAPRequest takes the CurrencyPair and the window over which the
+// time-weighted average price is computed.
+type GetTWAPRequest struct {
+	// CurrencyPair represents the pair that the user wishes to query.
+	CurrencyPair types.CurrencyPair `protobuf:"bytes,1,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair"`
+	// Window is the duration, ending at the current block time, over which the
+	// average is computed.
+	Window time.Duration `protobuf:"bytes,2,opt,name=window,proto3,stdduration" json:"window"`
+}
+
+func (m *GetTWAPRequest) Reset()         { *m = GetTWAPRequest{} }
+func (m *GetTWAPRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTWAPRequest) ProtoMessage()    {}
+func (*GetTWAPRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ba8e832073f3a7b0, []int{8}
+}
+func (m *GetTWAPRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetTWAPRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetTWAPRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetTWAPRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTWAPRequest.Merge(m, src)
+}
+func (m *GetTWAPRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetTWAPRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTWAPRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTWAPRequest proto.InternalMessageInfo
+
+func (m *GetTWAPRequest) GetCurrencyPair() types.CurrencyPair {
+	if m != nil {
+		return m.CurrencyPair
+	}
+	return types.CurrencyPair{}
+}
+
+func (m *GetTWAPRequest) GetWindow() time.Duration {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+// GetTWAPResponse is the response from the GetTWAP grpc method exposed from
+// the x/oracle query service.
+type GetTWAPResponse struct {
+	// Price is the time-weighted average price of the CurrencyPair.
+	Price cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=price,proto3,customtype=cosmossdk.io/math.Int" json:"price"`
+	// StartTimestamp is the start of the interval the average covers. This is
+	// later than the start of the requested window if the retained history does
+	// not cover the full window.
+	StartTimestamp time.Time `protobuf:"bytes,2,opt,name=start_timestamp,json=startTimestamp,proto3,stdtime" json:"start_timestamp"`
+	// decimals represents the number of decimals that the price is represented
+	// in.
+	Decimals uint64 `protobuf:"varint,3,opt,name=decimals,proto3" json:"decimals,omitempty"`
+}
+
+func (m *GetTWAPResponse) Reset()         { *m = GetTWAPResponse{} }
+func (m *GetTWAPResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTWAPResponse) ProtoMessage()    {}
+func (*GetTWAPResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ba8e832073f3a7b0, []int{9}
+}
+func (m *GetTWAPResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetTWAPResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetTWAPResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetTWAPResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTWAPResponse.Merge(m, src)
+}
+func (m *GetTWAPResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetTWAPResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTWAPResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTWAPResponse proto.InternalMessageInfo
+
+func (m *GetTWAPResponse) GetStartTimestamp() time.Time {
+	if m != nil {
+		return m.StartTimestamp
+	}
+	return time.Time{}
+}
+
+func (m *GetTWAPResponse) GetDecimals() uint64 {
+	if m != nil {
+		return m.Decimals
+	}
+	return 0
+}
+
+// GetCircuitBreakerRequest takes the CurrencyPair whose CircuitBreaker is
+// requested.
+type GetCircuitBreakerRequest struct {
+	// CurrencyPair represents the pair that the user wishes to query.
+	CurrencyPair types.CurrencyPair `protobuf:"bytes,1,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair"`
+}
+
+func (m *GetCircuitBreakerRequest) Reset()         { *m = GetCircuitBreakerRequest{} }
+func (m *GetCircuitBreakerRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCircuitBreakerRequest) ProtoMessage()    {}
+func (*GetCircuitBreakerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ba8e832073f3a7b0, []int{10}
+}
+func (m *GetCircuitBreakerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetCircuitBreakerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetCircuitBreakerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetCircuitBreakerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCircuitBreakerRequest.Merge(m, src)
+}
+func (m *GetCircuitBreakerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetCircuitBreakerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCircuitBreakerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCircuitBreakerRequest proto.InternalMessageInfo
+
+func (m *GetCircuitBreakerRequest) GetCurrencyPair() types.CurrencyPair {
+	if m != nil {
+		return m.CurrencyPair
+	}
+	return types.CurrencyPair{}
+}
+
+// GetCircuitBreakerResponse is the response from the GetCircuitBreaker grpc
+// method exposed from the x/oracle query service.
+type GetCircuitBreakerResponse struct {
+	// CircuitBreaker is the CircuitBreaker configured for the CurrencyPair.
+	CircuitBreaker CurrencyPairCircuitBreaker `protobuf:"bytes,1,opt,name=circuit_breaker,json=circuitBreaker,proto3" json:"circuit_breaker"`
+}
+
+func (m *GetCircuitBreakerResponse) Reset()         { *m = GetCircuitBreakerResponse{} }
+func (m *GetCircuitBreakerResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCircuitBreakerResponse) ProtoMessage()    {}
+func (*GetCircuitBreakerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ba8e832073f3a7b0, []int{11}
+}
+func (m *GetCircuitBreakerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetCircuitBreakerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetCircuitBreakerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetCircuitBreakerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCircuitBreakerResponse.Merge(m, src)
+}
+func (m *GetCircuitBreakerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetCircuitBreakerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCircuitBreakerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCircuitBreakerResponse proto.InternalMessageInfo
+
+func (m *GetCircuitBreakerResponse) GetCircuitBreaker() CurrencyPairCircuitBreaker {
+	if m != nil {
+		return m.CircuitBreaker
+	}
+	return CurrencyPairCircuitBreaker{}
+}
+
 func init() {
 	proto.RegisterType((*GetAllCurrencyPairsRequest)(nil), "slinky.oracle.v1.GetAllCurrencyPairsRequest")
 	proto.RegisterType((*GetAllCurrencyPairsResponse)(nil), "slinky.oracle.v1.GetAllCurrencyPairsResponse")
@@ -335,46 +689,76 @@ func init() {
 	proto.RegisterType((*GetPriceResponse)(nil), "slinky.oracle.v1.GetPriceResponse")
 	proto.RegisterType((*GetPricesRequest)(nil), "slinky.oracle.v1.GetPricesRequest")
 	proto.RegisterType((*GetPricesResponse)(nil), "slinky.oracle.v1.GetPricesResponse")
+	proto.RegisterType((*GetPriceHistoryRequest)(nil), "slinky.oracle.v1.GetPriceHistoryRequest")
+	proto.RegisterType((*GetPriceHistoryResponse)(nil), "slinky.oracle.v1.GetPriceHistoryResponse")
+	proto.RegisterType((*GetTWAPRequest)(nil), "slinky.oracle.v1.GetTWAPRequest")
+	proto.RegisterType((*GetTWAPResponse)(nil), "slinky.oracle.v1.GetTWAPResponse")
+	proto.RegisterType((*GetCircuitBreakerRequest)(nil), "slinky.oracle.v1.GetCircuitBreakerRequest")
+	proto.RegisterType((*GetCircuitBreakerResponse)(nil), "slinky.oracle.v1.GetCircuitBreakerResponse")
 }
 
 func init() { proto.RegisterFile("slinky/oracle/v1/query.proto", fileDescriptor_ba8e832073f3a7b0) }
 
 var fileDescriptor_ba8e832073f3a7b0 = []byte{
-	// 531 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xbf, 0x6f, 0xd3, 0x40,
-	0x18, 0xcd, 0xe5, 0x47, 0xd5, 0x1e, 0xf4, 0xd7, 0xd1, 0xc1, 0x72, 0x5d, 0x93, 0x3a, 0x0c, 0x2d,
-	0xa2, 0xb6, 0x52, 0x16, 0x26, 0x04, 0xed, 0x50, 0x60, 0x6a, 0x2d, 0xb1, 0xc0, 0x10, 0xb9, 0xf6,
-	0xc9, 0x9c, 0xe2, 0xf8, 0x5c, 0xdf, 0xd9, 0x22, 0x8c, 0xfc, 0x01, 0x08, 0x89, 0x11, 0xf1, 0xff,
-	0x74, 0xac, 0xc4, 0xc2, 0x84, 0x50, 0xc2, 0x1f, 0x82, 0x72, 0x77, 0x4e, 0x9d, 0xc6, 0x44, 0xd9,
-	0xec, 0x7b, 0xdf, 0xf7, 0xde, 0xf7, 0xde, 0x77, 0x07, 0x0d, 0x16, 0x91, 0xb8, 0x3f, 0x74, 0x68,
-	0xea, 0xf9, 0x11, 0x76, 0xf2, 0xae, 0x73, 0x95, 0xe1, 0x74, 0x68, 0x27, 0x29, 0xe5, 0x14, 0x6d,
-	0x49, 0xd4, 0x96, 0xa8, 0x9d, 0x77, 0xf5, 0x9d, 0x90, 0x86, 0x54, 0x80, 0xce, 0xe4, 0x4b, 0xd6,
-	0xe9, 0x46, 0x48, 0x69, 0x18, 0x61, 0xc7, 0x4b, 0x88, 0xe3, 0xc5, 0x31, 0xe5, 0x1e, 0x27, 0x34,
-	0x66, 0x0a, 0x35, 0xe7, 0x34, 0x42, 0x1c, 0x63, 0x46, 0x0a, 0xbc, 0xa3, 0x70, 0x3e, 0x4c, 0x30,
-	0x9b, 0xc0, 0x7e, 0x96, 0xa6, 0x38, 0xf6, 0x87, 0xbd, 0xc4, 0x23, 0xa9, 0x2c, 0xb2, 0x0c, 0xa8,
-	0x9f, 0x61, 0xfe, 0x32, 0x8a, 0x4e, 0x15, 0x78, 0xee, 0x91, 0x94, 0xb9, 0xf8, 0x2a, 0xc3, 0x8c,
-	0x5b, 0x04, 0xee, 0x56, 0xa2, 0x2c, 0xa1, 0x31, 0xc3, 0xe8, 0x0d, 0xdc, 0x98, 0xe1, 0x64, 0x1a,
-	0x68, 0x37, 0x0e, 0xee, 0x1d, 0xef, 0xd9, 0xca, 0xa0, 0x90, 0xb6, 0xf3, 0xae, 0x5d, 0xee, 0x3f,
-	0x69, 0x5e, 0xff, 0x7e, 0x58, 0x73, 0xd7, 0xfd, 0x32, 0xa7, 0xf5, 0x1e, 0x6e, 0x9e, 0x61, 0x7e,
-	0x9e, 0x12, 0x1f, 0x2b, 0x75, 0xf4, 0x0a, 0xae, 0xcf, 0xd0, 0x6b, 0xa0, 0x0d, 0x96, 0x65, 0xbf,
-	0x5f, 0x66, 0xb7, 0xbe, 0x00, 0xb8, 0x75, 0xcb, 0xae, 0xa6, 0x7f, 0x06, 0x5b, 0xc9, 0xe4, 0x40,
-	0xd1, 0x1a, 0xf6, 0xdd, 0xad, 0xd8, 0x17, 0x19, 0xe5, 0x58, 0x34, 0x09, 0x56, 0xe0, 0xca, 0x06,
-	0xb4, 0x03, 0x5b, 0x31, 0x8d, 0x7d, 0xac, 0xd5, 0xdb, 0xe0, 0xa0, 0xe9, 0xca, 0x1f, 0xa4, 0xc3,
-	0xd5, 0x00, 0xfb, 0x64, 0xe0, 0x45, 0x4c, 0x6b, 0x08, 0x60, 0xfa, 0x8f, 0x36, 0x60, 0x9d, 0x04,
-	0x5a, 0x53, 0x9c, 0xd6, 0x49, 0x60, 0x3d, 0xbf, 0x9d, 0xa7, 0x08, 0x1b, 0x3d, 0x86, 0xdb, 0x33,
-	0x76, 0x7b, 0x24, 0x90, 0x81, 0xae, 0xb9, 0x9b, 0x65, 0x37, 0xaf, 0x03, 0x66, 0xbd, 0x85, 0xdb,
-	0xa5, 0x7e, 0x65, 0xe8, 0x05, 0x5c, 0x11, 0xf3, 0x15, 0x6b, 0xb0, 0xe6, 0x1d, 0xdd, 0x0d, 0x41,
-	0xa5, 0xa5, 0xfa, 0x8e, 0xbf, 0x37, 0x60, 0xeb, 0x62, 0x72, 0x51, 0xd1, 0x0f, 0x00, 0x1f, 0x54,
-	0xac, 0x1e, 0x3d, 0xa9, 0xe4, 0xfc, 0xcf, 0xfd, 0xd1, 0x8f, 0x96, 0xac, 0x96, 0xc3, 0x58, 0x87,
-	0x9f, 0x7f, 0xfe, 0xfd, 0x56, 0xef, 0xa0, 0x7d, 0xa7, 0xe2, 0x6a, 0xf3, 0x9e, 0x17, 0x45, 0x3d,
-	0x4e, 0xfc, 0x3e, 0x4e, 0x19, 0xca, 0xe1, 0x6a, 0xe1, 0x05, 0xed, 0x2f, 0xf2, 0x29, 0x07, 0x59,
-	0x22, 0x0a, 0xab, 0x23, 0xd4, 0xf7, 0xd0, 0x6e, 0xb5, 0xba, 0x5c, 0xfd, 0x27, 0xb8, 0x36, 0x0d,
-	0x1e, 0x2d, 0x60, 0x9d, 0x46, 0xd0, 0x59, 0x58, 0xa3, 0xa4, 0x1f, 0x09, 0x69, 0x13, 0x19, 0x0b,
-	0xa4, 0xd9, 0xc9, 0xe9, 0xf5, 0xc8, 0x04, 0x37, 0x23, 0x13, 0xfc, 0x19, 0x99, 0xe0, 0xeb, 0xd8,
-	0xac, 0xdd, 0x8c, 0xcd, 0xda, 0xaf, 0xb1, 0x59, 0x7b, 0x77, 0x18, 0x12, 0xfe, 0x21, 0xbb, 0xb4,
-	0x7d, 0x3a, 0x70, 0x58, 0x9f, 0x24, 0x47, 0x03, 0x9c, 0x17, 0x54, 0x1f, 0x0b, 0x32, 0xf1, 0x5c,
-	0x2e, 0x57, 0xc4, 0xbb, 0x7f, 0xfa, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x8a, 0x08, 0x5e, 0x0f, 0xa2,
-	0x04, 0x00, 0x00,
+	// 928 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0x3a, 0x89, 0x9b, 0x3c, 0xa8, 0xdd, 0x0e, 0x05, 0x36, 0x5b, 0xc7, 0x71, 0xd6, 0x14,
+	0x92, 0xa6, 0xd9, 0x55, 0x8a, 0x90, 0x10, 0x48, 0x88, 0x38, 0x48, 0x6e, 0x91, 0x90, 0x52, 0xab,
+	0x08, 0x89, 0x1e, 0x56, 0xeb, 0xdd, 0x61, 0x33, 0xf2, 0x7a, 0x67, 0xb3, 0x33, 0x76, 0x6a, 0x8e,
+	0xfc, 0x82, 0x4a, 0x3d, 0x80, 0x84, 0xf8, 0x17, 0xdc, 0x39, 0x70, 0xe9, 0xb1, 0x82, 0x0b, 0xe2,
+	0x50, 0x50, 0xc2, 0xaf, 0xe0, 0x84, 0x76, 0x66, 0xd6, 0xf5, 0xda, 0x5b, 0xe3, 0x43, 0x6e, 0x9e,
+	0x7d, 0xdf, 0xfb, 0xbe, 0x6f, 0xde, 0xbc, 0xf7, 0x0c, 0x35, 0x16, 0x92, 0xa8, 0x37, 0xb2, 0x69,
+	0xe2, 0x7a, 0x21, 0xb6, 0x87, 0x07, 0xf6, 0xe9, 0x00, 0x27, 0x23, 0x2b, 0x4e, 0x28, 0xa7, 0xe8,
+	0x9a, 0x8c, 0x5a, 0x32, 0x6a, 0x0d, 0x0f, 0x8c, 0x1b, 0x01, 0x0d, 0xa8, 0x08, 0xda, 0xe9, 0x2f,
+	0x89, 0x33, 0x6a, 0x01, 0xa5, 0x41, 0x88, 0x6d, 0x37, 0x26, 0xb6, 0x1b, 0x45, 0x94, 0xbb, 0x9c,
+	0xd0, 0x88, 0xa9, 0x68, 0x5d, 0x45, 0xc5, 0xa9, 0x3b, 0xf8, 0xc6, 0xf6, 0x07, 0x89, 0x00, 0xa8,
+	0xf8, 0xd6, 0x74, 0x9c, 0x93, 0x3e, 0x66, 0xdc, 0xed, 0xc7, 0x0a, 0xb0, 0xe1, 0x51, 0xd6, 0xa7,
+	0xcc, 0x91, 0xba, 0xf2, 0x90, 0x71, 0xcf, 0xf8, 0x0f, 0x70, 0x84, 0x19, 0xc9, 0xe2, 0xef, 0xce,
+	0xc4, 0x3d, 0x92, 0x78, 0x03, 0xc2, 0x9d, 0x6e, 0x82, 0xdd, 0x1e, 0x4e, 0x14, 0xae, 0xa9, 0x70,
+	0x7c, 0x14, 0x63, 0x26, 0x60, 0x83, 0x24, 0xc1, 0x91, 0x37, 0x72, 0x62, 0x97, 0x28, 0x90, 0x59,
+	0x03, 0xa3, 0x8d, 0xf9, 0x61, 0x18, 0x1e, 0xa9, 0xe0, 0xb1, 0x4b, 0x12, 0xd6, 0xc1, 0xa7, 0x03,
+	0xcc, 0xb8, 0x49, 0xe0, 0x66, 0x61, 0x94, 0xc5, 0x34, 0x62, 0x18, 0x7d, 0x0e, 0x95, 0x1c, 0x27,
+	0xd3, 0xb5, 0xc6, 0xf2, 0xce, 0x6b, 0x77, 0x37, 0x2d, 0x55, 0x64, 0x21, 0x6d, 0x0d, 0x0f, 0xac,
+	0xc9, 0xfc, 0xd6, 0xca, 0xb3, 0x17, 0x5b, 0x4b, 0x9d, 0xab, 0xde, 0x24, 0xa7, 0xf9, 0x08, 0xaa,
+	0x6d, 0xcc, 0x8f, 0x13, 0xe2, 0x61, 0xa5, 0x8e, 0xee, 0xc1, 0xd5, 0x1c, 0xbd, 0xae, 0x35, 0xb4,
+	0x45, 0xd9, 0x5f, 0x9f, 0x64, 0x37, 0xff, 0xd5, 0xe0, 0xda, 0x4b, 0x76, 0xe5, 0xfe, 0x43, 0x58,
+	0x8d, 0xd3, 0x0f, 0x8a, 0xb6, 0x66, 0x4d, 0x77, 0x86, 0xf5, 0x60, 0x40, 0x39, 0x16, 0x49, 0x82,
+	0x55, 0xeb, 0xc8, 0x04, 0x74, 0x03, 0x56, 0x23, 0x1a, 0x79, 0x58, 0x2f, 0x35, 0xb4, 0x9d, 0x95,
+	0x8e, 0x3c, 0x20, 0x03, 0xd6, 0x7c, 0xec, 0x91, 0xbe, 0x1b, 0x32, 0x7d, 0x59, 0x04, 0xc6, 0x67,
+	0x54, 0x81, 0x12, 0xf1, 0xf5, 0x15, 0xf1, 0xb5, 0x44, 0xfc, 0x94, 0x81, 0x71, 0x37, 0xc4, 0xfa,
+	0x6a, 0x43, 0xdb, 0x59, 0xeb, 0xc8, 0x03, 0xda, 0x04, 0x70, 0x03, 0xec, 0x74, 0x43, 0xea, 0xf5,
+	0x98, 0x5e, 0x16, 0xe8, 0x75, 0x37, 0xc0, 0x2d, 0xf1, 0x01, 0x7d, 0x00, 0xcb, 0x6e, 0x80, 0xf5,
+	0x2b, 0xc2, 0xee, 0x86, 0x25, 0x5b, 0xcc, 0xca, 0x5a, 0xcc, 0xfa, 0x4c, 0xb5, 0x60, 0x6b, 0x2d,
+	0xad, 0xc0, 0x0f, 0x7f, 0x6d, 0x69, 0x9d, 0x14, 0x6f, 0x7e, 0xf2, 0xf2, 0xee, 0xd9, 0xc3, 0xa2,
+	0xdb, 0x70, 0x3d, 0x57, 0x5a, 0x87, 0xf8, 0xf2, 0xf1, 0xd6, 0x3b, 0xd5, 0xc9, 0xca, 0xdd, 0xf7,
+	0x99, 0xf9, 0x25, 0x5c, 0x9f, 0xc8, 0x57, 0xc5, 0xfb, 0x14, 0xca, 0xa2, 0x16, 0xd9, 0x93, 0x9b,
+	0xb3, 0xd5, 0x9b, 0x2e, 0xb8, 0x7a, 0x19, 0x95, 0x67, 0x76, 0xe1, 0xad, 0x0c, 0x71, 0x8f, 0x30,
+	0x4e, 0x93, 0xd1, 0xe5, 0xbf, 0xfb, 0x29, 0xbc, 0x3d, 0xa3, 0xa1, 0x2e, 0xf0, 0xd1, 0xd4, 0x05,
+	0xfe, 0xff, 0xf9, 0xc7, 0xd6, 0x73, 0x2f, 0x5d, 0xca, 0xbf, 0xb4, 0xf9, 0xbd, 0x06, 0x95, 0x36,
+	0xe6, 0x0f, 0xbf, 0x3a, 0x3c, 0xbe, 0xf4, 0xfb, 0xa0, 0x8f, 0xa1, 0x7c, 0x46, 0x22, 0x9f, 0x9e,
+	0xe9, 0xa5, 0xc5, 0x9b, 0x40, 0xa5, 0x98, 0xbf, 0x68, 0x50, 0x1d, 0x3b, 0x53, 0x55, 0x38, 0x9c,
+	0x9c, 0x81, 0xf5, 0xd6, 0x5e, 0x9a, 0xf4, 0xe7, 0x8b, 0xad, 0x37, 0xe5, 0x42, 0x62, 0x7e, 0xcf,
+	0x22, 0xd4, 0xee, 0xbb, 0xfc, 0xc4, 0xba, 0x1f, 0xf1, 0xdf, 0x7e, 0xde, 0x07, 0x19, 0x48, 0x4f,
+	0xd9, 0x30, 0x7c, 0x01, 0x55, 0xc6, 0xdd, 0x84, 0x3b, 0xe3, 0x15, 0xa7, 0xcc, 0x19, 0x33, 0xe6,
+	0x1e, 0x66, 0x08, 0xe9, 0xee, 0x49, 0xea, 0xae, 0x22, 0x92, 0xc7, 0x91, 0x79, 0x53, 0x64, 0xfa,
+	0xa0, 0xb7, 0x31, 0x3f, 0x92, 0xdb, 0xae, 0x25, 0x97, 0xdd, 0xe5, 0x37, 0xcd, 0x63, 0xd8, 0x28,
+	0x50, 0x51, 0x05, 0x7b, 0x04, 0xd5, 0xa9, 0x6d, 0xab, 0x84, 0xee, 0xcc, 0xf6, 0xcf, 0xa4, 0x52,
+	0x9e, 0x4e, 0xe9, 0x56, 0xbc, 0xdc, 0xd7, 0xbb, 0xbf, 0x96, 0x61, 0xf5, 0x41, 0xfa, 0x5f, 0x85,
+	0x7e, 0xd2, 0xe0, 0x8d, 0x82, 0xcd, 0x8b, 0xee, 0x14, 0x8e, 0xd9, 0x2b, 0xd6, 0xb7, 0xb1, 0xbf,
+	0x20, 0x5a, 0xde, 0xcd, 0xdc, 0xfd, 0xee, 0xf7, 0x7f, 0x9e, 0x96, 0x9a, 0x68, 0xdb, 0x2e, 0xf8,
+	0x07, 0xe2, 0x8e, 0x1b, 0x86, 0x0e, 0x27, 0x5e, 0x0f, 0x27, 0x0c, 0x0d, 0x61, 0x2d, 0x1b, 0x2c,
+	0xb4, 0x3d, 0x6f, 0xf4, 0xa5, 0x91, 0x05, 0xb6, 0x83, 0xd9, 0x14, 0xea, 0x9b, 0xe8, 0x66, 0xb1,
+	0xba, 0x6c, 0xb6, 0x6f, 0x61, 0x3d, 0x4b, 0x64, 0x68, 0x0e, 0xeb, 0xb8, 0x04, 0xcd, 0xb9, 0x18,
+	0x25, 0xfd, 0x8e, 0x90, 0xae, 0xa3, 0xda, 0x1c, 0x69, 0x86, 0x9e, 0x6a, 0x50, 0x9d, 0xda, 0x26,
+	0x68, 0xe7, 0xd5, 0xf4, 0xf9, 0xa5, 0x66, 0xec, 0x2e, 0x80, 0x54, 0x76, 0xf6, 0x84, 0x9d, 0x5b,
+	0xa8, 0x39, 0xc7, 0x8e, 0x73, 0xa2, 0x1c, 0x24, 0x70, 0x45, 0x0d, 0x35, 0x6a, 0x14, 0x4a, 0x4c,
+	0x6c, 0x22, 0x63, 0x7b, 0x0e, 0x42, 0x89, 0x9b, 0x42, 0xbc, 0x86, 0x8c, 0x62, 0x71, 0x7e, 0xe6,
+	0xc6, 0xe8, 0x47, 0x4d, 0xfc, 0x25, 0xe4, 0x7b, 0x1a, 0xdd, 0x2e, 0x24, 0x2f, 0x9c, 0x56, 0x63,
+	0x6f, 0x21, 0xac, 0xb2, 0xb4, 0x2f, 0x2c, 0xbd, 0x87, 0x6e, 0x15, 0x5b, 0x9a, 0x9a, 0xc7, 0xd6,
+	0xd1, 0xb3, 0xf3, 0xba, 0xf6, 0xfc, 0xbc, 0xae, 0xfd, 0x7d, 0x5e, 0xd7, 0x9e, 0x5c, 0xd4, 0x97,
+	0x9e, 0x5f, 0xd4, 0x97, 0xfe, 0xb8, 0xa8, 0x2f, 0x7d, 0xbd, 0x1b, 0x10, 0x7e, 0x32, 0xe8, 0x5a,
+	0x1e, 0xed, 0xdb, 0xac, 0x47, 0xe2, 0xfd, 0x3e, 0x1e, 0x66, 0x9c, 0x8f, 0x33, 0x56, 0xb1, 0x28,
+	0xba, 0x65, 0xb1, 0xb4, 0xde, 0xff, 0x6f, 0x00, 0xe1, 0x11, 0x92, 0x8c, 0x4d, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -395,6 +779,15 @@ type QueryClient interface {
 	// that CurrencyPair.
 	GetPrice(ctx context.Context, in *GetPriceRequest, opts ...grpc.CallOption) (*GetPriceResponse, error)
 	GetPrices(ctx context.Context, in *GetPricesRequest, opts ...grpc.CallOption) (*GetPricesResponse, error)
+	// Given a CurrencyPair, return the retained historical QuotePrices for that
+	// CurrencyPair ordered by block height.
+	GetPriceHistory(ctx context.Context, in *GetPriceHistoryRequest, opts ...grpc.CallOption) (*GetPriceHistoryResponse, error)
+	// Given a CurrencyPair and a window, return the time-weighted average price
+	// of the CurrencyPair over the window ending at the current block time.
+	GetTWAP(ctx context.Context, in *GetTWAPRequest, opts ...grpc.CallOption) (*GetTWAPResponse, error)
+	// Given a CurrencyPair, return the CircuitBreaker configured for that
+	// CurrencyPair and whether its price updates are halted.
+	GetCircuitBreaker(ctx context.Context, in *GetCircuitBreakerRequest, opts ...grpc.CallOption) (*GetCircuitBreakerResponse, error)
 }
 
 type queryClient struct {
@@ -432,6 +825,33 @@ func (c *queryClient) GetPrices(ctx context.Context, in *GetPricesRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) GetPriceHistory(ctx context.Context, in *GetPriceHistoryRequest, opts ...grpc.CallOption) (*GetPriceHistoryResponse, error) {
+	out := new(GetPriceHistoryResponse)
+	err := c.cc.Invoke(ctx, "/slinky.oracle.v1.Query/GetPriceHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetTWAP(ctx context.Context, in *GetTWAPRequest, opts ...grpc.CallOption) (*GetTWAPResponse, error) {
+	out := new(GetTWAPResponse)
+	err := c.cc.Invoke(ctx, "/slinky.oracle.v1.Query/GetTWAP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetCircuitBreaker(ctx context.Context, in *GetCircuitBreakerRequest, opts ...grpc.CallOption) (*GetCircuitBreakerResponse, error) {
+	out := new(GetCircuitBreakerResponse)
+	err := c.cc.Invoke(ctx, "/slinky.oracle.v1.Query/GetCircuitBreaker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Get all the currency pairs the x/oracle module is tracking price-data for.
@@ -440,6 +860,15 @@ type QueryServer interface {
 	// that CurrencyPair.
 	GetPrice(context.Context, *GetPriceRequest) (*GetPriceResponse, error)
 	GetPrices(context.Context, *GetPricesRequest) (*GetPricesResponse, error)
+	// Given a CurrencyPair, return the retained historical QuotePrices for that
+	// CurrencyPair ordered by block height.
+	GetPriceHistory(context.Context, *GetPriceHistoryRequest) (*GetPriceHistoryResponse, error)
+	// Given a CurrencyPair and a window, return the time-weighted average price
+	// of the CurrencyPair over the window ending at the current block time.
+	GetTWAP(context.Context, *GetTWAPRequest) (*GetTWAPResponse, error)
+	// Given a CurrencyPair, return the CircuitBreaker configured for that
+	// CurrencyPair and whether its price updates are halted.
+	GetCircuitBreaker(context.Context, *GetCircuitBreakerRequest) (*GetCircuitBreakerResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -455,6 +884,15 @@ func (*UnimplementedQueryServer) GetPrice(ctx context.Context, req *GetPriceRequ
 func (*UnimplementedQueryServer) GetPrices(ctx context.Context, req *GetPricesRequest) (*GetPricesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPrices not implemented")
 }
+func (*UnimplementedQueryServer) GetPriceHistory(ctx context.Context, req *GetPriceHistoryRequest) (*GetPriceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPriceHistory not implemented")
+}
+func (*UnimplementedQueryServer) GetTWAP(ctx context.Context, req *GetTWAPRequest) (*GetTWAPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTWAP not implemented")
+}
+func (*UnimplementedQueryServer) GetCircuitBreaker(ctx context.Context, req *GetCircuitBreakerRequest) (*GetCircuitBreakerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCircuitBreaker not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -514,39 +952,105 @@ func _Query_GetPrices_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "slinky.oracle.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetAllCurrencyPairs",
-			Handler:    _Query_GetAllCurrencyPairs_Handler,
-		},
-		{
-			MethodName: "GetPrice",
-			Handler:    _Query_GetPrice_Handler,
-		},
-		{
-			MethodName: "GetPrices",
-			Handler:    _Query_GetPrices_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "slinky/oracle/v1/query.proto",
-}
-
-func (m *GetAllCurrencyPairsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetPriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPriceHistoryRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetPriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/slinky.oracle.v1.Query/GetPriceHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetPriceHistory(ctx, req.(*GetPriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetAllCurrencyPairsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
+func _Query_GetTWAP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTWAPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetTWAP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/slinky.oracle.v1.Query/GetTWAP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTWAP(ctx, req.(*GetTWAPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetCircuitBreaker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCircuitBreakerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetCircuitBreaker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/slinky.oracle.v1.Query/GetCircuitBreaker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetCircuitBreaker(ctx, req.(*GetCircuitBreakerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "slinky.oracle.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetAllCurrencyPairs",
+			Handler:    _Query_GetAllCurrencyPairs_Handler,
+		},
+		{
+			MethodName: "GetPrice",
+			Handler:    _Query_GetPrice_Handler,
+		},
+		{
+			MethodName: "GetPrices",
+			Handler:    _Query_GetPrices_Handler,
+		},
+		{
+			MethodName: "GetPriceHistory",
+			Handler:    _Query_GetPriceHistory_Handler,
+		},
+		{
+			MethodName: "GetTWAP",
+			Handler:    _Query_GetTWAP_Handler,
+		},
+		{
+			MethodName: "GetCircuitBreaker",
+			Handler:    _Query_GetCircuitBreaker_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "slinky/oracle/v1/query.proto",
+}
+
+func (m *GetAllCurrencyPairsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetAllCurrencyPairsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
@@ -648,6 +1152,29 @@ func (m *GetPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Age, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Age):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintQuery(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x3a
+	if m.AgeBlocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AgeBlocks))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Stale {
+		i--
+		if m.Stale {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.Id != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
 		i--
@@ -747,87 +1274,323 @@ func (m *GetPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *GetPriceHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *GetAllCurrencyPairsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *GetPriceHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetPriceHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	{
+		size, err := m.CurrencyPair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *GetAllCurrencyPairsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetPriceHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetPriceHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetPriceHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.CurrencyPairs) > 0 {
-		for _, e := range m.CurrencyPairs {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Decimals != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Prices) > 0 {
+		for iNdEx := len(m.Prices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Prices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetPriceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetTWAPRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTWAPRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTWAPRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.CurrencyPair.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Window, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintQuery(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.CurrencyPair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *GetPriceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetTWAPResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTWAPResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTWAPResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Price != nil {
-		l = m.Price.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Nonce != 0 {
-		n += 1 + sovQuery(uint64(m.Nonce))
-	}
 	if m.Decimals != 0 {
-		n += 1 + sovQuery(uint64(m.Decimals))
+		i = encodeVarintQuery(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTimestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTimestamp):])
+	if err7 != nil {
+		return 0, err7
 	}
-	return n
+	i -= n7
+	i = encodeVarintQuery(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *GetPricesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetCircuitBreakerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetCircuitBreakerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetCircuitBreakerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.CurrencyPairIds) > 0 {
-		for _, s := range m.CurrencyPairIds {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.CurrencyPair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GetCircuitBreakerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetCircuitBreakerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetCircuitBreakerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CircuitBreaker.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GetAllCurrencyPairsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetAllCurrencyPairsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CurrencyPairs) > 0 {
+		for _, e := range m.CurrencyPairs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CurrencyPair.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *GetPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Price != nil {
+		l = m.Price.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovQuery(uint64(m.Decimals))
+	}
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	if m.Stale {
+		n += 2
+	}
+	if m.AgeBlocks != 0 {
+		n += 1 + sovQuery(uint64(m.AgeBlocks))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Age)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *GetPricesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CurrencyPairIds) > 0 {
+		for _, s := range m.CurrencyPairIds {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
 }
 
 func (m *GetPricesResponse) Size() (n int) {
@@ -842,16 +1605,610 @@ func (m *GetPricesResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	return n
-}
+	return n
+}
+
+func (m *GetPriceHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CurrencyPair.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *GetPriceHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Prices) > 0 {
+		for _, e := range m.Prices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovQuery(uint64(m.Decimals))
+	}
+	return n
+}
+
+func (m *GetTWAPRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CurrencyPair.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *GetTWAPResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTimestamp)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Decimals != 0 {
+		n += 1 + sovQuery(uint64(m.Decimals))
+	}
+	return n
+}
+
+func (m *GetCircuitBreakerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CurrencyPair.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *GetCircuitBreakerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CircuitBreaker.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GetAllCurrencyPairsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetAllCurrencyPairsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetAllCurrencyPairsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetAllCurrencyPairsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetAllCurrencyPairsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetAllCurrencyPairsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrencyPairs = append(m.CurrencyPairs, types.CurrencyPair{})
+			if err := m.CurrencyPairs[len(m.CurrencyPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetPriceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetPriceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrencyPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetPriceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetPriceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Price == nil {
+				m.Price = &QuotePrice{}
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stale", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Stale = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgeBlocks", wireType)
+			}
+			m.AgeBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AgeBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Age", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Age, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetPricesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetPricesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPairIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrencyPairIds = append(m.CurrencyPairIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *GetAllCurrencyPairsRequest) Unmarshal(dAtA []byte) error {
+func (m *GetPricesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -874,12 +2231,46 @@ func (m *GetAllCurrencyPairsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetAllCurrencyPairsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetPricesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetAllCurrencyPairsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prices = append(m.Prices, GetPriceResponse{})
+			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -901,7 +2292,7 @@ func (m *GetAllCurrencyPairsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetAllCurrencyPairsResponse) Unmarshal(dAtA []byte) error {
+func (m *GetPriceHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -924,15 +2315,15 @@ func (m *GetAllCurrencyPairsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetAllCurrencyPairsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetPriceHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetAllCurrencyPairsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetPriceHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPairs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -959,8 +2350,7 @@ func (m *GetAllCurrencyPairsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CurrencyPairs = append(m.CurrencyPairs, types.CurrencyPair{})
-			if err := m.CurrencyPairs[len(m.CurrencyPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CurrencyPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -985,7 +2375,7 @@ func (m *GetAllCurrencyPairsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetPriceRequest) Unmarshal(dAtA []byte) error {
+func (m *GetPriceHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1008,15 +2398,15 @@ func (m *GetPriceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetPriceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetPriceHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetPriceHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1043,10 +2433,30 @@ func (m *GetPriceRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CurrencyPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Prices = append(m.Prices, QuotePrice{})
+			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1068,7 +2478,7 @@ func (m *GetPriceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetPriceResponse) Unmarshal(dAtA []byte) error {
+func (m *GetTWAPRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1091,15 +2501,15 @@ func (m *GetPriceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetPriceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetTWAPRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetTWAPRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1126,18 +2536,15 @@ func (m *GetPriceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Price == nil {
-				m.Price = &QuotePrice{}
-			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CurrencyPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
 			}
-			m.Nonce = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1147,16 +2554,80 @@ func (m *GetPriceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.Decimals = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Window, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetTWAPResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetTWAPResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetTWAPResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1166,16 +2637,64 @@ func (m *GetPriceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Decimals |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTimestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTimestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
 			}
-			m.Id = 0
+			m.Decimals = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1185,7 +2704,7 @@ func (m *GetPriceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.Decimals |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1211,7 +2730,7 @@ func (m *GetPriceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetPricesRequest) Unmarshal(dAtA []byte) error {
+func (m *GetCircuitBreakerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1234,17 +2753,17 @@ func (m *GetPricesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetPricesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetCircuitBreakerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetCircuitBreakerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPairIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1254,23 +2773,24 @@ func (m *GetPricesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CurrencyPairIds = append(m.CurrencyPairIds, string(dAtA[iNdEx:postIndex]))
+			if err := m.CurrencyPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1293,7 +2813,7 @@ func (m *GetPricesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetPricesResponse) Unmarshal(dAtA []byte) error {
+func (m *GetCircuitBreakerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1316,15 +2836,15 @@ func (m *GetPricesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetPricesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetCircuitBreakerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetCircuitBreakerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreaker", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1351,8 +2871,7 @@ func (m *GetPricesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Prices = append(m.Prices, GetPriceResponse{})
-			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CircuitBreaker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
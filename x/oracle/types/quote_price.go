@@ -2,6 +2,9 @@ package types
 
 import (
 	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
 )
 
 // QuotePriceWithNonce is a wrapper around the QuotePrice object which also contains a nonce.
@@ -24,13 +27,22 @@ func (q *QuotePriceWithNonce) Nonce() uint64 {
 	return q.nonce
 }
 
-// ValidateBasic validates that the QuotePrice is valid, i.e. that the price is non-negative.
+// ValidateBasic validates that the QuotePrice is valid, i.e. that the price and dispersion are non-negative, and that
+// the voting power fraction is between 0 and 1.
 func (qp *QuotePrice) ValidateBasic() error {
 	// Check that the price is non-negative
 	if qp.Price.IsNegative() {
 		return fmt.Errorf("price cannot be negative: %s", qp.Price)
 	}
 
+	if qp.Dispersion != nil && qp.Dispersion.IsNegative() {
+		return fmt.Errorf("dispersion cannot be negative: %s", qp.Dispersion)
+	}
+
+	if qp.VotingPowerFraction != nil && (qp.VotingPowerFraction.IsNegative() || qp.VotingPowerFraction.GT(math.LegacyOneDec())) {
+		return fmt.Errorf("voting power fraction must be between 0 and 1: %s", qp.VotingPowerFraction)
+	}
+
 	return nil
 }
 
@@ -38,3 +50,22 @@ func (qp *QuotePrice) ValidateBasic() error {
 func (q *QuotePriceWithNonce) ValidateBasic() error {
 	return q.QuotePrice.ValidateBasic()
 }
+
+// Age returns the number of blocks and the time elapsed since the QuotePrice was set, as of the given block height and
+// time. Neither age is negative.
+func (qp *QuotePrice) Age(height uint64, now time.Time) (uint64, time.Duration) {
+	var (
+		ageBlocks uint64
+		age       time.Duration
+	)
+
+	if height > qp.BlockHeight {
+		ageBlocks = height - qp.BlockHeight
+	}
+
+	if now.After(qp.BlockTimestamp) {
+		age = now.Sub(qp.BlockTimestamp)
+	}
+
+	return ageBlocks, age
+}
@@ -12,6 +12,13 @@ import (
 )
 
 func TestQuotePrice(t *testing.T) {
+	var (
+		negative   = math.NewInt(-1)
+		dispersion = math.NewInt(10)
+		tooLarge   = math.LegacyNewDecWithPrec(11, 1)
+		fraction   = math.LegacyNewDecWithPrec(7, 1)
+	)
+
 	tcs := []struct {
 		name       string
 		quotePrice types.QuotePrice
@@ -44,6 +51,37 @@ func TestQuotePrice(t *testing.T) {
 			},
 			nil,
 		},
+		{
+			"negative dispersion",
+			types.QuotePrice{
+				Price:          math.NewInt(1),
+				BlockTimestamp: time.Now().UTC(),
+				BlockHeight:    1,
+				Dispersion:     &negative,
+			},
+			fmt.Errorf("dispersion cannot be negative: %s", negative),
+		},
+		{
+			"voting power fraction greater than 1",
+			types.QuotePrice{
+				Price:               math.NewInt(1),
+				BlockTimestamp:      time.Now().UTC(),
+				BlockHeight:         1,
+				VotingPowerFraction: &tooLarge,
+			},
+			fmt.Errorf("voting power fraction must be between 0 and 1: %s", tooLarge),
+		},
+		{
+			"valid dispersion and voting power fraction",
+			types.QuotePrice{
+				Price:               math.NewInt(1),
+				BlockTimestamp:      time.Now().UTC(),
+				BlockHeight:         1,
+				Dispersion:          &dispersion,
+				VotingPowerFraction: &fraction,
+			},
+			nil,
+		},
 	}
 
 	for _, tc := range tcs {
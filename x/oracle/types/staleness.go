@@ -0,0 +1,46 @@
+package types
+
+import (
+	"fmt"
+	"time"
+)
+
+// HasLimits returns true if the MaxPriceAge limits the age of a price in blocks or in time.
+func (m *MaxPriceAge) HasLimits() bool {
+	return m.MaxBlocks > 0 || m.MaxDuration > 0
+}
+
+// ValidateBasic validates that the maximum duration of the MaxPriceAge is not negative.
+func (m *MaxPriceAge) ValidateBasic() error {
+	if m.MaxDuration < 0 {
+		return fmt.Errorf("max duration cannot be negative: %s", m.MaxDuration)
+	}
+
+	return nil
+}
+
+// IsStale returns true if a price of the given age in blocks and in time exceeds either limit of the MaxPriceAge.
+func (m *MaxPriceAge) IsStale(ageBlocks uint64, age time.Duration) bool {
+	if m.MaxBlocks > 0 && ageBlocks > m.MaxBlocks {
+		return true
+	}
+
+	return m.MaxDuration > 0 && age > m.MaxDuration
+}
+
+// ValidateBasic validates the CurrencyPair and the MaxPriceAge, and that the MaxPriceAge has limits.
+func (cpma *CurrencyPairMaxPriceAge) ValidateBasic() error {
+	if err := cpma.CurrencyPair.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if err := cpma.MaxPriceAge.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid max price age for %s: %w", cpma.CurrencyPair, err)
+	}
+
+	if !cpma.MaxPriceAge.HasLimits() {
+		return fmt.Errorf("max price age for %s has no limits", cpma.CurrencyPair)
+	}
+
+	return nil
+}
@@ -0,0 +1,601 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: slinky/oracle/v1/staleness.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	types "github.com/skip-mev/slinky/pkg/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MaxPriceAge defines the maximum age of a CurrencyPair's price, in blocks and
+// in time, after which the price is considered stale.
+type MaxPriceAge struct {
+	// MaxBlocks is the maximum number of blocks since the price was last
+	// updated. If zero, the age in blocks is not limited.
+	MaxBlocks uint64 `protobuf:"varint,1,opt,name=max_blocks,json=maxBlocks,proto3" json:"max_blocks,omitempty"`
+	// MaxDuration is the maximum time elapsed since the price was last updated.
+	// If zero, the age in time is not limited.
+	MaxDuration time.Duration `protobuf:"bytes,2,opt,name=max_duration,json=maxDuration,proto3,stdduration" json:"max_duration"`
+}
+
+func (m *MaxPriceAge) Reset()         { *m = MaxPriceAge{} }
+func (m *MaxPriceAge) String() string { return proto.CompactTextString(m) }
+func (*MaxPriceAge) ProtoMessage()    {}
+func (*MaxPriceAge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82f14d1f423aad0b, []int{0}
+}
+func (m *MaxPriceAge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MaxPriceAge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MaxPriceAge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MaxPriceAge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaxPriceAge.Merge(m, src)
+}
+func (m *MaxPriceAge) XXX_Size() int {
+	return m.Size()
+}
+func (m *MaxPriceAge) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaxPriceAge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MaxPriceAge proto.InternalMessageInfo
+
+func (m *MaxPriceAge) GetMaxBlocks() uint64 {
+	if m != nil {
+		return m.MaxBlocks
+	}
+	return 0
+}
+
+func (m *MaxPriceAge) GetMaxDuration() time.Duration {
+	if m != nil {
+		return m.MaxDuration
+	}
+	return 0
+}
+
+// CurrencyPairMaxPriceAge is the MaxPriceAge configured for a CurrencyPair.
+type CurrencyPairMaxPriceAge struct {
+	// CurrencyPair is the CurrencyPair the MaxPriceAge is configured for.
+	CurrencyPair types.CurrencyPair `protobuf:"bytes,1,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair"`
+	// MaxPriceAge is the configured MaxPriceAge.
+	MaxPriceAge MaxPriceAge `protobuf:"bytes,2,opt,name=max_price_age,json=maxPriceAge,proto3" json:"max_price_age"`
+}
+
+func (m *CurrencyPairMaxPriceAge) Reset()         { *m = CurrencyPairMaxPriceAge{} }
+func (m *CurrencyPairMaxPriceAge) String() string { return proto.CompactTextString(m) }
+func (*CurrencyPairMaxPriceAge) ProtoMessage()    {}
+func (*CurrencyPairMaxPriceAge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82f14d1f423aad0b, []int{1}
+}
+func (m *CurrencyPairMaxPriceAge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CurrencyPairMaxPriceAge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CurrencyPairMaxPriceAge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CurrencyPairMaxPriceAge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CurrencyPairMaxPriceAge.Merge(m, src)
+}
+func (m *CurrencyPairMaxPriceAge) XXX_Size() int {
+	return m.Size()
+}
+func (m *CurrencyPairMaxPriceAge) XXX_DiscardUnknown() {
+	xxx_messageInfo_CurrencyPairMaxPriceAge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CurrencyPairMaxPriceAge proto.InternalMessageInfo
+
+func (m *CurrencyPairMaxPriceAge) GetCurrencyPair() types.CurrencyPair {
+	if m != nil {
+		return m.CurrencyPair
+	}
+	return types.CurrencyPair{}
+}
+
+func (m *CurrencyPairMaxPriceAge) GetMaxPriceAge() MaxPriceAge {
+	if m != nil {
+		return m.MaxPriceAge
+	}
+	return MaxPriceAge{}
+}
+
+func init() {
+	proto.RegisterType((*MaxPriceAge)(nil), "slinky.oracle.v1.MaxPriceAge")
+	proto.RegisterType((*CurrencyPairMaxPriceAge)(nil), "slinky.oracle.v1.CurrencyPairMaxPriceAge")
+}
+
+func init() { proto.RegisterFile("slinky/oracle/v1/staleness.proto", fileDescriptor_82f14d1f423aad0b) }
+
+var fileDescriptor_82f14d1f423aad0b = []byte{
+	// 341 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0xc1, 0x4e, 0xc2, 0x30,
+	0x1c, 0xc6, 0x57, 0x42, 0x8c, 0x76, 0x90, 0x98, 0xc5, 0x44, 0x24, 0x61, 0x10, 0xbc, 0xe0, 0xc1,
+	0x36, 0xe0, 0x13, 0x08, 0x46, 0xbd, 0x98, 0x10, 0x8e, 0x5e, 0x48, 0x37, 0x6b, 0x6d, 0xd8, 0xd6,
+	0xa5, 0xdd, 0x96, 0xf1, 0x16, 0x1e, 0x7d, 0x08, 0x1f, 0x84, 0x23, 0x47, 0x4f, 0x6a, 0xe0, 0x45,
+	0xcc, 0xba, 0xce, 0x8c, 0xdb, 0xf6, 0xfd, 0xbf, 0x7c, 0xdf, 0x6f, 0xdf, 0xe0, 0x40, 0x05, 0x3c,
+	0x5a, 0xad, 0xb1, 0x90, 0xc4, 0x0f, 0x28, 0xce, 0xc6, 0x58, 0x25, 0x24, 0xa0, 0x11, 0x55, 0x0a,
+	0xc5, 0x52, 0x24, 0xc2, 0x39, 0x2d, 0x1d, 0xa8, 0x74, 0xa0, 0x6c, 0xdc, 0x3d, 0x63, 0x82, 0x09,
+	0x7d, 0xc4, 0xc5, 0x53, 0xe9, 0xeb, 0xba, 0x4c, 0x08, 0x16, 0x50, 0xac, 0xdf, 0xbc, 0xf4, 0x15,
+	0xbf, 0xa4, 0x92, 0x24, 0x5c, 0x44, 0xe6, 0x7e, 0x69, 0x9a, 0x92, 0x75, 0x4c, 0x55, 0x51, 0xe4,
+	0xa7, 0x52, 0xd2, 0xc8, 0x5f, 0x2f, 0x63, 0xc2, 0x65, 0x69, 0x1a, 0x26, 0xd0, 0x7e, 0x22, 0xf9,
+	0x5c, 0x72, 0x9f, 0xde, 0x32, 0xea, 0xf4, 0x20, 0x0c, 0x49, 0xbe, 0xf4, 0x02, 0xe1, 0xaf, 0x54,
+	0x07, 0x0c, 0xc0, 0xa8, 0xb9, 0x38, 0x09, 0x49, 0x3e, 0xd5, 0x82, 0x73, 0x0f, 0x5b, 0xc5, 0xb9,
+	0x2a, 0xea, 0x34, 0x06, 0x60, 0x64, 0x4f, 0x2e, 0x50, 0x49, 0x82, 0x2a, 0x12, 0x74, 0x67, 0x0c,
+	0xd3, 0xe3, 0xcd, 0x77, 0xdf, 0xfa, 0xf8, 0xe9, 0x83, 0x85, 0x1d, 0x92, 0xbc, 0x92, 0x87, 0x9f,
+	0x00, 0x9e, 0xcf, 0x0c, 0xcd, 0x9c, 0x70, 0x59, 0x47, 0x78, 0x84, 0xed, 0x03, 0x50, 0x4d, 0x61,
+	0x4f, 0x7a, 0xc8, 0xcc, 0xa2, 0x3f, 0x07, 0x65, 0x63, 0x54, 0x0f, 0x98, 0x36, 0x8b, 0xa2, 0x45,
+	0xcb, 0xaf, 0x69, 0xce, 0x03, 0x6c, 0x17, 0xb4, 0x71, 0x91, 0xbc, 0x24, 0x8c, 0x76, 0x1a, 0x87,
+	0x49, 0xff, 0x03, 0xa3, 0x5a, 0xbf, 0x49, 0xb2, 0xc3, 0x9a, 0x34, 0xdb, 0xec, 0x5c, 0xb0, 0xdd,
+	0xb9, 0xe0, 0x77, 0xe7, 0x82, 0xf7, 0xbd, 0x6b, 0x6d, 0xf7, 0xae, 0xf5, 0xb5, 0x77, 0xad, 0xe7,
+	0x2b, 0xc6, 0x93, 0xb7, 0xd4, 0x43, 0xbe, 0x08, 0xb1, 0x5a, 0xf1, 0xf8, 0x3a, 0xa4, 0x19, 0x36,
+	0xbb, 0xe7, 0xd5, 0x3f, 0xd6, 0xc4, 0xde, 0x91, 0x5e, 0xe7, 0xe6, 0x6f, 0x00, 0xab, 0x08, 0x94,
+	0x06, 0x01, 0x02, 0x00, 0x00,
+}
+
+func (m *MaxPriceAge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MaxPriceAge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MaxPriceAge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxDuration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintStaleness(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	if m.MaxBlocks != 0 {
+		i = encodeVarintStaleness(dAtA, i, uint64(m.MaxBlocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CurrencyPairMaxPriceAge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CurrencyPairMaxPriceAge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CurrencyPairMaxPriceAge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MaxPriceAge.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaleness(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.CurrencyPair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaleness(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStaleness(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStaleness(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MaxPriceAge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxBlocks != 0 {
+		n += 1 + sovStaleness(uint64(m.MaxBlocks))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxDuration)
+	n += 1 + l + sovStaleness(uint64(l))
+	return n
+}
+
+func (m *CurrencyPairMaxPriceAge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CurrencyPair.Size()
+	n += 1 + l + sovStaleness(uint64(l))
+	l = m.MaxPriceAge.Size()
+	n += 1 + l + sovStaleness(uint64(l))
+	return n
+}
+
+func sovStaleness(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStaleness(x uint64) (n int) {
+	return sovStaleness(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MaxPriceAge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaleness
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MaxPriceAge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MaxPriceAge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlocks", wireType)
+			}
+			m.MaxBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaleness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaleness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaleness
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaleness
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaleness(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaleness
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CurrencyPairMaxPriceAge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaleness
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CurrencyPairMaxPriceAge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CurrencyPairMaxPriceAge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaleness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaleness
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaleness
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrencyPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceAge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaleness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaleness
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaleness
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPriceAge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaleness(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaleness
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipStaleness(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowStaleness
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStaleness
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStaleness
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthStaleness
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupStaleness
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthStaleness
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthStaleness        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowStaleness          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupStaleness = fmt.Errorf("proto: unexpected end of group")
+)
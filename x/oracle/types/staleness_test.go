@@ -0,0 +1,100 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	slinkytypes "github.com/skip-mev/slinky/pkg/types"
+	"github.com/skip-mev/slinky/x/oracle/types"
+)
+
+func TestMaxPriceAge(t *testing.T) {
+	tcs := []struct {
+		name      string
+		maxAge    types.MaxPriceAge
+		ageBlocks uint64
+		age       time.Duration
+		stale     bool
+	}{
+		{
+			"no limits - fresh",
+			types.MaxPriceAge{},
+			1000,
+			time.Hour,
+			false,
+		},
+		{
+			"at the block limit - fresh",
+			types.MaxPriceAge{MaxBlocks: 10},
+			10,
+			time.Hour,
+			false,
+		},
+		{
+			"beyond the block limit - stale",
+			types.MaxPriceAge{MaxBlocks: 10},
+			11,
+			0,
+			true,
+		},
+		{
+			"at the duration limit - fresh",
+			types.MaxPriceAge{MaxDuration: time.Minute},
+			1000,
+			time.Minute,
+			false,
+		},
+		{
+			"beyond the duration limit - stale",
+			types.MaxPriceAge{MaxDuration: time.Minute},
+			0,
+			time.Minute + time.Second,
+			true,
+		},
+		{
+			"beyond either limit - stale",
+			types.MaxPriceAge{MaxBlocks: 10, MaxDuration: time.Minute},
+			5,
+			2 * time.Minute,
+			true,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			require.NoError(t, tc.maxAge.ValidateBasic())
+			require.Equal(t, tc.stale, tc.maxAge.IsStale(tc.ageBlocks, tc.age))
+		})
+	}
+
+	t.Run("negative duration - fail", func(t *testing.T) {
+		maxAge := types.MaxPriceAge{MaxDuration: -time.Second}
+		require.Error(t, maxAge.ValidateBasic())
+	})
+
+	t.Run("currency pair max price age without limits - fail", func(t *testing.T) {
+		cpma := types.CurrencyPairMaxPriceAge{
+			CurrencyPair: slinkytypes.NewCurrencyPair("BTC", "USD"),
+		}
+		require.Error(t, cpma.ValidateBasic())
+	})
+}
+
+func TestQuotePriceAge(t *testing.T) {
+	now := time.Unix(100, 0)
+	qp := types.QuotePrice{
+		BlockTimestamp: time.Unix(40, 0),
+		BlockHeight:    10,
+	}
+
+	ageBlocks, age := qp.Age(15, now)
+	require.Equal(t, uint64(5), ageBlocks)
+	require.Equal(t, time.Minute, age)
+
+	// ages are never negative
+	ageBlocks, age = qp.Age(5, time.Unix(0, 0))
+	require.Zero(t, ageBlocks)
+	require.Zero(t, age)
+}
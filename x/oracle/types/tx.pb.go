@@ -225,46 +225,367 @@ func (m *MsgRemoveCurrencyPairsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRemoveCurrencyPairsResponse proto.InternalMessageInfo
 
+// Given an authority + a CurrencyPair + a CircuitBreaker, the x/oracle module
+// will check that the authority has permissions to update the CircuitBreakers
+// of the module, and set the CircuitBreaker for the CurrencyPair. Whether the
+// CurrencyPair is halted is left unchanged.
+type MsgSetCircuitBreaker struct {
+	// authority is the address of the account that is authorized to update the
+	// x/oracle's CircuitBreakers
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// currency_pair is the CurrencyPair the CircuitBreaker is set for
+	CurrencyPair types.CurrencyPair `protobuf:"bytes,2,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair"`
+	// circuit_breaker is the CircuitBreaker to set
+	CircuitBreaker CircuitBreaker `protobuf:"bytes,3,opt,name=circuit_breaker,json=circuitBreaker,proto3" json:"circuit_breaker"`
+}
+
+func (m *MsgSetCircuitBreaker) Reset()         { *m = MsgSetCircuitBreaker{} }
+func (m *MsgSetCircuitBreaker) String() string { return proto.CompactTextString(m) }
+func (*MsgSetCircuitBreaker) ProtoMessage()    {}
+func (*MsgSetCircuitBreaker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cd987ee4c7d5236, []int{4}
+}
+func (m *MsgSetCircuitBreaker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetCircuitBreaker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetCircuitBreaker.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetCircuitBreaker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetCircuitBreaker.Merge(m, src)
+}
+func (m *MsgSetCircuitBreaker) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetCircuitBreaker) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetCircuitBreaker.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetCircuitBreaker proto.InternalMessageInfo
+
+func (m *MsgSetCircuitBreaker) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetCircuitBreaker) GetCurrencyPair() types.CurrencyPair {
+	if m != nil {
+		return m.CurrencyPair
+	}
+	return types.CurrencyPair{}
+}
+
+func (m *MsgSetCircuitBreaker) GetCircuitBreaker() CircuitBreaker {
+	if m != nil {
+		return m.CircuitBreaker
+	}
+	return CircuitBreaker{}
+}
+
+type MsgSetCircuitBreakerResponse struct {
+}
+
+func (m *MsgSetCircuitBreakerResponse) Reset()         { *m = MsgSetCircuitBreakerResponse{} }
+func (m *MsgSetCircuitBreakerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetCircuitBreakerResponse) ProtoMessage()    {}
+func (*MsgSetCircuitBreakerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cd987ee4c7d5236, []int{5}
+}
+func (m *MsgSetCircuitBreakerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetCircuitBreakerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetCircuitBreakerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetCircuitBreakerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetCircuitBreakerResponse.Merge(m, src)
+}
+func (m *MsgSetCircuitBreakerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetCircuitBreakerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetCircuitBreakerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetCircuitBreakerResponse proto.InternalMessageInfo
+
+// Given an authority + a CurrencyPair, the x/oracle module will check that the
+// authority has permissions to update the CircuitBreakers of the module, and
+// resume price updates for the CurrencyPair if it is halted.
+type MsgResetCircuitBreaker struct {
+	// authority is the address of the account that is authorized to update the
+	// x/oracle's CircuitBreakers
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// currency_pair is the CurrencyPair to resume price updates for
+	CurrencyPair types.CurrencyPair `protobuf:"bytes,2,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair"`
+}
+
+func (m *MsgResetCircuitBreaker) Reset()         { *m = MsgResetCircuitBreaker{} }
+func (m *MsgResetCircuitBreaker) String() string { return proto.CompactTextString(m) }
+func (*MsgResetCircuitBreaker) ProtoMessage()    {}
+func (*MsgResetCircuitBreaker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cd987ee4c7d5236, []int{6}
+}
+func (m *MsgResetCircuitBreaker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResetCircuitBreaker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResetCircuitBreaker.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResetCircuitBreaker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResetCircuitBreaker.Merge(m, src)
+}
+func (m *MsgResetCircuitBreaker) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResetCircuitBreaker) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResetCircuitBreaker.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResetCircuitBreaker proto.InternalMessageInfo
+
+func (m *MsgResetCircuitBreaker) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgResetCircuitBreaker) GetCurrencyPair() types.CurrencyPair {
+	if m != nil {
+		return m.CurrencyPair
+	}
+	return types.CurrencyPair{}
+}
+
+type MsgResetCircuitBreakerResponse struct {
+}
+
+func (m *MsgResetCircuitBreakerResponse) Reset()         { *m = MsgResetCircuitBreakerResponse{} }
+func (m *MsgResetCircuitBreakerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgResetCircuitBreakerResponse) ProtoMessage()    {}
+func (*MsgResetCircuitBreakerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cd987ee4c7d5236, []int{7}
+}
+func (m *MsgResetCircuitBreakerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResetCircuitBreakerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResetCircuitBreakerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResetCircuitBreakerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResetCircuitBreakerResponse.Merge(m, src)
+}
+func (m *MsgResetCircuitBreakerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResetCircuitBreakerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResetCircuitBreakerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResetCircuitBreakerResponse proto.InternalMessageInfo
+
+// Given an authority + a CurrencyPair + a MaxPriceAge, the x/oracle module will
+// check that the authority has permissions to update the MaxPriceAges of the
+// module, and set the MaxPriceAge for the CurrencyPair.
+type MsgSetMaxPriceAge struct {
+	// authority is the address of the account that is authorized to update the
+	// x/oracle's MaxPriceAges
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// currency_pair is the CurrencyPair the MaxPriceAge is set for
+	CurrencyPair types.CurrencyPair `protobuf:"bytes,2,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair"`
+	// max_price_age is the MaxPriceAge to set
+	MaxPriceAge MaxPriceAge `protobuf:"bytes,3,opt,name=max_price_age,json=maxPriceAge,proto3" json:"max_price_age"`
+}
+
+func (m *MsgSetMaxPriceAge) Reset()         { *m = MsgSetMaxPriceAge{} }
+func (m *MsgSetMaxPriceAge) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMaxPriceAge) ProtoMessage()    {}
+func (*MsgSetMaxPriceAge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cd987ee4c7d5236, []int{8}
+}
+func (m *MsgSetMaxPriceAge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetMaxPriceAge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetMaxPriceAge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetMaxPriceAge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMaxPriceAge.Merge(m, src)
+}
+func (m *MsgSetMaxPriceAge) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetMaxPriceAge) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMaxPriceAge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetMaxPriceAge proto.InternalMessageInfo
+
+func (m *MsgSetMaxPriceAge) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetMaxPriceAge) GetCurrencyPair() types.CurrencyPair {
+	if m != nil {
+		return m.CurrencyPair
+	}
+	return types.CurrencyPair{}
+}
+
+func (m *MsgSetMaxPriceAge) GetMaxPriceAge() MaxPriceAge {
+	if m != nil {
+		return m.MaxPriceAge
+	}
+	return MaxPriceAge{}
+}
+
+type MsgSetMaxPriceAgeResponse struct {
+}
+
+func (m *MsgSetMaxPriceAgeResponse) Reset()         { *m = MsgSetMaxPriceAgeResponse{} }
+func (m *MsgSetMaxPriceAgeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMaxPriceAgeResponse) ProtoMessage()    {}
+func (*MsgSetMaxPriceAgeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cd987ee4c7d5236, []int{9}
+}
+func (m *MsgSetMaxPriceAgeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetMaxPriceAgeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetMaxPriceAgeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetMaxPriceAgeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMaxPriceAgeResponse.Merge(m, src)
+}
+func (m *MsgSetMaxPriceAgeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetMaxPriceAgeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMaxPriceAgeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetMaxPriceAgeResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgAddCurrencyPairs)(nil), "slinky.oracle.v1.MsgAddCurrencyPairs")
 	proto.RegisterType((*MsgAddCurrencyPairsResponse)(nil), "slinky.oracle.v1.MsgAddCurrencyPairsResponse")
 	proto.RegisterType((*MsgRemoveCurrencyPairs)(nil), "slinky.oracle.v1.MsgRemoveCurrencyPairs")
 	proto.RegisterType((*MsgRemoveCurrencyPairsResponse)(nil), "slinky.oracle.v1.MsgRemoveCurrencyPairsResponse")
+	proto.RegisterType((*MsgSetCircuitBreaker)(nil), "slinky.oracle.v1.MsgSetCircuitBreaker")
+	proto.RegisterType((*MsgSetCircuitBreakerResponse)(nil), "slinky.oracle.v1.MsgSetCircuitBreakerResponse")
+	proto.RegisterType((*MsgResetCircuitBreaker)(nil), "slinky.oracle.v1.MsgResetCircuitBreaker")
+	proto.RegisterType((*MsgResetCircuitBreakerResponse)(nil), "slinky.oracle.v1.MsgResetCircuitBreakerResponse")
+	proto.RegisterType((*MsgSetMaxPriceAge)(nil), "slinky.oracle.v1.MsgSetMaxPriceAge")
+	proto.RegisterType((*MsgSetMaxPriceAgeResponse)(nil), "slinky.oracle.v1.MsgSetMaxPriceAgeResponse")
 }
 
 func init() { proto.RegisterFile("slinky/oracle/v1/tx.proto", fileDescriptor_1cd987ee4c7d5236) }
 
 var fileDescriptor_1cd987ee4c7d5236 = []byte{
-	// 456 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2c, 0xce, 0xc9, 0xcc,
-	0xcb, 0xae, 0xd4, 0xcf, 0x2f, 0x4a, 0x4c, 0xce, 0x49, 0xd5, 0x2f, 0x33, 0xd4, 0x2f, 0xa9, 0xd0,
-	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0x48, 0xe9, 0x41, 0xa4, 0xf4, 0xca, 0x0c, 0xa5,
-	0xe4, 0x30, 0x14, 0xa7, 0xa7, 0xe6, 0xa5, 0x16, 0x67, 0x16, 0x43, 0x74, 0x48, 0x49, 0x26, 0xe7,
-	0x17, 0xe7, 0xe6, 0x17, 0xc7, 0x83, 0x79, 0xfa, 0x10, 0x0e, 0x54, 0x4a, 0x1c, 0xc2, 0xd3, 0xcf,
-	0x2d, 0x4e, 0x07, 0xe9, 0xcb, 0x2d, 0x4e, 0x87, 0x4a, 0x08, 0x26, 0xe6, 0x66, 0xe6, 0xe5, 0xeb,
-	0x83, 0x49, 0xa8, 0x90, 0x48, 0x7a, 0x7e, 0x7a, 0x3e, 0xc4, 0x0c, 0x10, 0x0b, 0x2a, 0xaa, 0x0c,
-	0xb5, 0xbc, 0xa4, 0xb2, 0x20, 0xb5, 0x18, 0x64, 0x46, 0x72, 0x69, 0x51, 0x51, 0x6a, 0x5e, 0x72,
-	0x65, 0x7c, 0x41, 0x62, 0x66, 0x11, 0x44, 0x91, 0xd2, 0x55, 0x46, 0x2e, 0x61, 0xdf, 0xe2, 0x74,
-	0xc7, 0x94, 0x14, 0x67, 0xa8, 0x6c, 0x40, 0x62, 0x66, 0x51, 0xb1, 0x90, 0x19, 0x17, 0x67, 0x62,
-	0x69, 0x49, 0x46, 0x7e, 0x51, 0x66, 0x49, 0xa5, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xa7, 0x93, 0xc4,
-	0xa5, 0x2d, 0xba, 0x22, 0x50, 0x37, 0x3a, 0xa6, 0xa4, 0x14, 0xa5, 0x16, 0x17, 0x07, 0x97, 0x14,
-	0x65, 0xe6, 0xa5, 0x07, 0x21, 0x94, 0x0a, 0x79, 0x71, 0xf1, 0xa1, 0x58, 0x53, 0x2c, 0xc1, 0xa4,
-	0xc0, 0xac, 0xc1, 0x6d, 0x24, 0xab, 0x07, 0x0d, 0x1c, 0xb0, 0x6b, 0xf4, 0xca, 0x0c, 0xf5, 0x90,
-	0xed, 0x73, 0x62, 0x39, 0x71, 0x4f, 0x9e, 0x21, 0x88, 0x37, 0x19, 0xd9, 0x0d, 0x56, 0x56, 0x2f,
-	0x16, 0xc8, 0x33, 0x34, 0x3d, 0xdf, 0xa0, 0x85, 0x30, 0xbf, 0xeb, 0xf9, 0x06, 0x2d, 0x98, 0xbf,
-	0x2a, 0x60, 0xc1, 0x8a, 0xc5, 0xfd, 0x4a, 0xb2, 0x5c, 0xd2, 0x58, 0x84, 0x83, 0x52, 0x8b, 0x0b,
-	0xf2, 0xf3, 0x8a, 0x53, 0x95, 0x76, 0x30, 0x72, 0x89, 0xf9, 0x16, 0xa7, 0x07, 0xa5, 0xe6, 0xe6,
-	0x97, 0xa5, 0x52, 0xc7, 0xe7, 0x5a, 0x5c, 0x82, 0x28, 0x3e, 0x8f, 0xcf, 0x4c, 0x81, 0x78, 0x9e,
-	0x33, 0x88, 0x1f, 0xd9, 0x5f, 0x9e, 0x29, 0x24, 0xf9, 0x2c, 0x38, 0xb5, 0x04, 0xd5, 0x67, 0x0a,
-	0x5c, 0x72, 0xd8, 0x5d, 0x0e, 0xf3, 0x9c, 0xd1, 0x4b, 0x46, 0x2e, 0x66, 0xdf, 0xe2, 0x74, 0xa1,
-	0x0c, 0x2e, 0x01, 0x8c, 0x78, 0x55, 0xd5, 0x43, 0x4f, 0xa4, 0x7a, 0x58, 0xc2, 0x49, 0x4a, 0x97,
-	0x28, 0x65, 0x30, 0x1b, 0x85, 0x0a, 0xb9, 0x84, 0xb1, 0x05, 0xa5, 0x06, 0x56, 0x53, 0xb0, 0xa8,
-	0x94, 0x32, 0x20, 0x56, 0x25, 0xcc, 0x4a, 0x29, 0xd6, 0x86, 0xe7, 0x1b, 0xb4, 0x18, 0x9d, 0x9c,
-	0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5,
-	0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x33, 0x3d, 0xb3, 0x24, 0xa3,
-	0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0xbf, 0x38, 0x3b, 0xb3, 0x40, 0x37, 0x37, 0xb5, 0x4c, 0x1f,
-	0x3d, 0x80, 0xc1, 0xa9, 0x31, 0x89, 0x0d, 0x9c, 0x17, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0x41, 0x02, 0x42, 0x54, 0xdc, 0x03, 0x00, 0x00,
+	// 683 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x95, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0xe3, 0xa4, 0x20, 0xf5, 0x4a, 0x7f, 0x24, 0xad, 0x20, 0x75, 0xa9, 0x1b, 0x52, 0xa8,
+	0x4a, 0x50, 0xed, 0xb6, 0x48, 0x08, 0x05, 0x31, 0x34, 0x1d, 0xf8, 0x21, 0x45, 0x54, 0xe9, 0xc6,
+	0x62, 0x39, 0xf6, 0xe9, 0x7a, 0x4a, 0xed, 0x33, 0x77, 0x4e, 0x94, 0x6c, 0x88, 0x91, 0x89, 0x91,
+	0x91, 0x89, 0x0d, 0xa9, 0x03, 0x03, 0x7f, 0x42, 0xc7, 0x0a, 0x09, 0x09, 0x16, 0x84, 0xda, 0x21,
+	0xfc, 0x19, 0x28, 0xf6, 0x39, 0xb1, 0xe3, 0x8b, 0x64, 0x10, 0x43, 0x97, 0x28, 0xf7, 0xee, 0x7b,
+	0xef, 0x7d, 0x3f, 0x2f, 0xef, 0x2e, 0x60, 0x99, 0x1d, 0x63, 0xa7, 0xd5, 0xd3, 0x08, 0x35, 0xcc,
+	0x63, 0xa8, 0x75, 0x76, 0x34, 0xaf, 0xab, 0xba, 0x94, 0x78, 0xa4, 0xb0, 0x10, 0x6c, 0xa9, 0xc1,
+	0x96, 0xda, 0xd9, 0x91, 0x95, 0x84, 0x18, 0x41, 0x07, 0x32, 0xcc, 0x82, 0x13, 0xf2, 0xb2, 0x49,
+	0x98, 0x4d, 0x98, 0xee, 0xaf, 0xb4, 0x60, 0xc1, 0xb7, 0x6e, 0x04, 0x2b, 0xcd, 0x66, 0x68, 0x70,
+	0xce, 0x66, 0x88, 0x6f, 0xe4, 0x0d, 0x1b, 0x3b, 0x44, 0xf3, 0x3f, 0x79, 0x68, 0x09, 0x11, 0x44,
+	0x82, 0x1c, 0x83, 0x6f, 0x3c, 0xba, 0xce, 0x8b, 0x7b, 0x3d, 0x17, 0xb2, 0x41, 0x0e, 0xb3, 0x4d,
+	0x29, 0x74, 0xcc, 0x9e, 0xee, 0x1a, 0x98, 0x72, 0xd1, 0x46, 0xc2, 0xa1, 0x89, 0xa9, 0xd9, 0xc6,
+	0x9e, 0xde, 0xa4, 0xd0, 0x68, 0xc1, 0x50, 0x57, 0x4a, 0xe8, 0x98, 0x67, 0x1c, 0x0f, 0x60, 0xb8,
+	0xe1, 0xf2, 0x37, 0x09, 0x2c, 0xd6, 0x19, 0xda, 0xb3, 0xac, 0x7d, 0x5e, 0xe7, 0xc0, 0xc0, 0x94,
+	0x15, 0x1e, 0x80, 0x69, 0xa3, 0xed, 0x1d, 0x11, 0x8a, 0xbd, 0x5e, 0x51, 0x2a, 0x49, 0x9b, 0xd3,
+	0xb5, 0xe2, 0xd7, 0xcf, 0x5b, 0x4b, 0x9c, 0x76, 0xcf, 0xb2, 0x28, 0x64, 0xec, 0xd0, 0xa3, 0xd8,
+	0x41, 0x8d, 0x91, 0xb4, 0xf0, 0x1c, 0xcc, 0xc5, 0x0c, 0xb3, 0x62, 0xb6, 0x94, 0xdb, 0x9c, 0xd9,
+	0x5d, 0x55, 0x79, 0x9b, 0x7d, 0x2e, 0xb5, 0xb3, 0xa3, 0x46, 0xeb, 0xd5, 0xa6, 0x4e, 0x7f, 0xae,
+	0x65, 0x1a, 0xb3, 0x66, 0xd4, 0x43, 0xb5, 0xfa, 0xfb, 0xc3, 0x5a, 0xe6, 0x4d, 0xff, 0xa4, 0x32,
+	0xca, 0xff, 0xb6, 0x7f, 0x52, 0x09, 0x3b, 0xd4, 0x0d, 0xb1, 0x04, 0xfe, 0xcb, 0xab, 0x60, 0x45,
+	0x10, 0x6e, 0x40, 0xe6, 0x12, 0x87, 0xc1, 0xf2, 0x17, 0x09, 0x5c, 0xaf, 0x33, 0xd4, 0x80, 0x36,
+	0xe9, 0xc0, 0xff, 0x43, 0x5e, 0x01, 0xf9, 0x18, 0xb9, 0x8e, 0xad, 0x00, 0x7e, 0xba, 0x31, 0x1f,
+	0xe5, 0x7a, 0x66, 0xfd, 0x15, 0xd9, 0x21, 0xf4, 0xe2, 0x64, 0x25, 0xa0, 0x88, 0x9d, 0x0f, 0xe1,
+	0x3e, 0x66, 0xc1, 0x12, 0x3f, 0x19, 0x4c, 0x45, 0x2d, 0x18, 0x8a, 0x7f, 0x46, 0x7b, 0x0a, 0x66,
+	0x63, 0x68, 0xc5, 0x6c, 0x49, 0x4a, 0xfb, 0x9b, 0x5e, 0x8b, 0xb2, 0x17, 0x5e, 0x80, 0xf9, 0xb1,
+	0x49, 0x2d, 0xe6, 0xfc, 0x5c, 0x25, 0x75, 0xfc, 0x1a, 0xaa, 0x71, 0xf3, 0x3c, 0xdd, 0x9c, 0x19,
+	0x8b, 0x56, 0x1f, 0x89, 0x3b, 0x79, 0x7b, 0x42, 0x27, 0x63, 0x87, 0xcb, 0x0a, 0xb8, 0x29, 0x8a,
+	0x0f, 0x1b, 0xf9, 0x23, 0x9c, 0x12, 0x76, 0xf9, 0x5a, 0x59, 0x7d, 0x2c, 0x26, 0xdf, 0x10, 0x90,
+	0x0b, 0x00, 0x86, 0x63, 0xc4, 0x26, 0xd2, 0xbf, 0xcf, 0x82, 0x7c, 0xd0, 0x9e, 0xba, 0xd1, 0x3d,
+	0xa0, 0xd8, 0x84, 0x7b, 0x08, 0x5e, 0x82, 0x19, 0x7a, 0x02, 0x66, 0x6d, 0xa3, 0xab, 0xbb, 0x03,
+	0x47, 0xba, 0x81, 0x60, 0x31, 0x17, 0xcf, 0x34, 0x9a, 0xa0, 0x88, 0x6f, 0x9e, 0x69, 0xc6, 0x1e,
+	0x85, 0xaa, 0x0f, 0xc5, 0x1d, 0xbc, 0x25, 0x9e, 0x9d, 0x48, 0xb2, 0xf2, 0x0a, 0x58, 0x4e, 0x04,
+	0xc3, 0xbe, 0xed, 0x7e, 0x9a, 0x02, 0xb9, 0x3a, 0x43, 0x85, 0x23, 0xb0, 0x90, 0x78, 0x56, 0xef,
+	0x08, 0x4c, 0x26, 0x9f, 0x29, 0x79, 0x2b, 0x95, 0x2c, 0xac, 0x58, 0x78, 0x05, 0x16, 0x45, 0x2f,
+	0xd9, 0xa6, 0x30, 0x8b, 0x40, 0x29, 0x6f, 0xa7, 0x55, 0x0e, 0x4b, 0xb6, 0x40, 0x3e, 0xf9, 0xbe,
+	0x6c, 0x08, 0xd3, 0x24, 0x74, 0xb2, 0x9a, 0x4e, 0x17, 0xe7, 0x4b, 0xde, 0xc1, 0x49, 0x7c, 0x09,
+	0xa5, 0xbc, 0x9d, 0x56, 0x39, 0x2c, 0xd9, 0x04, 0x73, 0x63, 0x83, 0xbf, 0x3e, 0xc9, 0x74, 0x44,
+	0x24, 0xdf, 0x4b, 0x21, 0x0a, 0x6b, 0xc8, 0x57, 0x5e, 0xf7, 0x4f, 0x2a, 0x52, 0x6d, 0xff, 0xf4,
+	0x5c, 0x91, 0xce, 0xce, 0x15, 0xe9, 0xd7, 0xb9, 0x22, 0xbd, 0xbb, 0x50, 0x32, 0x67, 0x17, 0x4a,
+	0xe6, 0xfb, 0x85, 0x92, 0x79, 0x79, 0x17, 0x61, 0xef, 0xa8, 0xdd, 0x54, 0x4d, 0x62, 0x6b, 0xac,
+	0x85, 0xdd, 0x2d, 0x1b, 0x76, 0xb4, 0xf1, 0xe9, 0xf4, 0x2f, 0x4e, 0xf3, 0xaa, 0xff, 0x77, 0x7e,
+	0xff, 0xcf, 0x00, 0x44, 0xd9, 0x97, 0x0b, 0xe9, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -287,6 +608,17 @@ type MsgClient interface {
 	// given set of currency-pairs from the module's state. Thus these
 	// CurrencyPairs will no longer have price-data available from this module.
 	RemoveCurrencyPairs(ctx context.Context, in *MsgRemoveCurrencyPairs, opts ...grpc.CallOption) (*MsgRemoveCurrencyPairsResponse, error)
+	// SetCircuitBreaker will be used only by governance to set the
+	// CircuitBreaker of a CurrencyPair. A CircuitBreaker without any limits
+	// removes the CurrencyPair's CircuitBreaker.
+	SetCircuitBreaker(ctx context.Context, in *MsgSetCircuitBreaker, opts ...grpc.CallOption) (*MsgSetCircuitBreakerResponse, error)
+	// ResetCircuitBreaker will be used only by governance to resume price
+	// updates for a CurrencyPair halted by its CircuitBreaker.
+	ResetCircuitBreaker(ctx context.Context, in *MsgResetCircuitBreaker, opts ...grpc.CallOption) (*MsgResetCircuitBreakerResponse, error)
+	// SetMaxPriceAge will be used only by governance to set the MaxPriceAge of
+	// a CurrencyPair. A MaxPriceAge without any limits removes the
+	// CurrencyPair's MaxPriceAge.
+	SetMaxPriceAge(ctx context.Context, in *MsgSetMaxPriceAge, opts ...grpc.CallOption) (*MsgSetMaxPriceAgeResponse, error)
 }
 
 type msgClient struct {
@@ -315,6 +647,33 @@ func (c *msgClient) RemoveCurrencyPairs(ctx context.Context, in *MsgRemoveCurren
 	return out, nil
 }
 
+func (c *msgClient) SetCircuitBreaker(ctx context.Context, in *MsgSetCircuitBreaker, opts ...grpc.CallOption) (*MsgSetCircuitBreakerResponse, error) {
+	out := new(MsgSetCircuitBreakerResponse)
+	err := c.cc.Invoke(ctx, "/slinky.oracle.v1.Msg/SetCircuitBreaker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ResetCircuitBreaker(ctx context.Context, in *MsgResetCircuitBreaker, opts ...grpc.CallOption) (*MsgResetCircuitBreakerResponse, error) {
+	out := new(MsgResetCircuitBreakerResponse)
+	err := c.cc.Invoke(ctx, "/slinky.oracle.v1.Msg/ResetCircuitBreaker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetMaxPriceAge(ctx context.Context, in *MsgSetMaxPriceAge, opts ...grpc.CallOption) (*MsgSetMaxPriceAgeResponse, error) {
+	out := new(MsgSetMaxPriceAgeResponse)
+	err := c.cc.Invoke(ctx, "/slinky.oracle.v1.Msg/SetMaxPriceAge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// AddCurrencyPairs will be used only by governance to update the set of
@@ -325,6 +684,17 @@ type MsgServer interface {
 	// given set of currency-pairs from the module's state. Thus these
 	// CurrencyPairs will no longer have price-data available from this module.
 	RemoveCurrencyPairs(context.Context, *MsgRemoveCurrencyPairs) (*MsgRemoveCurrencyPairsResponse, error)
+	// SetCircuitBreaker will be used only by governance to set the
+	// CircuitBreaker of a CurrencyPair. A CircuitBreaker without any limits
+	// removes the CurrencyPair's CircuitBreaker.
+	SetCircuitBreaker(context.Context, *MsgSetCircuitBreaker) (*MsgSetCircuitBreakerResponse, error)
+	// ResetCircuitBreaker will be used only by governance to resume price
+	// updates for a CurrencyPair halted by its CircuitBreaker.
+	ResetCircuitBreaker(context.Context, *MsgResetCircuitBreaker) (*MsgResetCircuitBreakerResponse, error)
+	// SetMaxPriceAge will be used only by governance to set the MaxPriceAge of
+	// a CurrencyPair. A MaxPriceAge without any limits removes the
+	// CurrencyPair's MaxPriceAge.
+	SetMaxPriceAge(context.Context, *MsgSetMaxPriceAge) (*MsgSetMaxPriceAgeResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -337,6 +707,15 @@ func (*UnimplementedMsgServer) AddCurrencyPairs(ctx context.Context, req *MsgAdd
 func (*UnimplementedMsgServer) RemoveCurrencyPairs(ctx context.Context, req *MsgRemoveCurrencyPairs) (*MsgRemoveCurrencyPairsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveCurrencyPairs not implemented")
 }
+func (*UnimplementedMsgServer) SetCircuitBreaker(ctx context.Context, req *MsgSetCircuitBreaker) (*MsgSetCircuitBreakerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCircuitBreaker not implemented")
+}
+func (*UnimplementedMsgServer) ResetCircuitBreaker(ctx context.Context, req *MsgResetCircuitBreaker) (*MsgResetCircuitBreakerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetCircuitBreaker not implemented")
+}
+func (*UnimplementedMsgServer) SetMaxPriceAge(ctx context.Context, req *MsgSetMaxPriceAge) (*MsgSetMaxPriceAgeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMaxPriceAge not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -378,6 +757,60 @@ func _Msg_RemoveCurrencyPairs_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetCircuitBreaker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetCircuitBreaker)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetCircuitBreaker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/slinky.oracle.v1.Msg/SetCircuitBreaker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetCircuitBreaker(ctx, req.(*MsgSetCircuitBreaker))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResetCircuitBreaker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResetCircuitBreaker)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResetCircuitBreaker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/slinky.oracle.v1.Msg/ResetCircuitBreaker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResetCircuitBreaker(ctx, req.(*MsgResetCircuitBreaker))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetMaxPriceAge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetMaxPriceAge)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetMaxPriceAge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/slinky.oracle.v1.Msg/SetMaxPriceAge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetMaxPriceAge(ctx, req.(*MsgSetMaxPriceAge))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "slinky.oracle.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -390,6 +823,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveCurrencyPairs",
 			Handler:    _Msg_RemoveCurrencyPairs_Handler,
 		},
+		{
+			MethodName: "SetCircuitBreaker",
+			Handler:    _Msg_SetCircuitBreaker_Handler,
+		},
+		{
+			MethodName: "ResetCircuitBreaker",
+			Handler:    _Msg_ResetCircuitBreaker_Handler,
+		},
+		{
+			MethodName: "SetMaxPriceAge",
+			Handler:    _Msg_SetMaxPriceAge_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "slinky/oracle/v1/tx.proto",
@@ -491,113 +936,926 @@ func (m *MsgRemoveCurrencyPairs) MarshalToSizedBuffer(dAtA []byte) (int, error)
 			dAtA[i] = 0x12
 		}
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgRemoveCurrencyPairsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgRemoveCurrencyPairsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveCurrencyPairsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveCurrencyPairsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveCurrencyPairsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetCircuitBreaker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetCircuitBreaker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetCircuitBreaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CircuitBreaker.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.CurrencyPair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetCircuitBreakerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetCircuitBreakerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetCircuitBreakerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResetCircuitBreaker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResetCircuitBreaker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResetCircuitBreaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CurrencyPair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResetCircuitBreakerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResetCircuitBreakerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResetCircuitBreakerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetMaxPriceAge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMaxPriceAge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMaxPriceAge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MaxPriceAge.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.CurrencyPair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetMaxPriceAgeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMaxPriceAgeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMaxPriceAgeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgAddCurrencyPairs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.CurrencyPairs) > 0 {
+		for _, e := range m.CurrencyPairs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAddCurrencyPairsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveCurrencyPairs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.CurrencyPairIds) > 0 {
+		for _, s := range m.CurrencyPairIds {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRemoveCurrencyPairsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetCircuitBreaker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.CurrencyPair.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.CircuitBreaker.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetCircuitBreakerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgResetCircuitBreaker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.CurrencyPair.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgResetCircuitBreakerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetMaxPriceAge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.CurrencyPair.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MaxPriceAge.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetMaxPriceAgeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgAddCurrencyPairs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddCurrencyPairs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddCurrencyPairs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrencyPairs = append(m.CurrencyPairs, types.CurrencyPair{})
+			if err := m.CurrencyPairs[len(m.CurrencyPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddCurrencyPairsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddCurrencyPairsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddCurrencyPairsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveCurrencyPairs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveCurrencyPairs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveCurrencyPairs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPairIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrencyPairIds = append(m.CurrencyPairIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveCurrencyPairsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveCurrencyPairsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveCurrencyPairsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetCircuitBreaker) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetCircuitBreaker: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetCircuitBreaker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrencyPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreaker", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CircuitBreaker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgRemoveCurrencyPairsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *MsgAddCurrencyPairs) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.CurrencyPairs) > 0 {
-		for _, e := range m.CurrencyPairs {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+func (m *MsgSetCircuitBreakerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
 		}
-	}
-	return n
-}
-
-func (m *MsgAddCurrencyPairsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgRemoveCurrencyPairs) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.CurrencyPairIds) > 0 {
-		for _, s := range m.CurrencyPairIds {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetCircuitBreakerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetCircuitBreakerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *MsgRemoveCurrencyPairsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgAddCurrencyPairs) Unmarshal(dAtA []byte) error {
+func (m *MsgResetCircuitBreaker) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -620,10 +1878,10 @@ func (m *MsgAddCurrencyPairs) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddCurrencyPairs: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResetCircuitBreaker: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddCurrencyPairs: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResetCircuitBreaker: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -660,7 +1918,7 @@ func (m *MsgAddCurrencyPairs) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPairs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -687,8 +1945,7 @@ func (m *MsgAddCurrencyPairs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CurrencyPairs = append(m.CurrencyPairs, types.CurrencyPair{})
-			if err := m.CurrencyPairs[len(m.CurrencyPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CurrencyPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -713,7 +1970,7 @@ func (m *MsgAddCurrencyPairs) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddCurrencyPairsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgResetCircuitBreakerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -736,10 +1993,10 @@ func (m *MsgAddCurrencyPairsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddCurrencyPairsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResetCircuitBreakerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddCurrencyPairsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResetCircuitBreakerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -763,7 +2020,7 @@ func (m *MsgAddCurrencyPairsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveCurrencyPairs) Unmarshal(dAtA []byte) error {
+func (m *MsgSetMaxPriceAge) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -786,10 +2043,10 @@ func (m *MsgRemoveCurrencyPairs) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveCurrencyPairs: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetMaxPriceAge: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveCurrencyPairs: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetMaxPriceAge: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -826,9 +2083,9 @@ func (m *MsgRemoveCurrencyPairs) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPairIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -838,23 +2095,57 @@ func (m *MsgRemoveCurrencyPairs) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CurrencyPairIds = append(m.CurrencyPairIds, string(dAtA[iNdEx:postIndex]))
+			if err := m.CurrencyPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceAge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPriceAge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -877,7 +2168,7 @@ func (m *MsgRemoveCurrencyPairs) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveCurrencyPairsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetMaxPriceAgeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -900,10 +2191,10 @@ func (m *MsgRemoveCurrencyPairsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveCurrencyPairsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetMaxPriceAgeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveCurrencyPairsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetMaxPriceAgeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default: